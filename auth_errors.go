@@ -0,0 +1,60 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/security"
+)
+
+// ErrAuthenticationFailed reports that a daemon did not accept the client's
+// credentials, e.g. a missing, expired or untrusted token
+var ErrAuthenticationFailed = errors.New("authentication failed")
+
+// ErrNotAuthorized reports that the client authenticated but the daemon
+// denied it the command, e.g. a user without WRITE access submitting jobs.
+// Match it with errors.As.
+type ErrNotAuthorized struct {
+	Command int   // CEDAR command that was denied
+	Err     error // Underlying handshake error
+}
+
+func (e *ErrNotAuthorized) Error() string {
+	return fmt.Sprintf("not authorized for command %s (%d): %v", commands.GetCommandName(e.Command), e.Command, e.Err)
+}
+
+// Unwrap returns the underlying handshake error
+func (e *ErrNotAuthorized) Unwrap() error {
+	return e.Err
+}
+
+// classifyHandshakeError tells authorization failures (the daemon answered
+// the security handshake with DENIED) from authentication failures (the
+// credentials were not accepted) for a handshake for command, returning an
+// *ErrNotAuthorized or an error wrapping ErrAuthenticationFailed, so that
+// callers can tell them apart with errors.As and errors.Is. Other errors,
+// such as dropped connections, are returned unchanged.
+//
+// The cedar library reports handshake failures only as formatted errors,
+// so this is the one place their messages are matched.
+func classifyHandshakeError(err error, command int) error {
+	if errors.Is(err, security.ErrNetwork) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, ": DENIED"):
+		return &ErrNotAuthorized{Command: command, Err: err}
+	case strings.Contains(msg, "authentication phase failed"),
+		strings.Contains(msg, "security negotiation failed"),
+		strings.Contains(msg, "authentication failed"):
+		return fmt.Errorf("%w: %w", ErrAuthenticationFailed, err)
+	default:
+		return err
+	}
+}
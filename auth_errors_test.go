@@ -0,0 +1,116 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// scriptedHandshake answers DC_AUTHENTICATE with serverAd and, if
+// postAuthAd is set, the post-authentication ad
+func scriptedHandshake(serverAd, postAuthAd *classad.ClassAd) func(context.Context, *stream.Stream) error {
+	return func(ctx context.Context, s *stream.Stream) error {
+		req := message.NewMessageFromStream(s)
+		if cmd, err := req.GetInt(ctx); err != nil || cmd != commands.DC_AUTHENTICATE {
+			return fmt.Errorf("expected DC_AUTHENTICATE, got %d (%v)", cmd, err)
+		}
+		if _, err := req.GetClassAd(ctx); err != nil {
+			return fmt.Errorf("client security ad: %w", err)
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, serverAd) }); err != nil {
+			return err
+		}
+		if postAuthAd == nil {
+			return nil
+		}
+		return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, postAuthAd) })
+	}
+}
+
+func TestHandshakeFailureModes(t *testing.T) {
+	noAuthAd := classad.New()
+	_ = noAuthAd.Set("AuthMethods", "NONE")
+	_ = noAuthAd.Set("Authentication", "NO")
+	_ = noAuthAd.Set("Encryption", "NO")
+	_ = noAuthAd.Set("Integrity", "NO")
+
+	deniedAd := classad.New()
+	_ = deniedAd.Set("ReturnCode", "DENIED")
+	_ = deniedAd.Set("User", "alice@example.com")
+
+	// The server insists on a method the client does not offer
+	sslOnlyAd := classad.New()
+	_ = sslOnlyAd.Set("AuthMethods", "SSL")
+	_ = sslOnlyAd.Set("Authentication", "YES")
+	_ = sslOnlyAd.Set("Encryption", "NO")
+	_ = sslOnlyAd.Set("Integrity", "NO")
+
+	tests := []struct {
+		name              string
+		serve             func(context.Context, *stream.Stream) error
+		wantNotAuthorized bool
+	}{
+		{"authorization denied", scriptedHandshake(noAuthAd, deniedAd), true},
+		{"authentication failed", scriptedHandshake(sslOnlyAd, nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := newScriptedTransport(tt.serve)
+			schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			ctx = testSecurityContext(ctx)
+
+			_, err := schedd.Query(ctx, "true", nil)
+			<-transport.errCh
+			if err == nil {
+				t.Fatal("Expected the query to fail")
+			}
+
+			var notAuthorized *ErrNotAuthorized
+			isNotAuthorized := errors.As(err, &notAuthorized)
+			if isNotAuthorized != tt.wantNotAuthorized {
+				t.Errorf("Expected ErrNotAuthorized=%v, got %v", tt.wantNotAuthorized, err)
+			}
+			if isNotAuthorized && notAuthorized.Command != commands.QUERY_JOB_ADS {
+				t.Errorf("Expected denied command %d, got %d", commands.QUERY_JOB_ADS, notAuthorized.Command)
+			}
+			if got := errors.Is(err, ErrAuthenticationFailed); got == tt.wantNotAuthorized {
+				t.Errorf("Expected ErrAuthenticationFailed=%v, got %v", !tt.wantNotAuthorized, err)
+			}
+		})
+	}
+}
+
+func TestClassifyHandshakeError(t *testing.T) {
+	denied := classifyHandshakeError(errors.New("authentication failed: DENIED"), commands.QMGMT_WRITE_CMD)
+	var notAuthorized *ErrNotAuthorized
+	if !errors.As(denied, &notAuthorized) || notAuthorized.Command != commands.QMGMT_WRITE_CMD {
+		t.Errorf("Expected ErrNotAuthorized for QMGMT_WRITE_CMD, got %v", denied)
+	}
+
+	failed := classifyHandshakeError(errors.New("authentication phase failed: token expired"), commands.QMGMT_WRITE_CMD)
+	if !errors.Is(failed, ErrAuthenticationFailed) {
+		t.Errorf("Expected ErrAuthenticationFailed, got %v", failed)
+	}
+
+	network := fmt.Errorf("authentication failed: %w", security.ErrNetwork)
+	if got := classifyHandshakeError(network, commands.QMGMT_WRITE_CMD); got != network { //nolint:errorlint // identity check
+		t.Errorf("Expected network errors unchanged, got %v", got)
+	}
+
+	dropped := errors.New("failed to parse server response: EOF")
+	if got := classifyHandshakeError(dropped, commands.QMGMT_WRITE_CMD); got != dropped { //nolint:errorlint // identity check
+		t.Errorf("Expected other errors unchanged, got %v", got)
+	}
+}
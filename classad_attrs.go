@@ -0,0 +1,73 @@
+package htcondor
+
+import (
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// AdString evaluates attribute attr of ad and returns its string value. The
+// attribute may be a literal or an expression; it is evaluated with ad as its
+// scope, so references to other attributes of the ad resolve. The bool is
+// false if ad is nil, the attribute is missing, or it does not evaluate to a
+// string.
+//
+// Prefer this to Lookup(attr).String(), which yields the ClassAd text of the
+// expression (a quoted and escaped literal, or the unevaluated expression).
+func AdString(ad *classad.ClassAd, attr string) (string, bool) {
+	value, ok := evalAdAttr(ad, attr)
+	if !ok {
+		return "", false
+	}
+	s, err := value.StringValue()
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// AdInt evaluates attribute attr of ad and returns its integer value, like
+// AdString. A real value is truncated, as HTCondor does when it reads an
+// integer attribute.
+func AdInt(ad *classad.ClassAd, attr string) (int64, bool) {
+	value, ok := evalAdAttr(ad, attr)
+	if !ok {
+		return 0, false
+	}
+	if value.IsReal() {
+		r, err := value.RealValue()
+		if err != nil {
+			return 0, false
+		}
+		return int64(r), true
+	}
+	i, err := value.IntValue()
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// AdBool evaluates attribute attr of ad and returns its boolean value, like
+// AdString.
+func AdBool(ad *classad.ClassAd, attr string) (bool, bool) {
+	value, ok := evalAdAttr(ad, attr)
+	if !ok {
+		return false, false
+	}
+	b, err := value.BoolValue()
+	if err != nil {
+		return false, false
+	}
+	return b, true
+}
+
+// evalAdAttr looks up and evaluates an attribute in the scope of its ad
+func evalAdAttr(ad *classad.ClassAd, attr string) (classad.Value, bool) {
+	if ad == nil {
+		return classad.Value{}, false
+	}
+	expr, ok := ad.Lookup(attr)
+	if !ok {
+		return classad.Value{}, false
+	}
+	return expr.Eval(ad), true
+}
@@ -0,0 +1,80 @@
+package htcondor
+
+import (
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestAdAttributeHelpers(t *testing.T) {
+	ad, err := classad.Parse(`[
+		Name = "schedd@example.com";
+		Quoted = "say \"hi\"";
+		MyAddress = strcat("<", Host, ":9618>");
+		Host = "10.0.0.1";
+		Cpus = 4;
+		Memory = Cpus * 1024;
+		LoadAvg = 1.75;
+		Draining = false;
+		Idle = Cpus > 2;
+		Missing = Nope
+	]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ad: %v", err)
+	}
+
+	stringCases := []struct {
+		attr string
+		want string
+		ok   bool
+	}{
+		{"Name", "schedd@example.com", true},
+		{"Quoted", `say "hi"`, true},
+		{"MyAddress", "<10.0.0.1:9618>", true},
+		{"Cpus", "", false},
+		{"Missing", "", false},
+		{"Absent", "", false},
+	}
+	for _, tt := range stringCases {
+		if got, ok := AdString(ad, tt.attr); got != tt.want || ok != tt.ok {
+			t.Errorf("AdString(%s) = %q, %v; want %q, %v", tt.attr, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	intCases := []struct {
+		attr string
+		want int64
+		ok   bool
+	}{
+		{"Cpus", 4, true},
+		{"Memory", 4096, true},
+		{"LoadAvg", 1, true},
+		{"Name", 0, false},
+		{"Absent", 0, false},
+	}
+	for _, tt := range intCases {
+		if got, ok := AdInt(ad, tt.attr); got != tt.want || ok != tt.ok {
+			t.Errorf("AdInt(%s) = %d, %v; want %d, %v", tt.attr, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	boolCases := []struct {
+		attr string
+		want bool
+		ok   bool
+	}{
+		{"Draining", false, true},
+		{"Idle", true, true},
+		{"Cpus", false, false},
+		{"Absent", false, false},
+	}
+	for _, tt := range boolCases {
+		if got, ok := AdBool(ad, tt.attr); got != tt.want || ok != tt.ok {
+			t.Errorf("AdBool(%s) = %v, %v; want %v, %v", tt.attr, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if _, ok := AdString(nil, "Name"); ok {
+		t.Error("Expected AdString of a nil ad to fail")
+	}
+}
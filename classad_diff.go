@@ -0,0 +1,148 @@
+package htcondor
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// AdDiffKind describes how an attribute differs between two ads
+type AdDiffKind string
+
+const (
+	// AdDiffAdded means the attribute is only in the second ad
+	AdDiffAdded AdDiffKind = "added"
+	// AdDiffRemoved means the attribute is only in the first ad
+	AdDiffRemoved AdDiffKind = "removed"
+	// AdDiffChanged means the attribute is in both ads with different values
+	AdDiffChanged AdDiffKind = "changed"
+)
+
+// AdDiff is one attribute difference between two ClassAds.
+// Old and New hold the attribute's expression in each ad (empty when absent).
+type AdDiff struct {
+	Name string     `json:"name"`
+	Kind AdDiffKind `json:"kind"`
+	Old  string     `json:"old,omitempty"`
+	New  string     `json:"new,omitempty"`
+}
+
+// DiffAds returns the attributes that were added, removed or changed going
+// from a to b, sorted by attribute name. Attribute names are compared
+// case-insensitively, as in ClassAds.
+//
+// Values are compared after evaluation in their own ad, so the type matters
+// (1 differs from 1.0 and "1") but formatting does not. An expression whose
+// result changes because an attribute it references changed is reported too.
+// Expressions that do not evaluate to a value on their own (undefined or
+// error, e.g. Requirements referencing TARGET) are compared by their text.
+func DiffAds(a, b *classad.ClassAd) []AdDiff {
+	oldNames := adAttributeNames(a)
+	newNames := adAttributeNames(b)
+
+	keys := make([]string, 0, len(oldNames)+len(newNames))
+	for key := range oldNames {
+		keys = append(keys, key)
+	}
+	for key := range newNames {
+		if _, ok := oldNames[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var diffs []AdDiff
+	for _, key := range keys {
+		oldName, inOld := oldNames[key]
+		newName, inNew := newNames[key]
+		switch {
+		case !inNew:
+			diffs = append(diffs, AdDiff{Name: oldName, Kind: AdDiffRemoved, Old: adExprString(a, oldName)})
+		case !inOld:
+			diffs = append(diffs, AdDiff{Name: newName, Kind: AdDiffAdded, New: adExprString(b, newName)})
+		default:
+			oldExpr := adExprString(a, oldName)
+			newExpr := adExprString(b, newName)
+			oldVal := a.EvaluateAttr(oldName)
+			newVal := b.EvaluateAttr(newName)
+			var same bool
+			if isDefinedValue(oldVal) && isDefinedValue(newVal) {
+				same = valuesIdentical(oldVal, newVal)
+			} else {
+				same = oldExpr == newExpr
+			}
+			if !same {
+				diffs = append(diffs, AdDiff{Name: newName, Kind: AdDiffChanged, Old: oldExpr, New: newExpr})
+			}
+		}
+	}
+	return diffs
+}
+
+// adAttributeNames maps lowercased attribute names to their spelling in ad
+func adAttributeNames(ad *classad.ClassAd) map[string]string {
+	names := make(map[string]string)
+	if ad == nil {
+		return names
+	}
+	for _, name := range ad.GetAttributes() {
+		names[strings.ToLower(name)] = name
+	}
+	return names
+}
+
+// adExprString returns the unparsed expression of an attribute
+func adExprString(ad *classad.ClassAd, name string) string {
+	expr, ok := ad.Lookup(name)
+	if !ok {
+		return ""
+	}
+	return expr.String()
+}
+
+// isDefinedValue reports whether v is neither undefined nor error
+func isDefinedValue(v classad.Value) bool {
+	return !v.IsUndefined() && !v.IsError()
+}
+
+// valuesIdentical reports whether two values have the same type and value,
+// like the ClassAd =?= operator
+func valuesIdentical(a, b classad.Value) bool {
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch {
+	case a.IsBool():
+		x, _ := a.BoolValue()
+		y, _ := b.BoolValue()
+		return x == y
+	case a.IsInteger():
+		x, _ := a.IntValue()
+		y, _ := b.IntValue()
+		return x == y
+	case a.IsReal():
+		x, _ := a.RealValue()
+		y, _ := b.RealValue()
+		return x == y
+	case a.IsString():
+		x, _ := a.StringValue()
+		y, _ := b.StringValue()
+		return x == y
+	case a.IsList():
+		x, _ := a.ListValue()
+		y, _ := b.ListValue()
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !valuesIdentical(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// Nested ads (and undefined/error) compare by their printed form
+		return a.String() == b.String()
+	}
+}
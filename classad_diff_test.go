@@ -0,0 +1,112 @@
+package htcondor
+
+import (
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestDiffAdsAttributes(t *testing.T) {
+	before, err := classad.Parse(`[
+		ClusterId = 42;
+		JobStatus = 1;
+		RequestMemory = 1024;
+		RequestDisk = 2 * 1024;
+		Cpus = 1;
+		Owner = "alice";
+		HoldReason = "none";
+		Requirements = TARGET.Memory >= RequestMemory;
+		Rank = TARGET.Mips
+	]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ClassAd: %v", err)
+	}
+	after, err := classad.Parse(`[
+		ClusterId = 42;
+		JobStatus = 2;
+		RequestMemory = 2048;
+		RequestDisk = 2048;
+		Cpus = 1.0;
+		owner = "alice";
+		Requirements = TARGET.Memory >= RequestMemory && TARGET.Disk >= RequestDisk;
+		Rank = TARGET.Mips;
+		RemoteHost = "slot1@node1"
+	]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ClassAd: %v", err)
+	}
+
+	diffs := DiffAds(before, after)
+	got := make(map[string]AdDiff)
+	var order []string
+	for _, d := range diffs {
+		got[d.Name] = d
+		order = append(order, d.Name)
+	}
+
+	expected := []AdDiff{
+		{Name: "Cpus", Kind: AdDiffChanged, Old: "1", New: "1.0"},
+		{Name: "HoldReason", Kind: AdDiffRemoved, Old: `"none"`},
+		{Name: "JobStatus", Kind: AdDiffChanged, Old: "1", New: "2"},
+		{Name: "RemoteHost", Kind: AdDiffAdded, New: `"slot1@node1"`},
+		{Name: "RequestMemory", Kind: AdDiffChanged, Old: "1024", New: "2048"},
+		{Name: "Requirements", Kind: AdDiffChanged},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("Expected %d diffs, got %d: %+v", len(expected), len(diffs), diffs)
+	}
+	for i, want := range expected {
+		if order[i] != want.Name {
+			t.Errorf("Diff %d: expected %s, got %s (diffs must be sorted)", i, want.Name, order[i])
+		}
+		d, ok := got[want.Name]
+		if !ok {
+			t.Errorf("Missing diff for %s", want.Name)
+			continue
+		}
+		if d.Kind != want.Kind {
+			t.Errorf("%s: expected kind %s, got %s", want.Name, want.Kind, d.Kind)
+		}
+		if want.Name == "Requirements" || want.Name == "Cpus" {
+			// Only check that both sides are reported; formatting is the library's
+			if d.Old == "" || d.New == "" {
+				t.Errorf("%s: expected old and new expressions, got %+v", want.Name, d)
+			}
+			continue
+		}
+		if d.Old != want.Old || d.New != want.New {
+			t.Errorf("%s: expected old=%q new=%q, got old=%q new=%q", want.Name, want.Old, want.New, d.Old, d.New)
+		}
+	}
+
+	// Same value written differently, same name in a different case, and an
+	// unchanged unevaluable expression are not differences
+	for _, name := range []string{"RequestDisk", "Owner", "owner", "Rank", "ClusterId"} {
+		if _, ok := got[name]; ok {
+			t.Errorf("Did not expect a diff for %s", name)
+		}
+	}
+
+	if diffs := DiffAds(before, before); len(diffs) != 0 {
+		t.Errorf("Expected no diffs comparing an ad to itself, got %+v", diffs)
+	}
+}
+
+func TestDiffAdsDependentExpression(t *testing.T) {
+	before, err := classad.Parse(`[A = 1; B = 2; Total = A + B]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ClassAd: %v", err)
+	}
+	after, err := classad.Parse(`[A = 5; B = 2; Total = A + B]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ClassAd: %v", err)
+	}
+
+	diffs := DiffAds(before, after)
+	if len(diffs) != 2 || diffs[0].Name != "A" || diffs[1].Name != "Total" {
+		t.Fatalf("Expected diffs for A and Total, got %+v", diffs)
+	}
+	if diffs[1].Kind != AdDiffChanged || diffs[1].Old != diffs[1].New {
+		t.Errorf("Expected Total reported as changed with the same expression, got %+v", diffs[1])
+	}
+}
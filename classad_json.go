@@ -0,0 +1,67 @@
+package htcondor
+
+import (
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/ast"
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// AdToJSON converts a ClassAd to a map in the layout of its JSON encoding
+// (ClassAd.MarshalJSON), as the HTTP API returns ads: literals become JSON
+// values (int64, float64, string, bool, nil for undefined), lists become
+// slices and nested records maps, and any other expression becomes the
+// string "/Expr(<expression>)/".
+func AdToJSON(ad *classad.ClassAd) (map[string]interface{}, error) {
+	record, err := adToAST(ad)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{}, len(record.Attributes))
+	for _, attr := range record.Attributes {
+		value := attr.Value
+		// The unparsed form prints whole-valued reals like integers
+		if lit, ok := value.(*ast.IntegerLiteral); ok && ad.EvaluateAttr(attr.Name).IsReal() {
+			value = &ast.RealLiteral{Value: float64(lit.Value)}
+		}
+		result[attr.Name] = jsonValue(value)
+	}
+	return result, nil
+}
+
+// jsonValue converts a single value for AdToJSON
+func jsonValue(expr ast.Expr) interface{} {
+	switch v := expr.(type) {
+	case *ast.IntegerLiteral:
+		return v.Value
+	case *ast.RealLiteral:
+		return v.Value
+	case *ast.StringLiteral:
+		return v.Value
+	case *ast.BooleanLiteral:
+		return v.Value
+	case *ast.UndefinedLiteral:
+		return nil
+	case *ast.ListLiteral:
+		list := make([]interface{}, len(v.Elements))
+		for i, elem := range v.Elements {
+			list[i] = jsonValue(elem)
+		}
+		return list
+	case *ast.RecordLiteral:
+		return jsonRecord(v.ClassAd)
+	case *ast.ClassAd:
+		return jsonRecord(v)
+	default:
+		return fmt.Sprintf("/Expr(%s)/", expr.String())
+	}
+}
+
+// jsonRecord converts a nested record for AdToJSON
+func jsonRecord(record *ast.ClassAd) map[string]interface{} {
+	result := make(map[string]interface{}, len(record.Attributes))
+	for _, attr := range record.Attributes {
+		result[attr.Name] = jsonValue(attr.Value)
+	}
+	return result
+}
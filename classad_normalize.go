@@ -0,0 +1,19 @@
+package htcondor
+
+import (
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// NormalizeExpression parses a ClassAd expression and re-serializes it in
+// canonical form, so equivalent spellings such as "Memory>1024" and
+// "((Memory > 1024))" produce the same string. Spacing is normalized and
+// every binary operation is parenthesized exactly once.
+func NormalizeExpression(expr string) (string, error) {
+	parsed, err := classad.ParseExpr(expr)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse expression %q: %w", expr, err)
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,79 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeExpression(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs []string
+		want   string
+	}{
+		{
+			name:   "spacing and redundant parentheses",
+			inputs: []string{"Memory>1024", "(Memory > 1024)", "((Memory   >1024))"},
+			want:   "(Memory > 1024)",
+		},
+		{
+			name:   "precedence made explicit",
+			inputs: []string{"a && b || c", "(a && b) || c", "((a)&&(b))||(c)"},
+			want:   "((a && b) || c)",
+		},
+		{
+			name:   "scoped attributes",
+			inputs: []string{"TARGET.Memory>=MY.RequestMemory", "(TARGET.Memory >= MY.RequestMemory)"},
+			want:   "(TARGET.Memory >= MY.RequestMemory)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, input := range tt.inputs {
+				got, err := NormalizeExpression(input)
+				if err != nil {
+					t.Fatalf("NormalizeExpression(%q) failed: %v", input, err)
+				}
+				if got != tt.want {
+					t.Errorf("NormalizeExpression(%q) = %q, want %q", input, got, tt.want)
+				}
+			}
+		})
+	}
+
+	if _, err := NormalizeExpression("Memory >"); err == nil {
+		t.Error("Expected error for invalid expression")
+	}
+}
+
+func TestRequirementsNormalized(t *testing.T) {
+	var canonical string
+	for _, req := range []string{`OpSys=="LINUX"&&Memory>1024`, `((OpSys == "LINUX") && (Memory > 1024))`} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\nrequirements = " + req + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+		if err != nil {
+			t.Fatalf("Failed to create job ad: %v", err)
+		}
+		reqExpr, ok := ad.Lookup("Requirements")
+		if !ok {
+			t.Fatal("Expected Requirements attribute")
+		}
+		if canonical == "" {
+			canonical = reqExpr.String()
+		} else if got := reqExpr.String(); got != canonical {
+			t.Errorf("Equivalent requirements produced %q and %q", canonical, got)
+		}
+	}
+
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\nrequirements = Memory >\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil); err == nil {
+		t.Error("Expected error for invalid requirements")
+	}
+}
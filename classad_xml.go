@@ -0,0 +1,280 @@
+package htcondor
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/ast"
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/PelicanPlatform/classad/parser"
+)
+
+// ClassAd XML format, as produced by condor_q -xml and condor_status -xml.
+//
+// Each ad is a <c> element holding one <a n="Name"> element per attribute.
+// Literal values are typed (<i>, <r>, <s>, <b v="t"/>, <un/>, <er/>, <l> for
+// lists and a nested <c> for records); anything else is written as the
+// unparsed expression in an <e> element.
+const (
+	xmlHeader  = "<?xml version=\"1.0\"?>\n<!DOCTYPE classads SYSTEM \"classads.dtd\">\n"
+	xmlIndent  = "    "
+	xmlRootTag = "classads"
+)
+
+// AdToXML renders a single ClassAd as an HTCondor ClassAd XML document
+func AdToXML(ad *classad.ClassAd) ([]byte, error) {
+	return AdsToXML([]*classad.ClassAd{ad})
+}
+
+// AdsToXML renders ClassAds as one HTCondor ClassAd XML document, in the
+// same layout as condor_q -xml
+func AdsToXML(ads []*classad.ClassAd) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xmlHeader)
+	buf.WriteString("<" + xmlRootTag + ">\n")
+	for _, ad := range ads {
+		node, err := adToAST(ad)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeXMLRecord(&buf, node, ad, ""); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("</" + xmlRootTag + ">\n")
+	return buf.Bytes(), nil
+}
+
+// adToAST returns the parsed form of ad, so attribute values can be inspected
+func adToAST(ad *classad.ClassAd) (*ast.ClassAd, error) {
+	if ad == nil {
+		return &ast.ClassAd{}, nil
+	}
+	node, err := parser.Parse(ad.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ClassAd: %w", err)
+	}
+	record, ok := node.(*ast.ClassAd)
+	if !ok {
+		return nil, fmt.Errorf("unexpected ClassAd representation %T", node)
+	}
+	return record, nil
+}
+
+// writeXMLRecord writes a <c> element for a ClassAd or nested record.
+// ad is the top-level ClassAd (nil for nested records); the unparsed form
+// prints whole-valued reals like integers, so ad is consulted to keep them real.
+func writeXMLRecord(buf *bytes.Buffer, record *ast.ClassAd, ad *classad.ClassAd, indent string) error {
+	buf.WriteString(indent + "<c>\n")
+	for _, attr := range record.Attributes {
+		value := attr.Value
+		if lit, ok := value.(*ast.IntegerLiteral); ok && ad != nil && ad.EvaluateAttr(attr.Name).IsReal() {
+			value = &ast.RealLiteral{Value: float64(lit.Value)}
+		}
+		buf.WriteString(indent + xmlIndent + `<a n="`)
+		if err := xml.EscapeText(buf, []byte(attr.Name)); err != nil {
+			return err
+		}
+		buf.WriteString(`">`)
+		if err := writeXMLValue(buf, value, indent+xmlIndent); err != nil {
+			return fmt.Errorf("attribute %s: %w", attr.Name, err)
+		}
+		buf.WriteString("</a>\n")
+	}
+	buf.WriteString(indent + "</c>")
+	if indent == "" {
+		buf.WriteString("\n")
+	}
+	return nil
+}
+
+// writeXMLValue writes the typed element for a single value
+func writeXMLValue(buf *bytes.Buffer, expr ast.Expr, indent string) error {
+	switch v := expr.(type) {
+	case *ast.IntegerLiteral:
+		buf.WriteString("<i>" + strconv.FormatInt(v.Value, 10) + "</i>")
+	case *ast.RealLiteral:
+		buf.WriteString("<r>" + formatXMLReal(v.Value) + "</r>")
+	case *ast.StringLiteral:
+		buf.WriteString("<s>")
+		if err := xml.EscapeText(buf, []byte(v.Value)); err != nil {
+			return err
+		}
+		buf.WriteString("</s>")
+	case *ast.BooleanLiteral:
+		if v.Value {
+			buf.WriteString(`<b v="t"/>`)
+		} else {
+			buf.WriteString(`<b v="f"/>`)
+		}
+	case *ast.UndefinedLiteral:
+		buf.WriteString("<un/>")
+	case *ast.ErrorLiteral:
+		buf.WriteString("<er/>")
+	case *ast.ListLiteral:
+		buf.WriteString("<l>")
+		for _, elem := range v.Elements {
+			if err := writeXMLValue(buf, elem, indent); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("</l>")
+	case *ast.RecordLiteral:
+		buf.WriteString("\n")
+		if err := writeXMLRecord(buf, v.ClassAd, nil, indent+xmlIndent); err != nil {
+			return err
+		}
+		buf.WriteString("\n" + indent)
+	case *ast.ClassAd:
+		buf.WriteString("\n")
+		if err := writeXMLRecord(buf, v, nil, indent+xmlIndent); err != nil {
+			return err
+		}
+		buf.WriteString("\n" + indent)
+	default:
+		buf.WriteString("<e>")
+		if err := xml.EscapeText(buf, []byte(expr.String())); err != nil {
+			return err
+		}
+		buf.WriteString("</e>")
+	}
+	return nil
+}
+
+// formatXMLReal formats a real so it always reads back as a real
+func formatXMLReal(v float64) string {
+	s := strconv.FormatFloat(v, 'G', -1, 64)
+	if !strings.ContainsAny(s, ".EIN") {
+		s += ".0"
+	}
+	return s
+}
+
+// xmlNode is a generic element used to decode ClassAd XML
+type xmlNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []xmlNode  `xml:",any"`
+}
+
+// attr returns the value of the named XML attribute
+func (n *xmlNode) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// AdsFromXML parses an HTCondor ClassAd XML document, such as the output of
+// condor_q -xml or AdsToXML
+func AdsFromXML(data []byte) ([]*classad.ClassAd, error) {
+	var root xmlNode
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse ClassAd XML: %w", err)
+	}
+	if root.XMLName.Local != xmlRootTag {
+		return nil, fmt.Errorf("unexpected root element <%s>, expected <%s>", root.XMLName.Local, xmlRootTag)
+	}
+
+	ads := make([]*classad.ClassAd, 0, len(root.Nodes))
+	for i := range root.Nodes {
+		node := &root.Nodes[i]
+		if node.XMLName.Local != "c" {
+			return nil, fmt.Errorf("unexpected element <%s> in <%s>", node.XMLName.Local, xmlRootTag)
+		}
+		record, err := xmlToRecord(node)
+		if err != nil {
+			return nil, err
+		}
+		ad := classad.New()
+		for _, attr := range record.Attributes {
+			ad.Insert(attr.Name, attr.Value)
+		}
+		ads = append(ads, ad)
+	}
+	return ads, nil
+}
+
+// xmlToRecord converts a <c> element to a ClassAd record
+func xmlToRecord(node *xmlNode) (*ast.ClassAd, error) {
+	record := &ast.ClassAd{}
+	for i := range node.Nodes {
+		a := &node.Nodes[i]
+		if a.XMLName.Local != "a" {
+			return nil, fmt.Errorf("unexpected element <%s> in <c>", a.XMLName.Local)
+		}
+		name := a.attr("n")
+		if name == "" {
+			return nil, fmt.Errorf("attribute element without a name")
+		}
+		if len(a.Nodes) != 1 {
+			return nil, fmt.Errorf("attribute %s: expected one value, got %d", name, len(a.Nodes))
+		}
+		value, err := xmlToValue(&a.Nodes[0])
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		record.Attributes = append(record.Attributes, &ast.AttributeAssignment{Name: name, Value: value})
+	}
+	return record, nil
+}
+
+// xmlToValue converts a typed value element to an expression
+func xmlToValue(node *xmlNode) (ast.Expr, error) {
+	switch node.XMLName.Local {
+	case "i":
+		v, err := strconv.ParseInt(strings.TrimSpace(node.Text), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", node.Text)
+		}
+		return &ast.IntegerLiteral{Value: v}, nil
+	case "r":
+		v, err := strconv.ParseFloat(strings.TrimSpace(node.Text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid real %q", node.Text)
+		}
+		return &ast.RealLiteral{Value: v}, nil
+	case "s":
+		return &ast.StringLiteral{Value: node.Text}, nil
+	case "b":
+		return &ast.BooleanLiteral{Value: node.attr("v") == "t"}, nil
+	case "un":
+		return &ast.UndefinedLiteral{}, nil
+	case "er":
+		return &ast.ErrorLiteral{}, nil
+	case "l":
+		list := &ast.ListLiteral{}
+		for i := range node.Nodes {
+			elem, err := xmlToValue(&node.Nodes[i])
+			if err != nil {
+				return nil, err
+			}
+			list.Elements = append(list.Elements, elem)
+		}
+		return list, nil
+	case "c":
+		record, err := xmlToRecord(node)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.RecordLiteral{ClassAd: record}, nil
+	case "e":
+		parsed, err := parser.Parse(fmt.Sprintf("[__expr__ = %s]", node.Text))
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression %q: %w", node.Text, err)
+		}
+		record, ok := parsed.(*ast.ClassAd)
+		if !ok || len(record.Attributes) != 1 {
+			return nil, fmt.Errorf("invalid expression %q", node.Text)
+		}
+		return record.Attributes[0].Value, nil
+	default:
+		return nil, fmt.Errorf("unsupported value element <%s>", node.XMLName.Local)
+	}
+}
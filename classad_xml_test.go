@@ -0,0 +1,103 @@
+package htcondor
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestAdToXMLRoundTrip(t *testing.T) {
+	ad, err := classad.Parse(`[
+		MyType = "Job";
+		ClusterId = 42;
+		Rank = 1.5;
+		Cpus = 2.0;
+		Cmd = "/bin/echo <a & b>";
+		WantCheckpoint = false;
+		OnExitRemove = true;
+		Missing = undefined;
+		Broken = error;
+		Requirements = TARGET.Memory >= RequestMemory && TARGET.OpSys == "LINUX";
+		Nums = {1, 2, 3};
+		Nested = [Inner = "x"; Count = 7]
+	]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ClassAd: %v", err)
+	}
+
+	data, err := AdToXML(ad)
+	if err != nil {
+		t.Fatalf("AdToXML failed: %v", err)
+	}
+
+	// The document must be well-formed XML
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		if _, err := decoder.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Generated XML is not well-formed: %v\n%s", err, data)
+		}
+	}
+	for _, want := range []string{
+		`<a n="ClusterId"><i>42</i></a>`,
+		`<a n="Cpus"><r>2.0</r></a>`,
+		`<a n="Cmd"><s>/bin/echo &lt;a &amp; b&gt;</s></a>`,
+		`<a n="OnExitRemove"><b v="t"/></a>`,
+		`<a n="Missing"><un/></a>`,
+		`<a n="Nums"><l><i>1</i><i>2</i><i>3</i></l></a>`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected XML to contain %s\n%s", want, data)
+		}
+	}
+
+	ads, err := AdsFromXML(data)
+	if err != nil {
+		t.Fatalf("AdsFromXML failed: %v\n%s", err, data)
+	}
+	if len(ads) != 1 {
+		t.Fatalf("Expected 1 ad, got %d", len(ads))
+	}
+	if got, want := ads[0].String(), ad.String(); got != want {
+		t.Errorf("Round trip mismatch:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestAdsFromXMLCondorOutput(t *testing.T) {
+	// Trimmed condor_q -xml output
+	data := `<?xml version="1.0"?>
+<!DOCTYPE classads SYSTEM "classads.dtd">
+<classads>
+<c>
+    <a n="ClusterId"><i>1</i></a>
+    <a n="Owner"><s>alice</s></a>
+</c>
+<c>
+    <a n="ClusterId"><i>2</i></a>
+    <a n="JobPrio"><e>0 + 1</e></a>
+</c>
+</classads>
+`
+	ads, err := AdsFromXML([]byte(data))
+	if err != nil {
+		t.Fatalf("AdsFromXML failed: %v", err)
+	}
+	if len(ads) != 2 {
+		t.Fatalf("Expected 2 ads, got %d", len(ads))
+	}
+	if owner, _ := ads[0].EvaluateAttrString("Owner"); owner != "alice" {
+		t.Errorf("Expected Owner alice, got %q", owner)
+	}
+	if prio, _ := ads[1].EvaluateAttrInt("JobPrio"); prio != 1 {
+		t.Errorf("Expected JobPrio to evaluate to 1, got %d", prio)
+	}
+
+	if _, err := AdsFromXML([]byte("<classads><c><a n=\"x\"><q/></a></c></classads>")); err == nil {
+		t.Error("Expected error for unknown value element")
+	}
+}
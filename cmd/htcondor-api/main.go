@@ -12,6 +12,9 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -20,6 +23,7 @@ import (
 	"github.com/bbockelm/golang-htcondor/config"
 	"github.com/bbockelm/golang-htcondor/httpserver"
 	"github.com/bbockelm/golang-htcondor/logging"
+	"github.com/bbockelm/golang-htcondor/ratelimit"
 )
 
 var (
@@ -74,8 +78,10 @@ func fixConfigDefaults(cfg *config.Config, debug bool) {
 			if debug {
 				log.Println("DEBUG: condor user does not exist, setting LOCAL_DIR to /usr and LOG to /var/log/condor")
 			}
-			cfg.Set("LOCAL_DIR", "/usr")
-			cfg.Set("LOG", "/var/log/condor")
+			cfg.Apply(map[string]string{
+				"LOCAL_DIR": "/usr",
+				"LOG":       "/var/log/condor",
+			})
 		}
 	}
 }
@@ -228,23 +234,13 @@ func discoverSchedd(cfg *config.Config, collector *htcondor.Collector, logger *l
 			scheddAd := schedds[0]
 
 			// Extract MyAddress
-			if myAddr, ok := scheddAd.Lookup("MyAddress"); ok {
-				addrStr := myAddr.String()
-				// Remove quotes if present
-				addrStr = strings.Trim(addrStr, "\"")
-				if addrStr != "" {
-					addr = addrStr
-				}
+			if addrStr, ok := htcondor.AdString(scheddAd, "MyAddress"); ok && addrStr != "" {
+				addr = addrStr
 			}
 
 			// Extract Name
-			if nameExpr, ok := scheddAd.Lookup("Name"); ok {
-				nameStr := nameExpr.String()
-				// Remove quotes if present
-				nameStr = strings.Trim(nameStr, "\"")
-				if nameStr != "" {
-					name = nameStr
-				}
+			if nameStr, ok := htcondor.AdString(scheddAd, "Name"); ok && nameStr != "" {
+				name = nameStr
 			}
 
 			if addr != "" {
@@ -495,6 +491,9 @@ func loadConfigWithDefaults() *config.Config {
 		log.Println("Proceeding with minimal configuration...")
 		cfg = config.NewEmpty()
 	}
+	for _, loadErr := range cfg.LoadErrors() {
+		log.Printf("Warning: %v; skipping", loadErr)
+	}
 
 	// Fix TILDE and LOCAL_DIR defaults if needed
 	// Enable debug messages in development (can be controlled by env var if needed)
@@ -574,6 +573,251 @@ func getUserHeaderConfig(cfg *config.Config) (userHeaderFromConfig, uidDomain, t
 	return userHeaderFromConfig, uidDomain, trustDomain
 }
 
+// getHardeningConfig reads request header limits and slow-client deadlines.
+// Zero values leave the server defaults in place.
+func getHardeningConfig(cfg *config.Config) (readHeaderTimeout, transferTimeout time.Duration, maxHeaderBytes int) {
+	if timeoutStr, ok := cfg.Get("HTTP_API_READ_HEADER_TIMEOUT"); ok {
+		if duration, err := time.ParseDuration(timeoutStr); err == nil {
+			readHeaderTimeout = duration
+		} else {
+			log.Printf("Warning: failed to parse HTTP_API_READ_HEADER_TIMEOUT '%s', using default: %v", timeoutStr, err)
+		}
+	}
+
+	if timeoutStr, ok := cfg.Get("HTTP_API_TRANSFER_TIMEOUT"); ok {
+		if duration, err := time.ParseDuration(timeoutStr); err == nil {
+			transferTimeout = duration
+		} else {
+			log.Printf("Warning: failed to parse HTTP_API_TRANSFER_TIMEOUT '%s', using default: %v", timeoutStr, err)
+		}
+	}
+
+	if sizeStr, ok := cfg.Get("HTTP_API_MAX_HEADER_BYTES"); ok {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			maxHeaderBytes = size
+		} else {
+			log.Printf("Warning: failed to parse HTTP_API_MAX_HEADER_BYTES '%s', using default", sizeStr)
+		}
+	}
+
+	return readHeaderTimeout, transferTimeout, maxHeaderBytes
+}
+
+// getCLIFallbackConfig reads whether the condor_q fallback is enabled and which binary to use
+func getCLIFallbackConfig(cfg *config.Config) (allowCLIFallback bool, condorQPath string) {
+	if allow, ok := cfg.Get("HTTP_API_ALLOW_CLI_FALLBACK"); ok && allow == "true" {
+		allowCLIFallback = true
+		log.Println("condor_q CLI fallback enabled via configuration")
+	}
+	if path, ok := cfg.Get("HTTP_API_CONDOR_Q"); ok && path != "" {
+		condorQPath = path
+	}
+	return allowCLIFallback, condorQPath
+}
+
+// getScheddsConfig reads the further schedds requests may select from
+// HTTP_API_SCHEDDS, a comma-separated list of "name" or "name=address"
+// entries; schedds without an address are discovered from the collector
+func getScheddsConfig(cfg *config.Config) map[string]string {
+	entries := getListConfig(cfg, "HTTP_API_SCHEDDS")
+	if entries == nil {
+		return nil
+	}
+	schedds := make(map[string]string)
+	for _, entry := range entries {
+		name, addr, _ := strings.Cut(entry, "=")
+		schedds[strings.TrimSpace(name)] = strings.TrimSpace(addr)
+	}
+	return schedds
+}
+
+// getScheddProxyConfig reads HTTP_API_SCHEDD_PROXY, the URL of the proxy
+// schedd connections are tunneled through
+func getScheddProxyConfig(cfg *config.Config) string {
+	proxyURL, _ := cfg.Get("HTTP_API_SCHEDD_PROXY")
+	return strings.TrimSpace(proxyURL)
+}
+
+// getOpenAPIVersionConfig reads HTTP_API_OPENAPI_VERSION, the OpenAPI
+// version /openapi.json is served in ("3.0" or "3.1")
+func getOpenAPIVersionConfig(cfg *config.Config) string {
+	version, _ := cfg.Get("HTTP_API_OPENAPI_VERSION")
+	return strings.TrimSpace(version)
+}
+
+// getReadOnlyConfig reads whether the server starts in read-only mode
+func getReadOnlyConfig(cfg *config.Config) bool {
+	if value, ok := cfg.Get("HTTP_API_READ_ONLY"); ok && value == "true" {
+		log.Println("Starting in read-only mode via configuration")
+		return true
+	}
+	return false
+}
+
+// getListConfig reads a comma-separated list setting, dropping empty
+// entries. It returns nil if the setting is unset, and an empty list if it
+// is set but empty.
+func getListConfig(cfg *config.Config, name string) []string {
+	list, ok := cfg.Get(name)
+	if !ok {
+		return nil
+	}
+	entries := []string{}
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// getRedactConfig reads HTTP_API_REDACT_ATTRIBUTES, a comma-separated list of
+// attributes to strip from returned ads. Unset keeps the default list; set
+// but empty disables redaction.
+func getRedactConfig(cfg *config.Config) []string {
+	return getListConfig(cfg, "HTTP_API_REDACT_ATTRIBUTES")
+}
+
+// getDefaultJobProjectionConfig reads HTTP_API_DEFAULT_JOB_PROJECTION, a
+// comma-separated list of the attributes job lists return by default
+func getDefaultJobProjectionConfig(cfg *config.Config) []string {
+	return getListConfig(cfg, "HTTP_API_DEFAULT_JOB_PROJECTION")
+}
+
+// getAdminUsersConfig reads HTTP_API_ADMIN_USERS, a comma-separated list of
+// users who may list and cancel every user's transfers
+func getAdminUsersConfig(cfg *config.Config) []string {
+	return getListConfig(cfg, "HTTP_API_ADMIN_USERS")
+}
+
+// getOAuthServicesConfig returns the OAuth services submitted jobs may
+// request: HTTP_API_OAUTH_SERVICES if set, or else the services the pool's
+// credmon is configured for, those with a <SERVICE>_CLIENT_ID and the local
+// issuer named by LOCAL_CREDMON_PROVIDER_NAME
+func getOAuthServicesConfig(cfg *config.Config) []string {
+	if services := getListConfig(cfg, "HTTP_API_OAUTH_SERVICES"); services != nil {
+		return services
+	}
+	services := []string{}
+	for _, key := range cfg.Keys() {
+		upper := strings.ToUpper(key)
+		if strings.HasPrefix(upper, "HTTP_API_") {
+			continue
+		}
+		service, ok := strings.CutSuffix(upper, "_CLIENT_ID")
+		if !ok || service == "" {
+			continue
+		}
+		if clientID, _ := cfg.Get(key); clientID != "" {
+			services = append(services, strings.ToLower(service))
+		}
+	}
+	if provider, ok := cfg.Get("LOCAL_CREDMON_PROVIDER_NAME"); ok && provider != "" {
+		services = append(services, provider)
+	}
+	sort.Strings(services)
+	return slices.Compact(services)
+}
+
+// getJobLeaseDurationConfig reads HTTP_API_JOB_LEASE_DURATION, the lease of
+// submitted jobs that set no job_lease_duration; invalid or negative values
+// keep the default
+func getJobLeaseDurationConfig(cfg *config.Config) time.Duration {
+	leaseStr, ok := cfg.Get("HTTP_API_JOB_LEASE_DURATION")
+	if !ok {
+		return 0
+	}
+	duration, err := time.ParseDuration(leaseStr)
+	if err != nil || duration < 0 {
+		log.Printf("Warning: invalid HTTP_API_JOB_LEASE_DURATION '%s', using default %v", leaseStr, htcondor.DefaultJobLeaseDuration)
+		return 0
+	}
+	return duration
+}
+
+// getLogVerbosityConfig reads LOG_VERBOSITY, ignoring values the logger
+// does not understand
+func getLogVerbosityConfig(cfg *config.Config) string {
+	verbosity, ok := cfg.Get("LOG_VERBOSITY")
+	if !ok {
+		return ""
+	}
+	if _, err := logging.ParseVerbosity(verbosity); err != nil {
+		log.Printf("Warning: %v, keeping the default", err)
+		return ""
+	}
+	return verbosity
+}
+
+// reloadedServerConfig re-reads the configuration files and returns current
+// with the settings the server can change at runtime updated from them.
+// The listen address and TLS files are re-read too, so that changing them
+// is reported as needing a restart rather than silently ignored.
+func reloadedServerConfig(current httpserver.Config) httpserver.Config {
+	cfg := loadConfigWithDefaults()
+	updated := current
+	updated.ListenAddr, updated.TLSCertFile, updated.TLSKeyFile = getHTTPConfig(cfg)
+	updated.AllowedExecutables, updated.TransferredExecutables, updated.RequireImageDigest = getExecutablePolicyConfig(cfg)
+	updated.RedactAttributes = getRedactConfig(cfg)
+	updated.RateLimits = ratelimit.ConfigFromHTCondor(cfg)
+	updated.LogVerbosity = getLogVerbosityConfig(cfg)
+	updated.SubmitProfiles = getSubmitProfilesConfig(cfg)
+	return updated
+}
+
+// getSubmitProfilesConfig reads the submit profiles named by
+// HTTP_API_SUBMIT_PROFILES (comma-separated). Each profile's commands are
+// in HTTP_API_SUBMIT_PROFILE_<name>, one "command = value" per line, with
+// "!" before the commands it enforces. The commands are read as written:
+// their macros are expanded by the submit file, not the configuration.
+func getSubmitProfilesConfig(cfg *config.Config) map[string]map[string]string {
+	names := getListConfig(cfg, "HTTP_API_SUBMIT_PROFILES")
+	if len(names) == 0 {
+		return nil
+	}
+	profiles := make(map[string]map[string]string, len(names))
+	for _, name := range names {
+		key := "HTTP_API_SUBMIT_PROFILE_" + name
+		explained, ok := cfg.Explain(key)
+		if !ok {
+			log.Printf("Warning: submit profile %s has no %s, skipping it", name, key)
+			continue
+		}
+		commands := make(map[string]string)
+		for _, line := range strings.Split(explained.Value, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			command, value, ok := strings.Cut(line, "=")
+			if !ok {
+				log.Printf("Warning: ignoring %q in %s: not a \"command = value\" line", line, key)
+				continue
+			}
+			commands[strings.TrimSpace(command)] = strings.TrimSpace(value)
+		}
+		profiles[name] = commands
+	}
+	return profiles
+}
+
+// getExecutablePolicyConfig reads the allowlist of executables users may submit
+// and whether container images must be pinned by digest
+func getExecutablePolicyConfig(cfg *config.Config) (allowed []string, transferred htcondor.TransferredExecutableMode, requireDigest bool) {
+	allowed = getListConfig(cfg, "HTTP_API_ALLOWED_EXECUTABLES")
+	if mode, ok := cfg.Get("HTTP_API_TRANSFERRED_EXECUTABLES"); ok {
+		var err error
+		if transferred, err = htcondor.ParseTransferredExecutableMode(mode); err != nil {
+			log.Printf("Warning: %v, using default (allow)", err)
+		}
+	}
+	if require, ok := cfg.Get("HTTP_API_REQUIRE_IMAGE_DIGEST"); ok && require == "true" {
+		requireDigest = true
+		log.Println("Digest-pinned container images required via configuration")
+	}
+	return allowed, transferred, requireDigest
+}
+
 // setupCollector creates collector from CLI flag or config
 func setupCollector(cfg *config.Config, logger *logging.Logger) *htcondor.Collector {
 	collectorHostValue := *collectorHost
@@ -602,12 +846,14 @@ func runNormalMode() error {
 
 	// Get schedd configuration
 	scheddNameValue, scheddAddrValue := getScheddConfig(cfg)
+	schedds := getScheddsConfig(cfg)
 
 	// Get HTTP API configuration
 	listenAddrFromConfig, tlsCertFile, tlsKeyFile := getHTTPConfig(cfg)
 
 	// Get timeout configuration
 	readTimeout, writeTimeout, idleTimeout := getTimeoutConfig(cfg)
+	readHeaderTimeout, transferTimeout, maxHeaderBytes := getHardeningConfig(cfg)
 
 	// Get user header configuration
 	userHeaderFromConfig, uidDomain, trustDomain := getUserHeaderConfig(cfg)
@@ -617,6 +863,14 @@ func runNormalMode() error {
 	if !ok || signingKeyPath == "" {
 		signingKeyPath, _ = cfg.Get("SEC_TOKEN_POOL_SIGNING_KEY_FILE")
 	}
+	var keyRotationWindow time.Duration
+	if windowStr, ok := cfg.Get("HTTP_API_SIGNING_KEY_ROTATION_WINDOW"); ok {
+		if duration, err := time.ParseDuration(windowStr); err == nil {
+			keyRotationWindow = duration
+		} else {
+			log.Printf("Warning: failed to parse HTTP_API_SIGNING_KEY_ROTATION_WINDOW '%s', using default: %v", windowStr, err)
+		}
+	}
 
 	// Create logger with reasonable defaults for unprivileged operation
 	logger, err := createLogger(cfg)
@@ -644,41 +898,75 @@ func runNormalMode() error {
 	// Load MCP configuration
 	mcpCfg := loadMCPConfig(cfg, listenAddrFromConfig)
 
+	// Get condor_q fallback configuration
+	allowCLIFallback, condorQPath := getCLIFallbackConfig(cfg)
+
+	// Get executable allowlist
+	allowedExecutables, transferredExecutables, requireImageDigest := getExecutablePolicyConfig(cfg)
+	readOnly := getReadOnlyConfig(cfg)
+	redactAttributes := getRedactConfig(cfg)
+
 	// Create and start server
-	server, err := httpserver.NewServer(httpserver.Config{
-		ListenAddr:          listenAddrFromConfig,
-		ScheddName:          scheddNameValue,
-		ScheddAddr:          scheddAddrValue,
-		UserHeader:          userHeaderFromConfig,
-		SigningKeyPath:      signingKeyPath,
-		TLSCertFile:         tlsCertFile,
-		TLSKeyFile:          tlsKeyFile,
-		TrustDomain:         trustDomain,
-		UIDDomain:           uidDomain,
-		ReadTimeout:         readTimeout,
-		WriteTimeout:        writeTimeout,
-		IdleTimeout:         idleTimeout,
-		Collector:           collector,
-		Logger:              logger,
-		EnableMCP:           mcpCfg.enabled,
-		OAuth2DBPath:        mcpCfg.oauth2DBPath,
-		OAuth2Issuer:        mcpCfg.oauth2Issuer,
-		OAuth2ClientID:      mcpCfg.oauth2ClientID,
-		OAuth2ClientSecret:  mcpCfg.oauth2ClientSecret,
-		OAuth2AuthURL:       mcpCfg.oauth2AuthURL,
-		OAuth2TokenURL:      mcpCfg.oauth2TokenURL,
-		OAuth2RedirectURL:   mcpCfg.oauth2RedirectURL,
-		OAuth2UserInfoURL:   mcpCfg.oauth2UserInfoURL,
-		OAuth2Scopes:        mcpCfg.oauth2Scopes,
-		OAuth2UsernameClaim: mcpCfg.oauth2UsernameClaim,
-		OAuth2GroupsClaim:   mcpCfg.oauth2GroupsClaim,
-		MCPAccessGroup:      mcpCfg.mcpAccessGroup,
-		MCPReadGroup:        mcpCfg.mcpReadGroup,
-		MCPWriteGroup:       mcpCfg.mcpWriteGroup,
-	})
+	serverCfg := httpserver.Config{
+		ListenAddr:             listenAddrFromConfig,
+		ScheddName:             scheddNameValue,
+		ScheddAddr:             scheddAddrValue,
+		Schedds:                schedds,
+		ScheddProxy:            getScheddProxyConfig(cfg),
+		OpenAPIVersion:         getOpenAPIVersionConfig(cfg),
+		UserHeader:             userHeaderFromConfig,
+		SigningKeyPath:         signingKeyPath,
+		KeyRotationWindow:      keyRotationWindow,
+		TLSCertFile:            tlsCertFile,
+		TLSKeyFile:             tlsKeyFile,
+		TrustDomain:            trustDomain,
+		UIDDomain:              uidDomain,
+		ReadTimeout:            readTimeout,
+		WriteTimeout:           writeTimeout,
+		IdleTimeout:            idleTimeout,
+		ReadHeaderTimeout:      readHeaderTimeout,
+		MaxHeaderBytes:         maxHeaderBytes,
+		TransferTimeout:        transferTimeout,
+		Collector:              collector,
+		Logger:                 logger,
+		EnableMCP:              mcpCfg.enabled,
+		OAuth2DBPath:           mcpCfg.oauth2DBPath,
+		OAuth2Issuer:           mcpCfg.oauth2Issuer,
+		OAuth2ClientID:         mcpCfg.oauth2ClientID,
+		OAuth2ClientSecret:     mcpCfg.oauth2ClientSecret,
+		OAuth2AuthURL:          mcpCfg.oauth2AuthURL,
+		OAuth2TokenURL:         mcpCfg.oauth2TokenURL,
+		OAuth2RedirectURL:      mcpCfg.oauth2RedirectURL,
+		OAuth2UserInfoURL:      mcpCfg.oauth2UserInfoURL,
+		OAuth2Scopes:           mcpCfg.oauth2Scopes,
+		OAuth2UsernameClaim:    mcpCfg.oauth2UsernameClaim,
+		OAuth2GroupsClaim:      mcpCfg.oauth2GroupsClaim,
+		MCPAccessGroup:         mcpCfg.mcpAccessGroup,
+		MCPReadGroup:           mcpCfg.mcpReadGroup,
+		MCPWriteGroup:          mcpCfg.mcpWriteGroup,
+		AllowCLIFallback:       allowCLIFallback,
+		CondorQPath:            condorQPath,
+		AllowedExecutables:     allowedExecutables,
+		TransferredExecutables: transferredExecutables,
+		RequireImageDigest:     requireImageDigest,
+		RedactAttributes:       redactAttributes,
+		RateLimits:             ratelimit.ConfigFromHTCondor(cfg),
+		LogVerbosity:           getLogVerbosityConfig(cfg),
+		AdminUsers:             getAdminUsersConfig(cfg),
+		DefaultJobProjection:   getDefaultJobProjectionConfig(cfg),
+		JobLeaseDuration:       getJobLeaseDurationConfig(cfg),
+		OAuthServices:          getOAuthServicesConfig(cfg),
+		SubmitProfiles:         getSubmitProfilesConfig(cfg),
+	}
+	server, err := httpserver.NewServer(serverCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
+	if readOnly {
+		server.SetReadOnly(true)
+	}
+	watchReadOnlySignals(server, logger)
+	watchReloadSignal(server, serverCfg, logger)
 
 	// Set up signal handling
 	sigChan := make(chan os.Signal, 1)
@@ -0,0 +1,27 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bbockelm/golang-htcondor/httpserver"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// watchReadOnlySignals puts the server in read-only mode on SIGUSR1 and
+// takes it out again on SIGUSR2, so operators can pause job changes for
+// schedd maintenance without restarting the server
+func watchReadOnlySignals(server *httpserver.Server, logger *logging.Logger) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	go func() {
+		for sig := range sigChan {
+			logger.Info(logging.DestinationGeneral, "Received read-only mode signal", "signal", sig)
+			server.SetReadOnly(sig == syscall.SIGUSR1)
+		}
+	}()
+}
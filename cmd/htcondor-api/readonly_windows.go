@@ -0,0 +1,11 @@
+//go:build windows
+
+package main
+
+import (
+	"github.com/bbockelm/golang-htcondor/httpserver"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// watchReadOnlySignals does nothing on Windows, which has no SIGUSR1/SIGUSR2
+func watchReadOnlySignals(_ *httpserver.Server, _ *logging.Logger) {}
@@ -0,0 +1,32 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bbockelm/golang-htcondor/httpserver"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// watchReloadSignal re-reads the configuration files on SIGHUP and applies
+// the settings that can change without a restart (rate limits, redacted
+// attributes, log verbosity, executable allowlist)
+func watchReloadSignal(server *httpserver.Server, cfg httpserver.Config, logger *logging.Logger) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGHUP)
+
+	go func() {
+		for range sigChan {
+			logger.Info(logging.DestinationGeneral, "Received SIGHUP, reloading configuration")
+			updated := reloadedServerConfig(cfg)
+			if err := server.Reload(updated); err != nil {
+				logger.Error(logging.DestinationGeneral, "Configuration reload failed", "error", err)
+				continue
+			}
+			cfg = updated
+		}
+	}()
+}
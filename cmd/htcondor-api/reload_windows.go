@@ -0,0 +1,11 @@
+//go:build windows
+
+package main
+
+import (
+	"github.com/bbockelm/golang-htcondor/httpserver"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// watchReloadSignal does nothing on Windows, which has no SIGHUP
+func watchReloadSignal(_ *httpserver.Server, _ httpserver.Config, _ *logging.Logger) {}
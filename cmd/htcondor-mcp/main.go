@@ -63,6 +63,9 @@ func runNormalMode() error {
 	if err != nil {
 		return fmt.Errorf("failed to create logger: %w", err)
 	}
+	for _, loadErr := range cfg.LoadErrors() {
+		logger.Warn(logging.DestinationGeneral, "Skipped configuration file", "error", loadErr)
+	}
 
 	// Create collector from COLLECTOR_HOST
 	var collector *htcondor.Collector
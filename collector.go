@@ -3,9 +3,9 @@ package htcondor
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/PelicanPlatform/classad/classad"
-	"github.com/bbockelm/cedar/client"
 	"github.com/bbockelm/cedar/commands"
 	"github.com/bbockelm/cedar/message"
 	"github.com/bbockelm/cedar/security"
@@ -13,7 +13,8 @@ import (
 
 // Collector represents an HTCondor collector daemon
 type Collector struct {
-	address string
+	address   string
+	transport Transport
 }
 
 // NewCollector creates a new Collector instance
@@ -23,6 +24,24 @@ func NewCollector(address string) *Collector {
 	}
 }
 
+// NewCollectorWithTransport creates a new Collector instance that opens its
+// CEDAR connections through the given transport instead of the default cedar client
+func NewCollectorWithTransport(address string, transport Transport) *Collector {
+	return &Collector{
+		address:   address,
+		transport: transport,
+	}
+}
+
+// connect opens a new connection to the collector using the configured transport
+func (c *Collector) connect(ctx context.Context) (Connection, error) {
+	transport := c.transport
+	if transport == nil {
+		transport = DefaultTransport()
+	}
+	return transport.Connect(ctx, c.address)
+}
+
 // QueryAds queries the collector for daemon advertisements
 // adType specifies the type of ads to query (e.g., "StartdAd", "ScheddAd")
 // constraint is a ClassAd constraint expression string (pass empty string for no constraint)
@@ -32,7 +51,8 @@ func (c *Collector) QueryAds(ctx context.Context, adType string, constraint stri
 
 // QueryAdsWithProjection queries the collector for daemon advertisements with optional projection
 // adType specifies the type of ads to query (e.g., "StartdAd", "ScheddAd")
-// constraint is a ClassAd constraint expression string (pass empty string for no constraint)
+// constraint is a ClassAd constraint expression string (pass empty string for no constraint),
+// evaluated by the collector as the query's Requirements
 // projection is an optional list of attribute names to return (pass nil for all attributes)
 func (c *Collector) QueryAdsWithProjection(ctx context.Context, adType string, constraint string, projection []string) ([]*classad.ClassAd, error) {
 	// Apply rate limiting if configured
@@ -44,8 +64,8 @@ func (c *Collector) QueryAdsWithProjection(ctx context.Context, adType string, c
 		}
 	}
 
-	// Establish connection using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, c.address)
+	// Establish connection using the configured transport
+	htcondorClient, err := c.connect(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to collector: %w", err)
 	}
@@ -74,7 +94,7 @@ func (c *Collector) QueryAdsWithProjection(ctx context.Context, adType string, c
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	negotiation, err := auth.ClientHandshake(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("security handshake failed: %w", err)
+		return nil, fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// If username not already set in context, use the authenticated user from handshake
@@ -140,6 +160,39 @@ func (c *Collector) QueryAdsWithProjection(ctx context.Context, adType string, c
 	return ads, nil
 }
 
+// QueryAdsAsMaps queries the collector like QueryAdsWithProjection and
+// returns the ads converted with AdToJSON. With a projection, only the
+// projected attributes are returned, even if the collector adds others.
+func (c *Collector) QueryAdsAsMaps(ctx context.Context, adType string, constraint string, projection []string) ([]map[string]interface{}, error) {
+	ads, err := c.QueryAdsWithProjection(ctx, adType, constraint, projection)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attribute names are case-insensitive
+	projected := make(map[string]bool, len(projection))
+	for _, attr := range projection {
+		projected[strings.ToLower(attr)] = true
+	}
+
+	maps := make([]map[string]interface{}, 0, len(ads))
+	for _, ad := range ads {
+		m, err := AdToJSON(ad)
+		if err != nil {
+			return nil, err
+		}
+		if len(projected) > 0 {
+			for name := range m {
+				if !projected[strings.ToLower(name)] {
+					delete(m, name)
+				}
+			}
+		}
+		maps = append(maps, m)
+	}
+	return maps, nil
+}
+
 // getCommandForAdType maps ad type to HTCondor command
 func getCommandForAdType(adType string) (commands.CommandType, error) {
 	switch adType {
@@ -180,16 +233,10 @@ func createQueryAd(adType string, constraint *classad.Expr, projection []string)
 		_ = ad.Set("Requirements", constraint)
 	}
 
-	// Set ProjectionAttributes if projection is specified
+	// Set Projection (ATTR_PROJECTION) if projection is specified, so the
+	// collector sends only those attributes (plus a few of its own)
 	if len(projection) > 0 {
-		projectionStr := ""
-		for i, attr := range projection {
-			if i > 0 {
-				projectionStr += ","
-			}
-			projectionStr += attr
-		}
-		_ = ad.Set("ProjectionAttributes", projectionStr)
+		_ = ad.Set("Projection", strings.Join(projection, ","))
 	}
 
 	return ad
@@ -215,18 +262,27 @@ func getTargetTypeForAdType(adType string) string {
 	}
 }
 
-// Advertise sends an advertisement to the collector
-func (c *Collector) Advertise(_ context.Context, _ *classad.ClassAd, _ string) error {
-	// TODO: Implement advertisement using cedar protocol
-	return fmt.Errorf("not implemented")
-}
-
 // LocateDaemon locates a daemon by querying the collector
 func (c *Collector) LocateDaemon(_ context.Context, _ string, _ string) (*DaemonLocation, error) {
 	// TODO: Implement daemon location logic
 	return nil, fmt.Errorf("not implemented")
 }
 
+// LocateNegotiator returns the pool's negotiator, found by its ad in the
+// collector. Connections to it use the collector's transport.
+func (c *Collector) LocateNegotiator(ctx context.Context) (*Negotiator, error) {
+	ads, err := c.QueryAdsWithProjection(ctx, "NegotiatorAd", "", []string{"Name", "MyAddress"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query negotiator ads: %w", err)
+	}
+	for _, ad := range ads {
+		if address, ok := ad.EvaluateAttrString("MyAddress"); ok && address != "" {
+			return &Negotiator{address: address, transport: c.transport}, nil
+		}
+	}
+	return nil, ErrNegotiatorNotFound
+}
+
 // DaemonLocation represents the location information for a daemon
 type DaemonLocation struct {
 	Name    string
@@ -0,0 +1,110 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+)
+
+// Advertise sends ad to the collector with the given command, like
+// DCCollector::sendUpdate. Update commands (e.g. commands.UPDATE_AD_GENERIC
+// or commands.UPDATE_MASTER_AD) take the ad to store; invalidate commands
+// (e.g. commands.INVALIDATE_ADS_GENERIC) take a query ad whose Requirements
+// select the ads to remove, as built by InvalidateGeneric.
+//
+// Private attributes such as ClaimId are only sent if the connection is
+// encrypted. The collector does not reply to updates, so a nil error means
+// the ad was sent, not that the collector accepted it: the collector
+// silently drops updates that fail its ADVERTISE authorization.
+func (c *Collector) Advertise(ctx context.Context, command int, ad *classad.ClassAd) (err error) {
+	if ad == nil {
+		return fmt.Errorf("ad is required")
+	}
+
+	htcondorClient, err := c.connect(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to collector: %w", err)
+	}
+	defer func() {
+		if cerr := htcondorClient.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("failed to close connection: %w", cerr)
+		}
+	}()
+	cedarStream := htcondorClient.GetStream()
+
+	secConfig, err := GetSecurityConfigOrDefault(ctx, nil, command, "CLIENT", c.address)
+	if err != nil {
+		return fmt.Errorf("failed to create security config: %w", err)
+	}
+	auth := security.NewAuthenticator(secConfig, cedarStream)
+	if _, err := auth.ClientHandshake(ctx); err != nil {
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
+	}
+
+	// Only send secrets (e.g. claim IDs) over an encrypted channel
+	putConfig := &message.PutClassAdConfig{}
+	if !cedarStream.IsEncrypted() {
+		putConfig.Options |= message.PutClassAdNoPrivate
+	}
+	msg := message.NewMessageForStream(cedarStream)
+	if err := msg.PutClassAdWithOptions(ctx, ad, putConfig); err != nil {
+		return fmt.Errorf("failed to send ad: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to send ad: %w", err)
+	}
+	return nil
+}
+
+// AdvertiseGeneric stores ad in the collector with UPDATE_AD_GENERIC. The ad
+// must have a MyType, the type it is queried by, and a Name, which together
+// identify it: advertising it again replaces it.
+func (c *Collector) AdvertiseGeneric(ctx context.Context, ad *classad.ClassAd) error {
+	if _, _, err := genericAdKey(ad); err != nil {
+		return err
+	}
+	return c.Advertise(ctx, int(commands.UPDATE_AD_GENERIC), ad)
+}
+
+// InvalidateGeneric removes an ad stored with AdvertiseGeneric from the
+// collector, e.g. when the daemon advertising it shuts down. Only the ad's
+// MyType and Name are used.
+func (c *Collector) InvalidateGeneric(ctx context.Context, ad *classad.ClassAd) error {
+	myType, name, err := genericAdKey(ad)
+	if err != nil {
+		return err
+	}
+	requirements, err := classad.ParseExpr("TARGET.Name == " + classad.Quote(name))
+	if err != nil {
+		return fmt.Errorf("failed to build invalidate query: %w", err)
+	}
+
+	// As in DaemonCore's invalidation: the query's TargetType picks the ad
+	// table and its Name lets the collector find the ad without a scan
+	query := classad.New()
+	_ = query.Set("MyType", "Query")
+	_ = query.Set("TargetType", myType)
+	_ = query.Set("Name", name)
+	query.InsertExpr("Requirements", requirements)
+	return c.Advertise(ctx, int(commands.INVALIDATE_ADS_GENERIC), query)
+}
+
+// genericAdKey returns the MyType and Name identifying a generic ad
+func genericAdKey(ad *classad.ClassAd) (myType, name string, err error) {
+	if ad == nil {
+		return "", "", fmt.Errorf("ad is required")
+	}
+	myType, ok := AdString(ad, "MyType")
+	if !ok || myType == "" {
+		return "", "", fmt.Errorf("generic ad must have a MyType")
+	}
+	name, ok = AdString(ad, "Name")
+	if !ok || name == "" {
+		return "", "", fmt.Errorf("generic ad must have a Name")
+	}
+	return myType, name, nil
+}
@@ -0,0 +1,108 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// receiveUpdate replays the collector side of an update with the given
+// command, returning the ad sent
+func receiveUpdate(ctx context.Context, s *stream.Stream, command commands.CommandType) (*classad.ClassAd, error) {
+	if err := negotiatorHandshakeCommand(ctx, s, command); err != nil {
+		return nil, err
+	}
+	ad, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ad: %w", err)
+	}
+	return ad, nil
+}
+
+func TestCollectorAdvertiseGeneric(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	ad, err := classad.Parse(`[MyType = "MyDaemon"; Name = "mydaemon@host"; State = "Running"; ClaimId = "secret"]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ad: %v", err)
+	}
+
+	var sent *classad.ClassAd
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		var err error
+		sent, err = receiveUpdate(ctx, s, commands.UPDATE_AD_GENERIC)
+		return err
+	})
+	collector := NewCollectorWithTransport("mock-collector:9618", transport)
+	if err := collector.AdvertiseGeneric(ctx, ad); err != nil {
+		t.Fatalf("AdvertiseGeneric failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted collector failed: %v", err)
+	}
+	if state, _ := AdString(sent, "State"); state != "Running" {
+		t.Errorf("Expected State Running, got %q", state)
+	}
+	if _, ok := sent.Lookup("ClaimId"); ok {
+		t.Error("Expected the private ClaimId not to be sent unencrypted")
+	}
+
+	// Invalidating sends a query for the ad's type and name
+	var query *classad.ClassAd
+	transport = newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		var err error
+		query, err = receiveUpdate(ctx, s, commands.INVALIDATE_ADS_GENERIC)
+		return err
+	})
+	collector = NewCollectorWithTransport("mock-collector:9618", transport)
+	if err := collector.InvalidateGeneric(ctx, ad); err != nil {
+		t.Fatalf("InvalidateGeneric failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted collector failed: %v", err)
+	}
+	if myType, _ := AdString(query, "MyType"); myType != "Query" {
+		t.Errorf("Expected MyType Query, got %q", myType)
+	}
+	if targetType, _ := AdString(query, "TargetType"); targetType != "MyDaemon" {
+		t.Errorf("Expected TargetType MyDaemon, got %q", targetType)
+	}
+	if name, _ := AdString(query, "Name"); name != "mydaemon@host" {
+		t.Errorf("Expected Name mydaemon@host, got %q", name)
+	}
+	requirements, ok := query.Lookup("Requirements")
+	if !ok {
+		t.Fatal("Expected Requirements in the invalidate query")
+	}
+	if matches, _ := classad.NewMatchClassAd(query, ad).EvaluateAttrLeft("Requirements").BoolValue(); !matches {
+		t.Errorf("Expected Requirements %v to match the advertised ad", requirements)
+	}
+}
+
+func TestCollectorAdvertiseGenericRequiresKey(t *testing.T) {
+	collector := NewCollector("collector.example.com:9618")
+	ctx := context.Background()
+
+	for _, adText := range []string{`[Name = "x"]`, `[MyType = "MyDaemon"]`} {
+		ad, err := classad.Parse(adText)
+		if err != nil {
+			t.Fatalf("Failed to parse ad: %v", err)
+		}
+		if err := collector.AdvertiseGeneric(ctx, ad); err == nil {
+			t.Errorf("Expected AdvertiseGeneric of %s to fail", adText)
+		}
+		if err := collector.InvalidateGeneric(ctx, ad); err == nil {
+			t.Errorf("Expected InvalidateGeneric of %s to fail", adText)
+		}
+	}
+	if err := collector.Advertise(ctx, int(commands.UPDATE_AD_GENERIC), nil); err == nil {
+		t.Error("Expected Advertise of a nil ad to fail")
+	}
+}
@@ -0,0 +1,103 @@
+package htcondor
+
+import (
+	"context"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// QueryOptions configures a collector query
+type QueryOptions struct {
+	// Projection is an optional list of attribute names to return (nil for all attributes)
+	Projection []string
+	// LatestOnly keeps only the most recently updated ad for each Name.
+	// A collector can briefly hold several ads with the same Name (for example
+	// after a daemon restarts); LatestOnly drops all but the one with the highest
+	// LastHeardFrom, falling back to DaemonStartTime. Ads without a Name are kept.
+	LatestOnly bool
+}
+
+// latestOnlyAttrs are the attributes needed to deduplicate ads by Name
+var latestOnlyAttrs = []string{"Name", "LastHeardFrom", "DaemonStartTime"}
+
+// QueryAdsWithOptions is like QueryAds but allows configuring the projection
+// and client-side filtering of the results. opts may be nil.
+func (c *Collector) QueryAdsWithOptions(ctx context.Context, adType string, constraint string, opts *QueryOptions) ([]*classad.ClassAd, error) {
+	if opts == nil {
+		return c.QueryAdsWithProjection(ctx, adType, constraint, nil)
+	}
+
+	projection := opts.Projection
+	if opts.LatestOnly && len(projection) > 0 {
+		projection = withAttrs(projection, latestOnlyAttrs)
+	}
+
+	ads, err := c.QueryAdsWithProjection(ctx, adType, constraint, projection)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.LatestOnly {
+		ads = latestAdsByName(ads)
+	}
+	return ads, nil
+}
+
+// withAttrs returns projection with any missing attrs appended
+func withAttrs(projection []string, attrs []string) []string {
+	result := append([]string(nil), projection...)
+	for _, attr := range attrs {
+		found := false
+		for _, p := range projection {
+			if p == attr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, attr)
+		}
+	}
+	return result
+}
+
+// latestAdsByName keeps the most recently updated ad for each Name, preserving
+// the position of the first ad seen with that Name
+func latestAdsByName(ads []*classad.ClassAd) []*classad.ClassAd {
+	result := make([]*classad.ClassAd, 0, len(ads))
+	index := make(map[string]int)
+	for _, ad := range ads {
+		name, ok := ad.EvaluateAttrString("Name")
+		if !ok || name == "" {
+			result = append(result, ad)
+			continue
+		}
+		if i, seen := index[name]; seen {
+			if adUpdatedAfter(ad, result[i]) {
+				result[i] = ad
+			}
+			continue
+		}
+		index[name] = len(result)
+		result = append(result, ad)
+	}
+	return result
+}
+
+// adUpdatedAfter reports whether ad a is more recent than ad b, comparing
+// LastHeardFrom and then DaemonStartTime
+func adUpdatedAfter(a, b *classad.ClassAd) bool {
+	for _, attr := range []string{"LastHeardFrom", "DaemonStartTime"} {
+		ta, okA := a.EvaluateAttrInt(attr)
+		tb, okB := b.EvaluateAttrInt(attr)
+		switch {
+		case okA && okB && ta != tb:
+			return ta > tb
+		case okA && !okB:
+			return true
+		case !okA && okB:
+			return false
+		}
+	}
+	return false
+}
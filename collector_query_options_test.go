@@ -0,0 +1,87 @@
+package htcondor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func makeTimedStartdAd(name string, lastHeard int64) *classad.ClassAd {
+	ad := makeStartdAd(name, "Unclaimed")
+	_ = ad.Set("LastHeardFrom", lastHeard)
+	return ad
+}
+
+func TestQueryAdsWithOptionsLatestOnly(t *testing.T) {
+	older := makeTimedStartdAd("slot1@host1", 1000)
+	newer := makeTimedStartdAd("slot1@host1", 2000)
+	other := makeTimedStartdAd("slot1@host2", 1500)
+
+	transport := &pollingCollectorTransport{
+		adsForPoll: func(int) []*classad.ClassAd {
+			return []*classad.ClassAd{older, other, newer}
+		},
+	}
+	collector := NewCollectorWithTransport("mock-collector:9618", transport)
+	ctx := context.Background()
+
+	all, err := collector.QueryAdsWithOptions(ctx, "StartdAd", "", nil)
+	if err != nil {
+		t.Fatalf("QueryAdsWithOptions failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("Expected 3 ads without LatestOnly, got %d", len(all))
+	}
+
+	latest, err := collector.QueryAdsWithOptions(ctx, "StartdAd", "", &QueryOptions{LatestOnly: true})
+	if err != nil {
+		t.Fatalf("QueryAdsWithOptions failed: %v", err)
+	}
+	if len(latest) != 2 {
+		t.Fatalf("Expected 2 ads with LatestOnly, got %d", len(latest))
+	}
+	if name, _ := latest[0].EvaluateAttrString("Name"); name != "slot1@host1" {
+		t.Errorf("Expected first ad slot1@host1, got %q", name)
+	}
+	if heard, _ := latest[0].EvaluateAttrInt("LastHeardFrom"); heard != 2000 {
+		t.Errorf("Expected the newer ad (LastHeardFrom 2000) to survive, got %d", heard)
+	}
+	if name, _ := latest[1].EvaluateAttrString("Name"); name != "slot1@host2" {
+		t.Errorf("Expected second ad slot1@host2, got %q", name)
+	}
+}
+
+func TestLatestAdsByNameDaemonStartTime(t *testing.T) {
+	older := makeStartdAd("schedd@host", "")
+	_ = older.Set("DaemonStartTime", int64(100))
+	newer := makeStartdAd("schedd@host", "")
+	_ = newer.Set("DaemonStartTime", int64(200))
+	unnamed := classad.New()
+	_ = unnamed.Set("MyType", "Machine")
+
+	result := latestAdsByName([]*classad.ClassAd{newer, unnamed, older})
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 ads, got %d", len(result))
+	}
+	if result[0] != newer {
+		t.Error("Expected the ad with the later DaemonStartTime to survive")
+	}
+	if result[1] != unnamed {
+		t.Error("Expected the unnamed ad to be kept")
+	}
+}
+
+func TestWithAttrs(t *testing.T) {
+	got := withAttrs([]string{"Name", "State"}, latestOnlyAttrs)
+	want := []string{"Name", "State", "LastHeardFrom", "DaemonStartTime"}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+			break
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// DefaultSubscribePollInterval is how often Subscribe re-queries the collector
+const DefaultSubscribePollInterval = 30 * time.Second
+
+// AdUpdateType describes what happened to an ad between two collector polls
+type AdUpdateType int
+
+const (
+	// AdAdded means the ad was not present before
+	AdAdded AdUpdateType = iota
+	// AdUpdated means the ad was present before but its contents changed
+	AdUpdated
+	// AdRemoved means the ad is no longer present (expired or invalidated)
+	AdRemoved
+)
+
+// String returns a human-readable name for the update type
+func (t AdUpdateType) String() string {
+	switch t {
+	case AdAdded:
+		return "added"
+	case AdUpdated:
+		return "updated"
+	case AdRemoved:
+		return "removed"
+	default:
+		return fmt.Sprintf("AdUpdateType(%d)", int(t))
+	}
+}
+
+// AdUpdate is a single change to the set of ads matching a subscription
+type AdUpdate struct {
+	Type AdUpdateType
+	// Key identifies the ad across polls (MyType and Name, or MyAddress if unnamed)
+	Key string
+	// Ad is the current ad, or the last known ad for AdRemoved
+	Ad *classad.ClassAd
+}
+
+// SubscribeOptions configures a collector subscription
+type SubscribeOptions struct {
+	// PollInterval is the time between collector queries (default: DefaultSubscribePollInterval)
+	PollInterval time.Duration
+	// Projection limits the attributes fetched and compared (nil for all attributes)
+	Projection []string
+	// Logger receives the errors of polls after the first (optional)
+	Logger *logging.Logger
+}
+
+// Subscribe watches the collector for ads of adType matching constraint and
+// emits add/update/remove events on the returned channel.
+//
+// The HTCondor collector has no client-facing push protocol for ad updates, so
+// this is implemented with diff-polling: the collector is queried periodically
+// (every DefaultSubscribePollInterval by default) and the result is compared with the previous poll.
+// The first poll runs before Subscribe returns and reports every matching ad as
+// AdAdded; an error from it is returned directly. Errors from later polls are
+// logged to SubscribeOptions.Logger and the poll is retried at the next
+// interval.
+//
+// The channel is closed when ctx is cancelled.
+func (c *Collector) Subscribe(ctx context.Context, adType, constraint string) (<-chan AdUpdate, error) {
+	return c.SubscribeWithOptions(ctx, adType, constraint, nil)
+}
+
+// SubscribeWithOptions is like Subscribe but allows configuring the poll
+// interval and projection. opts may be nil.
+func (c *Collector) SubscribeWithOptions(ctx context.Context, adType, constraint string, opts *SubscribeOptions) (<-chan AdUpdate, error) {
+	interval := DefaultSubscribePollInterval
+	var projection []string
+	var logger *logging.Logger
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+		projection = opts.Projection
+		logger = opts.Logger
+	}
+
+	// Validate the ad type before starting
+	if _, err := getCommandForAdType(adType); err != nil {
+		return nil, err
+	}
+
+	// Initial poll establishes the baseline
+	ads, err := c.QueryAdsWithProjection(ctx, adType, constraint, projection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query collector: %w", err)
+	}
+	known := make(map[string]adSnapshot)
+	initial := diffAds(known, ads)
+
+	updates := make(chan AdUpdate, len(initial))
+	for _, update := range initial {
+		updates <- update
+	}
+
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			ads, err := c.QueryAdsWithProjection(ctx, adType, constraint, projection)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				logger.Warn(logging.DestinationCollector, "Collector subscription poll failed", "ad_type", adType, "error", err)
+				continue
+			}
+
+			for _, update := range diffAds(known, ads) {
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// adSnapshot is the last seen version of an ad
+type adSnapshot struct {
+	ad   *classad.ClassAd
+	text string
+}
+
+// diffAds compares the current poll with the known ads, returning the
+// changes in order (added/updated in poll order, then removals sorted by key) and
+// updating known to match the current poll
+func diffAds(known map[string]adSnapshot, ads []*classad.ClassAd) []AdUpdate {
+	var updates []AdUpdate
+	seen := make(map[string]bool, len(ads))
+
+	for _, ad := range ads {
+		key := adKey(ad)
+		if seen[key] {
+			// Duplicate keys in a single poll; keep the first
+			continue
+		}
+		seen[key] = true
+
+		text := ad.String()
+		prev, ok := known[key]
+		switch {
+		case !ok:
+			updates = append(updates, AdUpdate{Type: AdAdded, Key: key, Ad: ad})
+		case prev.text != text:
+			updates = append(updates, AdUpdate{Type: AdUpdated, Key: key, Ad: ad})
+		}
+		known[key] = adSnapshot{ad: ad, text: text}
+	}
+
+	var removed []string
+	for key := range known {
+		if !seen[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		updates = append(updates, AdUpdate{Type: AdRemoved, Key: key, Ad: known[key].ad})
+		delete(known, key)
+	}
+
+	return updates
+}
+
+// adKey returns the identity of an ad, mirroring how the collector tells ads apart
+func adKey(ad *classad.ClassAd) string {
+	myType, _ := ad.EvaluateAttrString("MyType")
+	if name, ok := ad.EvaluateAttrString("Name"); ok && name != "" {
+		return myType + "/" + name
+	}
+	if addr, ok := ad.EvaluateAttrString("MyAddress"); ok && addr != "" {
+		return myType + "/" + addr
+	}
+	// No identifying attributes; fall back to the full ad text
+	return myType + "/" + ad.String()
+}
@@ -0,0 +1,175 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// pollingCollectorTransport is a mock Transport that answers each collector
+// query with the ads returned by adsForPoll for that poll number
+type pollingCollectorTransport struct {
+	mu         sync.Mutex
+	polls      int
+	adsForPoll func(poll int) []*classad.ClassAd
+}
+
+func (t *pollingCollectorTransport) Connect(ctx context.Context, _ string) (Connection, error) {
+	t.mu.Lock()
+	poll := t.polls
+	t.polls++
+	t.mu.Unlock()
+
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		defer func() { _ = serverConn.Close() }()
+		_ = serveCollectorQuery(ctx, stream.NewStream(serverConn), t.adsForPoll(poll))
+	}()
+	return &pipeConnection{conn: clientConn, stream: stream.NewStream(clientConn)}, nil
+}
+
+// serveCollectorQuery replays the server side of a collector query
+func serveCollectorQuery(ctx context.Context, s *stream.Stream, ads []*classad.ClassAd) error {
+	if err := serverHandshake(ctx, s); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+		return fmt.Errorf("query ad: %w", err)
+	}
+	return sendMessage(ctx, s, func(m *message.Message) error {
+		for _, ad := range ads {
+			if err := m.PutInt32(ctx, 1); err != nil {
+				return err
+			}
+			if err := m.PutClassAd(ctx, ad); err != nil {
+				return err
+			}
+		}
+		return m.PutInt32(ctx, 0)
+	})
+}
+
+func makeStartdAd(name string, state string) *classad.ClassAd {
+	ad := classad.New()
+	_ = ad.Set("MyType", "Machine")
+	_ = ad.Set("Name", name)
+	_ = ad.Set("State", state)
+	return ad
+}
+
+// nextUpdate waits for the next update on the channel
+func nextUpdate(t *testing.T, updates <-chan AdUpdate) AdUpdate {
+	t.Helper()
+	select {
+	case update, ok := <-updates:
+		if !ok {
+			t.Fatal("Update channel closed unexpectedly")
+		}
+		return update
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for update")
+	}
+	return AdUpdate{}
+}
+
+func TestCollectorSubscribeDetectsNewAd(t *testing.T) {
+	transport := &pollingCollectorTransport{
+		adsForPoll: func(poll int) []*classad.ClassAd {
+			ads := []*classad.ClassAd{makeStartdAd("slot1@host1", "Unclaimed")}
+			if poll >= 1 {
+				ads = append(ads, makeStartdAd("slot1@host2", "Unclaimed"))
+			}
+			return ads
+		},
+	}
+	collector := NewCollectorWithTransport("mock-collector:9618", transport)
+
+	ctx, cancel := context.WithCancel(testSecurityContext(context.Background()))
+	defer cancel()
+
+	updates, err := collector.SubscribeWithOptions(ctx, "StartdAd", "", &SubscribeOptions{PollInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+
+	// The initial poll reports existing ads
+	update := nextUpdate(t, updates)
+	if update.Type != AdAdded || update.Key != "Machine/slot1@host1" {
+		t.Errorf("Expected initial add of slot1@host1, got %s %s", update.Type, update.Key)
+	}
+
+	// The next poll detects the newly-advertised ad
+	update = nextUpdate(t, updates)
+	if update.Type != AdAdded || update.Key != "Machine/slot1@host2" {
+		t.Errorf("Expected add of slot1@host2, got %s %s", update.Type, update.Key)
+	}
+	if name, _ := update.Ad.EvaluateAttrString("Name"); name != "slot1@host2" {
+		t.Errorf("Expected ad for slot1@host2, got %q", name)
+	}
+
+	// Cancelling the context closes the channel
+	cancel()
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-updates:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("Update channel not closed after cancel")
+		}
+	}
+}
+
+func TestCollectorSubscribeInvalidAdType(t *testing.T) {
+	collector := NewCollector("collector.example.com:9618")
+	if _, err := collector.Subscribe(context.Background(), "BogusAd", ""); err == nil {
+		t.Error("Expected error for unknown ad type")
+	}
+}
+
+func TestDiffAds(t *testing.T) {
+	known := make(map[string]adSnapshot)
+
+	updates := diffAds(known, []*classad.ClassAd{
+		makeStartdAd("slot1@host1", "Unclaimed"),
+		makeStartdAd("slot2@host1", "Unclaimed"),
+	})
+	if len(updates) != 2 || updates[0].Type != AdAdded || updates[1].Type != AdAdded {
+		t.Fatalf("Expected two adds, got %+v", updates)
+	}
+
+	// No change produces no updates
+	updates = diffAds(known, []*classad.ClassAd{
+		makeStartdAd("slot1@host1", "Unclaimed"),
+		makeStartdAd("slot2@host1", "Unclaimed"),
+	})
+	if len(updates) != 0 {
+		t.Fatalf("Expected no updates, got %+v", updates)
+	}
+
+	// One ad changes, one disappears
+	updates = diffAds(known, []*classad.ClassAd{
+		makeStartdAd("slot1@host1", "Claimed"),
+	})
+	if len(updates) != 2 {
+		t.Fatalf("Expected 2 updates, got %+v", updates)
+	}
+	if updates[0].Type != AdUpdated || updates[0].Key != "Machine/slot1@host1" {
+		t.Errorf("Expected update of slot1@host1, got %s %s", updates[0].Type, updates[0].Key)
+	}
+	if updates[1].Type != AdRemoved || updates[1].Key != "Machine/slot2@host1" {
+		t.Errorf("Expected removal of slot2@host1, got %s %s", updates[1].Type, updates[1].Key)
+	}
+	if len(known) != 1 {
+		t.Errorf("Expected 1 known ad, got %d", len(known))
+	}
+}
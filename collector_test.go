@@ -2,9 +2,15 @@ package htcondor
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
 )
 
 func TestNewCollector(t *testing.T) {
@@ -29,24 +35,164 @@ func TestCollectorQueryAds(t *testing.T) {
 	}
 }
 
-func TestCollectorAdvertise(t *testing.T) {
+func TestCollectorLocateDaemon(t *testing.T) {
 	collector := NewCollector("collector.example.com:9618")
 	ctx := context.Background()
 
-	ad := classad.New()
-	_ = ad.Set("Name", "test")
-	err := collector.Advertise(ctx, ad, "UPDATE_AD")
+	_, err := collector.LocateDaemon(ctx, "Schedd", "test_schedd")
 	if err == nil {
 		t.Error("Expected error for unimplemented method")
 	}
 }
 
-func TestCollectorLocateDaemon(t *testing.T) {
-	collector := NewCollector("collector.example.com:9618")
-	ctx := context.Background()
+func TestCollectorQueryAdsAsMaps(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return err
+		}
+		query, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		if projection, _ := query.EvaluateAttrString("Projection"); projection != "Name,Memory,Rank,LoadAvg" {
+			return fmt.Errorf("unexpected projection %q", projection)
+		}
 
-	_, err := collector.LocateDaemon(ctx, "Schedd", "test_schedd")
-	if err == nil {
-		t.Error("Expected error for unimplemented method")
+		// Collectors add attributes of their own to projected ads
+		ad, err := classad.Parse(`[Name = "slot1@host"; Memory = 2048; Rank = Memory * 2; LoadAvg = 0.25; MyType = "Machine"; Disk = 100]`)
+		if err != nil {
+			return err
+		}
+		return sendMessage(ctx, s, func(m *message.Message) error {
+			if err := m.PutInt32(ctx, 1); err != nil {
+				return err
+			}
+			if err := m.PutClassAd(ctx, ad); err != nil {
+				return err
+			}
+			return m.PutInt32(ctx, 0)
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	collector := NewCollectorWithTransport("mock-collector:9618", transport)
+	ads, err := collector.QueryAdsAsMaps(ctx, "StartdAd", "", []string{"Name", "Memory", "Rank", "LoadAvg"})
+	if err != nil {
+		t.Fatalf("QueryAdsAsMaps failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted collector failed: %v", err)
+	}
+	if len(ads) != 1 {
+		t.Fatalf("Expected 1 ad, got %d", len(ads))
+	}
+
+	want := map[string]interface{}{
+		"Name":    "slot1@host",
+		"Memory":  int64(2048),
+		"Rank":    "/Expr((Memory * 2))/",
+		"LoadAvg": 0.25,
+	}
+	if !reflect.DeepEqual(ads[0], want) {
+		t.Errorf("Got %#v, expected %#v", ads[0], want)
+	}
+}
+
+func TestCollectorQueryAdsWithProjection(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return err
+		}
+		query, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		requirements, ok := query.Lookup("Requirements")
+		if !ok || requirements.String() != `((State == "Unclaimed") && (Memory >= 4096))` {
+			return fmt.Errorf("unexpected requirements %v", requirements)
+		}
+		if projection, _ := query.EvaluateAttrString("Projection"); projection != "Name,Memory" {
+			return fmt.Errorf("unexpected projection %q", projection)
+		}
+
+		ad, err := classad.Parse(`[Name = "slot1@host"; Memory = 8192; MyType = "Machine"]`)
+		if err != nil {
+			return err
+		}
+		return sendMessage(ctx, s, func(m *message.Message) error {
+			if err := m.PutInt32(ctx, 1); err != nil {
+				return err
+			}
+			if err := m.PutClassAd(ctx, ad); err != nil {
+				return err
+			}
+			return m.PutInt32(ctx, 0)
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	collector := NewCollectorWithTransport("mock-collector:9618", transport)
+	ads, err := collector.QueryAdsWithProjection(ctx, "StartdAd", `State == "Unclaimed" && Memory >= 4096`, []string{"Name", "Memory"})
+	if err != nil {
+		t.Fatalf("QueryAdsWithProjection failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted collector failed: %v", err)
+	}
+	if len(ads) != 1 {
+		t.Fatalf("Expected 1 ad, got %d", len(ads))
+	}
+	if name, _ := ads[0].EvaluateAttrString("Name"); name != "slot1@host" {
+		t.Errorf("Expected slot1@host, got %q", name)
+	}
+}
+
+func TestCreateQueryAdDefaults(t *testing.T) {
+	ad := createQueryAd("StartdAd", nil, nil)
+	if requirements, ok := ad.EvaluateAttrBool("Requirements"); !ok || !requirements {
+		t.Errorf("Expected Requirements = true without a constraint, got %v", requirements)
+	}
+	if _, ok := ad.Lookup("Projection"); ok {
+		t.Error("Expected no Projection without a projection")
+	}
+}
+
+func TestAdToJSONMatchesMarshalJSON(t *testing.T) {
+	ad, err := classad.Parse(`[A = 1; B = 2.5; C = "x"; D = true; E = undefined; F = {1, "two", A + 1}; G = [H = 3; I = H * 2]; J = A + B; K = 3.0]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ad: %v", err)
+	}
+	m, err := AdToJSON(ad)
+	if err != nil {
+		t.Fatalf("AdToJSON failed: %v", err)
+	}
+
+	// Compare through a JSON round trip, which normalizes number types
+	decode := func(data []byte) interface{} {
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			t.Fatalf("Failed to decode %s: %v", data, err)
+		}
+		return v
+	}
+	fromMap, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map: %v", err)
+	}
+	fromAd, err := json.Marshal(ad)
+	if err != nil {
+		t.Fatalf("Failed to marshal ad: %v", err)
+	}
+	if !reflect.DeepEqual(decode(fromMap), decode(fromAd)) {
+		t.Errorf("AdToJSON gave %s, MarshalJSON %s", fromMap, fromAd)
+	}
+	if _, ok := m["K"].(float64); !ok {
+		t.Errorf("Expected K to stay real, got %#v", m["K"])
 	}
 }
@@ -45,16 +45,12 @@ func (c *Config) evaluateCondition(condition string) (bool, error) {
 	// Handle defined(VAR) checks - both "defined(VAR)" and "defined VAR"
 	if strings.HasPrefix(condition, "defined(") && strings.HasSuffix(condition, ")") {
 		varName := condition[8 : len(condition)-1]
-		varName = strings.TrimSpace(varName)
-		_, ok := c.values[varName]
-		return ok, nil
+		return c.isDefined(strings.TrimSpace(varName)), nil
 	}
 
 	// Handle "defined VAR" syntax (without parentheses)
 	if strings.HasPrefix(condition, "defined ") {
-		varName := strings.TrimSpace(condition[8:])
-		_, ok := c.values[varName]
-		return ok, nil
+		return c.isDefined(strings.TrimSpace(condition[8:])), nil
 	}
 
 	// Handle version comparisons: "version >= 8.9.0"
@@ -103,6 +99,18 @@ func (c *Config) evaluateCondition(condition string) (bool, error) {
 	return c.isTruthy(expanded), nil
 }
 
+// isDefined implements "defined NAME". A name containing a macro reference,
+// such as $ENV(DAG_STATUS) or $(NAME), is defined if it expands to a
+// non-empty string, so conditions can test the submit-side environment.
+func (c *Config) isDefined(name string) bool {
+	if strings.Contains(name, "$") {
+		expanded, err := c.expandMacrosWithFunctions(name)
+		return err == nil && strings.TrimSpace(expanded) != ""
+	}
+	_, ok := c.values[name]
+	return ok
+}
+
 // evaluateVersionCondition evaluates version comparison like ">= 8.9.0"
 func (c *Config) evaluateVersionCondition(condition string) (bool, error) {
 	condition = strings.TrimSpace(condition)
@@ -196,3 +196,71 @@ func TestCompareVersions(t *testing.T) {
 		}
 	}
 }
+
+func TestEvaluateConditionDefinedMacroReference(t *testing.T) {
+	cfg := NewEmpty()
+	cfg.SetEnvLookup(func(name string) (string, bool) {
+		if name == "DAG_STATUS" {
+			return "0", true
+		}
+		return "", false
+	})
+	cfg.Set("NODE", "A")
+	cfg.Set("EMPTY", "")
+
+	tests := []struct {
+		condition string
+		expected  bool
+	}{
+		{"defined $ENV(DAG_STATUS)", true},
+		{"defined $ENV(NOT_SET)", false},
+		{"defined $(NODE)", true},
+		{"defined $(EMPTY)", false},
+		{"defined($(MISSING))", false},
+	}
+
+	for _, tt := range tests {
+		result, err := cfg.evaluateCondition(tt.condition)
+		if err != nil {
+			t.Errorf("%s failed: %v", tt.condition, err)
+			continue
+		}
+		if result != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.condition, tt.expected, result)
+		}
+	}
+}
+
+func TestExpandConditionKeepsDefinedOperands(t *testing.T) {
+	cfg := NewEmpty()
+	cfg.SetEnvLookup(func(name string) (string, bool) {
+		if name == "MODE" {
+			return "fast", true
+		}
+		return "", false
+	})
+	cfg.Set("NODE", "A")
+	cfg.Set("LEVEL", "2")
+
+	tests := []struct {
+		condition string
+		expected  string
+	}{
+		{"$(LEVEL) >= 2", "2 >= 2"},
+		{"defined $ENV(MODE) && $(LEVEL) >= 2", "defined $ENV(MODE) && 2 >= 2"},
+		{"defined($(NODE)) || $ENV(MODE) == fast", "defined($(NODE)) || fast == fast"},
+		{"!defined $(NODE)&&$(NODE) == A", "!defined $(NODE)&&A == A"},
+		{"$(NODE) == A && defined NODE", "A == A && defined NODE"},
+	}
+
+	for _, tt := range tests {
+		result, err := cfg.expandCondition(tt.condition)
+		if err != nil {
+			t.Errorf("%s failed: %v", tt.condition, err)
+			continue
+		}
+		if result != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.condition, tt.expected, result)
+		}
+	}
+}
@@ -26,13 +26,16 @@ package config
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net"
 	"os"
 	"os/exec"
 	"os/user"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
@@ -50,6 +53,16 @@ type ConfigOptions struct {
 	// Subsystem is the HTCondor subsystem (e.g., "MASTER", "SCHEDD", "STARTD")
 	// This affects subsystem-specific variable resolution (e.g., MASTER.VARIABLE)
 	Subsystem string
+
+	// ConfigDirs lists directories whose *.conf files NewWithOptions reads
+	// in lexical order after the main configuration file and LOCAL_CONFIG_DIR,
+	// later files overriding earlier ones
+	ConfigDirs []string
+
+	// Strict makes NewWithOptions fail when a configuration directory file
+	// cannot be read or parsed; otherwise the file is skipped and its error
+	// reported by LoadErrors
+	Strict bool
 }
 
 // Config represents an HTCondor configuration with key-value pairs
@@ -57,12 +70,38 @@ type Config struct {
 	values map[string]string
 	// Track macro evaluation depth to detect loops
 	evaluating map[string]bool
+	// expanding is the chain of variables being expanded, to report cycles
+	expanding []string
+	// pass is the expansion in progress, nil between expansions
+	pass *expansionPass
 	// Track included files to prevent cycles
 	includedFiles map[string]bool
 	// Configuration options
 	options ConfigOptions
 	// Track if we're executing inside a metaknob template
 	inMetaknob bool
+	// envLookup resolves $ENV(name); nil means the process environment
+	envLookup func(name string) (string, bool)
+	// random drives $RANDOM_CHOICE and $RANDOM_INTEGER; nil until first use
+	random *rand.Rand
+	// includeDir is the base for relative include paths; empty means the working directory
+	includeDir string
+	// includeDepth is the nesting depth of the include being executed
+	includeDepth int
+	// includesDisabled rejects include directives (see DisableIncludes)
+	includesDisabled bool
+	// sources records where each key was last set (see Explain)
+	sources map[string]valueSource
+	// source is the origin of values currently being set; empty means SourceRuntime
+	source string
+	// line is the line of the statement being executed; 0 outside of one
+	line int
+	// loadErrors are the errors of configuration directory files skipped
+	// while loading
+	loadErrors []error
+	// unknownFunctions are the function macros expansion left unexpanded
+	// because they are not implemented (see UnknownFunctions)
+	unknownFunctions map[string]bool
 }
 
 // New creates a new Config from the runtime environment
@@ -85,7 +124,9 @@ func NewEmpty() *Config {
 	return cfg
 }
 
-// NewWithOptions creates a new Config with specified options
+// NewWithOptions creates a new Config with specified options. It reads the
+// main configuration file, then the files in LOCAL_CONFIG_DIR and
+// opts.ConfigDirs.
 func NewWithOptions(opts ConfigOptions) (*Config, error) {
 	cfg := &Config{
 		values:        make(map[string]string),
@@ -97,8 +138,11 @@ func NewWithOptions(opts ConfigOptions) (*Config, error) {
 	// Initialize with built-in macros and param defaults
 	cfg.initBuiltins()
 
-	// Load from environment
-	return cfg, cfg.LoadFromEnvironment()
+	// Load from environment, then the configuration directories
+	if err := cfg.LoadFromEnvironment(); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.loadConfigDirs()
 }
 
 // NewFromReader creates a Config from an io.Reader using the parser
@@ -147,6 +191,29 @@ func (c *Config) Get(key string) (string, bool) {
 	return expanded, true
 }
 
+// Expand expands every $(NAME) and function macro in expr, recursively, as
+// condor_config_val -expand does. Names that are not defined expand to the
+// default given as $(NAME:default), or to nothing; param defaults count as
+// defined. A chain of references that leads back to itself is an error.
+func (c *Config) Expand(expr string) (string, error) {
+	return c.expandMacrosWithFunctions(expr)
+}
+
+// GetExpanded retrieves the fully expanded value of a configuration key.
+// Unlike Get, which falls back to the unexpanded value, it reports false if
+// the value cannot be expanded, e.g. because it is part of a macro reference
+// cycle; Expand("$(key)") returns the reason.
+func (c *Config) GetExpanded(key string) (string, bool) {
+	if _, ok := c.values[key]; !ok {
+		return "", false
+	}
+	expanded, err := c.expandVariable(key, "")
+	if err != nil {
+		return "", false
+	}
+	return expanded, true
+}
+
 // Set sets a configuration value
 func (c *Config) Set(key, value string) {
 	// Check if this is a self-referential definition
@@ -161,6 +228,87 @@ func (c *Config) Set(key, value string) {
 	}
 
 	c.values[key] = value
+
+	if c.sources == nil {
+		c.sources = make(map[string]valueSource)
+	}
+	source := c.source
+	if source == "" {
+		source = SourceRuntime
+	}
+	c.sources[key] = valueSource{file: source, line: c.line}
+}
+
+// SetEnvLookup sets the function used to resolve $ENV(name) macros.
+// This allows callers to restrict which host environment variables are visible;
+// a lookup returning false expands to the empty string. A nil lookup restores
+// the default of reading the process environment.
+func (c *Config) SetEnvLookup(lookup func(name string) (string, bool)) {
+	c.envLookup = lookup
+}
+
+// SetRandomSeed seeds the generator used by $RANDOM_CHOICE and
+// $RANDOM_INTEGER, so that a configuration expands the same way every time.
+// This is mainly useful for tests.
+func (c *Config) SetRandomSeed(seed int64) {
+	//nolint:gosec // G404: Non-cryptographic random is appropriate for config macros
+	c.random = rand.New(rand.NewSource(seed))
+}
+
+// SetIncludeDir sets the directory that relative include paths are resolved
+// against. An empty dir restores the default of the current working directory.
+func (c *Config) SetIncludeDir(dir string) {
+	// Make the base absolute so paths already resolved against it (such as
+	// glob matches) are not joined a second time
+	if dir != "" {
+		if abs, err := filepath.Abs(dir); err == nil {
+			dir = abs
+		}
+	}
+	c.includeDir = dir
+}
+
+// ErrIncludeDisabled is returned for an include directive in a
+// configuration whose includes are disabled
+var ErrIncludeDisabled = errors.New("include is disabled")
+
+// DisableIncludes makes every form of include directive (include,
+// include_ifexist and their command variants) fail with ErrIncludeDisabled,
+// for text from untrusted sources: an include would read local files, and
+// an include command would run a shell command.
+func (c *Config) DisableIncludes() {
+	c.includesDisabled = true
+}
+
+// Clone returns an independent copy of the configuration.
+// Later changes to either Config are not visible in the other.
+func (c *Config) Clone() *Config {
+	clone := &Config{
+		values:           make(map[string]string, len(c.values)),
+		evaluating:       make(map[string]bool),
+		includedFiles:    make(map[string]bool, len(c.includedFiles)),
+		options:          c.options,
+		envLookup:        c.envLookup,
+		includeDir:       c.includeDir,
+		includesDisabled: c.includesDisabled,
+		sources:          make(map[string]valueSource, len(c.sources)),
+		source:           c.source,
+	}
+	if c.random != nil {
+		// Seed the clone from this generator, so a seeded Config still
+		// yields deterministic clones
+		clone.SetRandomSeed(c.random.Int63())
+	}
+	for k, v := range c.values {
+		clone.values[k] = v
+	}
+	for k, v := range c.sources {
+		clone.sources[k] = v
+	}
+	for k, v := range c.includedFiles {
+		clone.includedFiles[k] = v
+	}
+	return clone
 }
 
 // Keys returns all configuration keys
@@ -174,9 +322,14 @@ func (c *Config) Keys() []string {
 
 // initBuiltins initializes built-in predefined macros
 func (c *Config) initBuiltins() {
+	defer c.setSource(SourceDefault)()
+
 	// First, load defaults from param_info.in (unexpanded)
 	// These act as the base defaults that can be overridden
 	for _, pd := range paramDefaults {
+		if pd.Default == "" && pd.Win32Default == "" {
+			continue // Declared without a default
+		}
 		// Use Win32Default on Windows if available, otherwise use Default
 		defaultVal := pd.Default
 		if pd.Win32Default != "" && isWindows() {
@@ -894,15 +1047,21 @@ func (c *Config) parseReader(r io.Reader, filename string) error {
 			return fmt.Errorf("circular include detected: %s", filename)
 		}
 		c.includedFiles[filename] = true
+		defer c.setSource(filename)()
 	}
 
 	scanner := bufio.NewScanner(r)
 	var currentLine string
 	lineNum := 0
+	startLine := 0
+	defer c.setLine(0)()
 
 	for scanner.Scan() {
 		lineNum++
 		line := scanner.Text()
+		if currentLine == "" {
+			startLine = lineNum
+		}
 
 		// Handle line continuation
 		if strings.HasSuffix(strings.TrimSpace(line), "\\") {
@@ -913,6 +1072,7 @@ func (c *Config) parseReader(r io.Reader, filename string) error {
 		currentLine += line
 
 		// Process the complete line
+		c.line = startLine
 		if err := c.parseLine(currentLine); err != nil {
 			return fmt.Errorf("line %d: %w", lineNum, err)
 		}
@@ -964,7 +1124,9 @@ func (c *Config) LoadFromEnvironment() error {
 			if len(parts) == 2 {
 				key := strings.TrimPrefix(parts[0], "_CONDOR_")
 				key = strings.TrimPrefix(key, "_condor_")
+				restore := c.setSource(SourceEnvironment)
 				c.Set(key, parts[1])
+				restore()
 			}
 		}
 	}
@@ -1014,71 +1176,131 @@ func (c *Config) LoadFromEnvironment() error {
 	return nil
 }
 
+// loadConfigDirs reads the directories listed in LOCAL_CONFIG_DIR and then
+// those in ConfigOptions.ConfigDirs
+func (c *Config) loadConfigDirs() error {
+	if err := c.processLocalConfigDir(); err != nil {
+		return err
+	}
+	for _, dir := range c.options.ConfigDirs {
+		if err := c.processConfigDir(dir, isConfFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isConfFile selects the files of ConfigOptions.ConfigDirs
+func isConfFile(name string) bool {
+	return strings.HasSuffix(name, ".conf") && !strings.HasPrefix(name, ".")
+}
+
 // processLocalConfigDir processes directories listed in LOCAL_CONFIG_DIR
 // Directories are processed left-to-right, files within each directory are
-// processed in lexicographical order
+// processed in lexicographical order, skipping those whose names match
+// LOCAL_CONFIG_DIR_EXCLUDE_REGEXP
 func (c *Config) processLocalConfigDir() error {
-	dirList, ok := c.Get("LOCAL_CONFIG_DIR")
+	dirList, ok := c.GetExpanded("LOCAL_CONFIG_DIR")
 	if !ok || dirList == "" {
 		return nil
 	}
 
-	// Split on comma and/or space
-	dirs := splitConfigList(dirList)
+	include := func(string) bool { return true }
+	if pattern, ok := c.GetExpanded("LOCAL_CONFIG_DIR_EXCLUDE_REGEXP"); ok && pattern != "" {
+		exclude, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid LOCAL_CONFIG_DIR_EXCLUDE_REGEXP: %w", err)
+		}
+		include = func(name string) bool { return !exclude.MatchString(name) }
+	}
 
-	for _, dir := range dirs {
+	// Split on comma and/or space
+	for _, dir := range splitConfigList(dirList) {
 		dir = strings.TrimSpace(dir)
 		if dir == "" {
 			continue
 		}
-
-		// Check if directory exists
-		info, err := os.Stat(dir)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue // Skip non-existent directories
-			}
-			return fmt.Errorf("error accessing directory %s: %w", dir, err)
-		}
-
-		if !info.IsDir() {
-			continue // Skip non-directories
+		if err := c.processConfigDir(dir, include); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		// Read directory entries
-		entries, err := os.ReadDir(dir)
-		if err != nil {
-			return fmt.Errorf("error reading directory %s: %w", dir, err)
+// processConfigDir reads the files in dir accepted by include, in
+// lexicographical order. A missing directory is skipped. Files that cannot
+// be read or parsed fail the load in strict mode; otherwise they are logged,
+// recorded for LoadErrors and skipped.
+func (c *Config) processConfigDir(dir string, include func(name string) bool) error {
+	// Check if directory exists
+	info, err := os.Stat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // Skip non-existent directories
 		}
+		return c.loadError(fmt.Errorf("error accessing directory %s: %w", dir, err))
+	}
+	if !info.IsDir() {
+		return nil // Skip non-directories
+	}
 
-		// Sort entries lexicographically (ReadDir already returns sorted)
-		for _, entry := range entries {
-			if entry.IsDir() {
-				continue // Skip subdirectories
-			}
-
-			filePath := filepath.Join(dir, entry.Name())
-
-			// Open and parse the file
-			//nolint:gosec // G304: Config directory path comes from validated configuration
-			f, err := os.Open(filePath)
-			if err != nil {
-				return fmt.Errorf("error opening %s: %w", filePath, err)
-			}
-			err = c.parseAndExecute(f)
-			if cerr := f.Close(); cerr != nil && err == nil {
-				err = fmt.Errorf("failed to close file: %w", cerr)
-			}
+	// ReadDir returns the entries sorted by name
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return c.loadError(fmt.Errorf("error reading directory %s: %w", dir, err))
+	}
 
-			if err != nil {
-				return fmt.Errorf("error parsing %s: %w", filePath, err)
+	for _, entry := range entries {
+		if entry.IsDir() || !include(entry.Name()) {
+			continue
+		}
+		if err := c.loadConfigFile(filepath.Join(dir, entry.Name())); err != nil {
+			if err := c.loadError(err); err != nil {
+				return err
 			}
 		}
 	}
+	return nil
+}
+
+// loadConfigFile parses and executes the configuration file at path
+func (c *Config) loadConfigFile(path string) error {
+	//nolint:gosec // G304: Config file path comes from validated configuration
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", path, err)
+	}
+	restoreSource := c.setSource(path)
+	restore := c.enterIncludeDir(path)
+	err = c.parseAndExecute(f)
+	restore()
+	restoreSource()
+	if cerr := f.Close(); cerr != nil && err == nil {
+		err = fmt.Errorf("failed to close file: %w", cerr)
+	}
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return nil
+}
 
+// loadError returns err in strict mode; otherwise it records err for
+// LoadErrors and returns nil so loading continues
+func (c *Config) loadError(err error) error {
+	if c.options.Strict {
+		return err
+	}
+	c.loadErrors = append(c.loadErrors, err)
 	return nil
 }
 
+// LoadErrors returns the errors from configuration directory files that
+// were skipped because they could not be read or parsed. It is always
+// empty when ConfigOptions.Strict is set, as such errors fail the load.
+func (c *Config) LoadErrors() []error {
+	return c.loadErrors
+}
+
 // processLocalConfigFile processes files listed in LOCAL_CONFIG_FILE
 // Files are processed left-to-right
 func (c *Config) processLocalConfigFile() error {
@@ -1108,18 +1330,8 @@ func (c *Config) processLocalConfigFile() error {
 		}
 
 		// Regular file - open and parse
-		//nolint:gosec // G304: File path comes from validated config directive
-		f, err := os.Open(file)
-		if err != nil {
-			return fmt.Errorf("error opening %s: %w", file, err)
-		}
-		err = c.parseAndExecute(f)
-		if cerr := f.Close(); cerr != nil && err == nil {
-			err = fmt.Errorf("failed to close file: %w", cerr)
-		}
-
-		if err != nil {
-			return fmt.Errorf("error parsing %s: %w", file, err)
+		if err := c.loadConfigFile(file); err != nil {
+			return err
 		}
 	}
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -152,6 +153,86 @@ B = $(A)
 	t.Logf("A = %q (circular reference detected)", val)
 }
 
+func TestExpand(t *testing.T) {
+	input := `
+LOCAL_DIR = /var/lib/condor
+A = $(B)/x
+B = $(C:fallback)
+X = $(Y)
+Y = $(Z)
+Z = $(X)
+`
+	cfg, err := NewFromReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"$(SPOOL)", "/var/lib/condor/spool"}, // param default referring to LOCAL_DIR
+		{"$(A)", "fallback/x"},
+		{"price $5 for $(A)", "price $5 for fallback/x"},
+		{"$(UNDEFINED_PARAM:$(B))", "fallback"},
+		{"$(UNDEFINED_PARAM)", ""},
+	}
+	for _, tt := range tests {
+		got, err := cfg.Expand(tt.expr)
+		if err != nil {
+			t.Errorf("Expand(%q): %v", tt.expr, err)
+		} else if got != tt.want {
+			t.Errorf("Expand(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+
+	if val, ok := cfg.GetExpanded("A"); !ok || val != "fallback/x" {
+		t.Errorf("GetExpanded(A) = %q, %v; want fallback/x", val, ok)
+	}
+	if _, ok := cfg.GetExpanded("UNDEFINED_PARAM"); ok {
+		t.Error("GetExpanded(UNDEFINED_PARAM) reported a value")
+	}
+
+	// A reference cycle is an error rather than an endless expansion
+	_, err = cfg.Expand("value: $(X)")
+	if err == nil || !strings.Contains(err.Error(), "X -> Y -> Z -> X") {
+		t.Errorf("Expected a cycle error naming X -> Y -> Z -> X, got %v", err)
+	}
+	if _, ok := cfg.GetExpanded("Y"); ok {
+		t.Error("GetExpanded(Y) reported a value for a cyclic reference")
+	}
+}
+
+func TestExpandRepeatedReferences(t *testing.T) {
+	// Each level references the one below twice: expanding every reference
+	// anew would take 2^40 steps
+	var input strings.Builder
+	input.WriteString("L0 = x\n")
+	for i := 1; i <= 40; i++ {
+		fmt.Fprintf(&input, "L%d = $SUBSTR($(L%d)$(L%d), 0, 1)\n", i, i-1, i-1)
+	}
+	// Each level doubles the one below: the expansion is 2^40 bytes
+	input.WriteString("D0 = xx\n")
+	for i := 1; i <= 40; i++ {
+		fmt.Fprintf(&input, "D%d = $(D%d)$(D%d)\n", i, i-1, i-1)
+	}
+	cfg, err := NewFromReader(strings.NewReader(input.String()))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	if got, err := cfg.Expand("$(L40)"); err != nil || got != "x" {
+		t.Errorf("Expand($(L40)) = %q, %v; want x", got, err)
+	}
+	if _, err := cfg.Expand("$(D40)"); err == nil || !strings.Contains(err.Error(), "longer than") {
+		t.Errorf("Expected an expansion size error, got %v", err)
+	}
+	// The failed expansion leaves later ones unaffected
+	if got, err := cfg.Expand("$(D2)"); err != nil || got != strings.Repeat("x", 8) {
+		t.Errorf("Expand($(D2)) = %q, %v; want 8 x's", got, err)
+	}
+}
+
 func TestBuiltinMacros(t *testing.T) {
 	cfg, err := NewFromReader(strings.NewReader(""))
 	if err != nil {
@@ -774,6 +855,58 @@ func TestCircularIncludeDetection(t *testing.T) {
 	}
 }
 
+func TestIncludeRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Each file's relative includes resolve against its own directory
+	writeFile("top.config", "TOP = 1\ninclude : config.d/middle.config\n")
+	writeFile("config.d/middle.config", "MIDDLE = 2\ninclude : bottom.config # trailing comment\n")
+	writeFile("config.d/bottom.config", "BOTTOM = 3\n")
+	writeFile("values.txt", "FROM_COMMAND = 4\n")
+
+	cfg := NewEmpty()
+	input := "include : " + filepath.Join(dir, "top.config") + "\ninclude command : cat " + filepath.Join(dir, "values.txt") + "\n"
+	if err := cfg.parseAndExecute(strings.NewReader(input)); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	for key, want := range map[string]string{"TOP": "1", "MIDDLE": "2", "BOTTOM": "3", "FROM_COMMAND": "4"} {
+		if got, ok := cfg.Get(key); !ok || got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+
+	// Errors name the file they occur in
+	writeFile("config.d/broken.config", "include : missing.config\n")
+	err := NewEmpty().parseAndExecute(strings.NewReader("include : " + filepath.Join(dir, "config.d/broken.config") + "\n"))
+	if err == nil || !strings.Contains(err.Error(), "broken.config") || !strings.Contains(err.Error(), filepath.Join(dir, "config.d/missing.config")) {
+		t.Errorf("Expected an error naming broken.config and the missing file, got %v", err)
+	}
+}
+
+func TestIncludeDepthLimit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("include command uses sh")
+	}
+	// A command that includes itself is not caught by the cycle check
+	loop := filepath.Join(t.TempDir(), "loop.config")
+	if err := os.WriteFile(loop, []byte("include command : cat "+loop+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	err := NewEmpty().parseAndExecute(strings.NewReader("include : " + loop + "\n"))
+	if err == nil || !strings.Contains(err.Error(), "nested more than") || !strings.Contains(err.Error(), loop) {
+		t.Errorf("Expected a nesting depth error naming %s, got %v", loop, err)
+	}
+}
+
 func TestMacroDefaultWithArithmetic(t *testing.T) {
 	input := `DETECTED_CPUS_LIMIT = 4
 MAX_ALLOC_CPUS = $(NUMCPUS:$(DETECTED_CPUS_LIMIT))-1
@@ -793,3 +926,24 @@ MAX_ALLOC_CPUS = $(NUMCPUS:$(DETECTED_CPUS_LIMIT))-1
 		t.Errorf("MAX_ALLOC_CPUS = %q, want '4-1'", val)
 	}
 }
+
+func TestClone(t *testing.T) {
+	cfg, err := NewFromReader(strings.NewReader("A = one\nB = $(A) two\n"))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	clone := cfg.Clone()
+	cfg.Set("A", "changed")
+	clone.Set("C", "three")
+
+	if val, ok := clone.Get("B"); !ok || val != "one two" {
+		t.Errorf("clone B = %q, want 'one two'", val)
+	}
+	if val, ok := cfg.Get("B"); !ok || val != "changed two" {
+		t.Errorf("original B = %q, want 'changed two'", val)
+	}
+	if _, ok := cfg.Get("C"); ok {
+		t.Error("C set on clone should not be visible in original")
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -49,6 +52,11 @@ func (c *Config) executeStatement(stmt Statement) error {
 func (c *Config) executeAssignment(a *Assignment) error {
 	value := a.Value
 
+	// Values set by a metaknob template are attributed to the use statement
+	if !c.inMetaknob {
+		defer c.setLine(a.Line)()
+	}
+
 	// If we're in a metaknob, expand parameters before storing
 	// This is necessary because metaknob parameters ($(1), $(2), etc.)
 	// are deleted after the metaknob template finishes executing
@@ -66,10 +74,73 @@ func (c *Config) executeAssignment(a *Assignment) error {
 	return nil
 }
 
+// definedKeyword matches the "defined" operator in a condition
+var definedKeyword = regexp.MustCompile(`\bdefined\b`)
+
+// expandCondition expands macros in an if/elif condition, except in the
+// operands of "defined": evaluateCondition expands those itself, as
+// "defined $ENV(X)" must test the expansion rather than look up a macro
+// named after X's value.
+func (c *Config) expandCondition(condition string) (string, error) {
+	var b strings.Builder
+	rest := condition
+	for {
+		loc := definedKeyword.FindStringIndex(rest)
+		if loc == nil {
+			break
+		}
+		expanded, err := c.expandMacrosWithFunctions(rest[:loc[0]])
+		if err != nil {
+			return "", err
+		}
+		end := loc[1] + definedOperandLen(rest[loc[1]:])
+		b.WriteString(expanded)
+		b.WriteString(rest[loc[0]:end])
+		rest = rest[end:]
+	}
+	expanded, err := c.expandMacrosWithFunctions(rest)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(expanded)
+	return b.String(), nil
+}
+
+// definedOperandLen returns the length of the operand at the start of s,
+// which follows a "defined" keyword: a parenthesized name, or a name
+// (possibly holding macro references) ending at whitespace, a closing
+// parenthesis or a logical operator
+func definedOperandLen(s string) int {
+	i := len(s) - len(strings.TrimLeft(s, " \t"))
+	if i < len(s) && s[i] == '(' {
+		if end := matchingParen(s, i+1); end != -1 {
+			return end + 1
+		}
+		return len(s)
+	}
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case ' ', '\t', '&', '|':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return i
+}
+
 // executeConditional executes an if/elif/else/endif block
 func (c *Config) executeConditional(cond *Conditional) error {
 	// Expand macros in condition before evaluation
-	expandedCondition, err := c.expandMacrosWithFunctions(cond.Condition)
+	expandedCondition, err := c.expandCondition(cond.Condition)
 	if err != nil {
 		return fmt.Errorf("error expanding condition %q: %w", cond.Condition, err)
 	}
@@ -87,7 +158,7 @@ func (c *Config) executeConditional(cond *Conditional) error {
 	// Try elif blocks
 	for _, elif := range cond.ElseIfBlock {
 		// Expand macros in elif condition
-		expandedElifCondition, err := c.expandMacrosWithFunctions(elif.Condition)
+		expandedElifCondition, err := c.expandCondition(elif.Condition)
 		if err != nil {
 			return fmt.Errorf("error expanding elif condition %q: %w", elif.Condition, err)
 		}
@@ -110,8 +181,16 @@ func (c *Config) executeConditional(cond *Conditional) error {
 	return nil
 }
 
+// maxIncludeDepth limits how deeply includes nest, which stops include
+// loops the cycle check cannot see, such as a command that includes itself
+const maxIncludeDepth = 20
+
 // executeInclude executes an include directive
 func (c *Config) executeInclude(inc *IncludeDirective) error {
+	if c.includesDisabled {
+		return fmt.Errorf("%s %q: %w", inc.Type, inc.Path, ErrIncludeDisabled)
+	}
+
 	// Expand macros in the path
 	path, err := c.expandMacrosWithFunctions(inc.Path)
 	if err != nil {
@@ -139,6 +218,10 @@ func (c *Config) executeInclude(inc *IncludeDirective) error {
 
 // includeFile includes a configuration file or glob pattern
 func (c *Config) includeFile(path string, optional bool) error {
+	if c.includeDir != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(c.includeDir, path)
+	}
+
 	// Check for glob patterns
 	if strings.ContainsAny(path, "*?[]") {
 		matches, err := filepath.Glob(path)
@@ -171,6 +254,9 @@ func (c *Config) includeFile(path string, optional bool) error {
 	if c.includedFiles[absPath] {
 		return fmt.Errorf("circular include detected: %s", path)
 	}
+	if c.includeDepth >= maxIncludeDepth {
+		return fmt.Errorf("include of %s nested more than %d deep", path, maxIncludeDepth)
+	}
 
 	// Open the file
 	//nolint:gosec // G304: Config path comes from validated config directive
@@ -190,13 +276,34 @@ func (c *Config) includeFile(path string, optional bool) error {
 	// Mark as included
 	c.includedFiles[absPath] = true
 	defer delete(c.includedFiles, absPath)
+	c.includeDepth++
+	defer func() { c.includeDepth-- }()
+
+	// Parse and execute the file; its own includes are relative to it
+	defer c.setSource(path)()
+	defer c.enterIncludeDir(absPath)()
+	if err := c.parseAndExecute(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
 
-	// Parse and execute the file
-	return c.parseAndExecute(f)
+// enterIncludeDir makes relative include paths resolve against the
+// directory of the config file path, until the returned function is called
+func (c *Config) enterIncludeDir(path string) func() {
+	saved := c.includeDir
+	if abs, err := filepath.Abs(path); err == nil {
+		c.includeDir = filepath.Dir(abs)
+	}
+	return func() { c.includeDir = saved }
 }
 
 // includeCommand executes a command and includes its output
 func (c *Config) includeCommand(command string) error {
+	if c.includeDepth >= maxIncludeDepth {
+		return fmt.Errorf("include of command %q nested more than %d deep", command, maxIncludeDepth)
+	}
+
 	// Execute the command
 	cmd := exec.CommandContext(context.Background(), "sh", "-c", command)
 	output, err := cmd.Output()
@@ -204,8 +311,15 @@ func (c *Config) includeCommand(command string) error {
 		return fmt.Errorf("error executing command %q: %w", command, err)
 	}
 
-	// Parse the output as configuration
-	return c.parseAndExecute(strings.NewReader(string(output)))
+	// Parse the output as configuration, recording its values as coming
+	// from the command in LOCAL_CONFIG_FILE syntax
+	defer c.setSource(command + " |")()
+	c.includeDepth++
+	defer func() { c.includeDepth-- }()
+	if err := c.parseAndExecute(strings.NewReader(string(output))); err != nil {
+		return fmt.Errorf("output of command %q: %w", command, err)
+	}
+	return nil
 }
 
 // parseAndExecute parses and executes configuration from a reader
@@ -224,13 +338,16 @@ func (c *Config) parseAndExecute(r io.Reader) error {
 	return c.executeStatements(stmts)
 }
 
-// executeUse executes a use directive (role-based configuration)
+// executeUse executes a use directive (role-based configuration):
+// "use CATEGORY : NAME", where NAME may take arguments, as in
+// "use POLICY : Hold_If_Memory_Exceeded", and several names may be listed,
+// as in "use ROLE : Submit, Execute". Each name is a metaknob template from
+// the param defaults ($CATEGORY.NAME), matched without regard to case.
 func (c *Config) executeUse(use *UseDirective) error {
-	// Parse the use directive: "FEATURE : NAME" or "POLICY : NAME(args)"
-	// The Role field contains the full string after "use"
+	defer c.setLine(use.Line)()
 
 	// First, expand any macros in the role string
-	roleStr, _ := c.expandMacrosWithFunctions(use.Role) // Parse: TYPE : NAME or TYPE : NAME(args)
+	roleStr, _ := c.expandMacrosWithFunctions(use.Role)
 	parts := strings.SplitN(roleStr, ":", 2)
 	if len(parts) != 2 {
 		// Old-style "use ROLE" - just set the ROLE variable
@@ -238,89 +355,137 @@ func (c *Config) executeUse(use *UseDirective) error {
 		return nil
 	}
 
-	roleType := strings.TrimSpace(parts[0])
-	roleSpec := strings.TrimSpace(parts[1])
+	category := strings.ToUpper(strings.TrimSpace(parts[0]))
+	if !c.hasMetaknobCategory(category) {
+		return fmt.Errorf("use %s: unknown metaknob category %q (known categories: %s)",
+			strings.TrimSpace(roleStr), category, strings.Join(c.metaknobCategories(), ", "))
+	}
 
-	// Extract name and parameters from roleSpec
-	// e.g., "NAME" or "NAME(arg1, arg2, arg3)"
-	var roleName string
-	var params []string
+	for _, item := range splitParams(parts[1]) {
+		if item == "" {
+			continue
+		}
+		if err := c.applyMetaknob(category, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if idx := strings.Index(roleSpec, "("); idx >= 0 {
-		roleName = strings.TrimSpace(roleSpec[:idx])
-		// Extract parameters
-		endIdx := strings.LastIndex(roleSpec, ")")
-		if endIdx < 0 {
-			return fmt.Errorf("use directive: mismatched parentheses in %q", roleSpec)
+// applyMetaknob expands one metaknob, "NAME" or "NAME(arg1, arg2, ...)",
+// into its assignments
+func (c *Config) applyMetaknob(category, item string) error {
+	var name string
+	var params []string
+	if idx := strings.Index(item, "("); idx >= 0 {
+		name = strings.TrimSpace(item[:idx])
+		endIdx := strings.LastIndex(item, ")")
+		if endIdx < idx {
+			return fmt.Errorf("use directive: mismatched parentheses in %q", item)
 		}
-		paramStr := roleSpec[idx+1 : endIdx]
-		if paramStr != "" {
+		if paramStr := item[idx+1 : endIdx]; paramStr != "" {
 			// Split by commas, but be careful with nested function calls
 			params = splitParams(paramStr)
 		}
 	} else {
-		roleName = roleSpec
+		name = item
 	}
 
-	// Look up the metaknob: $TYPE.NAME
-	// Use raw value access to avoid premature macro expansion
-	metaknobKey := fmt.Sprintf("$%s.%s", strings.ToUpper(roleType), roleName)
-	metaknobValue, ok := c.values[metaknobKey]
+	// Look up the metaknob $CATEGORY.NAME, using the raw value to avoid
+	// premature macro expansion
+	metaknobKey, metaknobValue, ok := c.lookupMetaknob(category, name)
 	if !ok {
-		// Not found - this might be OK for some use cases
-		// Just set ROLE variable for compatibility
-		c.values["ROLE"] = roleStr
-		return nil
+		return fmt.Errorf("use %s : %s: no such %s metaknob", category, name, category)
 	}
 
-	// Now we need to execute the metaknob template
-	// First, set up numbered parameter variables $(1), $(2), etc.
-	oldParams := make(map[string]string)
-	for i, param := range params {
-		paramNum := fmt.Sprintf("%d", i+1)
-		oldVal, hadOld := c.values[paramNum]
-		if hadOld {
-			oldParams[paramNum] = oldVal
+	// The arguments are $(1), $(2), ... while the template executes; those of
+	// an enclosing metaknob are hidden and restored afterwards
+	saved := make(map[string]string)
+	for i := 1; i <= 9; i++ {
+		paramNum := fmt.Sprintf("%d", i)
+		if oldVal, ok := c.values[paramNum]; ok {
+			saved[paramNum] = oldVal
+			delete(c.values, paramNum)
+		}
+	}
+	defer func() {
+		for i := 1; i <= 9; i++ {
+			delete(c.values, fmt.Sprintf("%d", i))
+		}
+		for paramNum, oldVal := range saved {
+			c.values[paramNum] = oldVal
 		}
+	}()
+	for i, param := range params {
 		// Expand macros in the parameter value before storing
 		expandedParam, _ := c.expandMacrosWithFunctions(param)
-		c.values[paramNum] = expandedParam
+		c.values[fmt.Sprintf("%d", i+1)] = expandedParam
 	}
 
-	// Also set "1+", "2+", etc. for the $(1+) syntax (remaining params)
-	// But we need to handle this in expandMacrosWithFunctions
-
-	// Parse and execute the metaknob template as if it were a config file
-	lex := NewLexer(strings.NewReader(metaknobValue))
-	stmts, err := Parse(lex)
+	// Arguments may appear anywhere in the template, even in the names
+	// assigned to, so they are substituted before it is parsed
+	template := metaknobArgRef.ReplaceAllStringFunc(metaknobValue, func(ref string) string {
+		return c.expandMetaknobParam(ref[2 : len(ref)-1])
+	})
+	stmts, err := Parse(NewLexer(strings.NewReader(template)))
 	if err != nil {
 		return fmt.Errorf("use directive: failed to parse metaknob %s: %w", metaknobKey, err)
 	}
 
 	// Set flag to expand parameters during assignment
+	wasInMetaknob := c.inMetaknob
 	c.inMetaknob = true
-	defer func() { c.inMetaknob = false }()
+	defer func() { c.inMetaknob = wasInMetaknob }()
 
-	// Execute the statements from the metaknob
 	for _, stmt := range stmts {
 		if err := c.executeStatement(stmt); err != nil {
 			return fmt.Errorf("use directive: error executing metaknob %s: %w", metaknobKey, err)
 		}
 	}
+	return nil
+}
+
+// metaknobArgRef matches references to metaknob arguments: $(1), $(2?),
+// $(0#), $(3+), $(1:default) and so on
+var metaknobArgRef = regexp.MustCompile(`\$\([0-9][?#+]?(:[^()]*)?\)`)
 
-	// Restore old parameter values
-	for paramNum, oldVal := range oldParams {
-		c.values[paramNum] = oldVal
+// lookupMetaknob returns the key and template of the metaknob
+// $CATEGORY.NAME, preferring an exact match over one differing in case
+func (c *Config) lookupMetaknob(category, name string) (string, string, bool) {
+	key := "$" + category + "." + name
+	if value, ok := c.values[key]; ok {
+		return key, value, true
 	}
-	// Clean up new parameter values that didn't exist before
-	for i := range params {
-		paramNum := fmt.Sprintf("%d", i+1)
-		if _, hadOld := oldParams[paramNum]; !hadOld {
-			delete(c.values, paramNum)
+	for k, value := range c.values {
+		if strings.EqualFold(k, key) {
+			return k, value, true
 		}
 	}
+	return "", "", false
+}
 
-	return nil
+// hasMetaknobCategory reports whether any metaknob is defined in category
+func (c *Config) hasMetaknobCategory(category string) bool {
+	return slices.Contains(c.metaknobCategories(), category)
+}
+
+// metaknobCategories returns the sorted categories of the defined metaknobs
+func (c *Config) metaknobCategories() []string {
+	seen := make(map[string]bool)
+	for k := range c.values {
+		if !strings.HasPrefix(k, "$") {
+			continue
+		}
+		if dot := strings.Index(k, "."); dot > 1 {
+			seen[strings.ToUpper(k[1:dot])] = true
+		}
+	}
+	categories := make([]string, 0, len(seen))
+	for category := range seen {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
 }
 
 // splitParams splits a parameter list by commas, respecting nested parentheses
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -253,6 +254,35 @@ func TestExecuteIncludeCommand(t *testing.T) {
 	}
 }
 
+func TestExecuteIncludeDisabled(t *testing.T) {
+	tmpDir := t.TempDir()
+	marker := filepath.Join(tmpDir, "ran")
+	included := filepath.Join(tmpDir, "included.conf")
+	if err := os.WriteFile(included, []byte("SECRET = leaked\n"), 0644); err != nil {
+		t.Fatalf("Failed to write included file: %v", err)
+	}
+
+	for _, inc := range []*IncludeDirective{
+		{Type: "include", Path: included},
+		{Type: "include_ifexist", Path: included},
+		{Type: "include_command", Path: "touch " + marker},
+		{Type: "include_ifexist_command", Path: "touch " + marker},
+	} {
+		cfg := NewEmpty()
+		cfg.DisableIncludes()
+		err := cfg.executeInclude(inc)
+		if !errors.Is(err, ErrIncludeDisabled) {
+			t.Errorf("%s: expected ErrIncludeDisabled, got %v", inc.Type, err)
+		}
+		if _, ok := cfg.Get("SECRET"); ok {
+			t.Errorf("%s: expected the file not to be read", inc.Type)
+		}
+	}
+	if _, err := os.Stat(marker); err == nil {
+		t.Error("Expected the include command not to run")
+	}
+}
+
 func TestExecuteCircularInclude(t *testing.T) {
 	tmpDir := t.TempDir()
 	config1 := filepath.Join(tmpDir, "config1.conf")
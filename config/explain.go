@@ -0,0 +1,172 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Sources reported by Explain for values that did not come from a config file
+const (
+	// SourceDefault marks param defaults and built-in macros
+	SourceDefault = "<Default>"
+	// SourceEnvironment marks values from _CONDOR_ environment variables
+	SourceEnvironment = "<Environment>"
+	// SourceRuntime marks values set by the program via Set, Apply or ApplyStatements
+	SourceRuntime = "<Runtime>"
+)
+
+// Explanation describes a configuration value and where it came from
+type Explanation struct {
+	Name     string // Configuration key
+	Value    string // Value as written, before macro expansion
+	Expanded string // Value after macro expansion
+	Source   string // Config file path, or one of SourceDefault, SourceEnvironment or SourceRuntime
+	Line     int    // Line of the statement that set the value; 0 if not set by one
+}
+
+// valueSource records where a value was last set
+type valueSource struct {
+	file string
+	line int
+}
+
+// Explain reports the value of key and where it was last set, like
+// condor_config_val -verbose
+func (c *Config) Explain(key string) (Explanation, bool) {
+	raw, ok := c.values[key]
+	if !ok {
+		return Explanation{}, false
+	}
+	expanded, _ := c.Get(key)
+	file, line, _ := c.Source(key)
+	return Explanation{Name: key, Value: raw, Expanded: expanded, Source: file, Line: line}, true
+}
+
+// Source reports which file and line last assigned key. file is a config
+// file path, "cmd |" for the output of a command, or one of SourceDefault,
+// SourceEnvironment or SourceRuntime. line is the line of the assignment
+// (or of the use statement that expanded to it), and 0 for values that no
+// parsed statement set, such as param defaults and Set calls.
+func (c *Config) Source(key string) (file string, line int, ok bool) {
+	if _, ok := c.values[key]; !ok {
+		return "", 0, false
+	}
+	source, ok := c.sources[key]
+	if !ok {
+		// Param defaults are loaded without going through Set
+		return SourceDefault, 0, true
+	}
+	return source.file, source.line, true
+}
+
+// DumpOptions controls what Dump writes
+type DumpOptions struct {
+	// Expand writes values after macro expansion instead of as written
+	Expand bool
+	// IncludeDefaults also writes param defaults and built-in macros that
+	// were never overridden
+	IncludeDefaults bool
+	// ShowSource appends where each value was set as a trailing comment
+	ShowSource bool
+}
+
+// Dump writes every configuration value as "KEY = value" lines sorted by key,
+// like condor_config_val -dump. Values spanning several lines are written
+// as "KEY @=end" blocks, with any source comment on the line before them.
+// Values are not changed.
+func (c *Config) Dump(w io.Writer, opts DumpOptions) error {
+	keys := c.Keys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		exp, _ := c.Explain(key)
+		if exp.Source == SourceDefault && !opts.IncludeDefaults {
+			continue
+		}
+		value := exp.Value
+		if opts.Expand {
+			value = exp.Expanded
+		}
+		var err error
+		switch {
+		case strings.Contains(value, "\n") && opts.ShowSource:
+			_, err = fmt.Fprintf(w, "# %s\n%s @=end\n%s\n@end\n", exp.location(), key, value)
+		case strings.Contains(value, "\n"):
+			_, err = fmt.Fprintf(w, "%s @=end\n%s\n@end\n", key, value)
+		case opts.ShowSource:
+			_, err = fmt.Fprintf(w, "%s = %s # %s\n", key, value, exp.location())
+		default:
+			_, err = fmt.Fprintf(w, "%s = %s\n", key, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply sets a batch of overrides, such as values from command-line flags.
+// Values are stored unexpanded like Set, so they may refer to other keys and
+// to each other. Overrides are recorded with SourceRuntime.
+func (c *Config) Apply(overrides map[string]string) {
+	// Apply in a stable order so self-references behave the same on every run
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	defer c.setSource(SourceRuntime)()
+	for _, key := range keys {
+		c.Set(key, overrides[key])
+	}
+}
+
+// ApplyStatements parses text as configuration (assignments, if blocks,
+// includes and so on) and executes it on top of the current values.
+// The change is atomic: if parsing or execution fails, c is left unchanged.
+// Values set by text are recorded with SourceRuntime.
+func (c *Config) ApplyStatements(text string) error {
+	stmts, err := Parse(NewLexer(strings.NewReader(text)))
+	if err != nil {
+		return err
+	}
+
+	staged := c.Clone()
+	restore := staged.setSource(SourceRuntime)
+	err = staged.executeStatements(stmts)
+	restore()
+	if err != nil {
+		return err
+	}
+
+	c.values = staged.values
+	c.sources = staged.sources
+	return nil
+}
+
+// location formats the source as "file:line", or just the source when no
+// line is known
+func (e Explanation) location() string {
+	if e.Line == 0 {
+		return e.Source
+	}
+	return fmt.Sprintf("%s:%d", e.Source, e.Line)
+}
+
+// setLine sets the line recorded for values set until the returned function
+// is called, which restores the previous line
+func (c *Config) setLine(line int) func() {
+	previous := c.line
+	c.line = line
+	return func() { c.line = previous }
+}
+
+// setSource sets the origin recorded for values set until the returned
+// function is called, which restores the previous origin
+func (c *Config) setSource(source string) func() {
+	previous := c.source
+	c.source = source
+	return func() { c.source = previous }
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestApplyOverrides(t *testing.T) {
+	cfg, err := NewFromReader(strings.NewReader("BASE = /opt/condor\nLOG = $(BASE)/log\nSPOOL = $(BASE)/spool\n"))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	cfg.Apply(map[string]string{"BASE": "/srv/condor", "SPOOL": "/scratch/spool"})
+	cfg.Apply(map[string]string{"SPOOL": "$(BASE)/spool2"})
+
+	// Expansion sees the overridden BASE
+	if val, _ := cfg.Get("LOG"); val != "/srv/condor/log" {
+		t.Errorf("LOG = %q, want /srv/condor/log", val)
+	}
+	// The later override wins and is still expanded
+	if val, _ := cfg.Get("SPOOL"); val != "/srv/condor/spool2" {
+		t.Errorf("SPOOL = %q, want /srv/condor/spool2", val)
+	}
+
+	exp, ok := cfg.Explain("SPOOL")
+	if !ok {
+		t.Fatal("Explain(SPOOL) not found")
+	}
+	if exp.Source != SourceRuntime || exp.Value != "$(BASE)/spool2" || exp.Expanded != "/srv/condor/spool2" {
+		t.Errorf("Explain(SPOOL) = %+v", exp)
+	}
+}
+
+func TestApplyStatements(t *testing.T) {
+	cfg := NewEmpty()
+	cfg.Set("NAME", "first")
+
+	err := cfg.ApplyStatements(`
+NAME = second
+GREETING = hello $(NAME)
+if defined(NAME)
+  HAS_NAME = yes
+endif
+`)
+	if err != nil {
+		t.Fatalf("ApplyStatements failed: %v", err)
+	}
+	if val, _ := cfg.Get("GREETING"); val != "hello second" {
+		t.Errorf("GREETING = %q, want 'hello second'", val)
+	}
+	if val, _ := cfg.Get("HAS_NAME"); val != "yes" {
+		t.Errorf("HAS_NAME = %q, want yes", val)
+	}
+	if exp, _ := cfg.Explain("GREETING"); exp.Source != SourceRuntime {
+		t.Errorf("GREETING source = %q, want %q", exp.Source, SourceRuntime)
+	}
+
+	// A failing snippet leaves the config untouched
+	if err := cfg.ApplyStatements("NAME = third\nerror : stop here\n"); err == nil {
+		t.Fatal("Expected error from error directive")
+	}
+	if val, _ := cfg.Get("NAME"); val != "second" {
+		t.Errorf("NAME = %q after failed ApplyStatements, want second", val)
+	}
+}
+
+func TestExplainSources(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "extra.config")
+	if err := os.WriteFile(path, []byte("FROM_FILE = 1\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg := NewEmpty()
+	if err := cfg.ApplyStatements("include : \"" + path + "\"\n"); err != nil {
+		t.Fatalf("ApplyStatements failed: %v", err)
+	}
+
+	if exp, _ := cfg.Explain("FROM_FILE"); exp.Source != path {
+		t.Errorf("FROM_FILE source = %q, want %q", exp.Source, path)
+	}
+	if exp, _ := cfg.Explain("SECOND"); exp.Source != SourceDefault {
+		t.Errorf("SECOND source = %q, want %q", exp.Source, SourceDefault)
+	}
+	if _, ok := cfg.Explain("NOT_A_KNOB"); ok {
+		t.Error("Explain of an unset key should report false")
+	}
+}
+
+func TestDump(t *testing.T) {
+	cfg, err := NewFromReader(strings.NewReader("BASE = /opt/condor\nLOG = $(BASE)/log\nSCRIPT @=end\necho one\necho two\n@end\n"))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	cfg.Set("ZED", "last")
+	before := len(cfg.Keys())
+
+	var raw strings.Builder
+	if err := cfg.Dump(&raw, DumpOptions{}); err != nil {
+		t.Fatalf("Dump failed: %v", err)
+	}
+	want := "BASE = /opt/condor\nLOG = $(BASE)/log\nSCRIPT @=end\necho one\necho two\n@end\nZED = last\n"
+	if raw.String() != want {
+		t.Errorf("Dump() =\n%s\nwant\n%s", raw.String(), want)
+	}
+
+	// The raw dump reads back as the same configuration
+	reparsed, err := NewFromReader(strings.NewReader(raw.String()))
+	if err != nil {
+		t.Fatalf("Parsing the dump failed: %v", err)
+	}
+	if val, _ := reparsed.Get("SCRIPT"); val != "echo one\necho two" {
+		t.Errorf("Reparsed SCRIPT = %q", val)
+	}
+
+	var expanded strings.Builder
+	if err := cfg.Dump(&expanded, DumpOptions{Expand: true, ShowSource: true}); err != nil {
+		t.Fatalf("Dump failed: %v", err)
+	}
+	if !strings.Contains(expanded.String(), "LOG = /opt/condor/log # ") {
+		t.Errorf("Expected the expanded LOG with its source, got:\n%s", expanded.String())
+	}
+	if !strings.Contains(expanded.String(), "ZED = last # "+SourceRuntime+"\n") {
+		t.Errorf("Expected ZED from %s, got:\n%s", SourceRuntime, expanded.String())
+	}
+	if strings.Contains(expanded.String(), "SECOND =") {
+		t.Error("Expected param defaults to be left out")
+	}
+
+	var all strings.Builder
+	if err := cfg.Dump(&all, DumpOptions{IncludeDefaults: true, ShowSource: true}); err != nil {
+		t.Fatalf("Dump failed: %v", err)
+	}
+	if !strings.Contains(all.String(), "\nSECOND = 1 # "+SourceDefault+"\n") {
+		t.Errorf("Expected the SECOND default, got %d bytes", all.Len())
+	}
+
+	if len(cfg.Keys()) != before {
+		t.Error("Dump changed the configuration")
+	}
+}
+
+func TestSourceLines(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "extra.config")
+	content := "# comment\nFIRST = 1\n\nSCRIPT @=end\necho hi\n@end\nLONG = a \\\n  b\nuse POLICY : ALWAYS_RUN_JOBS\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg := NewEmpty()
+	if err := cfg.ApplyStatements("BEFORE = 0\ninclude : \"" + path + "\"\n"); err != nil {
+		t.Fatalf("ApplyStatements failed: %v", err)
+	}
+	cfg.Set("LATER", "x")
+
+	tests := []struct {
+		key  string
+		file string
+		line int
+	}{
+		{"BEFORE", SourceRuntime, 1},
+		{"FIRST", path, 2},
+		{"SCRIPT", path, 4},
+		{"LONG", path, 7},
+		// Values from a metaknob are attributed to the use statement
+		{"START", path, 9},
+		{"LATER", SourceRuntime, 0},
+		{"SECOND", SourceDefault, 0},
+	}
+	for _, tt := range tests {
+		file, line, ok := cfg.Source(tt.key)
+		if !ok || file != tt.file || line != tt.line {
+			t.Errorf("Source(%s) = %q, %d, %v; want %q, %d", tt.key, file, line, ok, tt.file, tt.line)
+		}
+	}
+	if _, _, ok := cfg.Source("NOT_A_KNOB"); ok {
+		t.Error("Source of an unset key should report false")
+	}
+
+	var out strings.Builder
+	if err := cfg.Dump(&out, DumpOptions{ShowSource: true}); err != nil {
+		t.Fatalf("Dump failed: %v", err)
+	}
+	if want := "FIRST = 1 # " + path + ":2\n"; !strings.Contains(out.String(), want) {
+		t.Errorf("Expected %q in the dump, got:\n%s", want, out.String())
+	}
+}
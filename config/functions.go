@@ -1,10 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +15,44 @@ import (
 	"github.com/PelicanPlatform/classad/classad"
 )
 
+// errUnknownFunction reports a function macro this package does not
+// implement; expansion leaves such macros as literal text
+var errUnknownFunction = errors.New("unknown function")
+
+// recordUnknownFunction notes the function of call, e.g. "FOO(x)", as
+// unknown for UnknownFunctions
+func (c *Config) recordUnknownFunction(call string) {
+	name, _, _ := strings.Cut(call, "(")
+	if c.unknownFunctions == nil {
+		c.unknownFunctions = make(map[string]bool)
+	}
+	c.unknownFunctions[name] = true
+}
+
+// UnknownFunctions returns the names of the function macros, sorted, that
+// expansion has met so far and left as literal text because this package
+// does not implement them. They are usually typos or functions of a newer
+// HTCondor release.
+func (c *Config) UnknownFunctions() []string {
+	names := make([]string, 0, len(c.unknownFunctions))
+	for name := range c.unknownFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// filenameFuncOptions are the option letters of the $F[fpduwnxbqa] functions
+const filenameFuncOptions = "fpduwnxbqa"
+
+// knownFunctions are the function macros evaluateFunctionMacro implements,
+// besides the $F family
+var knownFunctions = map[string]bool{
+	"ENV": true, "INT": true, "STRING": true, "RANDOM_INTEGER": true,
+	"RANDOM_CHOICE": true, "CHOICE": true, "SUBSTR": true, "REAL": true,
+	"EVAL": true, "DIRNAME": true, "BASENAME": true,
+}
+
 // evaluateFunctionMacro evaluates function-style macros like $ENV(VAR)
 func (c *Config) evaluateFunctionMacro(funcCall string) (string, error) {
 	// Parse function name and arguments
@@ -29,6 +69,12 @@ func (c *Config) evaluateFunctionMacro(funcCall string) (string, error) {
 	}
 	argsStr := funcCall[lparen+1 : len(funcCall)-1]
 
+	isFilenameFunc := len(funcName) > 1 && funcName[0] == 'F' &&
+		strings.Trim(strings.ToLower(funcName[1:]), filenameFuncOptions) == ""
+	if !knownFunctions[funcName] && !isFilenameFunc {
+		return "", fmt.Errorf("%w: %s", errUnknownFunction, funcName)
+	}
+
 	// Expand macros in the arguments first
 	expandedArgs, err := c.expandMacrosWithFunctions(argsStr)
 	if err != nil {
@@ -59,36 +105,41 @@ func (c *Config) evaluateFunctionMacro(funcCall string) (string, error) {
 	case "BASENAME":
 		return c.evalBASENAME(expandedArgs)
 	default:
-		// Check if it's a filename manipulation function like $Fpd(...)
-		if len(funcName) > 1 && funcName[0] == 'F' {
-			return c.evalFilenameFunc(funcName[1:], expandedArgs)
-		}
-		return "", fmt.Errorf("unknown function: %s", funcName)
+		// A filename manipulation function like $Fpd(...)
+		return c.evalFilenameFunc(funcName[1:], expandedArgs)
 	}
 }
 
-// evalENV returns an environment variable value
+// evalENV returns an environment variable value.
+// Unlike $(VAR), this never consults configuration macros.
 func (c *Config) evalENV(args string) (string, error) {
 	varName := strings.TrimSpace(args)
 	if varName == "" {
 		return "", fmt.Errorf("ENV requires variable name")
 	}
+	if c.envLookup != nil {
+		value, _ := c.envLookup(varName)
+		return value, nil
+	}
 	return os.Getenv(varName), nil
 }
 
-// evalINT converts a value to an integer
+// evalINT converts a value to an integer. The value may be the name of a
+// configuration variable or an expression; an optional second argument is a
+// printf-style format such as %x.
 func (c *Config) evalINT(args string) (string, error) {
-	value := strings.TrimSpace(args)
-	if value == "" {
+	parts := splitArgs(args)
+	if len(parts) == 0 || parts[0] == "" {
 		return "0", nil
 	}
 
-	// Try to parse as float first (to handle "3.14" -> "3")
-	f, err := strconv.ParseFloat(value, 64)
+	f, err := c.numericValue(parts[0])
 	if err != nil {
-		return "", fmt.Errorf("INT: cannot convert %q to integer", value)
+		return "", fmt.Errorf("INT: %w", err)
+	}
+	if len(parts) >= 2 {
+		return fmt.Sprintf(parts[1], int64(f)), nil
 	}
-
 	return fmt.Sprintf("%d", int64(f)), nil
 }
 
@@ -135,10 +186,7 @@ func (c *Config) evalRANDOM_INTEGER(args string) (string, error) {
 	// Calculate number of possible values
 	numValues := (maxVal-minVal)/step + 1
 
-	// Generate random value
-	//nolint:gosec // G404: Non-cryptographic random is appropriate for config macros
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	randomIndex := r.Int63n(numValues)
+	randomIndex := c.randomSource().Int63n(numValues)
 	result := minVal + (randomIndex * step)
 
 	return fmt.Sprintf("%d", result), nil
@@ -180,175 +228,238 @@ func (c *Config) evalSUBSTR(args string) (string, error) {
 	return str[start:end], nil
 }
 
-// evalREAL converts a value to a real number
+// evalREAL converts a value to a real number. Like $INT, the value may be a
+// variable name or an expression, optionally followed by a format.
 func (c *Config) evalREAL(args string) (string, error) {
-	value := strings.TrimSpace(args)
-	if value == "" {
+	parts := splitArgs(args)
+	if len(parts) == 0 || parts[0] == "" {
 		return "0.0", nil
 	}
 
-	f, err := strconv.ParseFloat(value, 64)
+	f, err := c.numericValue(parts[0])
 	if err != nil {
-		return "", fmt.Errorf("REAL: cannot convert %q to real number", value)
+		return "", fmt.Errorf("REAL: %w", err)
+	}
+	if len(parts) >= 2 {
+		return fmt.Sprintf(parts[1], f), nil
 	}
-
 	return fmt.Sprintf("%g", f), nil
 }
 
-// expandMacrosWithFunctions expands both regular and function macros
+// numericValue returns the number item stands for: a literal, the expanded
+// value of the configuration variable it names, or the value of the
+// expression it contains
+func (c *Config) numericValue(item string) (float64, error) {
+	value := strings.TrimSpace(item)
+	if _, ok := c.values[value]; ok {
+		expanded, err := c.expandVariable(value, "")
+		if err != nil {
+			return 0, err
+		}
+		value = strings.TrimSpace(expanded)
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, nil
+	}
+
+	evaluated, err := c.evalEVAL(value)
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %q to a number", item)
+	}
+	f, err := strconv.ParseFloat(evaluated, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %q to a number", item)
+	}
+	return f, nil
+}
+
+// maxExpansionSize bounds the text one expansion substitutes for macros,
+// so that references doubling up at each level cannot exhaust memory
+const maxExpansionSize = 1 << 20
+
+// expansionPass is the state of one expansion, with the nested expansions
+// of the variables it references
+type expansionPass struct {
+	values      map[string]string // expanded values of the variables referenced so far
+	substituted int               // bytes substituted for macros so far
+}
+
+// expandMacrosWithFunctions expands both regular and function macros.
+// A variable's value is itself expanded before it is substituted, so a
+// chain of references that leads back to a variable being expanded is
+// reported as a cycle. Each variable is expanded once per expansion, and
+// expansions substituting more than maxExpansionSize bytes fail.
+func (c *Config) expandMacrosWithFunctions(value string) (string, error) {
+	defer c.startPass()()
+	return c.expandInPass(value)
+}
+
+// startPass starts an expansion pass unless one is in progress, returning
+// the function that ends it
+func (c *Config) startPass() func() {
+	if c.pass != nil {
+		return func() {}
+	}
+	c.pass = &expansionPass{values: make(map[string]string)}
+	return func() { c.pass = nil }
+}
+
+// substitute accounts for n bytes substituted for a macro
+func (p *expansionPass) substitute(n int) error {
+	p.substituted += n
+	if p.substituted > maxExpansionSize {
+		return fmt.Errorf("macro expansion longer than %d bytes", maxExpansionSize)
+	}
+	return nil
+}
+
+// expandInPass is expandMacrosWithFunctions within the current pass
 //
 //nolint:gocyclo // Complex function required for HTCondor macro expansion
-func (c *Config) expandMacrosWithFunctions(value string) (string, error) {
+func (c *Config) expandInPass(value string) (string, error) {
 	result := value
 	maxDepth := 100
 	depth := 0
+	// pos is where the search for the next macro starts; text before it
+	// is fully expanded
+	pos := 0
 
 	for depth < maxDepth {
-		changed := false
-
-		// First, look for function macros: $FUNC(...)
-		dollarIdx := strings.Index(result, "$")
+		// Look for the next macro: $(VAR) or $FUNC(...)
+		offset := strings.Index(result[pos:], "$")
+		if offset == -1 {
+			break
+		}
+		dollarIdx := pos + offset
+
+		// Check if this is a function macro or regular macro
+		if dollarIdx+1 < len(result) && result[dollarIdx+1] == '(' {
+			// This is a regular macro $(VAR)
+			// Find the matching closing paren
+			endIdx := matchingParen(result, dollarIdx+2)
+			if endIdx == -1 {
+				return "", fmt.Errorf("unmatched parentheses in macro expansion")
+			}
 
-		if dollarIdx != -1 {
-			// Check if this is a function macro or regular macro
-			if dollarIdx+1 < len(result) && result[dollarIdx+1] == '(' {
-				// This is a regular macro $(VAR)
-				// Find the matching closing paren
-				parenDepth := 1
-				endIdx := -1
-				for i := dollarIdx + 2; i < len(result); i++ {
-					if result[i] == '(' {
-						parenDepth++
-					} else if result[i] == ')' {
-						parenDepth--
-						if parenDepth == 0 {
-							endIdx = i
-							break
-						}
-					}
+			macroContent := result[dollarIdx+2 : endIdx]
+
+			// Check if this is a function call (contains '(' after name)
+			// But not if it starts with '$' (that's a nested macro like $($(1)))
+			// Also not if it contains ':' before '(' (that's a default value like $(VAR:$(DEFAULT)))
+			colonIdx := strings.Index(macroContent, ":")
+			parenIdx := strings.Index(macroContent, "(")
+
+			isFunctionMacro := parenIdx != -1 &&
+				!strings.HasPrefix(macroContent, "$") &&
+				(colonIdx == -1 || parenIdx < colonIdx)
+
+			if isFunctionMacro {
+				// This is a function macro inside $(); its result is
+				// scanned again for macros
+				replacement, err := c.evaluateFunctionMacro(macroContent)
+				if errors.Is(err, errUnknownFunction) {
+					c.recordUnknownFunction(macroContent)
+					pos = endIdx + 1
+					continue
 				}
-
-				if endIdx == -1 {
-					return "", fmt.Errorf("unmatched parentheses in macro expansion")
+				if err == nil {
+					err = c.pass.substitute(len(replacement))
 				}
+				if err != nil {
+					return "", err
+				}
+				result = result[:dollarIdx] + replacement + result[endIdx+1:]
+				depth++
+				continue
+			}
+
+			// Regular variable expansion
+			varName := macroContent
 
-				macroContent := result[dollarIdx+2 : endIdx]
-
-				// Check if this is a function call (contains '(' after name)
-				// But not if it starts with '$' (that's a nested macro like $($(1)))
-				// Also not if it contains ':' before '(' (that's a default value like $(VAR:$(DEFAULT)))
-				colonIdx := strings.Index(macroContent, ":")
-				parenIdx := strings.Index(macroContent, "(")
-
-				isFunctionMacro := parenIdx != -1 &&
-					!strings.HasPrefix(macroContent, "$") &&
-					(colonIdx == -1 || parenIdx < colonIdx)
-
-				if isFunctionMacro {
-					// This is a function macro inside $()
-					replacement, err := c.evaluateFunctionMacro(macroContent)
-					if err != nil {
-						return "", err
-					}
-					result = result[:dollarIdx] + replacement + result[endIdx+1:]
-					changed = true
-				} else {
-					// Regular variable expansion
-					varName := macroContent
-
-					// First, recursively expand the macro content if it contains macros
-					if strings.Contains(varName, "$") {
-						expanded, err := c.expandMacrosWithFunctions(varName)
-						if err != nil {
-							return "", err
-						}
-						varName = expanded
-					}
-
-					defaultVal := ""
-
-					// Handle default values VAR:default
-					if colonIdx := strings.Index(varName, ":"); colonIdx != -1 {
-						defaultVal = varName[colonIdx+1:]
-						varName = varName[:colonIdx]
-						// Expand the default value itself (for nested macros like $(VAR:$(DEFAULT)))
-						expandedDefault, err := c.expandMacrosWithFunctions(defaultVal)
-						if err != nil {
-							return "", err
-						}
-						defaultVal = expandedDefault
-					}
-
-					// Handle metaknob parameter special syntax
-					// $(0), $(0?), $(0#), $(1), $(1?), $(1+), etc.
-					if len(varName) > 0 && varName[0] >= '0' && varName[0] <= '9' {
-						replacement := c.expandMetaknobParam(varName)
-						result = result[:dollarIdx] + replacement + result[endIdx+1:]
-						changed = true
-						continue
-					}
-
-					// Check for circular reference
-					if c.evaluating[varName] {
-						return result, fmt.Errorf("circular reference detected: %s", varName)
-					}
-
-					c.evaluating[varName] = true
-					replacement, ok := c.values[varName]
-					if !ok {
-						replacement = defaultVal
-					}
-					delete(c.evaluating, varName)
-
-					result = result[:dollarIdx] + replacement + result[endIdx+1:]
-					changed = true
+			// First, recursively expand the macro content if it contains macros
+			if strings.Contains(varName, "$") {
+				expanded, err := c.expandInPass(varName)
+				if err != nil {
+					return "", err
 				}
-			} else if dollarIdx+1 < len(result) && isIdentStart(rune(result[dollarIdx+1])) {
-				// This might be a function macro $FUNC(...)
-				// Find the function name
-				nameEnd := dollarIdx + 1
-				for nameEnd < len(result) && (unicode.IsLetter(rune(result[nameEnd])) || result[nameEnd] == '_') {
-					nameEnd++
+				varName = expanded
+			}
+
+			defaultVal := ""
+
+			// Handle default values VAR:default
+			if colonIdx := strings.Index(varName, ":"); colonIdx != -1 {
+				defaultVal = varName[colonIdx+1:]
+				varName = varName[:colonIdx]
+				// Expand the default value itself (for nested macros like $(VAR:$(DEFAULT)))
+				expandedDefault, err := c.expandInPass(defaultVal)
+				if err != nil {
+					return "", err
 				}
+				defaultVal = expandedDefault
+			}
 
-				if nameEnd < len(result) && result[nameEnd] == '(' {
-					// This is a function macro
-					// Find the matching closing paren
-					parenDepth := 1
-					endIdx := -1
-					for i := nameEnd + 1; i < len(result); i++ {
-						if result[i] == '(' {
-							parenDepth++
-						} else if result[i] == ')' {
-							parenDepth--
-							if parenDepth == 0 {
-								endIdx = i
-								break
-							}
-						}
-					}
-
-					if endIdx == -1 {
-						return "", fmt.Errorf("unmatched parentheses in function macro")
-					}
-
-					funcCall := result[dollarIdx+1 : endIdx+1]
-					replacement, err := c.evaluateFunctionMacro(funcCall)
-					if err != nil {
-						return "", err
-					}
-					result = result[:dollarIdx] + replacement + result[endIdx+1:]
-					changed = true
+			// Handle metaknob parameter special syntax
+			// $(0), $(0?), $(0#), $(1), $(1?), $(1+), etc.
+			if len(varName) > 0 && varName[0] >= '0' && varName[0] <= '9' {
+				replacement := c.expandMetaknobParam(varName)
+				if err := c.pass.substitute(len(replacement)); err != nil {
+					return "", err
 				}
+				result = result[:dollarIdx] + replacement + result[endIdx+1:]
+				depth++
+				continue
+			}
+
+			replacement, err := c.expandVariable(varName, defaultVal)
+			if err == nil {
+				err = c.pass.substitute(len(replacement))
 			}
+			if err != nil {
+				return "", err
+			}
+			result = result[:dollarIdx] + replacement + result[endIdx+1:]
+			pos = dollarIdx + len(replacement)
+			continue
 		}
 
-		depth++
+		if dollarIdx+1 < len(result) && isIdentStart(rune(result[dollarIdx+1])) {
+			// This might be a function macro $FUNC(...)
+			// Find the function name
+			nameEnd := dollarIdx + 1
+			for nameEnd < len(result) && (unicode.IsLetter(rune(result[nameEnd])) || result[nameEnd] == '_') {
+				nameEnd++
+			}
 
-		// If nothing changed, we're done
-		if !changed {
-			break
+			if nameEnd < len(result) && result[nameEnd] == '(' {
+				// This is a function macro
+				// Find the matching closing paren
+				endIdx := matchingParen(result, nameEnd+1)
+				if endIdx == -1 {
+					return "", fmt.Errorf("unmatched parentheses in function macro")
+				}
+
+				funcCall := result[dollarIdx+1 : endIdx+1]
+				replacement, err := c.evaluateFunctionMacro(funcCall)
+				if errors.Is(err, errUnknownFunction) {
+					c.recordUnknownFunction(funcCall)
+					pos = endIdx + 1
+					continue
+				}
+				if err == nil {
+					err = c.pass.substitute(len(replacement))
+				}
+				if err != nil {
+					return "", err
+				}
+				result = result[:dollarIdx] + replacement + result[endIdx+1:]
+				depth++
+				continue
+			}
 		}
+
+		// A '$' that starts no macro is literal text
+		pos = dollarIdx + 1
 	}
 
 	if depth >= maxDepth {
@@ -358,6 +469,53 @@ func (c *Config) expandMacrosWithFunctions(value string) (string, error) {
 	return result, nil
 }
 
+// expandVariable returns the fully expanded value of the variable name, or
+// defaultVal if it is not defined, expanding it once per pass
+func (c *Config) expandVariable(name, defaultVal string) (string, error) {
+	value, ok := c.values[name]
+	if !ok {
+		return defaultVal, nil
+	}
+	defer c.startPass()()
+	if expanded, ok := c.pass.values[name]; ok {
+		return expanded, nil
+	}
+
+	for i, expanding := range c.expanding {
+		if expanding == name {
+			cycle := append(append([]string{}, c.expanding[i:]...), name)
+			return "", fmt.Errorf("macro reference cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	c.expanding = append(c.expanding, name)
+	defer func() { c.expanding = c.expanding[:len(c.expanding)-1] }()
+
+	expanded, err := c.expandInPass(value)
+	if err != nil {
+		return "", err
+	}
+	c.pass.values[name] = expanded
+	return expanded, nil
+}
+
+// matchingParen returns the index of the ')' closing a '(' just before
+// start in s, or -1 if it is not closed
+func matchingParen(s string, start int) int {
+	parenDepth := 1
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			parenDepth++
+		case ')':
+			parenDepth--
+			if parenDepth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // evalEVAL evaluates a ClassAd expression and returns the result
 // $EVAL(item-to-convert) expands, evaluates, and returns a classad unparsed version
 // of item-to-convert. The resulting value is formatted using the equivalent of
@@ -443,10 +601,17 @@ func (c *Config) evalRANDOM_CHOICE(args string) (string, error) {
 		return "", fmt.Errorf("RANDOM_CHOICE requires at least one argument")
 	}
 
-	//nolint:gosec // G404: Non-cryptographic random is appropriate for config macros
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	randomIndex := r.Intn(len(parts))
-	return parts[randomIndex], nil
+	return parts[c.randomSource().Intn(len(parts))], nil
+}
+
+// randomSource returns the generator for the random macros, seeding one
+// from the clock unless SetRandomSeed was called
+func (c *Config) randomSource() *rand.Rand {
+	if c.random == nil {
+		//nolint:gosec // G404: Non-cryptographic random is appropriate for config macros
+		c.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return c.random
 }
 
 // evalCHOICE selects an item from a list by index
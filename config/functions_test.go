@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -392,3 +394,106 @@ func TestSplitArgs(t *testing.T) {
 		}
 	}
 }
+
+func TestFunctionENVLookup(t *testing.T) {
+	t.Setenv("TEST_VAR", "from_process")
+
+	cfg := NewEmpty()
+	cfg.Set("TEST_VAR", "from_macro")
+	cfg.SetEnvLookup(func(name string) (string, bool) {
+		if name == "TEST_VAR" {
+			return "from_lookup", true
+		}
+		return "", false
+	})
+	cfg.Set("A", "$ENV(TEST_VAR) $(TEST_VAR) [$ENV(OTHER)]")
+
+	if got, _ := cfg.Get("A"); got != "from_lookup from_macro []" {
+		t.Errorf("Expected 'from_lookup from_macro []', got %q", got)
+	}
+
+	// Clones keep the lookup
+	if got, _ := cfg.Clone().Get("A"); got != "from_lookup from_macro []" {
+		t.Errorf("Expected clone to keep the env lookup, got %q", got)
+	}
+}
+
+func TestFunctionMacrosInConfig(t *testing.T) {
+	cfg, err := NewFromReader(strings.NewReader(`
+LOG = $ENV(CONDOR_LOG)
+NUM_SLOTS = 2 * 4
+SLOTS = $INT(NUM_SLOTS)
+SLOTS_HEX = $INT(255, %x)
+HALF = $REAL(NUM_SLOTS, %.1f)
+SPOOL = $DIRNAME($(LOG)/spool.log)
+BASE = $BASENAME(/var/log/condor/SchedLog)
+`))
+	if err != nil {
+		t.Fatalf("NewFromReader: %v", err)
+	}
+	cfg.SetEnvLookup(func(name string) (string, bool) {
+		if name == "CONDOR_LOG" {
+			return "/var/log/condor", true
+		}
+		return "", false
+	})
+
+	tests := map[string]string{
+		"LOG":       "/var/log/condor",
+		"SLOTS":     "8",
+		"SLOTS_HEX": "ff",
+		"HALF":      "8.0",
+		"SPOOL":     "/var/log/condor/",
+		"BASE":      "SchedLog",
+	}
+	for key, expected := range tests {
+		if got, _ := cfg.Get(key); got != expected {
+			t.Errorf("%s: expected %q, got %q", key, expected, got)
+		}
+	}
+}
+
+func TestFunctionRANDOM_CHOICESeeded(t *testing.T) {
+	expand := func(cfg *Config) string {
+		var choices []string
+		for i := 0; i < 10; i++ {
+			choice, err := cfg.Expand("$RANDOM_CHOICE(a,b,c,d,e)")
+			if err != nil {
+				t.Fatalf("Expand: %v", err)
+			}
+			choices = append(choices, choice)
+		}
+		return strings.Join(choices, ",")
+	}
+
+	first, second := NewEmpty(), NewEmpty()
+	first.SetRandomSeed(42)
+	second.SetRandomSeed(42)
+	if a, b := expand(first), expand(second); a != b {
+		t.Errorf("Expected the same choices for the same seed, got %q and %q", a, b)
+	}
+
+	// Clones of configs in the same state choose alike
+	first.SetRandomSeed(7)
+	second.SetRandomSeed(7)
+	if a, b := expand(first.Clone()), expand(second.Clone()); a != b {
+		t.Errorf("Expected seeded clones to choose alike, got %q and %q", a, b)
+	}
+}
+
+func TestUnknownFunctionLeftLiteral(t *testing.T) {
+	cfg := NewEmpty()
+	cfg.Set("NAME", "world")
+	cfg.Set("A", "$NOSUCH($(NAME)) $(BOGUS(x)) $ENV(HOME_DIR_UNSET_FOR_TEST) $(NAME)")
+
+	got, err := cfg.Expand("$(A)")
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if want := "$NOSUCH($(NAME)) $(BOGUS(x))  world"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if got, want := cfg.UnknownFunctions(), []string{"BOGUS", "NOSUCH"}; !slices.Equal(got, want) {
+		t.Errorf("Expected UnknownFunctions %v, got %v", want, got)
+	}
+}
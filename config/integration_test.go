@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -582,6 +583,58 @@ include : "%s"
 	}
 }
 
+// TestConfigDirs tests that NewWithOptions layers LOCAL_CONFIG_DIR and then
+// ConfigOptions.ConfigDirs over the main config, skipping unparsable files
+// unless Strict is set
+func TestConfigDirs(t *testing.T) {
+	tmpDir := t.TempDir()
+	localDir := filepath.Join(tmpDir, "config.d")
+	extraDir := filepath.Join(tmpDir, "extra.d")
+	for _, dir := range []string{localDir, extraDir} {
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+
+	badFile := filepath.Join(extraDir, "15-bad.conf")
+	files := map[string]string{
+		filepath.Join(tmpDir, "condor_config"):     fmt.Sprintf("LOCAL_CONFIG_DIR = %s\nSHARED = main\nMAIN = yes\n", localDir),
+		filepath.Join(localDir, "00-local.config"): "SHARED = local\nLOCAL = yes\n",
+		filepath.Join(localDir, "local.config~"):   "LOCAL = backup\n",
+		filepath.Join(extraDir, "20-second.conf"):  "SHARED = second\n",
+		filepath.Join(extraDir, "10-first.conf"):   "SHARED = first\nFIRST = yes\n",
+		filepath.Join(extraDir, "30-notes.txt"):    "SHARED = notes\n",
+		badFile:                                    "if true\nBAD = yes\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+	}
+	t.Setenv("CONDOR_CONFIG", filepath.Join(tmpDir, "condor_config"))
+
+	cfg, err := NewWithOptions(ConfigOptions{ConfigDirs: []string{extraDir, filepath.Join(tmpDir, "missing.d")}})
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+	for key, want := range map[string]string{"SHARED": "second", "MAIN": "yes", "LOCAL": "yes", "FIRST": "yes"} {
+		if val, ok := cfg.Get(key); !ok || val != want {
+			t.Errorf("%s: got %q, want %q", key, val, want)
+		}
+	}
+	if val, ok := cfg.Get("BAD"); ok {
+		t.Errorf("BAD: got %q from the unparsable file", val)
+	}
+	if loadErrs := cfg.LoadErrors(); len(loadErrs) != 1 || !strings.Contains(loadErrs[0].Error(), badFile) {
+		t.Errorf("LoadErrors() = %v, want one error for %s", loadErrs, badFile)
+	}
+
+	_, err = NewWithOptions(ConfigOptions{ConfigDirs: []string{extraDir}, Strict: true})
+	if err == nil || !strings.Contains(err.Error(), badFile) {
+		t.Errorf("Strict NewWithOptions: got error %v, want one naming %s", err, badFile)
+	}
+}
+
 // Helper function to open a file for testing
 func mustOpen(t *testing.T, path string) *os.File {
 	t.Helper()
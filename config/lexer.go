@@ -49,9 +49,16 @@ type Lexer struct {
 	nextCh              rune
 	buf                 strings.Builder
 	atEOF               bool
-	afterUse            bool // True if the previous token was USE
-	afterIfOrElif       bool // True if the previous token was IF or ELIF
-	afterErrorOrWarning bool // True if the previous token was ERROR or WARNING
+	afterUse            bool  // True if the previous token was USE
+	afterIfOrElif       bool  // True if the previous token was IF or ELIF
+	afterErrorOrWarning bool  // True if the previous token was ERROR or WARNING
+	inInclude           bool  // True between an INCLUDE keyword and its colon
+	afterIncludeColon   bool  // True if the previous token was the colon of an include
+	inQueue             bool  // True while lexing the rest of a queue statement
+	queueParens         int   // Open parentheses in the current queue statement
+	afterMatching       bool  // True if the previous token was MATCHING in a queue statement
+	ifLines             []int // Lines of the if statements still open, innermost last
+	segmentEnd          bool  // True if the last EOF token ended a queue statement rather than the input
 }
 
 // NewLexer creates a new lexer
@@ -440,6 +447,23 @@ func (l *Lexer) NextToken() *TokenInfo {
 		return tok
 	}
 
+	// Special handling after the colon of an include - unless quoted, the
+	// rest of the line is the file name or command, with any arguments
+	if l.afterIncludeColon {
+		l.afterIncludeColon = false
+		l.skipWhitespace()
+		if l.ch == '"' || l.ch == '\'' {
+			return l.NextToken()
+		}
+		tok := &TokenInfo{
+			Line: l.line,
+			Col:  l.col,
+		}
+		tok.Token = STRING
+		tok.Lit = l.readUntilNewline()
+		return tok
+	}
+
 	// Special handling after IF or ELIF keywords - read the entire rest of the line
 	if l.afterIfOrElif {
 		l.afterIfOrElif = false
@@ -453,6 +477,26 @@ func (l *Lexer) NextToken() *TokenInfo {
 		return tok
 	}
 
+	// Special handling after MATCHING in a queue statement - unless quoted,
+	// the rest of the line is the glob pattern, after an optional "files"
+	// or "dirs" keyword
+	if l.afterMatching {
+		l.afterMatching = false
+		l.skipWhitespace()
+		if l.ch != '"' && l.ch != '\'' && l.ch != '\n' && l.ch != 0 {
+			tok := &TokenInfo{
+				Line: l.line,
+				Col:  l.col,
+			}
+			tok.Token = STRING
+			tok.Lit = l.readUntilNewline()
+			if kind, pattern, found := strings.Cut(tok.Lit, " "); found && (strings.EqualFold(kind, "files") || strings.EqualFold(kind, "dirs")) {
+				tok.Lit = strings.TrimSpace(pattern)
+			}
+			return tok
+		}
+	}
+
 	// Special handling after USE keyword - read the entire rest of the line
 	if l.afterUse {
 		l.afterUse = false
@@ -479,6 +523,19 @@ func (l *Lexer) NextToken() *TokenInfo {
 
 	case '\n':
 		l.readChar()
+		l.inInclude = false
+		// A queue statement ends at the end of its line. Outside of a
+		// conditional, report EOF so the parser can finish the statement;
+		// without a newline token the grammar cannot otherwise tell a
+		// following assignment apart from queue variable names.
+		if l.inQueue && l.queueParens == 0 {
+			l.inQueue = false
+			if len(l.ifLines) == 0 {
+				l.segmentEnd = true
+				tok.Token = EOF
+				return tok
+			}
+		}
 		return l.NextToken() // Skip newlines and get next token
 
 	case '#':
@@ -505,20 +562,42 @@ func (l *Lexer) NextToken() *TokenInfo {
 			l.readChar()
 		}
 
+	case '+':
+		// Submit files set custom job attributes with +Name = value
+		if isIdentStart(l.peekChar()) {
+			l.readChar()
+			tok.Token = IDENT
+			tok.Lit = "+" + l.readIdentifier()
+		} else {
+			tok.Token = ILLEGAL
+			tok.Lit = string(l.ch)
+			l.readChar()
+		}
+
 	case ':':
 		tok.Token = COLON
 		tok.Lit = ":"
 		l.readChar()
+		if l.inInclude {
+			l.inInclude = false
+			l.afterIncludeColon = true
+		}
 
 	case '(':
 		tok.Token = LPAREN
 		tok.Lit = "("
 		l.readChar()
+		if l.inQueue {
+			l.queueParens++
+		}
 
 	case ')':
 		tok.Token = RPAREN
 		tok.Lit = ")"
 		l.readChar()
+		if l.inQueue && l.queueParens > 0 {
+			l.queueParens--
+		}
 
 	case '[':
 		tok.Token = LBRACK
@@ -563,8 +642,35 @@ func (l *Lexer) NextToken() *TokenInfo {
 				switch kw {
 				case USE:
 					l.afterUse = true
-				case IF, ELIF:
-					l.afterIfOrElif = true
+				case INCLUDE:
+					// include = value is an ordinary assignment
+					if l.peekNextNonWhitespace() != '=' {
+						l.inInclude = true
+					} else {
+						tok.Token = IDENT
+					}
+				case IF, ELIF, ELSE, ENDIF:
+					// if = value and the like are ordinary assignments
+					if l.peekNextNonWhitespace() == '=' {
+						tok.Token = IDENT
+						break
+					}
+					switch kw {
+					case IF:
+						l.ifLines = append(l.ifLines, tok.Line)
+						l.afterIfOrElif = true
+					case ELIF:
+						l.afterIfOrElif = true
+					case ENDIF:
+						if len(l.ifLines) > 0 {
+							l.ifLines = l.ifLines[:len(l.ifLines)-1]
+						}
+					}
+				case QUEUE:
+					l.inQueue = true
+					l.queueParens = 0
+				case MATCHING:
+					l.afterMatching = l.inQueue
 				case ERROR, WARNING:
 					// Only treat as directive if followed by ':' or whitespace then message
 					// If followed by '=', treat as regular identifier for assignment
@@ -591,6 +697,29 @@ func (l *Lexer) NextToken() *TokenInfo {
 	return tok
 }
 
+// skipLine recovers from a syntax error on the given line by discarding
+// the rest of it, if the lexer is still there, along with any pending
+// keyword state
+func (l *Lexer) skipLine(line int) {
+	l.afterUse = false
+	l.afterIfOrElif = false
+	l.afterErrorOrWarning = false
+	l.afterMatching = false
+	l.inInclude = false
+	l.afterIncludeColon = false
+	if l.line == line {
+		l.skipToEndOfLine()
+	}
+}
+
+// endOfSegment reports whether the last EOF token marked the end of a queue
+// statement rather than the end of input, and resets that state
+func (l *Lexer) endOfSegment() bool {
+	end := l.segmentEnd
+	l.segmentEnd = false
+	return end
+}
+
 // ReadValue reads everything after = as a value (including macros)
 func (l *Lexer) ReadValue() string {
 	return l.readUntilNewline()
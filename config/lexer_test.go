@@ -23,6 +23,21 @@ func TestLexerBasicTokens(t *testing.T) {
 	}
 }
 
+func TestLexerCustomAttribute(t *testing.T) {
+	input := `+MyAttr = "value"`
+	lex := NewLexer(strings.NewReader(input))
+
+	tok := lex.NextToken()
+	if tok.Token != IDENT || tok.Lit != "+MyAttr" {
+		t.Errorf("Expected IDENT +MyAttr, got %d %q", tok.Token, tok.Lit)
+	}
+
+	tok = lex.NextToken()
+	if tok.Token != ASSIGN || tok.Lit != `"value"` {
+		t.Errorf("Expected ASSIGN \"value\", got %d %q", tok.Token, tok.Lit)
+	}
+}
+
 func TestLexerMacroExpansion(t *testing.T) {
 	input := "FOO = $(BAR)"
 	lex := NewLexer(strings.NewReader(input))
@@ -459,3 +459,58 @@ use POLICY : PREEMPT_IF(COND)
 		}
 	}
 }
+
+// TestMetaknobCommonCategories expands the ROLE, SECURITY and FEATURE
+// metaknobs production configs rely on
+func TestMetaknobCommonCategories(t *testing.T) {
+	input := `
+use role : submit, Execute
+use SECURITY: STRONG
+use FEATURE: GPUs
+`
+	cfg, err := NewFromReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"DAEMON_LIST", "MASTER SCHEDD STARTD"},
+		{"SEC_DEFAULT_AUTHENTICATION", "REQUIRED"},
+		// GPUs uses GPUsMonitor, which passes arguments to Monitor; they
+		// appear in the names Monitor assigns
+		{"STARTD_CRON_GPUs_MONITOR_MODE", "WaitForExit"},
+		{"STARTD_CRON_GPUs_MONITOR_METRICS", "SUM:GPUs, PEAK:GPUsMemory"},
+	}
+	for _, tt := range tests {
+		val, ok := cfg.Get(tt.name)
+		if !ok || !strings.EqualFold(val, tt.expected) {
+			t.Errorf("%s = %q (defined %v), want %q", tt.name, val, ok, tt.expected)
+		}
+	}
+	if val, _ := cfg.Get("MACHINE_RESOURCE_INVENTORY_GPUs"); !strings.Contains(val, "condor_gpu_discovery") {
+		t.Errorf("MACHINE_RESOURCE_INVENTORY_GPUs = %q, want the GPU discovery command", val)
+	}
+	// Arguments do not outlive the metaknob
+	if _, ok := cfg.values["1"]; ok {
+		t.Error("Expected metaknob arguments to be removed")
+	}
+}
+
+func TestMetaknobUnknown(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"use FLAVOR : Vanilla\n", `unknown metaknob category "FLAVOR"`},
+		{"use ROLE : Execute, Janitor\n", "no such ROLE metaknob"},
+	}
+	for _, tt := range tests {
+		_, err := NewFromReader(strings.NewReader(tt.input))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: expected an error containing %q, got %v", tt.input, tt.want, err)
+		}
+	}
+}
@@ -2,1021 +2,1248 @@
 
 package config
 
-// ParamDefault represents a default parameter value from param_info.in
+// ParamDefault represents a parameter declared in param_info.in
 type ParamDefault struct {
 	Name         string
 	Default      string
 	Win32Default string
 	Type         string
+	Range        string
+	Description  string
 }
 
-// paramDefaults contains all default values from HTCondor param_info.in
+// paramDefaults contains all parameters declared in HTCondor param_info.in,
+// including those with no default value
 var paramDefaults = []ParamDefault{
-	{Name: "STARTER_DIAGNOSTIC_send_ep_logs", Default: "$(LIBEXEC)/condor_diagnostic_send_ep_logs", Win32Default: "", Type: "string"},
-	{Name: "DAEMON_CORE_KILL_ANY_PROCESS", Default: "false", Win32Default: "", Type: "string"},
-	{Name: "MAX_DRAINING_ACTIVATION_DELAY", Default: "20", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_KERNEL_TUNING", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "KERNEL_TUNING_LOG", Default: "$(LOG)/KernelTuning.log", Win32Default: "", Type: "path"},
-	{Name: "LINUX_KERNEL_TUNING_SCRIPT", Default: "$(LIBEXEC)/linux_kernel_tuning", Win32Default: "", Type: "path"},
-	{Name: "MASTER", Default: "$(SBIN)/condor_master", Win32Default: "$(SBIN)\\condor_master.exe", Type: "path"},
-	{Name: "SHUTDOWN_FAST_TIMEOUT", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "PREEN_INTERVAL", Default: "86400", Win32Default: "", Type: "int"},
-	{Name: "PREEN_COREFILE_MAX_SIZE", Default: "50000000", Win32Default: "", Type: "long"},
-	{Name: "PREEN_COREFILE_STALE_AGE", Default: "5184000", Win32Default: "", Type: "int"},
-	{Name: "PREEN_COREFILES_PER_PROCESS", Default: "10", Win32Default: "", Type: "int"},
-	{Name: "MASTER_NEW_BINARY_DELAY", Default: "120", Win32Default: "", Type: "int"},
-	{Name: "MASTER_NEW_BINARY_RESTART", Default: "GRACEFUL", Win32Default: "", Type: "string"},
-	{Name: "MASTER_CHECK_NEW_EXEC_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "MASTER_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "MASTER_RECOVER_FACTOR", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "MASTER_BACKOFF_FACTOR", Default: "2.0", Win32Default: "", Type: "double"},
-	{Name: "MASTER_BACKOFF_CEILING", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "MASTER_BACKOFF_CONSTANT", Default: "9", Win32Default: "", Type: "int"},
-	{Name: "HOST_ALIAS", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "USE_COLLECTOR_HOST_CNAME", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "MASTER_TIMEOUT_MULTIPLIER", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DNS_CACHE_REFRESH", Default: "28800", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_TCP_SOCKET_BUFSIZE", Default: "128*1024", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_PORT", Default: "9618", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_QUERY_WORKERS", Default: "4", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_QUERY_WORKERS_RESERVE_FOR_HIGH_PRIO", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_QUERY_WORKERS_PENDING", Default: "50", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_QUERY_MAX_WORKTIME", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "SOCKET_LISTEN_BACKLOG", Default: "4096", Win32Default: "", Type: "int"},
-	{Name: "CONDOR_CREDENTIAL_DIR", Default: "/tmp", Win32Default: "", Type: "string"},
-	{Name: "QUERY_TIMEOUT", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "LIBVIRT_XML_SCRIPT", Default: "$(LIBEXEC)/libvirt_simple_script.awk", Win32Default: "", Type: "string"},
-	{Name: "VM_GAHP_SERVER", Default: "$(SBIN)/condor_vm-gahp", Win32Default: "$(SBIN)\\condor_vm-gahp.exe", Type: "path"},
-	{Name: "VM_GAHP_LOG", Default: "$(LOG)/VMGahpLog", Win32Default: "", Type: "path"},
-	{Name: "MAX_VM_GAHP_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "XEN_ALLOW_HARDWARE_VT_SUSPEND", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "VM_MAX_MEMORY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "VM_NETWORKING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "VM_HARDWARE_VT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "USE_SCRIPT_TO_CREATE_CONFIG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "PROCD_ADDRESS", Default: "$(RUN)/procd_pipe", Win32Default: "\\\\.\\pipe\\$Fpub(LOCK)", Type: "string"},
-	{Name: "BIND_ALL_INTERFACES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "EXECUTE", Default: "$(LOCAL_DIR)/execute", Win32Default: "", Type: "path"},
-	{Name: "UID_DOMAIN", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "ACCOUNTING_DOMAIN", Default: "$(UID_DOMAIN)", Win32Default: "", Type: "string"},
-	{Name: "TRUST_LOCAL_UID_DOMAIN", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "FILESYSTEM_DOMAIN", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "STARTER_LOG", Default: "$(LOG)/StarterLog", Win32Default: "", Type: "path"},
-	{Name: "STARTER_DEBUG", Default: "D_PID", Win32Default: "", Type: "string"},
-	{Name: "DAGMAN_LOG_ON_NFS_IS_ERROR", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_RESET_RETRIES_UPON_RESCUE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_AUTO_RESCUE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_ON_EXIT_REMOVE", Default: "(ExitSignal =?= 11 || (ExitCode =!= UNDEFINED && ExitCode >=0 && ExitCode <= 2))", Win32Default: "", Type: "string"},
-	{Name: "DAGMAN_REMOVE_JOBS_AFTER_LIMIT_CHANGE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_DEBUG_CACHE_ENABLE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_STARTUP_CYCLE_DETECT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_ALLOW_EVENTS", Default: "114", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_RETRY_SUBMIT_FIRST", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_RETRY_NODE_FIRST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_MUNGE_NODE_NAMES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_ALLOW_ANY_NODE_NAME_CHARACTERS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_PROHIBIT_MULTI_JOBS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_SUBMIT_DEPTH_FIRST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_CONDOR_SUBMIT_EXE", Default: "$(BIN)/condor_submit", Win32Default: "$(BIN)\\condor_submit.exe", Type: "path"},
-	{Name: "DAGMAN_CONDOR_RM_EXE", Default: "$(BIN)/condor_rm", Win32Default: "$(BIN)\\condor_rm.exe", Type: "path"},
-	{Name: "DAGMAN_ABORT_DUPLICATES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_ABORT_ON_SCARY_SUBMIT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_PENDING_REPORT_INTERVAL", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_CHECK_QUEUE_INTERVAL", Default: "28800", Win32Default: "", Type: "int"},
-	{Name: "MAX_DAGMAN_LOG", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_ALWAYS_RUN_POST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_COPY_TO_SPOOL", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_DEBUG_CACHE_SIZE", Default: "5*1024*1024", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_DEFAULT_NODE_LOG", Default: "@(DAG_DIR)/@(DAG_FILE).nodes.log", Win32Default: "", Type: "path"},
-	{Name: "DAGMAN_DEFAULT_PRIORITY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_GENERATE_SUBDAG_SUBMITS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_HOLD_CLAIM_TIME", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_HOLD_SCRIPTS", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_JOB_HOLDS", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_JOBS_IDLE", Default: "1000", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_JOBS_SUBMITTED", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_POST_SCRIPTS", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_PRE_SCRIPTS", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_RESCUE_NUM", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_SUBMIT_ATTEMPTS", Default: "6", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_MAX_SUBMITS_PER_INTERVAL", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_AGGRESSIVE_SUBMIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_USE_DIRECT_SUBMIT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_DEFAULT_APPEND_VARS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_REPORT_GRAPH_METRICS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_REMOVE_NODE_JOBS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_SUBMIT_DELAY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_SUPPRESS_JOB_LOGS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_SUPPRESS_NOTIFICATION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_USER_LOG_SCAN_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_QUEUE_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_USE_STRICT", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_VERBOSITY", Default: "3", Win32Default: "", Type: "int"},
-	{Name: "DAGMAN_WRITE_PARTIAL_RESCUE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_USE_JOIN_NODES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_PUT_FAILED_JOBS_ON_HOLD", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LIBEXEC", Default: "$(RELEASE_DIR)/libexec", Win32Default: "$(BIN)", Type: "string"},
-	{Name: "LOG", Default: "$(LOCAL_DIR)/log", Win32Default: "", Type: "path"},
-	{Name: "INCLUDE", Default: "$(RELEASE_DIR)/include", Win32Default: "", Type: "path"},
-	{Name: "ETC", Default: "$(LOCAL_DIR)", Win32Default: "", Type: "path"},
-	{Name: "PRIORITY_HALFLIFE", Default: "86400", Win32Default: "", Type: "double"},
-	{Name: "NICE_USER_PRIO_FACTOR", Default: "1e10", Win32Default: "", Type: "double"},
-	{Name: "NICE_USER_ACCOUNTING_GROUP_NAME", Default: "nice-user", Win32Default: "", Type: "string"},
-	{Name: "REMOTE_PRIO_FACTOR", Default: "1e7", Win32Default: "", Type: "double"},
-	{Name: "DEFAULT_PRIO_FACTOR", Default: "1000", Win32Default: "", Type: "double"},
-	{Name: "ACCOUNTANT_DATABASE_FILE", Default: "$(SPOOL)/Accountantnew.log", Win32Default: "$(SPOOL)\\Accountantnew.log", Type: "path"},
-	{Name: "MAX_ACCOUNTANT_DATABASE_SIZE", Default: "1000000", Win32Default: "", Type: "int"},
-	{Name: "SPOOL", Default: "$(LOCAL_DIR)/spool", Win32Default: "", Type: "path"},
-	{Name: "NEGOTIATOR_DISCOUNT_SUSPENDED_RESOURCES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "CONCURRENCY_LIMIT_DEFAULT", Default: "2308032", Win32Default: "", Type: "int"},
-	{Name: "PREEMPTION_REQUIREMENTS", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "SEC_SCITOKENS_ALLOW_FOREIGN_TOKEN_TYPES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_SCITOKENS_FOREIGN_TOKEN_ISSUERS", Default: "https://aai-dev.egi.eu/auth/realms/egi", Win32Default: "", Type: "string"},
-	{Name: "SEC_SCITOKENS_CACHE", Default: "$(RUN)/cache", Win32Default: "", Type: "string"},
-	{Name: "SEC_ENABLE_MATCH_PASSWORD_AUTHENTICATION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_ENABLE_IMPERSONATION_TOKENS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SEC_ENABLE_REMOTE_ADMINISTRATION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DELEGATE_JOB_GSI_CREDENTIALS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "USE_VOMS_ATTRIBUTES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_USE_VOMS_IDENTITY", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "RECONNECT_BACKOFF_CEILING", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "SHADOW_MAX_JOB_CLEANUP_RETRIES", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "SHADOW_JOB_CLEANUP_RETRY_DELAY", Default: "30", Win32Default: "", Type: "int"},
-	{Name: "SHADOW_LAZY_QUEUE_UPDATE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "RESERVED_MEMORY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "RESERVED_SWAP", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "COUNT_HYPERTHREAD_CPUS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DETECTED_CPUS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "DETECTED_PHYSICAL_CPUS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "DETECTED_CORES", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "DETECTED_CPUS_LIMIT", Default: "$(DETECTED_CPUS)", Win32Default: "", Type: "int"},
-	{Name: "NUM_CPUS", Default: "$(DETECTED_CPUS_LIMIT)", Win32Default: "", Type: "int"},
-	{Name: "CONSOLE_DEVICES", Default: "mouse, console", Win32Default: "", Type: "string"},
-	{Name: "SLOTS_CONNECTED_TO_CONSOLE", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "SLOTS_CONNECTED_TO_KEYBOARD", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "STARTD_HAS_BAD_UTMP", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "SYSAPI_GET_LOADAVG", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "RELEASE_DIR", Default: "/usr", Win32Default: "c:\\condor", Type: "string"},
-	{Name: "HAD_USE_PRIMARY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "HAD_CONNECTION_TIMEOUT", Default: "5", Win32Default: "", Type: "string"},
-	{Name: "HAD_USE_REPLICATION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "HAD_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "string"},
-	{Name: "HAD_FIPS_MODE", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "TRANSFER_JOB_ATTRS", Default: "ClusterID, ProcID, NumShadowStarts", Win32Default: "", Type: "string"},
-	{Name: "MAX_TRANSFER_LIFETIME", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "LVM_USE_THIN_PROVISIONING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LVM_BACKING_FILE", Default: "$(SPOOL)/startd_disk.img", Win32Default: "", Type: "string"},
-	{Name: "LVM_BACKING_FILE_SIZE_MB", Default: "10240", Win32Default: "", Type: "int"},
-	{Name: "LVM_THIN_LV_EXTRA_SIZE_MB", Default: "2000", Win32Default: "", Type: "int"},
-	{Name: "LVM_HIDE_MOUNT", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "MAX_TIME_SKIP", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "MAX_TIMER_EVENTS_PER_CYCLE", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "MAX_ACCEPTS_PER_CYCLE", Default: "8", Win32Default: "", Type: "int"},
-	{Name: "MAX_UDP_MSGS_PER_CYCLE", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "MAX_REAPS_PER_CYCLE", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "PID_SNAPSHOT_INTERVAL", Default: "15", Win32Default: "", Type: "int"},
-	{Name: "MASTER.PID_SNAPSHOT_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "SEC_DEBUG_PRINT_KEYS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SEC_CREDENTIAL_SWEEP_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "SEC_CREDENTIAL_SWEEP_DELAY", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "SEC_CREDENTIAL_REFRESH_INTERVAL", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "SEC_DEFAULT_AUTHENTICATION_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "WANT_UDP_COMMAND_SOCKET", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NETWORK_MAX_PENDING_CONNECTS", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "PRIVATE_NETWORK_NAME", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "USE_CLONE_TO_CREATE_PROCESSES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_INVALIDATE_SESSIONS_VIA_TCP", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_USE_FAMILY_SESSION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CERTIFICATE_MAPFILE_ASSUME_HASH_KEYS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NOT_RESPONDING_TIMEOUT", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "DISABLE_SETUID", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DISABLE_AUTHENTICATION_IP_CHECK", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SEC_C_GAHP_WORKER_THREAD_DEFAULT_SESSION_DURATION", Default: "1800", Win32Default: "", Type: "int"},
-	{Name: "SEC_SESSION_DURATION_SLOP", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "WINDOWS_SOFTKILL", Default: "", Win32Default: "$(SBIN)\\condor_softkill.exe", Type: "path"},
-	{Name: "WINDOWS_RMDIR", Default: "", Win32Default: "$(SBIN)\\condor_rmdir.exe", Type: "path"},
-	{Name: "USE_PROCESS_GROUPS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "USE_VISIBLE_DESKTOP", Default: "false", Win32Default: "", Type: "string"},
-	{Name: "MAX_PID_COLLISION_RETRY", Default: "9", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_SOCKET_BUFSIZE", Default: "10000*1024", Win32Default: "", Type: "int"},
-	{Name: "PIPE_BUFFER_MAX", Default: "10240", Win32Default: "", Type: "int"},
-	{Name: "TOUCH_LOG_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "BIN", Default: "$(RELEASE_DIR)/bin", Win32Default: "$(RELEASE_DIR)\\bin", Type: "path"},
-	{Name: "CREATE_CORE_FILES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CORE_FILE_NAME", Default: "core.$(SUBSYSTEM)", Win32Default: "core.$(SUBSYSTEM).WIN32", Type: "string"},
-	{Name: "COLLECTOR_NAME", Default: "My Pool - $(CONDOR_HOST)", Win32Default: "", Type: "string"},
-	{Name: "COLLECTOR_UPDATE_INTERVAL", Default: "900", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_FORWARD_WATCH_LIST", Default: "State,Cpus,Memory,IdleJobs,ClaimId,Capability,ClaimIdList,ChildClaimIds", Win32Default: "", Type: "string"},
-	{Name: "COLLECTOR_FORWARD_FILTERING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_FORWARD_CLAIMED_PRIVATE_ADS", Default: "$(NEGOTIATOR_CONSIDER_PREEMPTION)", Win32Default: "", Type: "string"},
-	{Name: "COLLECTOR_STATS_SWEEP", Default: "14400", Win32Default: "", Type: "int"},
-	{Name: "CONDOR_ADMIN", Default: "root@$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "POOL_HISTORY_SAMPLING_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "POOL_HISTORY_MAX_STORAGE", Default: "10000000", Win32Default: "", Type: "int"},
-	{Name: "LOCAL_DIR", Default: "$(TILDE)", Win32Default: "$(RELEASE_DIR)", Type: "string"},
-	{Name: "GRIDMANAGER_GAHPCLIENT_DEBUG", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GRIDMANAGER_GAHPCLIENT_DEBUG_SIZE", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "GAHP_DEBUG_HIDE_SENSITIVE_DATA", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GRIDMANAGER_MAX_PENDING_REQUESTS", Default: "50", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_MAX_SUBMITTED_JOBS_PER_RESOURCE_EC2", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "BLAHPD_LOCATION", Default: "$(RELEASE_DIR)", Win32Default: "", Type: "string"},
-	{Name: "BATCH_GAHP", Default: "$(BIN)/blahpd", Win32Default: "", Type: "string"},
-	{Name: "REMOTE_GAHP", Default: "$(SBIN)/remote_gahp", Win32Default: "$(SBIN)\\remote_gahp.exe", Type: "path"},
-	{Name: "GRIDMANAGER_CONTACT_SCHEDD_DELAY", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_PROXY_REFRESH_TIME", Default: "21600", Win32Default: "", Type: "string"},
-	{Name: "CONDOR_GAHP", Default: "$(SBIN)/condor_c-gahp", Win32Default: "$(SBIN)\\condor_c-gahp.exe", Type: "path"},
-	{Name: "C_GAHP_CONTACT_SCHEDD_DELAY", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "CGAHP_SCHEDD_INTERACTION_TIME", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "C_GAHP_MAX_FILE_REQUESTS", Default: "10", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_CONNECT_FAILURE_RETRY_COUNT", Default: "3", Win32Default: "", Type: "int"},
-	{Name: "PERIODIC_EXPR_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "MAX_PERIODIC_EXPR_INTERVAL", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "PERIODIC_EXPR_TIMESLICE", Default: "0.01", Win32Default: "", Type: "double"},
-	{Name: "GRIDMANAGER_CONNECT_FAILURE_RETRY_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_MAX_SUBMITTED_JOBS_PER_RESOURCE", Default: "1000", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_GAHP_CALL_TIMEOUT_CONDOR", Default: "28800", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_GAHP_RESPONSE_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_HOST", Default: "$(CONDOR_HOST)", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_STARTD_DAEMON_AD", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "CONTINUE_TO_ADVERTISE_BROKEN_DYNAMIC_SLOTS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_HOST_STRING", Default: "\"$(COLLECTOR_HOST)\"", Win32Default: "", Type: "string"},
-	{Name: "SYSTEM_STARTD_ATTRS", Default: "COLLECTOR_HOST_STRING DedicatedScheduler", Win32Default: "", Type: "string"},
-	{Name: "SYSTEM_MAX_RELEASES", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "SYSTEM_STARTD_LATCH_EXPRS", Default: "NumDynamicSlots", Win32Default: "", Type: "string"},
-	{Name: "NO_DNS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NETWORK_INTERFACE", Default: "*", Win32Default: "", Type: "string"},
-	{Name: "CRED_MIN_TIME_LEFT", Default: "120", Win32Default: "", Type: "int"},
-	{Name: "LOGS_USE_TIMESTAMP", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "FILE_LOCK_VIA_MUTEX", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IGNORE_NFS_LOCK_ERRORS", Default: "false", Win32Default: "", Type: "string"},
-	{Name: "SENDMAIL", Default: "sendmail", Win32Default: "", Type: "path"},
-	{Name: "MAIL", Default: "/usr/bin/mail", Win32Default: "$(BIN)\\condor_mail.exe", Type: "path"},
-	{Name: "STARTD_RECOMPUTE_DISK_FREE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_LIST", Default: "STARTER", Win32Default: "STARTER", Type: "string"},
-	{Name: "SBIN", Default: "$(RELEASE_DIR)/sbin", Win32Default: "$(BIN)", Type: "path"},
-	{Name: "SINGULARITY", Default: "$(LIBEXEC)/apptainer", Win32Default: "", Type: "string"},
-	{Name: "SINGULARITY_JOB", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SINGULARITY_VERBOSITY", Default: "-s", Win32Default: "", Type: "string"},
-	{Name: "SINGULARITY_IMAGE_EXPR", Default: "SingularityImage", Win32Default: "", Type: "string"},
-	{Name: "SINGULARITY_MOUNT_HOME", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SINGULARITY_TEST_SANDBOX", Default: "$(LIBEXEC)/singularity_test_sandbox", Win32Default: "", Type: "string"},
-	{Name: "SINGULARITY_USE_PID_NAMESPACES", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "STARTD_SENDS_ALIVES", Default: "peer", Win32Default: "", Type: "string"},
-	{Name: "STARTER_HANDLES_ALIVES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "VM_RECHECK_INTERVAL", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "LOCK", Default: "$(LOG)", Win32Default: "", Type: "string"},
-	{Name: "RUN", Default: "$(LOCK)", Win32Default: "", Type: "path"},
-	{Name: "VM_KILLING_TIMEOUT", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "RunBenchmarks", Default: "LastBenchmark == 0", Win32Default: "", Type: "string"},
-	{Name: "POLLING_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "UPDATE_SPREAD_TIME", Default: "$(UPDATE_COLLECTOR_WITH_TCP:0) ? 0 : 8", Win32Default: "", Type: "int"},
-	{Name: "MATCH_TIMEOUT", Default: "120", Win32Default: "", Type: "int"},
-	{Name: "KILLING_TIMEOUT", Default: "30", Win32Default: "", Type: "int"},
-	{Name: "MAX_CLAIM_ALIVES_MISSED", Default: "6", Win32Default: "", Type: "int"},
-	{Name: "SYSTEM_STARTD_JOB_ATTRS", Default: "ImageSize, ExecutableSize, JobUniverse, NiceUser, CPUsUsage, ResidentSetSize, ProportionalSetSizeKb, MemoryUsage, DiskUsage, StdoutMtime, StderrMtime, ScratchDirFileCount", Win32Default: "", Type: "string"},
-	{Name: "BROKEN_SLOT_CONTEXT_ATTRS", Default: "JobId,RemoteUser,RemoteScheddName", Win32Default: "", Type: "string"},
-	{Name: "KEYBOARD_CPUS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "DISCONNECTED_KEYBOARD_IDLE_BOOST", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "STARTD_NOCLAIM_SHUTDOWN", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "STARTD_SHOULD_WRITE_CLAIM_ID_FILE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "START", Default: "True", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_BACKFILL", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MAX_SLOT_TYPES", Default: "10", Win32Default: "", Type: "int"},
-	{Name: "STARTD_DETECT_GPUS", Default: "-properties $(GPU_DISCOVERY_EXTRA)", Win32Default: "", Type: "string"},
-	{Name: "STARTD_LEFTOVER_PROCS_BREAK_SLOTS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENVIRONMENT_FOR_AssignedGPUs", Default: "GPU_DEVICE_ORDINAL=/(CUDA|OCL)//  CUDA_VISIBLE_DEVICES=/CUDA//", Win32Default: "", Type: "string"},
-	{Name: "ENVIRONMENT_VALUE_FOR_UnAssignedGPUs", Default: "10000", Win32Default: "", Type: "string"},
-	{Name: "CLASSAD_USER_PYTHON_LIB", Default: "$(LIBEXEC)/libclassad_python_user.so", Win32Default: "$(BIN)\\classad_python_user$(PYTHON3_MODULE_SUFFIX:.pyd)", Type: "string"},
-	{Name: "ENABLE_CLASSAD_CACHING", Default: "true", Win32Default: "true", Type: "bool"},
-	{Name: "MASTER.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "WANT_XML_LOG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_ALLOW_GETENV", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_SKIP_FILECHECKS", Default: "true", Win32Default: "", Type: "string"},
-	{Name: "SUBMIT_GENERATE_CUSTOM_RESOURCE_REQUIREMENTS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_GENERATE_CONDOR_C_REQUIREMENTS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_SEND_RESCHEDULE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_PUBLISH_WINDOWS_OSVERSIONINFO", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT_CONTAINER_NEVER_XFER_ABSOLUTE_CMD", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LOG_ON_NFS_IS_ERROR", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "WARN_ON_UNUSED_SUBMIT_FILE_MACROS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "WINDOWS_FIREWALL_FAILURE_RETRY", Default: "2", Win32Default: "", Type: "int"},
-	{Name: "CREDD_CACHE_LOCALLY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "CREDD_POLLING_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "Q_QUERY_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "PROCD_LOG", Default: "$(LOG)/ProcLog", Win32Default: "", Type: "path"},
-	{Name: "PROCD", Default: "$(SBIN)/condor_procd", Win32Default: "$(SBIN)\\condor_procd.exe", Type: "path"},
-	{Name: "PROCD_MAX_SNAPSHOT_INTERVAL", Default: "60", Win32Default: "", Type: "string"},
-	{Name: "PROCD_DEBUG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "USE_GID_PROCESS_TRACKING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MIN_TRACKING_GID", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "MAX_TRACKING_GID", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "RESTART_PROCD_ON_ERROR", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ABORT_ON_EXCEPTION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "REQUIRE_LOCAL_CONFIG_FILE", Default: "true", Win32Default: "false", Type: "bool"},
-	{Name: "ENABLE_RUNTIME_CONFIG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "USER_CONFIG_FILE", Default: "user_config", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_PERSISTENT_CONFIG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "USE_PROCD", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "JAVA", Default: "/usr/bin/java", Win32Default: "java", Type: "string"},
-	{Name: "JAVA_MAXHEAP_ARGUMENT", Default: "-Xmx", Win32Default: "", Type: "string"},
-	{Name: "JAVA_CLASSPATH_ARGUMENT", Default: "-classpath", Win32Default: "", Type: "string"},
-	{Name: "JAVA_CLASSPATH_SEPARATOR", Default: ":", Win32Default: ";", Type: "string"},
-	{Name: "JAVA_CLASSPATH_DEFAULT", Default: "$(LIB) .", Win32Default: "$(BIN) .", Type: "string"},
-	{Name: "CONDOR_SSH_TO_JOB_FAKE_PASSWD_ENTRY", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_USERLOG_FSYNC", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_USERLOG_LOCKING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ALWAYS_CLOSE_USERLOG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "EVENT_LOG_USE_XML", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "EVENT_LOG_LOCKING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "EVENT_LOG_ROTATION_LOCK", Default: "$(LOCK)/EventLogLock", Win32Default: "", Type: "string"},
-	{Name: "EVENT_LOG_MAX_SIZE", Default: "1000000", Win32Default: "", Type: "long"},
-	{Name: "EVENT_LOG_MAX_ROTATIONS", Default: "1", Win32Default: "", Type: "long"},
-	{Name: "EVENT_LOG_FSYNC", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "EVENT_LOG_COUNT_EVENTS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "PASSWD_CACHE_REFRESH", Default: "72000", Win32Default: "", Type: "int"},
-	{Name: "SEC_PASSWORD_FILE", Default: "$(SEC_PASSWORD_DIRECTORY)/POOL", Win32Default: "", Type: "path"},
-	{Name: "SEC_PASSWORD_DIRECTORY", Default: "$(ETC)/passwords.d", Win32Default: "$(RELEASE_DIR)\\tokens.sk", Type: "path"},
-	{Name: "SEC_TOKEN_SYSTEM_DIRECTORY", Default: "$(ETC)/tokens.d", Win32Default: "$(RELEASE_DIR)\\tokens.d", Type: "path"},
-	{Name: "SEC_TOKEN_ISSUER_KEY", Default: "POOL", Win32Default: "", Type: "string"},
-	{Name: "SEC_TOKEN_FETCH_ALLOWED_SIGNING_KEYS", Default: "POOL AP", Win32Default: "", Type: "string"},
-	{Name: "SEC_TOKEN_AP_SIGNING_KEY_NAME", Default: "AP", Win32Default: "", Type: "string"},
-	{Name: "SEC_TOKEN_POOL_SIGNING_KEY_FILE", Default: "$(SEC_PASSWORD_FILE)", Win32Default: "$(RELEASE_DIR)\\tokens.sk\\POOL", Type: "path"},
-	{Name: "SEC_ENABLE_TOKEN_REQUEST", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_ENABLE_TOKEN_FETCH", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_ENABLE_SCITOKEN_EXCHANGE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SEC_TCP_SESSION_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "SEC_CLAIMTOBE_INCLUDE_DOMAIN", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SSL_SKIP_HOST_CHECK", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_SERVER_USE_DEFAULT_CAS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_CLIENT_USE_DEFAULT_CAS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_SERVER_CERTFILE", Default: "/etc/pki/tls/certs/localhost.crt,$(AUTH_SSL_AUTOGENERATE_CERTFILE)", Win32Default: "", Type: "path"},
-	{Name: "AUTH_SSL_SERVER_KEYFILE", Default: "/etc/pki/tls/private/localhost.key,$(AUTH_SSL_AUTOGENERATE_KEYFILE)", Win32Default: "", Type: "path"},
-	{Name: "AUTH_SSL_AUTOGENERATE_CERTFILE", Default: "$(ETC)/hostcert.pem", Win32Default: "", Type: "path"},
-	{Name: "AUTH_SSL_AUTOGENERATE_KEYFILE", Default: "$(ETC)/hostkey.pem", Win32Default: "", Type: "path"},
-	{Name: "AUTH_SSL_REQUIRE_CLIENT_CERTIFICATE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_REQUIRE_CLIENT_MAPPING", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_ALLOW_CLIENT_PROXY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "AUTH_SSL_USE_CLIENT_PROXY_ENV_VAR", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "BOOTSTRAP_SSL_SERVER_TRUST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "BOOTSTRAP_SSL_SERVER_TRUST_PROMPT_USER", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_BOOTSTRAP_SSL_CERTIFICATE", Default: "true", Win32Default: "false", Type: "bool"},
-	{Name: "TRUST_DOMAIN_CAFILE", Default: "$(ETC)/trust_domain_ca.pem", Win32Default: "", Type: "path"},
-	{Name: "TRUST_DOMAIN_CAKEY", Default: "$(ETC)/trust_domain_ca_privkey.pem", Win32Default: "", Type: "path"},
-	{Name: "SEC_SYSTEM_KNOWN_HOSTS", Default: "$(ETC)/known_hosts", Win32Default: "$(LOCAL_DIR)\\known_hosts", Type: "path"},
-	{Name: "TRUST_DOMAIN", Default: "$(UID_DOMAIN)", Win32Default: "", Type: "string"},
-	{Name: "FS_ALLOW_UNSAFE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENCRYPT_SECRETS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_UPLOAD_TIMEOUT", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "VM_GAHP_SEND_ALL_CLASSAD", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_INITIAL_UPDATE_INTERVAL", Default: "8", Win32Default: "", Type: "int"},
-	{Name: "STARTER_ALWAYS_HOLD_ON_OOM", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DISABLE_EXECUTE_DIRECTORY_ENCRYPTION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENCRYPT_EXECUTE_DIRECTORY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_NESTED_SCRATCH", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_NUM_THREADS_ENV_VARS", Default: "CUBACORES GOMAXPROCS JULIA_NUM_THREADS MKL_NUM_THREADS NUMEXPR_NUM_THREADS OMP_NUM_THREADS OMP_THREAD_LIMIT OPENBLAS_NUM_THREADS PYTHON_CPU_COUNT ROOT_MAX_THREADS TF_LOOP_PARALLEL_ITERATIONS TF_NUM_THREADS", Win32Default: "", Type: "string"},
-	{Name: "STARTER_HIDE_GPU_DEVICES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_FILE_XFER_STALL_TIMEOUT", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "JOB_RENICE_INCREMENT", Default: "0", Win32Default: "10", Type: "string"},
-	{Name: "JOB_INHERITS_STARTER_ENVIRONMENT", Default: "false", Win32Default: "", Type: "string"},
-	{Name: "LOCAL_UNIVERSE_MAX_JOB_CLEANUP_RETRIES", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "LOCAL_UNIVERSE_JOB_CLEANUP_RETRY_DELAY", Default: "30", Win32Default: "", Type: "int"},
-	{Name: "LOCAL_UNIVERSE_CGROUP_ENFORCEMENT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "VM_STATUS_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "VM_STATUS_MAX_ERROR", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "VM_GAHP_REQ_TIMEOUT", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "VM_SOFT_SUSPEND", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "CONDOR_GAHP_WORKER", Default: "$(SBIN)/condor_c-gahp_worker_thread", Win32Default: "$(SBIN)\\condor_c-gahp_worker_thread.exe", Type: "path"},
-	{Name: "REPLICATION_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_CROSS_SLOT_PRIOS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_MIN_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_UPDATE_AFTER_CYCLE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_TRIM_SHUTDOWN_THRESHOLD", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_TIMEOUT", Default: "30", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_SOCKET_CACHE_SIZE", Default: "500", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_MAX_TIME_PER_SUBMITTER", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_MAX_TIME_PER_SCHEDD", Default: "120", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_MAX_TIME_PER_PIESPIN", Default: "120", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_MAX_TIME_PER_CYCLE", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_NUM_THREADS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "PREEMPTION_RANK", Default: "(RemoteUserPrio * 1000000) - ifThenElse(isUndefined(TotalJobRuntime), 0, TotalJobRuntime)", Win32Default: "", Type: "string"},
-	{Name: "NEGOTIATOR_PRE_JOB_RANK", Default: "(10000000 * My.Rank) + (1000000 * (RemoteOwner =?= UNDEFINED)) - (100000 * Cpus) - Memory", Win32Default: "", Type: "string"},
-	{Name: "NEGOTIATOR_POST_JOB_RANK", Default: "(RemoteOwner =?= UNDEFINED) * (ifthenElse(isUndefined(KFlops), 1000, Kflops) - SlotID - 1.0e10*(Offline=?=True))", Win32Default: "", Type: "string"},
-	{Name: "NEGOTIATOR_MATCHLIST_CACHING", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_CONSIDER_PREEMPTION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_CONSIDER_EARLY_PREEMPTION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_DEPTH_FIRST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_INFORM_STARTD", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_USE_NONBLOCKING_STARTD_CONTACT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_USE_WEIGHTED_DEMAND", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GROUP_AUTOREGROUP", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "GROUP_ACCEPT_SURPLUS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_USE_SLOT_WEIGHTS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_ALLOW_QUOTA_OVERSUBSCRIPTION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GROUP_QUOTA_MAX_ALLOCATION_ROUNDS", Default: "3", Win32Default: "", Type: "int"},
-	{Name: "GROUP_QUOTA_ROUND_ROBIN_RATE", Default: "1.0e100", Win32Default: "", Type: "double"},
-	{Name: "NEGOTIATOR_IGNORE_USER_PRIORITIES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_HOST_FOR_NEGOTIATOR", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string"},
-	{Name: "RECEIVE_MSGS_DURATION", Default: "250", Win32Default: "", Type: "int"},
-	{Name: "NONBLOCKING_COLLECTOR_UPDATE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "UPDATE_COLLECTOR_WITH_TCP", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "UPDATE_VIEW_COLLECTOR_WITH_TCP", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DEAD_COLLECTOR_MAX_AVOIDANCE_TIME", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "HISTORY", Default: "$(SPOOL)/history", Win32Default: "", Type: "string"},
-	{Name: "STARTD_HISTORY", Default: "$(LOG)/startd_history", Win32Default: "", Type: "string"},
-	{Name: "JOB_EPOCH_HISTORY", Default: "$(SPOOL)/epoch_history", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_DAEMON_HISTORY", Default: "$(SPOOL)/schedd_daemon_history", Win32Default: "", Type: "string"},
-	{Name: "HISTORY_CONTAINS_JOB_ENVIRONMENT", Default: "true", Win32Default: "", Type: "string"},
-	{Name: "SYSTEM_VALID_SPOOL_FILES", Default: "job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_address.super, .schedd_classad, OfflineLog, startd_disk.img, checkpoint-cleanup", Win32Default: "", Type: "string"},
-	{Name: "LIB", Default: "$(RELEASE_DIR)/lib", Win32Default: "", Type: "path"},
-	{Name: "MAX_JOB_MIRROR_UPDATE_LAG", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "JOB_ROUTER_POLLING_PERIOD", Default: "10", Win32Default: "", Type: "int"},
-	{Name: "JOB_ROUTER_NAME", Default: "jobrouter", Win32Default: "", Type: "string"},
-	{Name: "JOB_ROUTER_LOCK", Default: "$(LOCK)/$(JOB_ROUTER_NAME)Lock", Win32Default: "", Type: "string"},
-	{Name: "JOB_ROUTER_ROUND_ROBIN_SELECTION", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "QUEUE_SUPER_USERS", Default: "root, condor", Win32Default: "condor, SYSTEM", Type: "string"},
-	{Name: "QUEUE_ALL_USERS_TRUSTED", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SYSTEM_SECURE_JOB_ATTRS", Default: "x509userProxySubject x509UserProxyEmail x509UserProxyVOName x509UserProxyFirstFQAN x509UserProxyFQAN TotalSubmitProcs AuthTokenSubject AuthTokenIssuer AuthTokenGroups AuthTokenId AuthTokenScopes OsUser", Win32Default: "", Type: "string"},
-	{Name: "IGNORE_ATTEMPTS_TO_SET_SECURE_JOB_ATTRS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SYSTEM_IMMUTABLE_JOB_ATTRS", Default: "Owner ClusterId ProcId MyType TargetType User OsUser", Win32Default: "", Type: "string"},
-	{Name: "GLOBAL_JOB_ID_WITH_TIME", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CLAIM_RECYCLING_CONSIDER_LIMITS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "UNUSED_CLAIM_TIMEOUT", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "SCHEDD_RESTART_REPORT", Default: "$(LOG)/ScheddRestartReport", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ALLOW_LATE_MATERIALIZE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_ASSUME_NEGOTIATOR_GONE", Default: "2000000000", Win32Default: "", Type: "int"},
-	{Name: "SCHEDD_NON_DURABLE_LATE_MATERIALIZE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_SEND_RESCHEDULE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DEDICATED_SCHEDULER_USE_FIFO", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW_LIST", Default: "SHADOW", Win32Default: "SHADOW", Type: "string"},
-	{Name: "SHADOW", Default: "$(SBIN)/condor_shadow", Win32Default: "$(SBIN)\\condor_shadow.exe", Type: "path"},
-	{Name: "SHADOW_RENICE_INCREMENT", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "STARTER_LOCAL", Default: "$(SBIN)/condor_starter", Win32Default: "$(SBIN)\\condor_starter.exe", Type: "path"},
-	{Name: "JOB_QUEUE_LOG", Default: "$(SPOOL)/job_queue.log", Win32Default: "$(SPOOL)\\job_queue.log", Type: "path"},
-	{Name: "LOCAL_UNIV_EXECUTE", Default: "$(SPOOL)/local_univ_execute", Win32Default: "$(SPOOL)\\local_univ_execute", Type: "path"},
-	{Name: "SCHED_UNIV_RENICE_INCREMENT", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "SCHEDD_SEND_VACATE_VIA_TCP", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "KEEP_OUTPUT_SANDBOX", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_HISTORY_ROTATION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_MIN_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "JOB_START_DELAY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "JOB_START_COUNT", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "MAX_JOBS_RUNNING", Default: "MIN({$(DETECTED_MEMORY), 10000})", Win32Default: "MIN({($(DETECTED_MEMORY)-200)/10, 2000})", Type: "int"},
-	{Name: "CURB_MATCHMAKING", Default: "(RecentDaemonCoreDutyCycle > 0.98) || (TransferQueueNumWaitingToUpload > TransferQueueMaxUploading)", Win32Default: "", Type: "string"},
-	{Name: "START_LOCAL_UNIVERSE", Default: "TotalLocalJobsRunning < 200", Win32Default: "", Type: "string"},
-	{Name: "START_SCHEDULER_UNIVERSE", Default: "TotalSchedulerJobsRunning < 500", Win32Default: "", Type: "string"},
-	{Name: "MAX_JOBS_SUBMITTED", Default: "2147483647", Win32Default: "", Type: "int"},
-	{Name: "MAX_JOBS_PER_OWNER", Default: "100000", Win32Default: "", Type: "int"},
-	{Name: "MAX_JOBS_PER_SUBMISSION", Default: "20000", Win32Default: "", Type: "int"},
-	{Name: "ALLOW_SUBMIT_FROM_KNOWN_USERS_ONLY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MAX_RUNNING_SCHEDULER_JOBS_PER_OWNER", Default: "200", Win32Default: "", Type: "string"},
-	{Name: "STARTD_CONTACT_TIMEOUT", Default: "45", Win32Default: "", Type: "int"},
-	{Name: "FLOCK_COLLECTOR_HOSTS", Default: "$(FLOCK_TO)", Win32Default: "", Type: "string"},
-	{Name: "FLOCK_NEGOTIATOR_HOSTS", Default: "$(FLOCK_TO)", Win32Default: "", Type: "string"},
-	{Name: "FLOCK_INCREMENT", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "MIN_FLOCK_LEVEL", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "FLOCK_BY_DEFAULT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW_SIZE_ESTIMATE", Default: "125", Win32Default: "", Type: "int"},
-	{Name: "MAX_SHADOW_EXCEPTIONS", Default: "2", Win32Default: "", Type: "int"},
-	{Name: "MAX_PENDING_STARTD_CONTACTS", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER_LOG_APPEND_SELECTION_EXPR", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MAX_JOB_QUEUE_LOG_ROTATIONS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "GRIDMANAGER", Default: "$(SBIN)/condor_gridmanager", Win32Default: "$(SBIN)\\condor_gridmanager.exe", Type: "path"},
-	{Name: "CHOWN_JOB_SPOOL_FILES", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_QUERY_WORKERS", Default: "8", Win32Default: "", Type: "int"},
-	{Name: "OBITUARY_LOG_LENGTH", Default: "200", Win32Default: "", Type: "int"},
-	{Name: "ALLOW_ADMIN_COMMANDS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ALLOW_CLIENT", Default: "*", Win32Default: "", Type: "string"},
-	{Name: "PREEN", Default: "$(SBIN)/condor_preen", Win32Default: "$(SBIN)\\condor_preen.exe", Type: "path"},
-	{Name: "DAEMON_LIST", Default: "MASTER", Win32Default: "", Type: "string"},
-	{Name: "PREEN_ARGS", Default: "-m -r", Win32Default: "", Type: "string"},
-	{Name: "COLLECTOR_LOG", Default: "$(LOG)/CollectorLog", Win32Default: "", Type: "path"},
-	{Name: "KBDD_LOG", Default: "$(LOG)/KbdLog", Win32Default: "", Type: "path"},
-	{Name: "MASTER_LOG", Default: "$(LOG)/MasterLog", Win32Default: "", Type: "path"},
-	{Name: "NEGOTIATOR_LOG", Default: "$(LOG)/NegotiatorLog", Win32Default: "", Type: "path"},
-	{Name: "NEGOTIATOR_MATCH_LOG", Default: "$(LOG)/MatchLog", Win32Default: "", Type: "path"},
-	{Name: "SCHEDD_LOG", Default: "$(LOG)/SchedLog", Win32Default: "", Type: "path"},
-	{Name: "SHADOW_LOG", Default: "$(LOG)/ShadowLog", Win32Default: "", Type: "path"},
-	{Name: "SHADOW_STATS_LOG", Default: "$(LOG)/XferStatsLog", Win32Default: "", Type: "path"},
-	{Name: "STARTER_STATS_LOG", Default: "$(LOG)/XferStatsLog", Win32Default: "", Type: "path"},
-	{Name: "STARTD_LOG", Default: "$(LOG)/StartLog", Win32Default: "", Type: "path"},
-	{Name: "JOB_ROUTER_LOG", Default: "$(LOG)/JobRouterLog", Win32Default: "", Type: "path"},
-	{Name: "ROOSTER_LOG", Default: "$(LOG)/RoosterLog", Win32Default: "", Type: "path"},
-	{Name: "SHARED_PORT_LOG", Default: "$(LOG)/SharedPortLog", Win32Default: "", Type: "path"},
-	{Name: "HAD_LOG", Default: "$(LOG)/HADLog", Win32Default: "", Type: "path"},
-	{Name: "REPLICATION_LOG", Default: "$(LOG)/ReplicationLog", Win32Default: "", Type: "path"},
-	{Name: "TRANSFERER_LOG", Default: "$(LOG)/TransfererLog", Win32Default: "", Type: "path"},
-	{Name: "ADD_WINDOWS_FIREWALL_EXCEPTION", Default: "$(CondorIsAdmin)", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_COMMAND_STATE", Default: "$(LOG)/AnnexCommand.state", Win32Default: "", Type: "path"},
-	{Name: "ANNEX_LOG", Default: "$(LOG)/AnnexLog", Win32Default: "", Type: "path"},
-	{Name: "ANNEX_AUDIT_LOG", Default: "$(LOG)/AnnexAuditLog", Win32Default: "", Type: "path"},
-	{Name: "ANNEX_GAHP_LOG", Default: "$(LOG)/AnnexGahpLog", Win32Default: "NUL", Type: "path"},
-	{Name: "ANNEX_DEFAULT_LEASE_DURATION", Default: "3000", Win32Default: "", Type: "long"},
-	{Name: "ANNEX_DEFAULT_UNCLAIMED_TIMEOUT", Default: "900", Win32Default: "", Type: "long"},
-	{Name: "ANNEX_DEFAULT_AWS_REGION", Default: "us-east-1", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_EC2_URL", Default: "https://ec2.us-east-1.amazonaws.com", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_CWE_URL", Default: "https://events.us-east-1.amazonaws.com", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_LAMBDA_URL", Default: "https://lambda.us-east-1.amazonaws.com", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_S3_URL", Default: "https://s3.amazonaws.com", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_CF_URL", Default: "https://cloudformation.us-east-1.amazonaws.com/", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_CONNECTIVITY_FUNCTION_ARN", Default: "HTCondorAnnex-CheckConnectivity", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_ODI_INSTANCE_TYPE", Default: "$(ANNEX_DEFAULT_ODI_INSTANCE_TYPE_V8_7_0)", Win32Default: "", Type: "string"},
-	{Name: "ANNEX_DEFAULT_ODI_INSTANCE_TYPE_V8_7_0", Default: "m4.large", Win32Default: "", Type: "string"},
-	{Name: "ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-078d5c7afa60d8d1b", Win32Default: "", Type: "string"},
-	{Name: "ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0463306823b53e30", Win32Default: "", Type: "string"},
-	{Name: "ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-02c69d521a0809249", Win32Default: "", Type: "string"},
-	{Name: "ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0c07ed1dc0cea3608", Win32Default: "", Type: "string"},
-	{Name: "ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-024615f49be85226a", Win32Default: "", Type: "string"},
-	{Name: "sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0b9bff095bcf587b9", Win32Default: "", Type: "string"},
-	{Name: "ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string"},
-	{Name: "ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-00ca51dc8295a9e4f", Win32Default: "", Type: "string"},
-	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string"},
-	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-0b0cba432f6183314", Win32Default: "", Type: "string"},
-	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0e5c4998acc873d00", Win32Default: "", Type: "string"},
-	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string"},
-	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-04cf1a4bcdb6c2ddb", Win32Default: "", Type: "string"},
-	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-052abc65f9a1f2191", Win32Default: "", Type: "string"},
-	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string"},
-	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-05db8a05b808cc82a", Win32Default: "", Type: "string"},
-	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-054747f2946a308fc", Win32Default: "", Type: "string"},
-	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string"},
-	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-000db792307d7ec4c", Win32Default: "", Type: "string"},
-	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-07ed49c22921c0d31", Win32Default: "", Type: "string"},
-	{Name: "eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string"},
-	{Name: "eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-0628aa554d3b907a5", Win32Default: "", Type: "string"},
-	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string"},
-	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-02ce5672c4c88146c", Win32Default: "", Type: "string"},
-	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-0c2bae939a03e211a", Win32Default: "", Type: "string"},
-	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0582916cc08a1d81e", Win32Default: "", Type: "string"},
-	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string"},
-	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-007920684150d7cb7", Win32Default: "", Type: "string"},
-	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-02a90df644af3b003", Win32Default: "", Type: "string"},
-	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0f61cdd6883793833", Win32Default: "", Type: "string"},
-	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string"},
-	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-0a6fbc8bda794e1f4", Win32Default: "", Type: "string"},
-	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-0f433606b5f80ccab", Win32Default: "", Type: "string"},
-	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-084d3d008e3740806", Win32Default: "", Type: "string"},
-	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string"},
-	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-0a46b14d914d27167", Win32Default: "", Type: "string"},
-	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-05cabd3969196c5ba", Win32Default: "", Type: "string"},
-	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-00daecb4d3b8f077f", Win32Default: "", Type: "string"},
-	{Name: "HPC_ANNEX_ENABLED", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ANNEX_TOKEN_DOMAIN", Default: "$(TRUST_DOMAIN)", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD", Default: "$(SBIN)/condor_schedd", Win32Default: "$(SBIN)\\condor_schedd.exe", Type: "path"},
-	{Name: "STARTD", Default: "$(SBIN)/condor_startd", Win32Default: "$(SBIN)\\condor_startd.exe", Type: "path"},
-	{Name: "CCB_POLLING_TIMESLICE", Default: "0.05", Win32Default: "", Type: "double"},
-	{Name: "CCB_POLLING_INTERVAL", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "CCB_POLLING_MAX_INTERVAL", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "CCB_SERVER_READ_BUFFER", Default: "2048", Win32Default: "", Type: "int"},
-	{Name: "CCB_SERVER_WRITE_BUFFER", Default: "2048", Win32Default: "", Type: "int"},
-	{Name: "CCB_SWEEP_INTERVAL", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "TRUNC_MASTER_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_NEGOTIATOR_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_COLLECTOR_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_SCHED_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_STARTER_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_SHADOW_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRUNC_MATCH_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW_LOCK", Default: "$(LOCK)/ShadowLock", Win32Default: "", Type: "path"},
-	{Name: "DEBUG_TIME_FORMAT", Default: "%m/%d/%y %H:%M:%S", Win32Default: "", Type: "string"},
-	{Name: "MASTER_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SCHED_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_READ_CONFIG_BEFORE_CYCLE", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_JOB_QUEUE_LOG_FLUSH_DELAY", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "DAEMON_SOCKET_DIR", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "SHARED_PORT_DAEMON_AD_FILE", Default: "$(LOCK)/shared_port_ad", Win32Default: "", Type: "string"},
-	{Name: "ALWAYS_REUSEADDR", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "USE_SHARED_PORT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "HAD_USE_SHARED_PORT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "REPLICATION_USE_SHARED_PORT", Default: "$(HAD_USE_SHARED_PORT)", Win32Default: "", Type: "bool"},
-	{Name: "DAGMAN_USE_SHARED_PORT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SHARED_PORT_PORT", Default: "$(COLLECTOR_PORT)", Win32Default: "", Type: "int"},
-	{Name: "AUTO_INCLUDE_SHARED_PORT_IN_DAEMON_LIST", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "AUTO_INCLUDE_CREDD_IN_DAEMON_LIST", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR_USES_SHARED_PORT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CCB_HEARTBEAT_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "ROOSTER_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "ROOSTER_UNHIBERNATE", Default: "Offline && Unhibernate", Win32Default: "", Type: "string"},
-	{Name: "ROOSTER_WAKEUP_CMD", Default: "\"$(BIN)/condor_power -d -i\"", Win32Default: "", Type: "string"},
-	{Name: "ROOSTER_MAX_UNHIBERNATE", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "MAX_PROCD_LOG", Default: "1000000", Win32Default: "", Type: "string"},
-	{Name: "SHADOW_WORKLIFE", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "CLAIM_WORKLIFE", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATION_CYCLE_STATS_LENGTH", Default: "3", Win32Default: "", Type: "int"},
-	{Name: "SYSTEM_JOB_MACHINE_ATTRS", Default: "Cpus,SlotWeight", Win32Default: "", Type: "string"},
-	{Name: "SYSTEM_JOB_MACHINE_ATTRS_HISTORY_LENGTH", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "CREATE_LOCKS_ON_LOCAL_DISK", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "LOCK_DEBUG_LOG_TO_APPEND", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LOG_TO_SYSLOG", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LOCAL_CONFIG_DIR_EXCLUDE_REGEXP", Default: "^((\\..*)|(.*\\.pl)|(.*\\.py)|(.*\\.sh)|(.*~)|(#.*)|(.*\\.rpmsave)|(.*\\.rpmnew)|(.*\\.dpkg-old)|(.*\\.dpkg-dist)|(.*\\.cfsaved))$", Win32Default: "", Type: "string"},
-	{Name: "DELEGATE_JOB_GSI_CREDENTIALS_REFRESH", Default: "0.25", Win32Default: "", Type: "double"},
-	{Name: "DELEGATE_JOB_GSI_CREDENTIALS_LIFETIME", Default: "86400", Win32Default: "", Type: "int"},
-	{Name: "DELEGATE_FULL_JOB_GSI_CREDENTIALS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STATISTICS_WINDOW_QUANTUM", Default: "240", Win32Default: "", Type: "int"},
-	{Name: "STATISTICS_WINDOW_SECONDS", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "DCSTATISTICS_TIMESPANS", Default: "4m:240 20m:1200 4h:14400", Win32Default: "", Type: "string"},
-	{Name: "TCP_KEEPALIVE_INTERVAL", Default: "360", Win32Default: "", Type: "int"},
-	{Name: "SHADOW_CHECKPROXY_INTERVAL", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "SHADOW_RUN_UNKNOWN_USER_JOBS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD_ROUND_ATTR_ProportionalSetSizeKb", Default: "25%", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ROUND_ATTR_ResidentSetSize", Default: "25%", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ROUND_ATTR_ExecutableSize", Default: "25%", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ROUND_ATTR_ImageSize", Default: "25%", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ROUND_ATTR_DiskUsage", Default: "25%", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_ROUND_ATTR_NumCkpts", Default: "4", Win32Default: "", Type: "string"},
-	{Name: "WANT_SUSPEND", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "WANT_VACATE", Default: "True", Win32Default: "", Type: "string"},
-	{Name: "SUSPEND", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "CONTINUE", Default: "True", Win32Default: "", Type: "string"},
-	{Name: "PREEMPT", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "KILL", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "MachineMaxVacateTime", Default: "$(MaxVacateTime)", Win32Default: "", Type: "int"},
-	{Name: "PERIODIC_CHECKPOINT", Default: "((time() - LastPeriodicCheckpoint)/60.0) > (180.0 + $RANDOM_INTEGER(-30,30,1))", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_DRAINING_START_EXPR", Default: "FALSE", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_CANCEL_REQUIREMENTS", Default: "$(DEFRAG_WHOLE_MACHINE_EXPR)", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_WHOLE_MACHINE_EXPR", Default: "Cpus == TotalSlotCpus", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_LOG", Default: "$(LOG)/DefragLog", Win32Default: "", Type: "path"},
-	{Name: "DEFRAG_INTERVAL", Default: "600", Win32Default: "", Type: "int"},
-	{Name: "DEFRAG_DRAINING_MACHINES_PER_HOUR", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "DEFRAG_MAX_CONCURRENT_DRAINING", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "DEFRAG_MAX_WHOLE_MACHINES", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "DEFRAG_RANK", Default: "-ExpectedMachineGracefulDrainingBadput", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_DRAINING_SCHEDULE", Default: "graceful", Win32Default: "", Type: "string"},
-	{Name: "DEFRAG_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int"},
-	{Name: "GROUP_SORT_EXPR", Default: "ifThenElse(AccountingGroup=?=\"<none>\",3.4e+38,ifThenElse(GroupQuota>0,GroupResourcesInUse/GroupQuota,3.3e+38))", Win32Default: "", Type: "string"},
-	{Name: "CLAIM_PARTITIONABLE_LEFTOVERS", Default: "$(NEGOTIATOR_USE_SLOT_WEIGHTS)", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_CLAIMABLE_PARTITIONABLE_SLOTS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MAX_PARTITIONABLE_SLOT_CLAIM_TIME", Default: "3600", Win32Default: "", Type: "int"},
-	{Name: "MOUNT_UNDER_SCRATCH", Default: "\"/tmp,/var/tmp\"", Win32Default: "", Type: "string"},
-	{Name: "MEMORY_USAGE_METRIC", Default: "((ResidentSetSize+1023)/1024)", Win32Default: "", Type: "string"},
-	{Name: "MEMORY_USAGE_METRIC_VM", Default: "((ImageSize+1023)/1024)", Win32Default: "", Type: "string"},
-	{Name: "DEFAULT_JOB_MAX_RETRIES", Default: "2", Win32Default: "", Type: "int"},
-	{Name: "JOB_DEFAULT_REQUESTMEMORY", Default: "128", Win32Default: "", Type: "string"},
-	{Name: "JOB_DEFAULT_REQUESTDISK", Default: "MAX({1024, (TransferInputSizeMB+1) * 1.25}) * 1024", Win32Default: "", Type: "string"},
-	{Name: "JOB_DEFAULT_REQUESTCPUS", Default: "1", Win32Default: "", Type: "string"},
-	{Name: "JOB_DEFAULT_LEASE_DURATION", Default: "2400", Win32Default: "", Type: "string"},
-	{Name: "MUST_MODIFY_REQUEST_EXPRS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MODIFY_REQUEST_EXPR_REQUESTCPUS", Default: "quantize(RequestCpus,{1})", Win32Default: "", Type: "string"},
-	{Name: "MODIFY_REQUEST_EXPR_REQUESTMEMORY", Default: "quantize(RequestMemory,{128})", Win32Default: "", Type: "string"},
-	{Name: "MODIFY_REQUEST_EXPR_REQUESTDISK", Default: "quantize(RequestDisk,{1024})", Win32Default: "", Type: "string"},
-	{Name: "CONSUMPTION_POLICY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "CONSUMPTION_CPUS", Default: "quantize(target.RequestCpus,{1})", Win32Default: "", Type: "string"},
-	{Name: "CONSUMPTION_MEMORY", Default: "quantize(target.RequestMemory,{128})", Win32Default: "", Type: "string"},
-	{Name: "CONSUMPTION_DISK", Default: "quantize(target.RequestDisk,{1024})", Win32Default: "", Type: "string"},
-	{Name: "SLOT_WEIGHT", Default: "Cpus", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_DEPRECATION_WARNINGS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CLASSAD_LOG_STRICT_PARSING", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CLASSAD_ENABLE_USER_HOME", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "STARTER_RLIMIT_AS", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "INTERACTIVE_SUBMIT_FILE", Default: "$(LIBEXEC)/interactive.sub", Win32Default: "", Type: "string"},
-	{Name: "INTERACTIVE_SUBMIT_CMD_OVERRIDE", Default: "/bin/sh", Win32Default: "", Type: "string"},
-	{Name: "INTERACTIVE_SUBMIT_ARGS_OVERRIDE", Default: "\"-c 'sleep 180 && while test -d ${_CONDOR_SCRATCH_DIR}/.condor_ssh_to_job_1; do /bin/sleep 3; done'\"", Win32Default: "", Type: "string"},
-	{Name: "MAX_GLOBAL_JOB_PRIOS", Default: "500", Win32Default: "", Type: "int"},
-	{Name: "USE_GLOBAL_JOB_PRIOS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TRANSFER_QUEUE_USER_EXPR", Default: "strcat(\"Owner_\",Owner)", Win32Default: "", Type: "string"},
-	{Name: "ALLOW_TRANSFER_REMAP_TO_MKDIR", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "MAX_TRANSFER_INPUT_MB", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "MAX_TRANSFER_OUTPUT_MB", Default: "-1", Win32Default: "", Type: "int"},
-	{Name: "MAX_REMAP_RECURSIONS", Default: "128", Win32Default: "", Type: "int"},
-	{Name: "MAX_FILE_TRANSFER_PLUGIN_LIFETIME", Default: "72000", Win32Default: "", Type: "int"},
-	{Name: "TRANSFER_IO_REPORT_INTERVAL", Default: "10", Win32Default: "", Type: "int"},
-	{Name: "TRANSFER_IO_REPORT_TIMESPANS", Default: "1m:60 5m:300 1h:3600 1d:86400", Win32Default: "", Type: "string"},
-	{Name: "GRACEFULLY_REMOVE_JOBS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "BASE_CGROUP", Default: "htcondor", Win32Default: "", Type: "string"},
-	{Name: "CGROUP_POLLING_INTERVAL", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "CGROUP_MEMORY_LIMIT_POLICY", Default: "hard", Win32Default: "", Type: "string"},
-	{Name: "CGROUP_IGNORE_CACHE_MEMORY", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DISABLE_SWAP_FOR_JOB", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "BATCH_GAHP_CHECK_STATUS_ATTEMPTS", Default: "5", Win32Default: "", Type: "int"},
-	{Name: "USE_PID_NAMESPACES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "PER_JOB_NAMESPACES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CHIRP_DELAYED_UPDATE_PREFIX", Default: "Chirp*", Win32Default: "", Type: "string"},
-	{Name: "CHIRP_DELAYED_UPDATE_MAX_ATTRS", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "ENABLE_CHIRP_IO", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_CHIRP_UPDATES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_CHIRP_DELAYED", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GANGLIA_LIB_PATH", Default: "/lib,/usr/lib,/usr/local/lib", Win32Default: "", Type: "string"},
-	{Name: "GANGLIA_LIB64_PATH", Default: "/lib64,/usr/lib64,/usr/local/lib64", Win32Default: "", Type: "string"},
-	{Name: "GANGLIA_CONFIG", Default: "/etc/ganglia/gmond.conf", Win32Default: "", Type: "string"},
-	{Name: "GANGLIA_GSTAT_COMMAND", Default: "gstat --all --mpifile --gmond_ip=localhost --gmond_port=8649", Win32Default: "", Type: "string"},
-	{Name: "GANGLIAD_WANT_PROJECTION", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GANGLIAD_RESET_METRICS_FILE", Default: "$(SPOOL)/metricsToReset.ganglia_metrics", Win32Default: "", Type: "path"},
-	{Name: "GANGLIAD_WANT_RESET_METRICS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "GANGLIAD_METRICS_CONFIG_DIR", Default: "$(ETC)/ganglia.d", Win32Default: "", Type: "path"},
-	{Name: "GANGLIAD_VERBOSITY", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "GANGLIAD_INTERVAL", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "GANGLIAD_LOG", Default: "$(LOG)/GangliadLog", Win32Default: "", Type: "path"},
-	{Name: "MAX_GANGLIAD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "GANGLIAD_PER_EXECUTE_NODE_METRICS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "GANGLIA_SEND_DATA_FOR_ALL_HOSTS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MAX_CONCURRENT_DOWNLOADS", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "MAX_CONCURRENT_UPLOADS", Default: "100", Win32Default: "", Type: "int"},
-	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE", Default: "2.0", Win32Default: "", Type: "string"},
-	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_SHORT_HORIZON", Default: "1m", Win32Default: "", Type: "string"},
-	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_LONG_HORIZON", Default: "5m", Win32Default: "", Type: "string"},
-	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_WAIT_BETWEEN_INCREMENTS", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "RUN_FILETRANSFER_PLUGINS_WITH_ROOT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "LOG_FILETRANSFER_PLUGIN_STDOUT_ON_FAILURE", Default: "D_ALWAYS", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_MULTIFILE_TRANSFER_PLUGINS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SIGN_S3_URLS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_HTTP_PUBLIC_FILES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "HTTP_PUBLIC_FILES_ADDRESS", Default: "127.0.0.1:80", Win32Default: "", Type: "string"},
-	{Name: "HTTP_PUBLIC_FILES_ROOT_DIR", Default: "/usr/share/nginx/html", Win32Default: "", Type: "string"},
-	{Name: "HTTP_PUBLIC_FILES_STALE_AGE", Default: "604800", Win32Default: "", Type: "int"},
-	{Name: "COLLECTOR_MAX_FILE_DESCRIPTORS", Default: "10240", Win32Default: "", Type: "int"},
-	{Name: "SCHEDD_MAX_FILE_DESCRIPTORS", Default: "20000", Win32Default: "", Type: "int"},
-	{Name: "SCHEDD_USE_SLOT_WEIGHT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "SHARED_PORT_MAX_FILE_DESCRIPTORS", Default: "20000", Win32Default: "", Type: "int"},
-	{Name: "USE_RESOURCE_REQUEST_COUNTS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_RESOURCE_REQUEST_LIST_SIZE", Default: "200", Win32Default: "", Type: "int"},
-	{Name: "NEGOTIATOR_PREFETCH_REQUESTS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR_PREFETCH_REQUESTS_MAX_TIME", Default: "60", Win32Default: "", Type: "int"},
-	{Name: "HISTORY_HELPER", Default: "$(BIN)/condor_history", Win32Default: "$(BIN)\\condor_history.exe", Type: "path"},
-	{Name: "HISTORY_HELPER_MAX_HISTORY", Default: "2000000000", Win32Default: "", Type: "int"},
-	{Name: "HISTORY_HELPER_MAX_CONCURRENCY", Default: "50", Win32Default: "", Type: "int"},
-	{Name: "CONDOR_Q_USE_V3_PROTOCOL", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CONDOR_Q_ONLY_MY_JOBS", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CONDOR_Q_DASH_BATCH_IS_DEFAULT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CONDOR_Q_SHOW_OLD_SUMMARY", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "ENABLE_IPV6", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "ENABLE_IPV4", Default: "auto", Win32Default: "", Type: "string"},
-	{Name: "PREFER_IPV4", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "ADVERTISE_IPV4_FIRST", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string"},
-	{Name: "IGNORE_TARGET_PROTOCOL_PREFERENCE", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string"},
-	{Name: "IGNORE_DNS_PROTOCOL_PREFERENCE", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string"},
-	{Name: "PREFER_OUTBOUND_IPV4", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string"},
-	{Name: "JOB_EXECDIR_PERMISSIONS", Default: "user", Win32Default: "", Type: "string"},
-	{Name: "JOB_SPOOL_PERMISSIONS", Default: "user", Win32Default: "", Type: "string"},
-	{Name: "USE_KEYRING_SESSIONS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "KEYRING_SESSION_CREATION_TIMEOUT", Default: "20", Win32Default: "", Type: "int"},
-	{Name: "DISCARD_SESSION_KEYRING_ON_STARTUP", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CONTAINER_SHARED_FS", Default: "/cvmfs", Win32Default: "", Type: "string"},
-	{Name: "DOCKER", Default: "/usr/bin/docker", Win32Default: "docker", Type: "string"},
-	{Name: "DOCKER_DROP_ALL_CAPABILITIES", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DOCKER_PAT_PRODUCER", Default: "$(SBIN)/condor_docker_pat_producer", Win32Default: "", Type: "string"},
-	{Name: "DOCKER_PERFORM_TEST", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DOCKER_RUN_UNDER_INIT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "DOCKER_TEST_IMAGE_PATH", Default: "$(LIBEXEC)/htcondor_docker_test", Win32Default: "", Type: "string"},
-	{Name: "DOCKER_TEST_IMAGE_NAME", Default: "htcondor_docker_test", Win32Default: "", Type: "string"},
-	{Name: "DOCKER_CACHE_ADVERTISE_INTERVAL", Default: "1200", Win32Default: "0", Type: "int"},
-	{Name: "DOCKER_IMAGE_CACHE_SIZE", Default: "8", Win32Default: "", Type: "int"},
-	{Name: "DOCKER_TRUST_LOCAL_IMAGES", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "OPENMPI_INSTALL_PATH", Default: "/usr/lib64/openmpi", Win32Default: "", Type: "string"},
-	{Name: "OPENMPI_EXCLUDE_NETWORK_INTERFACES", Default: "docker0,virbr0", Win32Default: "", Type: "string"},
-	{Name: "IS_OWNER", Default: "False", Win32Default: "", Type: "string"},
-	{Name: "FILETRANSFER_PLUGINS", Default: "$(LIBEXEC)/curl_plugin, $(LIBEXEC)/data_plugin, $(LIBEXEC)/box_plugin.py, $(LIBEXEC)/gdrive_plugin.py, $(LIBEXEC)/onedrive_plugin.py", Win32Default: "$(LIBEXEC)\\curl_plugin.exe, $(LIBEXEC)\\data_plugin.exe, $(LIBEXEC)\\box_plugin.py, $(LIBEXEC)/gdrive_plugin.py, $(LIBEXEC)/onedrive_plugin.py", Type: "string"},
-	{Name: "C_GAHP_LOG", Default: "/tmp/CGAHPLog.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "C_GAHP_DEBUG", Default: "D_STATS", Win32Default: "", Type: "string"},
-	{Name: "C_GAHP_LOCK", Default: "/tmp/CGAHPLock.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "C_GAHP_WORKER_THREAD_LOG", Default: "/tmp/CGAHPWorkerLog.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "C_GAHP_WORKER_THREAD_LOCK", Default: "/tmp/CGAHPWorkerLock.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "KBDD", Default: "$(SBIN)/condor_kbdd", Win32Default: "$(SBIN)\\condor_kbdd.exe", Type: "path"},
-	{Name: "KBDD_ADDRESS_FILE", Default: "$(LOG)/.kbdd_address", Win32Default: "", Type: "path"},
-	{Name: "NEGOTIATOR", Default: "$(SBIN)/condor_negotiator", Win32Default: "$(SBIN)\\condor_negotiator.exe", Type: "path"},
-	{Name: "COLLECTOR", Default: "$(SBIN)/condor_collector", Win32Default: "$(SBIN)\\condor_collector.exe", Type: "path"},
-	{Name: "JOB_ROUTER", Default: "$(LIBEXEC)/condor_job_router", Win32Default: "$(LIBEXEC)\\condor_job_router.exe", Type: "path"},
-	{Name: "ROOSTER", Default: "$(LIBEXEC)/condor_rooster", Win32Default: "$(LIBEXEC)\\condor_rooster.exe", Type: "path"},
-	{Name: "SHARED_PORT", Default: "$(LIBEXEC)/condor_shared_port", Win32Default: "$(LIBEXEC)\\condor_shared_port.exe", Type: "path"},
-	{Name: "HAD", Default: "$(SBIN)/condor_had", Win32Default: "$(SBIN)\\condor_had.exe", Type: "path"},
-	{Name: "HAD_SOCKET_NAME", Default: "had", Win32Default: "", Type: "string"},
-	{Name: "HAD_ARGS", Default: "-sock $(HAD_SOCKET_NAME)", Win32Default: "", Type: "string"},
-	{Name: "REPLICATION", Default: "$(SBIN)/condor_replication", Win32Default: "$(SBIN)\\condor_replication.exe", Type: "path"},
-	{Name: "REPLICATION_SOCKET_NAME", Default: "replication", Win32Default: "", Type: "string"},
-	{Name: "REPLICATION_ARGS", Default: "-sock $(REPLICATION_SOCKET_NAME)", Win32Default: "", Type: "string"},
-	{Name: "TRANSFERER", Default: "$(LIBEXEC)/condor_transferer", Win32Default: "$(LIBEXEC)\\condor_transferer.exe", Type: "path"},
-	{Name: "DEFRAG", Default: "$(LIBEXEC)/condor_defrag", Win32Default: "$(LIBEXEC)\\condor_defrag.exe", Type: "path"},
-	{Name: "GANGLIAD", Default: "$(LIBEXEC)/condor_gangliad", Win32Default: "$(LIBEXEC)\\condor_gangliad.exe", Type: "path"},
-	{Name: "STARTER", Default: "$(SBIN)/condor_starter", Win32Default: "$(SBIN)\\condor_starter.exe", Type: "path"},
-	{Name: "MASTER_ADDRESS_FILE", Default: "$(LOG)/.master_address", Win32Default: "", Type: "path"},
-	{Name: "COLLECTOR_ADDRESS_FILE", Default: "$(LOG)/.collector_address", Win32Default: "", Type: "path"},
-	{Name: "COLLECTOR_SUPER_ADDRESS_FILE", Default: "$(LOG)/.collector_address.super", Win32Default: "", Type: "path"},
-	{Name: "STARTD_ADDRESS_FILE", Default: "$(LOG)/.startd_address", Win32Default: "", Type: "path"},
-	{Name: "SCHEDD_ADDRESS_FILE", Default: "$(SPOOL)/.schedd_address", Win32Default: "", Type: "path"},
-	{Name: "SCHEDD_SUPER_ADDRESS_FILE", Default: "$(SPOOL)/.schedd_address.super", Win32Default: "", Type: "path"},
-	{Name: "SCHEDD_DAEMON_AD_FILE", Default: "$(SPOOL)/.schedd_classad", Win32Default: "", Type: "string"},
-	{Name: "MAX_DEFAULT_LOG", Default: "10 Mb", Win32Default: "", Type: "string"},
-	{Name: "MAX_COLLECTOR_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_KBDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "NEGOTIATOR_DEBUG", Default: "D_MATCH", Win32Default: "", Type: "string"},
-	{Name: "MAX_NEGOTIATOR_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_NEGOTIATOR_MATCH_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "SCHEDD_DEBUG", Default: "D_PID", Win32Default: "", Type: "string"},
-	{Name: "MAX_SCHEDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_SHADOW_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_STARTD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_STARTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_MASTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_JOB_ROUTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_ROOSTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_SHARED_PORT_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_HAD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_REPLICATION_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_TRANSFERER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "MAX_GRIDMANAGER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "GRIDMANAGER_LOG", Default: "$(LOG)/GridmanagerLog", Win32Default: "", Type: "path"},
-	{Name: "GRIDMANAGER_LOCK", Default: "$(LOCK)/GridmanagerLock", Win32Default: "", Type: "string"},
-	{Name: "MAX_C_GAHP_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "EC2_GAHP_LOG", Default: "/tmp/EC2GahpLog.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "EC2_GAHP_DEBUG", Default: "D_PID", Win32Default: "", Type: "string"},
-	{Name: "EC2_GAHP", Default: "$(SBIN)/ec2_gahp", Win32Default: "", Type: "string"},
-	{Name: "GCE_GAHP", Default: "$(SBIN)/gce_gahp", Win32Default: "", Type: "string"},
-	{Name: "GCE_GAHP_LOG", Default: "/tmp/GceGahpLog.$(USERNAME)", Win32Default: "NUL", Type: "string"},
-	{Name: "GCE_GAHP_LIFETIME", Default: "86400", Win32Default: "", Type: "int"},
-	{Name: "ANNEX_GAHP", Default: "$(EC2_GAHP)", Win32Default: "", Type: "path"},
-	{Name: "AZURE_GAHP", Default: "$(SBIN)/AzureGAHPServer", Win32Default: "$(SBIN)\\AzureGAHPServer.py", Type: "path"},
-	{Name: "ARC_GAHP", Default: "$(SBIN)/arc_gahp", Win32Default: "", Type: "string"},
-	{Name: "ARC_GAHP_LOG", Default: "/tmp/ArcGahpLog.$(USERNAME)", Win32Default: "", Type: "string"},
-	{Name: "ARC_GAHP_COMMAND_LIMIT", Default: "1000", Win32Default: "", Type: "int"},
-	{Name: "ARC_GAHP_USE_THREADS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "CREDD", Default: "$(SBIN)/condor_credd", Win32Default: "$(SBIN)\\condor_credd.exe", Type: "path"},
-	{Name: "CREDD_ADDRESS_FILE", Default: "$(LOG)/.credd_address", Win32Default: "", Type: "path"},
-	{Name: "CREDD_LOG", Default: "$(LOG)/CredLog", Win32Default: "", Type: "path"},
-	{Name: "CREDD_DEBUG", Default: "D_FULLDEBUG", Win32Default: "", Type: "string"},
-	{Name: "MAX_CREDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string"},
-	{Name: "CREDD_OAUTH_MODE", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CREDMON_KRB", Default: "$(SBIN)/condor_credmon_krb", Win32Default: "", Type: "path"},
-	{Name: "CREDMON_OAUTH", Default: "$(SBIN)/condor_credmon_oauth", Win32Default: "", Type: "path"},
-	{Name: "LOCAL_CREDMON_TOKEN_USE_JSON", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "LOCAL_CREDMON_TOKEN_VERSION", Default: "scitoken:2.0", Win32Default: "", Type: "string"},
-	{Name: "BOX_AUTHORIZATION_URL", Default: "https://account.box.com/api/oauth2/authorize", Win32Default: "", Type: "string"},
-	{Name: "BOX_TOKEN_URL", Default: "https://api.box.com/oauth2/token", Win32Default: "", Type: "string"},
-	{Name: "GDRIVE_AUTHORIZATION_URL", Default: "https://accounts.google.com/o/oauth2/v2/auth", Win32Default: "", Type: "string"},
-	{Name: "GDRIVE_TOKEN_URL", Default: "https://oauth2.googleapis.com/token", Win32Default: "", Type: "string"},
-	{Name: "ONEDRIVE_AUTHORIZATION_URL", Default: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize", Win32Default: "", Type: "string"},
-	{Name: "ONEDRIVE_TOKEN_URL", Default: "https://login.microsoftonline.com/common/oauth2/v2.0/token", Win32Default: "", Type: "string"},
-	{Name: "DROPBOX_AUTHORIZATION_URL", Default: "https://www.dropbox.com/oauth2/authorize", Win32Default: "", Type: "string"},
-	{Name: "DROPBOX_TOKEN_URL", Default: "https://api.dropboxapi.com/oauth2/token", Win32Default: "", Type: "string"},
-	{Name: "BenchmarkTimer", Default: "(time() - LastBenchmark)", Win32Default: "", Type: "string"},
-	{Name: "BENCHMARKS_JOBLIST", Default: "mips kflops", Win32Default: "", Type: "string"},
-	{Name: "BENCHMARKS_MAX_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string"},
-	{Name: "BENCHMARKS_MIPS_EXECUTABLE", Default: "$(LIBEXEC)/condor_mips", Win32Default: "$(LIBEXEC)\\condor_mips.exe", Type: "path"},
-	{Name: "BENCHMARKS_MIPS_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string"},
-	{Name: "BENCHMARKS_KFLOPS_EXECUTABLE", Default: "$(LIBEXEC)/condor_kflops", Win32Default: "$(LIBEXEC)\\condor_kflops.exe", Type: "path"},
-	{Name: "BENCHMARKS_KFLOPS_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string"},
-	{Name: "FILE_TRANSFER_STATS_LOG", Default: "$(LOG)/transfer_history", Win32Default: "", Type: "path"},
-	{Name: "IsWindows", Default: "false", Win32Default: "true", Type: "bool"},
-	{Name: "IsMaster", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "MASTER.IsMaster", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsCollector", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "COLLECTOR.IsCollector", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsNegotiator", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "NEGOTIATOR.IsNegotiator", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsSchedd", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SCHEDD.IsSchedd", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsStartd", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTD.IsStartd", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsStarter", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "STARTER.IsStarter", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsShadow", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SHADOW.IsShadow", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsTool", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "TOOL.IsTool", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "IsSubmit", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "SUBMIT.IsSubmit", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CONDOR_VERSION", Default: "8.*", Win32Default: "", Type: "string"},
-	{Name: "CONDOR_BUILD_PLATFORM", Default: "undefined", Win32Default: "", Type: "string"},
-	{Name: "PYTHON3_MODULE_SUFFIX", Default: ".pyd", Win32Default: "", Type: "string"},
-	{Name: "PYTHON3_VERSION_MINOR", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "HAVE_EXT_KRB5", Default: "0", Win32Default: "", Type: "int"},
-	{Name: "SEC_DEFAULT_AUTHENTICATION_METHODS", Default: "FS,TOKEN", Win32Default: "", Type: "string"},
-	{Name: "MINUTE", Default: "60", Win32Default: "", Type: "string"},
-	{Name: "HOUR", Default: "3600", Win32Default: "", Type: "string"},
-	{Name: "STANDARD", Default: "1", Win32Default: "", Type: "string"},
-	{Name: "IsStandard", Default: "(TARGET.JobUniverse == $(STANDARD))", Win32Default: "", Type: "string"},
-	{Name: "VANILLA", Default: "5", Win32Default: "", Type: "string"},
-	{Name: "IsVanilla", Default: "(TARGET.JobUniverse == $(VANILLA))", Win32Default: "", Type: "string"},
-	{Name: "MPI", Default: "8", Win32Default: "", Type: "string"},
-	{Name: "IsMPI", Default: "(TARGET.JobUniverse == $(MPI))", Win32Default: "", Type: "string"},
-	{Name: "VM", Default: "13", Win32Default: "", Type: "string"},
-	{Name: "IsVM", Default: "(TARGET.JobUniverse == $(VM))", Win32Default: "", Type: "string"},
-	{Name: "BigJob", Default: "(TARGET.ImageSize >= (50 * 1024))", Win32Default: "", Type: "string"},
-	{Name: "MediumJob", Default: "(TARGET.ImageSize >= (15 * 1024) && TARGET.ImageSize < (50 * 1024))", Win32Default: "", Type: "string"},
-	{Name: "SmallJob", Default: "(TARGET.ImageSize <  (15 * 1024))", Win32Default: "", Type: "string"},
-	{Name: "BackgroundLoad", Default: "0.3", Win32Default: "", Type: "string"},
-	{Name: "HighLoad", Default: "0.5", Win32Default: "", Type: "string"},
-	{Name: "StartIdleTime", Default: "15 * $(MINUTE)", Win32Default: "", Type: "string"},
-	{Name: "ContinueIdleTime", Default: "5 * $(MINUTE)", Win32Default: "", Type: "string"},
-	{Name: "MaxSuspendTime", Default: "10 * $(MINUTE)", Win32Default: "", Type: "string"},
-	{Name: "MaxVacateTime", Default: "10 * $(MINUTE)", Win32Default: "", Type: "string"},
-	{Name: "StateTimer", Default: "(time() - EnteredCurrentState)", Win32Default: "", Type: "string"},
-	{Name: "ActivityTimer", Default: "(time() - EnteredCurrentActivity)", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_SAMPLE_INTERVAL", Default: "1200", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_CHECKPOINT_FILE", Default: "$(LOG)/adstash_checkpoint.json", Win32Default: "", Type: "path"},
-	{Name: "ADSTASH_LOG", Default: "$(LOG)/adstash.log", Win32Default: "", Type: "path"},
-	{Name: "ADSTASH_DEBUG", Default: "D_ALWAYS", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_NUM_THREADS", Default: "1", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_READ_POOLS", Default: "$(CONDOR_HOST)", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_READ_SCHEDDS", Default: "*", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_READ_STARTDS", Default: "*", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_SCHEDD_HISTORY", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_STARTD_HISTORY", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_SCHEDD_JOB_EPOCH_HISTORY", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_SCHEDD_TRANSFER_EPOCH_HISTORY", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_SCHEDD_HISTORY_MAX_ADS", Default: "10000", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_STARTD_HISTORY_MAX_ADS", Default: "10000", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_SCHEDD_HISTORY_TIMEOUT", Default: "240", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_STARTD_HISTORY_TIMEOUT", Default: "240", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_INTERFACE", Default: "elasticsearch", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_SE_HOST", Default: "$(FULL_HOSTNAME):9200", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_SE_USE_HTTPS", Default: "False", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_SE_BUNCH_SIZE", Default: "250", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_SE_INDEX_NAME", Default: "htcondor-000001", Win32Default: "", Type: "string"},
-	{Name: "ADSTASH_SE_LOG_MAPPINGS", Default: "True", Win32Default: "", Type: "bool"},
-	{Name: "ADSTASH_SE_TIMEOUT", Default: "240", Win32Default: "", Type: "int"},
-	{Name: "ADSTASH_JSON_DIR", Default: "$(LOG)", Win32Default: "", Type: "path"},
-	{Name: "TOOLS_ASSUME_FIREWALLS", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "DEFAULT_KILL_CHILDREN_ON_EXIT", Default: "true", Win32Default: "", Type: "bool"},
-	{Name: "CHECKPOINT_DESTINATION_MAPFILE", Default: "$(LOCAL_DIR)/checkpoint-destination-mapfile", Win32Default: "", Type: "path"},
-	{Name: "CONTAINER_IMAGES_COMMON_BY_DEFAULT", Default: "false", Win32Default: "", Type: "bool"},
-	{Name: "$FEATURE.POLICY_EXPR_FRAGMENTS", Default: "\tPolicyExprFragments=1\n\tKeyboardBusy=(KeyboardIdle < $(MINUTE))\n\tConsoleBusy=(ConsoleIdle  < $(MINUTE))\n\tKeyboardNotBusy=($(KeyboardBusy) == False)\n\tJustCPU=($(CPUBusy) && ($(KeyboardBusy) == False))\n\tMachineBusy=($(CPUBusy) || $(KeyboardBusy))\n\tNonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n\tCpuIdle=($(NonCondorLoadAvg) <= $(BackgroundLoad))\n\tCpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n\tActivationTimer=ifThenElse(JobStart =!= UNDEFINED, (time() - JobStart), 0)\n\tLastCkpt=(time() - LastPeriodicCheckpoint)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.DESKTOP", Default: "\tif ! defined PolicyExprFragments\n\t\tuse FEATURE : POLICY_EXPR_FRAGMENTS\n\tendif\n\tSTARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n\tCpuBusyTimer=IfThenElse(CpuBusyValue is 1, time() - CpuBusyTime, 0)\n\tWANT_SUSPEND=($(SmallJob) || $(KeyboardNotBusy) || $(IsVanilla) ) && ( $(SUSPEND))\n\tWANT_VACATE=$(ActivationTimer) > 600 || $(IsVanilla)\n\tSUSPEND=($(KeyboardBusy) || ( ($(CpuBusyTimer) > 120) && $(ActivationTimer) > 90))\n\tCONTINUE=($(CPUIdle) && ($(ActivityTimer) > 10) && (KeyboardIdle > $(ContinueIdleTime)))\n\tPREEMPT=(((Activity == \"Suspended\") && ($(ActivityTimer) > $(MaxSuspendTime))) || (SUSPEND && (WANT_SUSPEND == False)))\n\tSTART=((KeyboardIdle > $(StartIdleTime)) && ( $(CPUIdle) || (State != \"Unclaimed\" && State != \"Owner\")) )\n\tKILL=False\n\tMaxJobRetirementTime=0\n\tCLAIM_WORKLIFE=\n\tSLOTS_CONNECTED_TO_KEYBOARD=1000000\n\tSLOTS_CONNECTED_TO_CONSOLE=1000000\n\tIS_OWNER=(START =?= False)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.POLICY_HOURS_TO_START", Default: "    # Desktops are never idle during the Workday, use 24 hour day, fractional hours\n    WorkdayBeginHour=$(1:8)\n    WorkdayEndHour=$(2:12+5)\n    # don't start jobs with 30 minutes of the start of day\n    WorkdayBeginMinuteStartJobs=($(WorkdayBeginHour))*60 - 29.5\n    # begin evicting jobs 10 minutes before the start of the day\n    WorkdayBeginMinuteStopJobs=($(WorkdayBeginHour))*60 - 9.5\n    WorkdayEndMinute=($(WorkdayEndHour))*60 + 0.5\n    RunJobsHours=ClockMin < $INT(WorkdayBeginMinuteStartJobs) || ClockMin > $INT(WorkdayEndMinute)\n    NoJobsHours=ClockMin > $INT(WorkdayBeginMinuteStopJobs) && ClockMin < $INT(WorkdayEndMinute)\n    if $(3?)\n       WorkweekFirstDay=$(3:1)\n       WorkweekLastDay=$(4:5)\n       IsWeekend=ClockDay < $INT(WorkweekFirstDay) || ClockDay > $INT(WorkWeekLastDay)\n       IsWorkday=ClockDay >= $INT(WorkweekFirstDay) && ClockDay <= $INT(WorkWeekLastDay)\n       RunJobsHours=$(IsWeekend) || $(RunJobsHours)\n       NoJobsHours=$(IsWorkday) && $(NoJobsHours)\n    endif\n    RunJobsHours=($(RunJobsHours))\n    NoJobsHours=($(NoJobsHours))", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.DESKTOP_IDLE", Default: "    if $(1?)\n        use FEATURE : POLICY_HOURS_TO_START($(1+))\n    endif\n    NonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n    CpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n    CpuIdle=($(NonCondorLoadAvg) <= $(BackgroundLoad))\n    STARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n    # the CpuBusy LATCH_EXPR produces CpuBusyValue=0 or 1, CpuBusyTime is the time that CpuBusyValue changed\n    CpuBusyTimer=(CpuBusyValue * (time() - CpuBusyTime))\n    # preempt on Keyboard activity, CpuBusy and optionally hours of day and/or day of week\n    PreemptKeyboardIdleTime=60\n    PreemptCpuBusyTime=120\n    PREEMPT=(KeyboardIdle < $(PreemptKeyboardIdleTime)) || ($(CpuBusyTimer) > $(PreemptCpuBusyTime))\n    if defined NoJobsHours\n        PREEMPT = ($(PREEMPT)) && ($(NoJobsHours))\n    endif\n    # start on KeyboardIdle, CpuIdle and optionally hours of day and/or day of week\n    START=(KeyboardIdle > $(StartIdleTime)) && $(CPUIdle)\n    if defined RunJobsHours\n        START = ($(START)) || ($(RunJobsHours))\n    endif\n    # boilerplate for evict but not suspend\n    WANT_SUSPEND=False\n    WANT_VACATE=True\n    SUSPEND=False\n    CONTINUE=True\n    KILL=False\n    MaxJobRetirementTime=0\n    CLAIM_WORKLIFE=\n    SLOTS_CONNECTED_TO_KEYBOARD=1000000\n    SLOTS_CONNECTED_TO_CONSOLE=1000000\n    IS_OWNER=(START =?= False)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.DESKTOP_HOURS", Default: "    use FEATURE : POLICY_HOURS_TO_START($(1+))\n    # set CpuBusy latch and NonCondorLoadAvg in case admin wants to use them\n    NonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n    CpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n    STARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n    # the CpuBusy LATCH_EXPR produces CpuBusyValue=0 or 1, CpuBusyTime is the time that CpuBusyValue changed\n    CpuBusyTimer=(CpuBusyValue * (time() - CpuBusyTime))\n    PREEMPT = $(NoJobsHours)\n    START = $(RunJobsHours)\n    # boilerplate for evict but not suspend\n    WANT_SUSPEND=False\n    WANT_VACATE=True\n    SUSPEND=False\n    CONTINUE=True\n    KILL=False\n    MaxJobRetirementTime=0\n    CLAIM_WORKLIFE=\n    SLOTS_CONNECTED_TO_KEYBOARD=1000000\n    SLOTS_CONNECTED_TO_CONSOLE=1000000\n    IS_OWNER=(START =?= False)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.ALWAYS_RUN_JOBS", Default: "\tWANT_SUSPEND=False\n\tWANT_VACATE=True\n\tSUSPEND=False\n\tCONTINUE=True\n\tPREEMPT=False\n\tSTART=True\n\tKILL=False\n\tPREEMPTION_REQUIREMENTS=False\n\tNEGOTIATOR_CONSIDER_PREEMPTION=False\n\tMaxJobRetirementTime=2147483647\n\tCLAIM_WORKLIFE=1200", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.TESTINGMODE_POLICY_VALUES", Default: "\tTESTINGMODE_WANT_SUSPEND=False\n\tTESTINGMODE_WANT_VACATE=True\n\tTESTINGMODE_START=True\n\tTESTINGMODE_SUSPEND=False\n\tTESTINGMODE_CONTINUE=True\n\tTESTINGMODE_PREEMPT=False\n\tTESTINGMODE_KILL=False\n\tTESTINGMODE_PERIODIC_CHECKPOINT=False\n\tTESTINGMODE_PREEMPTION_REQUIREMENTS=False\n\tTESTINGMODE_PREEMPTION_RANK=0\n\tTESTINGMODE_CLAIM_WORKLIFE=1200", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.UWCS_DESKTOP_POLICY_VALUES", Default: "\tif ! defined PolicyExprFragments\n\t\tuse FEATURE : POLICY_EXPR_FRAGMENTS\n\tendif\n\tSTARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n\tCpuBusyTimer=IfThenElse(CpuBusyValue is 1, time() - CpuBusyTime, 0)\n\tUWCS_WANT_SUSPEND=( $(SmallJob) || $(KeyboardNotBusy) || $(IsVanilla) ) && ( $(SUSPEND) )\n\tUWCS_WANT_VACATE=( $(ActivationTimer) > 10 * $(MINUTE) || $(IsVanilla) )\n\tUWCS_START=( (KeyboardIdle > $(StartIdleTime)) && ( $(CPUIdle) || (State != \"Unclaimed\" && State != \"Owner\")) )\n\tUWCS_SUSPEND=( $(KeyboardBusy) || ( ($(CpuBusyTimer) > 2 * $(MINUTE)) && $(ActivationTimer) > 90 ) )\n\tUWCS_CONTINUE=( $(CPUIdle) && ($(ActivityTimer) > 10) && (KeyboardIdle > $(ContinueIdleTime)) )\n\tUWCS_PREEMPT=( ((Activity == \"Suspended\") && ($(ActivityTimer) > $(MaxSuspendTime))) || (SUSPEND && (WANT_SUSPEND == False)) )\n\tUWCS_MaxJobRetirementTime=0\n\tUWCS_KILL=false\n\tUWCS_PERIODIC_CHECKPOINT=$(LastCkpt) > (3 * $(HOUR) + $RANDOM_INTEGER(-30,30,1) * $(MINUTE) )\n\tUWCS_NEGOTIATOR_PRE_JOB_RANK=(10000000 * My.Rank) + (1000000 * (RemoteOwner =?= UNDEFINED)) - (100000 * Cpus) - Memory\n\tUWCS_NEGOTIATOR_POST_JOB_RANK=(RemoteOwner =?= UNDEFINED) * (ifthenElse(isUndefined(KFlops), 1000, Kflops) - SlotID - 1.0e10*(Offline=?=True))\n\tUWCS_PREEMPTION_REQUIREMENTS=((SubmitterGroup =?= RemoteGroup) && ($(StateTimer) > (1 * $(HOUR))) && (RemoteUserPrio > TARGET.SubmitterUserPrio * 1.2)) || (MY.NiceUser == True)\n\tUWCS_PREEMPTION_RANK=(RemoteUserPrio * 1000000) - ifThenElse(isUndefined(TotalJobRuntime), 0, TotalJobRuntime)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.UWCS_DESKTOP", Default: "\tuse FEATURE : UWCS_DESKTOP_POLICY_VALUES\n\tWANT_SUSPEND=$(UWCS_WANT_SUSPEND)\n\tWANT_VACATE=$(UWCS_WANT_VACATE)\n\tSUSPEND=$(UWCS_SUSPEND)\n\tCONTINUE=$(UWCS_CONTINUE)\n\tPREEMPT=$(UWCS_PREEMPT)\n\tSTART=$(UWCS_START)\n\tKILL=$(UWCS_KILL)\n\tPERIODIC_CHECKPOINT=$(UWCS_PERIODIC_CHECKPOINT)\n\tPREEMPTION_REQUIREMENTS=$(UWCS_PREEMPTION_REQUIREMENTS)\n\tPREEMPTION_RANK=$(UWCS_PREEMPTION_RANK)\n\tNEGOTIATOR_PRE_JOB_RANK=$(UWCS_NEGOTIATOR_PRE_JOB_RANK)\n\tNEGOTIATOR_POST_JOB_RANK=$(UWCS_NEGOTIATOR_POST_JOB_RANK)\n\tMaxJobRetirementTime=$(UWCS_MaxJobRetirementTime)\n\tCLAIM_WORKLIFE=$(UWCS_CLAIM_WORKLIFE)\n\tSLOTS_CONNECTED_TO_KEYBOARD=1024*1024\n\tIS_OWNER=(START =?= False)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.LIMIT_JOB_RUNTIMES", Default: "\tMAX_JOB_RUNTIME=$(1:24*3600)\n\tMaxJobRetirementTime=$(MAX_JOB_RUNTIME)+120\n\tPREEMPT=(Activity == \"Busy\") && ((time() - EnteredCurrentActivity) > $(MAX_JOB_RUNTIME))", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.PREEMPT_IF_RUNTIME_EXCEEDS", Default: "\tMAX_JOB_RUNTIME = $(1:24*3600)\n\tPREEMPT_RUNTIME_EXCEEDS = (JobState == \"Running\") && ((time() - JobCurrentStartDate) > $(MAX_JOB_RUNTIME))\n\tuse policy : PREEMPT_IF( PREEMPT_RUNTIME_EXCEEDS )", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.HOLD_IF_RUNTIME_EXCEEDS", Default: "\tMAX_JOB_RUNTIME = $(1:24*3600)\n\tHOLD_REASON_RUNTIME_EXCEEDS = runtime exceeded maximum ($(MAX_JOB_RUNTIME) seconds)\n\tHOLD_RUNTIME_EXCEEDS = (JobState == \"Running\") && ((time() - JobCurrentStartDate) > $(MAX_JOB_RUNTIME))\n\tuse policy : WANT_HOLD_IF( HOLD_RUNTIME_EXCEEDS, $(HOLD_SUBCODE_RUNTIME_EXCEEDS:103), $(HOLD_REASON_RUNTIME_EXCEEDS) )", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.STARTD_PUBLISH_CpusUsage", Default: "  #\n  # Publish the number of CPU cores being used by the job into\n  # to slot ad as attribute \"CpusUsage\". This value will\n  # be the average number of cores used by the job over the\n  # past minute, sampling every 5 seconds\n  #\n  CpusUsage = ifthenelse(TotalLoadAvg > 0.0 && Activity!=\"Idle\", CondorLoadAvg/TotalLoadAvg*MIN({TotalLoadAvg, $(DETECTED_CORES)}), 0)\n  STARTD_ATTRS = $(STARTD_ATTRS) CpusUsage", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.PREEMPT_IF_CPUS_EXCEEDED", Default: "  use POLICY : STARTD_PUBLISH_CpusUsage\n  #\n  # If the startd sees the number of CPU cores used by the job exceed the\n  # number of cores in the slot by more than 0.8, preempt the job\n  # immediately ignoring any retirement time.\n  #\n  CPU_EXCEEDED = (CpusUsage > Cpus + 0.8)\n  use POLICY : PREEMPT_IF(CPU_EXCEEDED)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.HOLD_IF_CPUS_EXCEEDED", Default: "  use POLICY : STARTD_PUBLISH_CpusUsage\n  #\n  # If the startd sees the number of CPU cores used by the job exceed the\n  # number of cores in the slot by more than 0.8, preempt the job\n  # immediately ignoring any retirement time.\n  #\n  CPU_EXCEEDED = (CpusUsage > Cpus + 0.8)\n  HOLD_REASON_CPU_EXCEEDED = CPU usage exceeded request_cpus\n  use POLICY : WANT_HOLD_IF( CPU_EXCEEDED, $(HOLD_SUBCODE_CPU_EXCEEDED:101), $(HOLD_REASON_CPU_EXCEEDED) )", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.PREEMPT_IF_DISK_EXCEEDED", Default: "  #\n  # If the startd sees the disk usage of the job exceed the\n  # disk in the slot, preempt the job immediately ignoring\n  # any retirement time.  VM universe jobs can't go over their\n  # disk allocations, so we ignore them.\n  #\n  DISK_EXCEEDED = (JobUniverse != 13 && DiskUsage =!= UNDEFINED && DiskUsage > Disk)\n  use POLICY : PREEMPT_IF(DISK_EXCEEDED)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.HOLD_IF_DISK_EXCEEDED", Default: "  #\n  # If the startd preempted a job because disk usage exceeded\n  # the amount of memory in the slot, put the job on hold.  VM\n  # universe jobs can't go over their disk allocations, so\n  # we ignore them.\n  #\n  DISK_EXCEEDED = (JobUniverse != 13 && DiskUsage =!= UNDEFINED && DiskUsage > Disk)\n  HOLD_REASON_DISK_EXCEEDED = disk usage exceeded allocated disk\n  use POLICY : WANT_HOLD_IF( DISK_EXCEEDED, $(HOLD_SUBCODE_DISK_EXCEEDED:104), $(HOLD_REASON_DISK_EXCEEDED) )", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.PREEMPT_IF_MEMORY_EXCEEDED", Default: "  #\n  # If the startd sees the memory usage of the job exceed the\n  # memory in the slot, preempt the job immediately ignoring\n  # any retirement time.  VM universe jobs can't go over their\n  # memory allocations, so we ignore them.\n  #\n  MEMORY_EXCEEDED = (JobUniverse != 13 && MemoryUsage =!= UNDEFINED && MemoryUsage > Memory)\n  use POLICY : PREEMPT_IF(MEMORY_EXCEEDED)", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.HOLD_IF_MEMORY_EXCEEDED", Default: "  #\n  # If the startd preempted a job because memory usage exceeded\n  # the amount of memory in the slot, put the job on hold.  VM\n  # universe jobs can't go over their memory allocations, so\n  # we ignore them.\n  #\n  MEMORY_EXCEEDED = (JobUniverse != 13 && MemoryUsage =!= UNDEFINED && MemoryUsage > Memory)\n  HOLD_REASON_MEMORY_EXCEEDED = memory usage exceeded request_memory\n  use POLICY : WANT_HOLD_IF( MEMORY_EXCEEDED, $(HOLD_SUBCODE_MEMORY_EXCEEDED:102), $(HOLD_REASON_MEMORY_EXCEEDED) )", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.PREEMPT_IF", Default: "  if !$(1?)\n    error : use POLICY : PREEMPT_IF requires an argument. It should be the name of a config variable that has the trigger expression.\n  endif\n  if defined PREEMPT\n    PREEMPT = $($(1)) || $(PREEMPT)\n  else\n    PREEMPT = $($(1))\n  endif\n  MAXJOBRETIREMENTTIME = ifthenelse($($(1)),-1,$(MAXJOBRETIREMENTTIME:0))\n  if defined WANT_SUSPEND\n    WANT_SUSPEND = $($(1)) =!= true && $(WANT_SUSPEND)\n  else\n    WANT_SUSPEND = $($(1)) =!= true\n  endif", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.WANT_HOLD_IF", Default: "  use POLICY : PREEMPT_IF($(1))\n  if ! $(3?)\n    error : use POLICY : WANT_HOLD_IF requires 3 arguments: policy_variable, subcode, reason_text\n  endif\n  if defined WANT_HOLD\n    WANT_HOLD = (JobUniverse != 1 && $($(1))) || $(WANT_HOLD)\n  else\n    WANT_HOLD = (JobUniverse != 1 && $($(1)))\n  endif\n  WANT_HOLD_SUBCODE = ifThenElse($($(1)), $(2) , $(WANT_HOLD_SUBCODE:UNDEFINED))\n  WANT_HOLD_REASON = ifThenElse($($(1)), \"$(3+)\", $(WANT_HOLD_REASON:UNDEFINED))", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.Personal", Default: "\tCONDOR_HOST=127.0.0.1\n\tCOLLECTOR_HOST=$(CONDOR_HOST):0\n\tDAEMON_LIST=MASTER COLLECTOR NEGOTIATOR STARTD SCHEDD\n\tRunBenchmarks=0", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.Execute", Default: "\tDAEMON_LIST=$(DAEMON_LIST) STARTD", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.Submit", Default: "\tDAEMON_LIST=$(DAEMON_LIST) SCHEDD", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.CentralManager", Default: "\tDAEMON_LIST=$(DAEMON_LIST) COLLECTOR NEGOTIATOR", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.HOST_BASED", Default: "\tALLOW_ADMINISTRATOR=$(CONDOR_HOST)\n\tALLOW_READ=*\n\tALLOW_WRITE=$(FULL_HOSTNAME) $(IPV4_ADDRESS) $(IPV6_ADDRESS)\n\tALLOW_DAEMON=$(FULL_HOSTNAME) $(IPV4_ADDRESS) $(IPV6_ADDRESS)\n\tALLOW_NEGOTIATOR=$(CONDOR_HOST)\n\tALLOW_NEGOTIATOR_SCHEDD=$(ALLOW_NEGOTIATOR) $(FLOCK_NEGOTIATOR_HOSTS)\n\tALLOW_WRITE_COLLECTOR=$(ALLOW_WRITE) $(FLOCK_FROM)\n\tALLOW_WRITE_STARTD=$(ALLOW_WRITE) $(FLOCK_FROM)\n\tALLOW_READ_COLLECTOR=$(ALLOW_READ) $(FLOCK_FROM)\n\tALLOW_READ_STARTD=$(ALLOW_READ) $(FLOCK_FROM)\n\t# To smooth upgrades from 8.8.\n\tLEGACY_ALLOW_SEMANTICS = TRUE", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.WITH_AUTHENTICATION", Default: "\tSEC_DEFAULT_AUTHENTICATION=required\n\tSEC_DEFAULT_INTEGRITY=required", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.STRONG", Default: "\tSEC_DEFAULT_ENCRYPTION=required\n\tSEC_DEFAULT_INTEGRITY=required\n\tSEC_DEFAULT_AUTHENTICATION=required", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.USER_BASED", Default: "\tif $(CondorIsAdmin)\n\t  authuser=condor\n\telse\n\t  authuser=$(USERNAME)\n\tendif\n\tuse security:recommended($(authuser))\n\t# If we're running under a regular account, limit job submission\n\t# to this account.\n\tif ! $(CondorIsAdmin)\n\t  ALLOW_WRITE = $(authuser)@$(UID_DOMAIN)\n\tendif", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.AssignAccountingGroup", Default: "\tif ! $(1?)\n\t  error : use FEATURE : AssignAccountingGroup requires a map_file argument\n\tendif\n\tuse FEATURE : ScheddUsermapFile(AssignAccountingGroup, $(1))\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AssignAccountingGroup\n\tJOB_TRANSFORM_AssignAccountingGroup @=end\n\t\tCOPY Owner = AcctGroupUser\n\t\tCOPY AcctGroup = RequestedAcctGroup\n\t\tEVALSET AcctGroup = usermap(\"AssignAccountingGroup\",AcctGroupUser,AcctGroup)\n\t\tEVALSET AccountingGroup = join(\".\",AcctGroup,AcctGroupUser)\n\t@end\n\t# make sure that the user can't qedit these attributes once they have been set.\n\tIMMUTABLE_JOB_ATTRS = $(IMMUTABLE_JOB_ATTRS) AcctGroup AcctGroupUser AccountingGroup\n\t# optionally check that the user requested a valid group\n\tif $(2:True)\n\t  SUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) CheckRequestedGroup\n\t  SUBMIT_REQUIREMENT_CheckRequestedGroup = RequestedAcctGroup is undefined || RequestedAcctGroup == AcctGroup\n\t  SUBMIT_REQUIREMENT_CheckRequestedGroup_REASON = strcat(\"Permission denied setting accounting_group to \", RequestedAcctGroup)\n\tendif\n\t# require that the user mapped into an accounting group\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) AssignAccountingGroup\n\tSUBMIT_REQUIREMENT_AssignAccountingGroup = AcctGroup isnt undefined && AccountingGroup isnt undefined\n\tSUBMIT_REQUIREMENT_AssignAccountingGroup_REASON = strcat(\"Submit requirement AssignAccountingGroup not met - Could not map '\", Owner, \"' to an accounting group \", RequestedAcctGroup)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.SetJobAttrFromUsermap", Default: "\tif ! $(3?)\n\t  error : use FEATURE : SetJobAttrFromUsermap requires at least 3 arguments: dest_attr, src_attr, map_name [,map_file]\n\tendif\n\tif $(4?)\n\t   use FEATURE : ScheddUsermapFile($(3), $(4))\n\tendif\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) Set$(1)\n\tJOB_TRANSFORM_Set$(1) = [ eval_set_$(1)=usermap(\"$(3)\",$(2),$(1)); ]\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) Require$(1)\n\tSUBMIT_REQUIREMENT_Require$(1) = $(1) isnt undefined\n\tSUBMIT_REQUIREMENT_Require$(1)_REASON = strcat(\"Submit requirement Require$(1) not met - Could not map '\", $(2)?:\"undefined\", \"' to a $(1)\")", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ScheddUsermapFile", Default: "   if ! $(2?)\n     error : use FEATURE : ScheddUsermapFile requires 2 arguments: map_name, map_file\n   endif\n   SCHEDD_CLASSAD_USER_MAP_NAMES = $(SCHEDD_CLASSAD_USER_MAP_NAMES) $(1)\n   CLASSAD_USER_MAPFILE_$(1) = $(2)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.REMOTE_RUNTIME_CONFIG", Default: "\tENABLE_RUNTIME_CONFIG=True\n\tALLOW_CONFIG=$(ALLOW_ADMINISTRATOR)\n\t# the value of this attribute defines which parameters can be set remotely\n\tif $(0#)\n\t\tSETTABLE_ATTRS_CONFIG=$(SETTABLE_ATTRS_CONFIG) $(0)\n\tendif", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.REMOTE_CONFIG", Default: "\tENABLE_PERSISTENT_CONFIG=True\n\tPERSISTENT_CONFIG_DIR=$(SPOOL)\n\tuse FEATURE : REMOTE_RUNTIME_CONFIG($(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.GPUs", Default: "\tMACHINE_RESOURCE_INVENTORY_GPUs=$(LIBEXEC)/condor_gpu_discovery $(1) -properties $(GPU_DISCOVERY_EXTRA)\n\tENVIRONMENT_FOR_AssignedGPUs=GPU_DEVICE_ORDINAL=/(CUDA|OCL)//  CUDA_VISIBLE_DEVICES=/CUDA//\n\tENVIRONMENT_VALUE_FOR_UnAssignedGPUs=10000\n\tuse feature : GPUsMonitor", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.Monitor", Default: "\tif ! $(5?)\n\t\terror: use feature : monitor requires five or more arguments\n\tendif\n\tSTARTD_CRON_JOBLIST = $(STARTD_CRON_JOBLIST) $(1)_MONITOR\n\tSTARTD_CRON_$(1)_MONITOR_MODE = $(2)\n\tSTARTD_CRON_$(1)_MONITOR_PERIOD = $(3)\n\tSTARTD_CRON_$(1)_MONITOR_EXECUTABLE = $(4)\n\tSTARTD_CRON_$(1)_MONITOR_METRICS = $(5+)", Win32Default: "", Type: "string"},
-	{Name: "GPU_MONITOR", Default: "$(LIBEXEC)/condor_gpu_utilization", Win32Default: "$(LIBEXEC)\\condor_gpu_utilization.exe", Type: "string"},
-	{Name: "$FEATURE.GPUsMonitor", Default: "\tuse feature : Monitor( GPUs, WaitForExit, 300, $(GPU_MONITOR), SUM:GPUs, PEAK:GPUsMemory )\n\tSTARTD_CRON_GPUs_MONITOR_CONDITION = TotalGPUs > 0 && OpSys =!= \"WINDOWS\"\n\tSTARTD_JOB_ATTRS = $(STARTD_JOB_ATTRS) GPUsUsage GPUsMemoryUsage", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.PelicanCache", Default: "\t# NOTE: This feature is still very experimental and could have bugs and unexpected behavior\n\tPELICANCACHE=/usr/bin/pelican\n\tDAEMON_LIST=$(DAEMON_LIST) PELICANCACHE\n\tPELICANCACHE_LOG=$(LOG)/PelicanCacheLog\n\tPELICANCACHE_SIZE=$(1:256GB)\n\tPELICANCACHE_RUNLOCATION=$(2:$(LOCAL_DIR)/localcache)\n\tPELICANCACHE_FEDERATION=$(3:osg-htc.org)\n\tPELICANCACHE_HIGHWATERMARKPERCENTAGE=95\n\tPELICANCACHE_LOWWATERMARKPERCENTAGE=85\n\t# pass some args to the PELICANCACHE daemon in the environment and some in the args\n\tPELICANCACHE_ARGS=serve -d --module localcache -L $(PELICANCACHE_LOG) -f $(PELICANCACHE_FEDERATION)\n\tPELICANCACHE_ENVIRONMENT @=end\n\t   PELICAN_SERVER_ENABLEUI=false\n\t   PELICAN_LOCALCACHE_SIZE=$(PELICANCACHE_SIZE)\n\t   PELICAN_LOCALCACHE_RUNLOCATION=$(PELICANCACHE_RUNLOCATION)\n\t   PELICAN_LOCALCACHE_HIGHWATERMARKPERCENTAGE=$(PELICANCACHE_HIGHWATERMARKPERCENTAGE)\n\t   PELICAN_LOCALCACHE_LOWWATERMARKPERCENTAGE=$(PELICANCACHE_LOWWATERMARKPERCENTAGE)\n\t@end\n\t# push an environment variable into the STARTD, which the STARTER and the transfer plugins will inherit\n\t# we use the leading delimiter syntax here, with ; as the delimiter on all platforms\n\tSTARTD_ENVIRONMENT=;NEAREST_CACHE=unix:/$(PELICANCACHE_RUNLOCATION)/cache.sock;$(STARTD_ENVIRONMENT)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.PartitionableSlot", Default: "\tNUM_SLOTS_TYPE_$(1:1)=1\n\tSLOT_TYPE_$(1:1)_PARTITIONABLE=true\n\tSLOT_TYPE_$(1:1)=$(2+:100%)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.StaticSlots", Default: "\tNUM_SLOTS_TYPE_$(1:1)=$(2:$(NUM_CPUS))\n\tSLOT_TYPE_$(1:1)_PARTITIONABLE=false\n\tSLOT_TYPE_$(1:1)=$(3+:1/$(NUM_SLOTS_TYPE_$(1:1)))\n\tSLOT_TYPE_$(1:1)=$(3+)", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.OneShotCronHook", Default: "   if ! $(3?)\n     error : use FEATURE : OneShotCronHook requires at least 3 arguments: STARTD_CRON | SCHEDD_CRON, tag, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=OneShot\n   $(1)_$(2)_KILL=true\n   $(1)_$(2)_RECONFIG_RERUN=true\n   $(1)_$(2)_EXECUTABLE=$(3)\n   if $(4?)\n     $(1)_$(2)_ARGS=$(4)\n   endif", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.PeriodicCronHook", Default: "   if ! $(4?)\n     error : use FEATURE : PeriodicCronHook requires at least 4 arguments: STARTD_CRON | SCHEDD_CRON, tag, period, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=Periodic\n   $(1)_$(2)_PERIOD=$(3)\n   $(1)_$(2)_EXECUTABLE=$(4)\n   if $(5?)\n     $(1)_$(2)_ARGS=$(5)\n   endif", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ContinuousCronHook", Default: "   if ! $(3?)\n     error : use FEATURE : ContinuousCronHook requires at least 3 arguments: STARTD_CRON | SCHEDD_CRON, tag, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=WaitForExit\n   $(1)_$(2)_PERIOD=0\n   $(1)_$(2)_EXECUTABLE=$(3)\n   if $(4?)\n     $(1)_$(2)_ARGS=$(4)\n   endif", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.StartdCronPeriodic", Default: "use FEATURE : PeriodicCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ScheddCronPeriodic", Default: "use FEATURE : PeriodicCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.StartdCronOneShot", Default: "use FEATURE : OneShotCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ScheddCronOneShot", Default: "use FEATURE : OneShotCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.StartdCronContinuous", Default: "use FEATURE : ContinuousCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ScheddCronContinuous", Default: "use FEATURE : ContinuousCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ChildCollector", Default: "   COLLECTOR$(1)=$(COLLECTOR)\n   if $(2?)\n      COLLECTOR$(1)_ARGS= -p $(2)\n   else\n      COLLECTOR$(1)_ARGS= -sock collector$(1)\n   endif\n   COLLECTOR$(1).COLLECTOR_NAME=Collector$(1)\n   COLLECTOR$(1).CONDOR_VIEW_HOST=$(TOP_COLLECTOR_HOST)\n   COLLECTOR$(1).CCB_RECONNECT_FILE=$(SPOOL)/Collector$(1).ccb_reconnect\n   DAEMON_LIST=$(DAEMON_LIST) COLLECTOR$(1)\n   # change TOP_COLLECTOR_HOST to point all of the child collectors at a different top-level collector\n   TOP_COLLECTOR_HOST=$(COLLECTOR_HOST)", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.get_htcondor_idtokens", Default: "# Standard security configuration: Authenticate all non-READ connections\n# and do encryption and integrity checks for all connections.\nuse security:recommended\n# In older versions (23.X and prior), enabling integrity/encryption\n# required authentication. To allow unauthenticated READ commands with\n# encryption, we added ANONYMOUS, an authentication method that doesn't\n# actually authenticate.\n# To work with older peers, we include that setting here.\nSEC_READ_AUTHENTICATION_METHODS = $(SEC_DEFAULT_AUTHENTICATION_METHODS),ANONYMOUS\nSEC_CLIENT_AUTHENTICATION_METHODS = $(SEC_DEFAULT_AUTHENTICATION_METHODS),ANONYMOUS\n# IDTOKENs issued by HTCondor include the identity of the issuer.  This\n# identity is used to determine which IDTOKEN(s) to present to which\n# daemon(s).  The TRUST_DOMAIN variable sets that identity.  Setting it\n# to the same thing on each machine in your pool ensures that a token\n# issued by one machine is presented to every other machine in your pool.\n#\n# When get_htcondor creates an IDTOKEN for a machine, it uses this variable\n# to create the identity for that IDTOKEN.  This makes it easy to look at\n# the authorization lists (see below) and distinguish between IDTOKENS in\n# your pool and (if you allow them) IDTOKENS from other pools.\nTRUST_DOMAIN = $(CONDOR_HOST)", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.get_htcondor_central_manager", Default: "use security:get_htcondor_idtokens\nuse role:CentralManager", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.get_htcondor_submit", Default: "use security:get_htcondor_idtokens\nuse role:submit", Win32Default: "", Type: "string"},
-	{Name: "$ROLE.get_htcondor_execute", Default: "use security:get_htcondor_idtokens\nuse role:execute", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.recommended_v9_0", Default: "# Make it easy to detect if this metaknob was set.\nSECURITY_MODEL = 9.0\n# Assume that FS and IDTOKENS are in SEC_DEFAULT_AUTHENTICATION_METHODS,\n# which they are by default.\nuse security:strong\n# This allows anyone who can access port 9618 to READ, which lets\n# condor_status and condor_q work over the network out of the box.\nSEC_READ_AUTHENTICATION = OPTIONAL\nSEC_READ_ENCRYPTION = OPTIONAL\nSEC_READ_INTEGRITY = OPTIONAL\nALLOW_ADMINISTRATOR = $(1:condor)@* condor_pool@* $(2:root@*) $(3+)\nALLOW_DAEMON = $(1:condor)@*  condor_pool@*\nALLOW_NEGOTIATOR = $(1:condor)@* condor_pool@*\nALLOW_WRITE = *\nALLOW_READ = *", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.recommended_v24_0", Default: "# Make it easy to detect if this metaknob was set.\nSECURITY_MODEL = 24.0\n# Assume that FS and IDTOKENS are in SEC_DEFAULT_AUTHENTICATION_METHODS,\n# which they are by default.\nuse security:strong\n# This allows anyone who can access port 9618 to READ, which lets\n# condor_status and condor_q work over the network out of the box.\nSEC_READ_AUTHENTICATION = OPTIONAL\nSEC_CLIENT_AUTHENTICATION = OPTIONAL\nALLOW_ADMINISTRATOR = $(1:condor)@* condor@password $(2:root@*) $(3+)\nALLOW_DAEMON = $(1:condor)@*  condor@password\nALLOW_NEGOTIATOR = $(1:condor)@* condor@password\nALLOW_WRITE = *\nALLOW_READ = *", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.recommended", Default: "# Latest revision was made for 24.0\nuse security:recommended_v24_0($(1+))", Win32Default: "", Type: "string"},
-	{Name: "$SECURITY.FIPS", Default: "FIPS = True\nuse security:strong\nSEC_DEFAULT_CRYPTO_METHODS = AES,3DES", Win32Default: "", Type: "string"},
-	{Name: "$EXPERIMENTAL.CollectorNode", Default: "   COLLECTOR$(1)=$(COLLECTOR)\n   if $(2?)\n      COLLECTOR$(1)_ARGS=-f -local-name COLLECTOR$(1) -p $(2)\n   else\n      COLLECTOR$(1)_ARGS=-f -local-name COLLECTOR$(1) -sock collector$(1)\n   endif\n   COLLECTOR$(1).COLLECTOR_LOG=$(LOG)/Collector$(1)Log\n   COLLECTOR$(1).CCB_RECONNECT_FILE=$(SPOOL)/Collector$(1).ccb_reconnect\n   DAEMON_LIST=$(DAEMON_LIST) COLLECTOR$(1)", Win32Default: "", Type: "string"},
-	{Name: "$EXPERIMENTAL.HAD", Default: "   DAEMON_LIST = $(DAEMON_LIST) HAD REPLICATION\n   HAD_USE_REPLICATION = TRUE\n   MASTER_HAD_BACKOFF_CONSTANT = 360", Win32Default: "", Type: "string"},
-	{Name: "$EXPERIMENTAL.HADSharedPort", Default: "   use EXPERIMENTAL : HADReplication( $(1), $(2), $(SHARED_PORT_PORT), $(SHARED_PORT_PORT) )", Win32Default: "", Type: "string"},
-	{Name: "$EXPERIMENTAL.HADFixedPort", Default: "   HAD_ARGS = -p $(3)\n   REPLICATION_ARGS = -p $(4)\n   use EXPERIMENTAL : HADReplication( $(1), $(2), $(3), $(4) )", Win32Default: "", Type: "string"},
-	{Name: "$EXPERIMENTAL.HADReplication", Default: "   HAD_LIST = $(HAD_LIST) $(2):$(3)\n   REPLICATION_LIST = $(REPLICATION_LIST) $(2):$(4)\n   HAD_CONTROLLEE = $(1)\n   MASTER_$(1)_CONTROLLER = HAD", Win32Default: "", Type: "string"},
-	{Name: "$UPGRADE.DISCARD", Default: "\tLeaseManager\n\tLeaseManger_ADDRESS_FILE\n\tLeaseManager_LOG\n\tLeaseManager_DEBUG\n\tMAX_LeaseManager_LOG\n\tLeaseManager.GETADS_INTERVAL\n\tLeaseManager.PRUNE_INTERVAL\n\tLeaseManager.DEBUG_ADS\n\tLeaseManager.CLASSAD_LOG\n\tSTORK\n\tSTORK_ADDRESS_FILE\n\tSTORK_LOG_BASE\n\tSTORK_LOG\n\tSTORK_DEBUG\n\tMAX_STORK_LOG\n\tSTORK_PORT\n\tSTORK_ARGS\n\tDEFRAG_STATE_FILE", Win32Default: "", Type: "string"},
-	{Name: "$UPGRADE.DISCARDIF", Default: "\tRESERVED_DISK=5\n\tALL_DEBUG=\n\tMAX_COLLECTOR_LOG=1000000\n\tMAX_KBDD_LOG=1000000\n\tMAX_MASTER_LOG=1000000\n\tMAX_NEGOTIATOR_LOG=1000000\n\tMAX_NEGOTIATOR_MATCH_LOG=1000000\n\tMAX_SCHEDD_LOG=1000000\n\tMAX_SHADOW_LOG=1000000\n\tMAX_SHARED_PORT_LOG=1000000\n\tMAX_STARTD_LOG=1000000\n\tMAX_STARTER_LOG=1000000\n\tMAX_JOB_ROUTER_LOG=1000000\n\tMAX_REPLICATION_LOG=1000000\n\tMAX_ROOSTER_LOG=1000000\n\tMAX_HAD_LOG=1000000\n\tMAX_TRANSFERER_LOG=1000000\n\tMAX_GRIDMANAGER_LOG=1000000\n\tMAX_C_GAHP_LOG=1000000\n\tMAX_VM_GAHP_LOG=1000000\n\tMAX_CREDD_LOG=4000000\n\tVALID_SPOOL_FILES=job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_classad\n\tVALID_SPOOL_FILES=job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_classad, OfflineLog", Win32Default: "", Type: "string"},
-	{Name: "$UPGRADE.DISCARDIFX", Default: "\tQUEUE_SUPER_USERS=root, condor", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.KRB", Default: "\tSEC_CREDENTIAL_DIRECTORY_KRB=/var/lib/condor/krb_credentials\n\tDAEMON_LIST=$(DAEMON_LIST),CREDMON_KRB\n\tSEC_CREDENTIAL_PRODUCER=$(LIBEXEC)/cred_producer_krb\n\tSEC_CREDENTIAL_MONITOR_KRB_LOG=$(LOG)/CredMonKrbLog\n\tAUTO_INCLUDE_CREDD_IN_DAEMON_LIST = True\n\tUSE_KEYRING_SESSIONS=True", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.OAUTH", Default: "\tSEC_CREDENTIAL_DIRECTORY_OAUTH=/var/lib/condor/oauth_credentials\n\tCREDMON_OAUTH_LOG=$(LOG)/CredMonOAuthLog\n\tDAEMON_LIST=$(DAEMON_LIST),CREDMON_OAUTH\n\tAUTO_INCLUDE_CREDD_IN_DAEMON_LIST=True\n\tTRUST_CREDENTIAL_DIRECTORY=True", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.ADSTASH", Default: "\tADSTASH_SCHEDD_HISTORY=True\n\tDAEMON_LIST=$(DAEMON_LIST),ADSTASH", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.CommonCloudAttributesGoogle", Default: "\tuse FEATURE : StartdCronOneShot(cloud, $(LIBEXEC)/common-cloud-attributes-google.py, $(0))\n\tSTARTD_CRON_cloud_PREFIX = Cloud", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.JobsHaveInstanceIDs", Default: "\tSYSTEM_JOB_MACHINE_ATTRS = $(SYSTEM_JOB_MACHINE_ATTRS) CloudInstanceID", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.CommonCloudAttributesAWS", Default: "\tuse FEATURE : StartdCronOneShot(cloud, $(LIBEXEC)/common-cloud-attributes-aws.py, $(0))\n\tSTARTD_CRON_cloud_PREFIX = Cloud", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.HPC_ANNEX", Default: "\t# Let the command-line tool know that things are configured.\n\tHPC_ANNEX_ENABLED = true\n\t## The annex EPs need a specific port number.\n\t# This is the default value from `use role:personal`.\n\tispersonal = \"127.0.0.1:0\"==\"$(COLLECTOR_HOST)\"\n\tif $INT(ispersonal)\n\t\tCOLLECTOR_HOST = $(FULL_HOSTNAME):$(COLLECTOR_PORT)\n\tendif\n\t# This is the default value from the `make-personal-condor` script.\n\tispersonal = \"$(FULL_HOSTNAME):0\"==\"$(COLLECTOR_HOST)\"\n\tif $INT(ispersonal)\n\t\tCOLLECTOR_HOST = $(FULL_HOSTNAME):$(COLLECTOR_PORT)\n\tendif\n\t# Create an \"AP collector\".  At some point, we'd like to make such a\n\t# thing first-class.  In the short term, adding a command-line flag\n\t# to the collector to set its subsystem would be an improvement.\n\tAP_COLLECTOR = $(COLLECTOR)\n\tAP_COLLECTOR.COLLECTOR_LOG = $(LOG)/AP_Collector.log\n\tAP_COLLECTOR.CCB_RECONNECT_FILE = $(SPOOL)/AP_Collector.ccb_reconnect\n\tDAEMON_LIST = $(DAEMON_LIST) AP_COLLECTOR\n\t# Giving the AP collector a stable shared port ID means we don't\n\t# have to worry about updating annex pilots because of a restart.\n\tAP_COLLECTOR_ARGS = -f -local-name AP_COLLECTOR -sock ap_collector\n\t# For now, don't worry about quoting the Sinful brackets.\n\tANNEX_COLLECTOR = $(TCP_FORWARDING_HOST:$(FULL_HOSTNAME)):$(SHARED_PORT_PORT)?sock=ap_collector\n\t# Any user who can submit jobs can use the AP collector for annex.\n\tAP_COLLECTOR.ALLOW_ADVERTISE_STARTD = $(ALLOW_ADVERTISE_STARTD) $(ALLOW_WRITE)\n\tAP_COLLECTOR.ALLOW_ADVERTISE_MASTER = $(ALLOW_ADVERTISE_MASTER) $(ALLOW_WRITE)\n\t# Until we can give the schedd a stable shared port ID, the annex\n\t# pilot needs to be able to look the schedd up in the AP collector.\n\tif defined COLLECTOR_HOST\n\t\tSCHEDD.COLLECTOR_HOST = $(COLLECTOR_HOST) $(ANNEX_COLLECTOR)\n\telse\n\t\tSCHEDD.COLLECTOR_HOST = $(ANNEX_COLLECTOR)\n\tendif\n\t# The AP collector creates the \"AP\" signing key.\n\tANNEX_TOKEN_KEY_NAME = $(SEC_TOKEN_AP_SIGNING_KEY_NAME)\n\tANNEX_TOKEN_DOMAIN = $(UID_DOMAIN)\n\t# We assume that the token directories (passwords.d and tokens.d) are\n\t# already set.  HTCondor should create and populate the appropriately,\n\t# if so.\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AssignAnnex\n\tJOB_TRANSFORM_AssignAnnex @=end\n\t\tREQUIREMENTS TargetAnnexName =!= undefined\n\t\tmy_identity = \"$(MY.Owner)@$(ANNEX_TOKEN_DOMAIN)\"\n\t\taddl_reqs = ifThenElse(TARGET.AuthenticatedIdentity is undefined, true, $(my_identity) == TARGET.AuthenticatedIdentity) && (MY.TargetAnnexName == TARGET.AnnexName)\n\t\tif defined MY.JobMachineAttrs\n\t\t\tSET JobMachineAttrs \"$(MY.JobMachineAttrs),AnnexName\"\n\t\telse\n\t\t\tSET JobMachineAttrs \"AnnexName\"\n\t\tendif\n\t\tif defined MY.Requirements\n\t\t\tSET Requirements $(addl_reqs) && ($(MY.Requirements))\n\t\telse\n\t\t\tSET Requirements $(addl_reqs)\n\t\tendif\n\t@end\n\t# The schedd will not try to activate a directly-connected claim\n\t# with a job whose owner is not the authenticated identity of the\n\t# directly-connected EP.  This limits the damage that can be done\n\t# by a misconfigured EP to only running the annex owner's other\n\t# jobs, which I think no long justifies tranforming all other jobs.\n\tif $(ANNEX_JOBS_EXCLUSIVELY:FALSE)\n\t\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AnnexJobsExclusively\n\t\tJOB_TRANSFORM_AnnexJobsExclusively @=end\n\t\t\tREQUIREMENTS TargetAnnexName =?= undefined\n\t\t\taddl_reqs = ifThenElse(TARGET.AuthenticatedIdentity is undefined, true, regexp(\"^condor(_pool)?@.*$\", TARGET.AuthenticatedIdentity))\n\t\t\tif defined MY.Requirements\n\t\t\t\tSET Requirements $(addl_reqs) && ($(MY.Requirements))\n\t\t\telse\n\t\t\t\tSET Requirements $(addl_reqs)\n\t\t\tendif\n\t\t@end\n\tendif", Win32Default: "", Type: "string"},
-	{Name: "$POLICY.OnlyRegisteredCheckpointDestinations", Default: "\tTOOL_CLASSAD_USER_MAP_NAMES = $(TOOL_CLASSAD_USER_MAP_NAMES) checkpoint_destination_map\n\tSCHEDD_CLASSAD_USER_MAP_NAMES = $(SCHEDD_CLASSAD_USER_MAP_NAMES) checkpoint_destination_map\n\tCLASSAD_USER_MAPFILE_checkpoint_destination_map = $(CHECKPOINT_DESTINATION_MAPFILE)\n\tCLASSAD_USER_MAP_PREFIX_checkpoint_destination_map = TRUE\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) ValidateCheckpointDestination\n\tJOB_TRANSFORM_ValidateCheckpointDestination @=end\n\t\tEVALSET CheckpointDestinationValid = ifthenelse(undefined =?= userMap(\"checkpoint_destination_map\", CheckpointDestination), undefined, True)\n\t@end\n\tIMMUTABLE_JOB_ATTRS = $(IMMUTABLE_JOB_ATTRS) CheckpointDestinationValid\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) RejectInvalidCheckpointDestination\n\tSUBMIT_REQUIREMENT_RejectInvalidCheckpointDestination = CheckpointDestination =?= undefined || CheckpointDestinationValid =?= True\n\tSUBMIT_REQUIREMENT_RejectInvalidCheckpointDestination_REASON = \"You specified a checkpoint_destination this AP does not know how to clean up.  You may need to add a trailing / to the URL.\"", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.GANGLIA", Default: "\tDAEMON_LIST=$(DAEMON_LIST),GANGLIAD", Win32Default: "", Type: "string"},
-	{Name: "$FEATURE.DefaultCheckpointDestination", Default: "    if ! defined DEFAULT_CHECKPOINT_DESTINATION\n        if defined DEFAULT_CHECKPOINT_DESTINATION_PREFIX\n            DEFAULT_CHECKPOINT_DESTINATION = \"$(DEFAULT_CHECKPOINT_DESTINATION_PREFIX)/$(MY.Owner)\"\n        else\n            warning: You must set DEFAULT_CHECKPOINT_DESTINATION_PREFIX or DEFAULT_CHECKPOINT_DESTINATION to use feature:DefaultCheckpointDestination.\n        endif\n    endif\n    if defined DEFAULT_CHECKPOINT_DESTINATION\n        EXTENDED_SUBMIT_COMMANDS @=end\n            checkpoint_storage = \"'default' or 'spool' or a checkpoint_destination\"\n            $(EXTENDED_SUBMIT_COMMANDS)\n        @end\n        JOB_TRANSFORM_CheckpointStorage @=end\n            REQUIREMENTS (MY.CheckpointDestination =?= undefined) && (MY.SuccessCheckpointExitCode =!= undefined)\n            ShouldSetCheckpointDestination = TRUE\n            SetCheckpointDestination = $(DEFAULT_CHECKPOINT_DESTINATION)\n            if defined MY.checkpoint_storage\n                EVALMACRO IsSpool (checkpoint_storage == \"spool\")\n                EVALMACRO IsDefault (checkpoint_storage == \"default\")\n                if $(IsSpool)\n                    ShouldSetCheckpointDestination = FALSE\n                elif ! $(IsDefault)\n                    SetCheckpointDestination = checkpoint_storage\n                endif\n            endif\n            if $(ShouldSetCheckpointDestination)\n                EVALSET CheckpointDestination $(SetCheckpointDestination)\n            endif\n        @end\n        # This should almost certainly come before the validity check.\n        JOB_TRANSFORM_NAMES = CheckpointStorage $(JOB_TRANSFORM_NAMES)\n    endif", Win32Default: "", Type: "string"},
+	{Name: "STARTER_DIAGNOSTIC_send_ep_logs", Default: "$(LIBEXEC)/condor_diagnostic_send_ep_logs", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAEMON_CORE_KILL_ANY_PROCESS", Default: "false", Win32Default: "", Type: "string", Range: "", Description: "Allows DaemonCore to send signals to processes it didn't start."},
+	{Name: "MAX_DRAINING_ACTIVATION_DELAY", Default: "20", Win32Default: "", Type: "string", Range: "", Description: "When draining, how many seconds should a claimed slot wait to be activated?  Draining will be extended by this delay.."},
+	{Name: "ENABLE_KERNEL_TUNING", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "KERNEL_TUNING_LOG", Default: "$(LOG)/KernelTuning.log", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "LINUX_KERNEL_TUNING_SCRIPT", Default: "$(LIBEXEC)/linux_kernel_tuning", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "COLLECTOR_ENVIRONMENT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MASTER", Default: "$(SBIN)/condor_master", Win32Default: "$(SBIN)\\condor_master.exe", Type: "path", Range: "", Description: "full path of the Master binary"},
+	{Name: "MASTER_ENVIRONMENT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MASTER_FLAG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_FLAG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHUTDOWN_FAST_TIMEOUT", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PREEN_INTERVAL", Default: "86400", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PREEN_COREFILE_MAX_SIZE", Default: "50000000", Win32Default: "", Type: "long", Range: "", Description: "Maximum size allowed for coredump files. Anything bigger gets erased. Default 50Mb."},
+	{Name: "PREEN_COREFILE_STALE_AGE", Default: "5184000", Win32Default: "", Type: "int", Range: "", Description: "Age in seconds of a coredump file deemed stale. Default 60 days."},
+	{Name: "PREEN_COREFILES_PER_PROCESS", Default: "10", Win32Default: "", Type: "int", Range: "", Description: "Number of recent core dump files to retain per process."},
+	{Name: "DEFAULT_MASTER_SHUTDOWN_SCRIPT", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Program to execute upon condor_master shutdown"},
+	{Name: "MASTER_NEW_BINARY_DELAY", Default: "120", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER_NEW_BINARY_RESTART", Default: "GRACEFUL", Win32Default: "", Type: "string", Range: "", Description: "Master New Binary Restart Mode"},
+	{Name: "MASTER_CHECK_NEW_EXEC_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER_RECOVER_FACTOR", Default: "300", Win32Default: "", Type: "int", Range: "", Description: "Master Recover Ceiling"},
+	{Name: "MASTER_BACKOFF_FACTOR", Default: "2.0", Win32Default: "", Type: "double", Range: "", Description: ""},
+	{Name: "MASTER_BACKOFF_CEILING", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER_BACKOFF_CONSTANT", Default: "9", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "FULL_HOSTNAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Fully qualified hostname of the machine"},
+	{Name: "HOST_ALIAS", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: "Preferred DNS alias for this host"},
+	{Name: "USE_COLLECTOR_HOST_CNAME", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "HOSTNAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Detected name of the machine"},
+	{Name: "SUBSYSTEM", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Type of the Daemon or tool"},
+	{Name: "LOCALNAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Instance name of the Dameon, usually the same as SUBSYSTEM"},
+	{Name: "CCB_ADDRESS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SETTABLE_ATTRS_CONFIG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "List of configuration variables that can be set by condor_config_val -set"},
+	{Name: "SETTABLE_ATTRS_ADMINISTRATOR", Default: "", Win32Default: "", Type: "string", Range: "", Description: "List of configuration variables that can be set by condor_config_val -set"},
+	{Name: "MASTER_TIMEOUT_MULTIPLIER", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DNS_CACHE_REFRESH", Default: "28800", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER_INSTANCE_LOCK", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Path to instance lock file for the Master"},
+	{Name: "MASTER_DAEMON_AD_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Path to the daemon ad file for the Master"},
+	{Name: "COLLECTOR_TCP_SOCKET_BUFSIZE", Default: "128*1024", Win32Default: "", Type: "int", Range: "1024,", Description: "Outgoing traffic TCP write size for Collector"},
+	{Name: "COLLECTOR_PORT", Default: "9618", Win32Default: "", Type: "int", Range: "0,65535", Description: "Default collector port"},
+	{Name: "COLLECTOR_QUERY_WORKERS", Default: "4", Win32Default: "", Type: "int", Range: "0,", Description: "Max number of Collector child processes"},
+	{Name: "COLLECTOR_QUERY_WORKERS_RESERVE_FOR_HIGH_PRIO", Default: "1", Win32Default: "", Type: "int", Range: "0,", Description: "Max number of Collector child processes reserved for high prioirty queries"},
+	{Name: "COLLECTOR_QUERY_WORKERS_PENDING", Default: "50", Win32Default: "", Type: "int", Range: "0,", Description: "Max number of Collector queries to queue"},
+	{Name: "COLLECTOR_QUERY_MAX_WORKTIME", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: "Max number of seconds to serve a Collector query, 0=no limit"},
+	{Name: "SOCKET_LISTEN_BACKLOG", Default: "4096", Win32Default: "", Type: "int", Range: "1,", Description: ""},
+	{Name: "CONDOR_CREDENTIAL_DIR", Default: "/tmp", Win32Default: "", Type: "string", Range: "", Description: "Directory for condor credentials"},
+	{Name: "QUERY_TIMEOUT", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LIBVIRT_XML_SCRIPT", Default: "$(LIBEXEC)/libvirt_simple_script.awk", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_GAHP_SERVER", Default: "$(SBIN)/condor_vm-gahp", Win32Default: "$(SBIN)\\condor_vm-gahp.exe", Type: "path", Range: "", Description: ""},
+	{Name: "VM_GAHP_LOG", Default: "$(LOG)/VMGahpLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "MAX_VM_GAHP_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "XEN_BRIDGE_SCRIPT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "XEN_BOOTLOADER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "XEN_ALLOW_HARDWARE_VT_SUSPEND", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "XEN_SCRIPT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_TYPE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_MAX_MEMORY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "VM_NETWORKING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "VM_NETWORKING_TYPE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_NETWORKING_DEFAULT_TYPE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_NETWORKING_MAC_PREFIX", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_HARDWARE_VT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "USE_SCRIPT_TO_CREATE_CONFIG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "PROCD_ADDRESS", Default: "$(RUN)/procd_pipe", Win32Default: "\\\\.\\pipe\\$Fpub(LOCK)", Type: "string", Range: "", Description: ""},
+	{Name: "BIND_ALL_INTERFACES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EXECUTE", Default: "$(LOCAL_DIR)/execute", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "UID_DOMAIN", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ACCOUNTING_DOMAIN", Default: "$(UID_DOMAIN)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRUST_UID_DOMAIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRUST_LOCAL_UID_DOMAIN", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "FILESYSTEM_DOMAIN", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ARCH", Default: "", Win32Default: "", Type: "string", Range: "", Description: "CPU architecture"},
+	{Name: "OPSYS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Operating system"},
+	{Name: "OPSYSANDVER", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Operating system and major version number"},
+	{Name: "STARTER_LOG", Default: "$(LOG)/StarterLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "STARTER_DEBUG", Default: "D_PID", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the starter"},
+	{Name: "STARTER_LOG_NAME_APPEND", Default: "", Win32Default: "", Type: "string", Range: "", Description: "What to append StarterLog filename, may be: false, true, Slot, Cluster, or JobId, true or undef gives 8.0 compatible behavior."},
+	{Name: "USER_JOB_WRAPPER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SOFT_UID_DOMAIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAGMAN_LOG_ON_NFS_IS_ERROR", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_RESET_RETRIES_UPON_RESCUE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_INSERT_SUB_FILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "DAGMAN_AUTO_RESCUE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_ON_EXIT_REMOVE", Default: "(ExitSignal =?= 11 || (ExitCode =!= UNDEFINED && ExitCode >=0 && ExitCode <= 2))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAGMAN_REMOVE_JOBS_AFTER_LIMIT_CHANGE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_CONFIG_FILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "DAGMAN_MANAGER_JOB_APPEND_GETENV", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAGMAN_DEBUG_CACHE_ENABLE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_STARTUP_CYCLE_DETECT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_ALLOW_EVENTS", Default: "114", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_RETRY_SUBMIT_FIRST", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_RETRY_NODE_FIRST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_MUNGE_NODE_NAMES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_ALLOW_ANY_NODE_NAME_CHARACTERS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_PROHIBIT_MULTI_JOBS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_SUBMIT_DEPTH_FIRST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_CONDOR_SUBMIT_EXE", Default: "$(BIN)/condor_submit", Win32Default: "$(BIN)\\condor_submit.exe", Type: "path", Range: "", Description: ""},
+	{Name: "DAGMAN_CONDOR_RM_EXE", Default: "$(BIN)/condor_rm", Win32Default: "$(BIN)\\condor_rm.exe", Type: "path", Range: "", Description: ""},
+	{Name: "DAGMAN_ABORT_DUPLICATES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_ABORT_ON_SCARY_SUBMIT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_PENDING_REPORT_INTERVAL", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_CHECK_QUEUE_INTERVAL", Default: "28800", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MAX_DAGMAN_LOG", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_ALWAYS_RUN_POST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_COPY_TO_SPOOL", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug log options for dagman"},
+	{Name: "DAGMAN_DEBUG_CACHE_SIZE", Default: "5*1024*1024", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_DEFAULT_NODE_LOG", Default: "@(DAG_DIR)/@(DAG_FILE).nodes.log", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "DAGMAN_DEFAULT_PRIORITY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_GENERATE_SUBDAG_SUBMITS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_HOLD_CLAIM_TIME", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_HOLD_SCRIPTS", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_JOB_HOLDS", Default: "100", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_JOBS_IDLE", Default: "1000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_JOBS_SUBMITTED", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_POST_SCRIPTS", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_PRE_SCRIPTS", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_RESCUE_NUM", Default: "100", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_SUBMIT_ATTEMPTS", Default: "6", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_MAX_SUBMITS_PER_INTERVAL", Default: "100", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_AGGRESSIVE_SUBMIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_USE_DIRECT_SUBMIT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_DEFAULT_APPEND_VARS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_REPORT_GRAPH_METRICS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_REMOVE_NODE_JOBS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_SUBMIT_DELAY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_SUPPRESS_JOB_LOGS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_SUPPRESS_NOTIFICATION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_USER_LOG_SCAN_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_QUEUE_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_USE_STRICT", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_VERBOSITY", Default: "3", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAGMAN_WRITE_PARTIAL_RESCUE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_USE_JOIN_NODES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_PUT_FAILED_JOBS_ON_HOLD", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_NODE_RECORD_INFO", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAGMAN_RECORD_MACHINE_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LIBEXEC", Default: "$(RELEASE_DIR)/libexec", Win32Default: "$(BIN)", Type: "string", Range: "", Description: ""},
+	{Name: "LOG", Default: "$(LOCAL_DIR)/log", Win32Default: "", Type: "path", Range: "", Description: "Location of Log directory, used by other params"},
+	{Name: "INCLUDE", Default: "$(RELEASE_DIR)/include", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ETC", Default: "$(LOCAL_DIR)", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "GROUP_NAMES", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PRIORITY_HALFLIFE", Default: "86400", Win32Default: "", Type: "double", Range: "0.00001,", Description: ""},
+	{Name: "NICE_USER_PRIO_FACTOR", Default: "1e10", Win32Default: "", Type: "double", Range: "", Description: ""},
+	{Name: "NICE_USER_ACCOUNTING_GROUP_NAME", Default: "nice-user", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REMOTE_PRIO_FACTOR", Default: "1e7", Win32Default: "", Type: "double", Range: "", Description: ""},
+	{Name: "DEFAULT_PRIO_FACTOR", Default: "1000", Win32Default: "", Type: "double", Range: "", Description: ""},
+	{Name: "ACCOUNTANT_LOCAL_DOMAIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ACCOUNTANT_DATABASE_FILE", Default: "$(SPOOL)/Accountantnew.log", Win32Default: "$(SPOOL)\\Accountantnew.log", Type: "path", Range: "", Description: ""},
+	{Name: "MAX_ACCOUNTANT_DATABASE_SIZE", Default: "1000000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SPOOL", Default: "$(LOCAL_DIR)/spool", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_DISCOUNT_SUSPENDED_RESOURCES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CONCURRENCY_LIMIT_DEFAULT", Default: "2308032", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "REAL_TIME_JOB_SUSPEND_UPDATES", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREEMPTION_REQUIREMENTS", Default: "False", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_SCITOKENS_ALLOW_FOREIGN_TOKEN_TYPES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_SCITOKENS_FOREIGN_TOKEN_ISSUERS", Default: "https://aai-dev.egi.eu/auth/realms/egi", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_SCITOKENS_CACHE", Default: "$(RUN)/cache", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_ENABLE_MATCH_PASSWORD_AUTHENTICATION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_ENABLE_IMPERSONATION_TOKENS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_IMPERSONATION_TOKEN_LIMITS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_ENABLE_REMOTE_ADMINISTRATION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DELEGATE_JOB_GSI_CREDENTIALS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "USE_VOMS_ATTRIBUTES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_USE_VOMS_IDENTITY", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "USE_NFS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "RECONNECT_BACKOFF_CEILING", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SHADOW_MAX_JOB_CLEANUP_RETRIES", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SHADOW_JOB_CLEANUP_RETRY_DELAY", Default: "30", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SHADOW_LAZY_QUEUE_UPDATE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "RESERVED_MEMORY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "RESERVED_SWAP", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SCHEDD_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COUNT_HYPERTHREAD_CPUS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "DETECTED_CPUS should count hyperthreads as CPUs"},
+	{Name: "DETECTED_CPUS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DETECTED_PHYSICAL_CPUS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DETECTED_CORES", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DETECTED_CPUS_LIMIT", Default: "$(DETECTED_CPUS)", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NUM_CPUS", Default: "$(DETECTED_CPUS_LIMIT)", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CONSOLE_DEVICES", Default: "mouse, console", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SLOTS_CONNECTED_TO_CONSOLE", Default: "0", Win32Default: "", Type: "int", Range: "", Description: "Slots above this number do not have ConsoleIdle updated"},
+	{Name: "SLOTS_CONNECTED_TO_KEYBOARD", Default: "0", Win32Default: "", Type: "int", Range: "", Description: "Slots above this number do not have KeyboardIdle updated"},
+	{Name: "STARTD_HAS_BAD_UTMP", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SYSAPI_GET_LOADAVG", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "RELEASE_DIR", Default: "/usr", Win32Default: "c:\\condor", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_USE_PRIMARY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "HAD_LIST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_STAND_ALONE_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_CONNECTION_TIMEOUT", Default: "5", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_USE_REPLICATION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "HAD_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_FIPS_MODE", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "REPLICATION_LIST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_STATE_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRANSFER_JOB_ATTRS", Default: "ClusterID, ProcID, NumShadowStarts", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_TRANSFER_LIFETIME", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_AVAILABLE_PARTITIONS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_QUERY_WORK_LOADS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_GENERATE_PARTITION", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_DESTROY_PARTITION", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_BOOT_PARTITION", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_SHUTDOWN_PARTITION", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_FACTORY_SCRIPT_BACK_PARTITION", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LVM_USE_THIN_PROVISIONING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "LVM_BACKING_FILE", Default: "$(SPOOL)/startd_disk.img", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LVM_BACKING_FILE_SIZE_MB", Default: "10240", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LVM_THIN_LV_EXTRA_SIZE_MB", Default: "2000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LVM_HIDE_MOUNT", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: "Inform LVM to mount per job LV in hidden mount namespace"},
+	{Name: "MAX_TIME_SKIP", Default: "1200", Win32Default: "", Type: "int", Range: "0,", Description: "Max seconds system clock can skip without a restart"},
+	{Name: "MAX_TIMER_EVENTS_PER_CYCLE", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "MAX_ACCEPTS_PER_CYCLE", Default: "8", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "MAX_UDP_MSGS_PER_CYCLE", Default: "100", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "MAX_REAPS_PER_CYCLE", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "PID_SNAPSHOT_INTERVAL", Default: "15", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MASTER.PID_SNAPSHOT_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: "Pid Snapshot Interval for the Master"},
+	{Name: "SEC_DEBUG_PRINT_KEYS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_CREDENTIAL_SWEEP_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: "Period to check for old (marked) credentials"},
+	{Name: "SEC_CREDENTIAL_SWEEP_DELAY", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: "Default delay before sweeping unused credentials"},
+	{Name: "SEC_CREDENTIAL_REFRESH_INTERVAL", Default: "-1", Win32Default: "", Type: "int", Range: "", Description: "Default interval for re-storing credentials"},
+	{Name: "SEC_DEFAULT_AUTHENTICATION_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: "Default timeout for all authentication methods"},
+	{Name: "WANT_UDP_COMMAND_SOCKET", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NETWORK_MAX_PENDING_CONNECTS", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "TCP_FORWARDING_HOST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PRIVATE_NETWORK_INTERFACE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PRIVATE_NETWORK_NAME", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "USE_CLONE_TO_CREATE_PROCESSES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_INVALIDATE_SESSIONS_VIA_TCP", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_USE_FAMILY_SESSION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CERTIFICATE_MAPFILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CERTIFICATE_MAPFILE_ASSUME_HASH_KEYS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Assume keys in the CERTIFICATE_MAPFILE are hash keys, not regular expressions"},
+	{Name: "USER_MAPFILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NOT_RESPONDING_TIMEOUT", Default: "3600", Win32Default: "", Type: "int", Range: "1,", Description: ""},
+	{Name: "EXCEPT_ON_ERROR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DISABLE_SETUID", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DISABLE_AUTHENTICATION_IP_CHECK", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_C_GAHP_WORKER_THREAD_DEFAULT_SESSION_DURATION", Default: "1800", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "SEC_SESSION_DURATION_SLOP", Default: "20", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "WINDOWS_SOFTKILL", Default: "", Win32Default: "$(SBIN)\\condor_softkill.exe", Type: "path", Range: "", Description: ""},
+	{Name: "WINDOWS_RMDIR", Default: "", Win32Default: "$(SBIN)\\condor_rmdir.exe", Type: "path", Range: "", Description: "Path to binary that does recursive directory removal for Windows"},
+	{Name: "USE_PROCESS_GROUPS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "USE_VISIBLE_DESKTOP", Default: "false", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_PID_COLLISION_RETRY", Default: "9", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "COLLECTOR_SOCKET_BUFSIZE", Default: "10000*1024", Win32Default: "", Type: "int", Range: "1024,", Description: "Collector read buffer size for incoming UDP traffic"},
+	{Name: "PIPE_BUFFER_MAX", Default: "10240", Win32Default: "", Type: "int", Range: "", Description: "Maximum buffer size for stdout/err pipes"},
+	{Name: "TOUCH_LOG_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "BIN", Default: "$(RELEASE_DIR)/bin", Win32Default: "$(RELEASE_DIR)\\bin", Type: "path", Range: "", Description: "Path to directory of HTCondor tools"},
+	{Name: "CREATE_CORE_FILES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CORE_FILE_NAME", Default: "core.$(SUBSYSTEM)", Win32Default: "core.$(SUBSYSTEM).WIN32", Type: "string", Range: "", Description: ""},
+	{Name: "DROP_CORE_ON_RECONFIG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHUTDOWN_GRACEFUL_TIMEOUT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_NAME", Default: "My Pool - $(CONDOR_HOST)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_UPDATE_INTERVAL", Default: "900", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CONDOR_VIEW_HOST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONDOR_VIEW_CLASSAD_TYPES", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_FORWARD_WATCH_LIST", Default: "State,Cpus,Memory,IdleJobs,ClaimId,Capability,ClaimIdList,ChildClaimIds", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_FORWARD_FILTERING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR_FORWARD_CLAIMED_PRIVATE_ADS", Default: "$(NEGOTIATOR_CONSIDER_PREEMPTION)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_FORWARD_PROJECTION", Default: "", Win32Default: "", Type: "string", Range: "", Description: "An expression that returns a list of attributes to forward for the current ad"},
+	{Name: "COLLECTOR_STATS_SWEEP", Default: "14400", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CONDOR_ADMIN", Default: "root@$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: "HTCondor sends email to this address on pool failure"},
+	{Name: "KEEP_POOL_HISTORY", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "POOL_HISTORY_SAMPLING_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "POOL_HISTORY_MAX_STORAGE", Default: "10000000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "POOL_HISTORY_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOCAL_DIR", Default: "$(TILDE)", Win32Default: "$(RELEASE_DIR)", Type: "string", Range: "", Description: ""},
+	{Name: "CRED_SUPER_USERS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CREDD_HOST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_GAHPCLIENT_DEBUG", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_GAHPCLIENT_DEBUG_SIZE", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GAHP_DEBUG_HIDE_SENSITIVE_DATA", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_MAX_PENDING_REQUESTS", Default: "50", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_MAX_SUBMITTED_JOBS_PER_RESOURCE_EC2", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "BLAHPD_LOCATION", Default: "$(RELEASE_DIR)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BATCH_GAHP", Default: "$(BIN)/blahpd", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REMOTE_GAHP", Default: "$(SBIN)/remote_gahp", Win32Default: "$(SBIN)\\remote_gahp.exe", Type: "path", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_CONTACT_SCHEDD_DELAY", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_PROXY_REFRESH_TIME", Default: "21600", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONDOR_GAHP", Default: "$(SBIN)/condor_c-gahp", Win32Default: "$(SBIN)\\condor_c-gahp.exe", Type: "path", Range: "", Description: ""},
+	{Name: "C_GAHP_CONTACT_SCHEDD_DELAY", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CGAHP_SCHEDD_INTERACTION_TIME", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "C_GAHP_MAX_FILE_REQUESTS", Default: "10", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JAVA_EXTRA_ARGUMENTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_CONNECT_FAILURE_RETRY_COUNT", Default: "3", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PERIODIC_EXPR_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MAX_PERIODIC_EXPR_INTERVAL", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PERIODIC_EXPR_TIMESLICE", Default: "0.01", Win32Default: "", Type: "double", Range: "0.0,1.0", Description: ""},
+	{Name: "GRIDMANAGER_CONNECT_FAILURE_RETRY_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_MAX_PENDING_SUBMITS_PER_RESOURCE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_MAX_PENDING_SUBMITS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_MAX_SUBMITTED_JOBS_PER_RESOURCE", Default: "1000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_GAHP_CALL_TIMEOUT_CONDOR", Default: "28800", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_GAHP_RESPONSE_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CONDORC_ATTRS_TO_COPY", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_HOST", Default: "$(CONDOR_HOST)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Names of attibutes from config file to insert into STARTD ads"},
+	{Name: "STARTD_LATCH_EXPRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "STARTD_ATTRS that HTCondor will periodically evaluate and timestamp changes"},
+	{Name: "ENABLE_STARTD_DAEMON_AD", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: "Enable a singular daemon ad for Startds, and separate Slot ads for each slot."},
+	{Name: "CONTINUE_TO_ADVERTISE_BROKEN_DYNAMIC_SLOTS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Dynamic slots in broken state should continue to exist as slots"},
+	{Name: "COLLECTOR_HOST_STRING", Default: "\"$(COLLECTOR_HOST)\"", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SYSTEM_STARTD_ATTRS", Default: "COLLECTOR_HOST_STRING DedicatedScheduler", Win32Default: "", Type: "string", Range: "", Description: "Configuration attibutes that HTCondor needs to insert into STARTD ads"},
+	{Name: "SYSTEM_MAX_RELEASES", Default: "-1", Win32Default: "", Type: "int", Range: "", Description: "The maximum number of times a user can release a single held job"},
+	{Name: "SYSTEM_STARTD_LATCH_EXPRS", Default: "NumDynamicSlots", Win32Default: "", Type: "string", Range: "", Description: "SYSTEM_STARTD_ATTRS that HTCondor will periodically evaluate and timestamp changes"},
+	{Name: "NO_DNS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NETWORK_HOSTNAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NETWORK_INTERFACE", Default: "*", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_DOMAIN_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOWPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "IN_LOWPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "OUT_LOWPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "HIGHPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "IN_HIGHPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "OUT_HIGHPORT", Default: "", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CRED_MIN_TIME_LEFT", Default: "120", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "ALL_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Options for all debug logs"},
+	{Name: "LOGS_USE_TIMESTAMP", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "FILE_LOCK_VIA_MUTEX", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IGNORE_NFS_LOCK_ERRORS", Default: "false", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SENDMAIL", Default: "sendmail", Win32Default: "", Type: "path", Range: "", Description: "Path to sendmail"},
+	{Name: "MAIL", Default: "/usr/bin/mail", Win32Default: "$(BIN)\\condor_mail.exe", Type: "path", Range: "", Description: ""},
+	{Name: "SMTP_SERVER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONDOR_SUPPORT_EMAIL", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_RESOURCE_PREFIX", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_RECOMPUTE_DISK_FREE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTD_JOB_HOOK_KEYWORD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTER_LIST", Default: "STARTER", Win32Default: "STARTER", Type: "string", Range: "", Description: ""},
+	{Name: "SBIN", Default: "$(RELEASE_DIR)/sbin", Win32Default: "$(BIN)", Type: "path", Range: "", Description: ""},
+	{Name: "SINGULARITY", Default: "$(LIBEXEC)/apptainer", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SINGULARITY_JOB", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SINGULARITY_VERBOSITY", Default: "-s", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SINGULARITY_IMAGE_EXPR", Default: "SingularityImage", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SINGULARITY_MOUNT_HOME", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SINGULARITY_TEST_SANDBOX", Default: "$(LIBEXEC)/singularity_test_sandbox", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SINGULARITY_USE_PID_NAMESPACES", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: "Rate at which the Startd sends updates to the Collector"},
+	{Name: "STARTD_SENDS_ALIVES", Default: "peer", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTER_HANDLES_ALIVES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Startd should only send alives when slot idle"},
+	{Name: "VM_GAHP_CONFIG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_MAX_NUMBER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_MEMORY", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_RECHECK_INTERVAL", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LOCK", Default: "$(LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "RUN", Default: "$(LOCK)", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "VM_KILLING_TIMEOUT", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "RunBenchmarks", Default: "LastBenchmark == 0", Win32Default: "", Type: "string", Range: "", Description: "Expression that when true, causes the STARTD to run benchmarks"},
+	{Name: "POLLING_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "UPDATE_SPREAD_TIME", Default: "$(UPDATE_COLLECTOR_WITH_TCP:0) ? 0 : 8", Win32Default: "", Type: "int", Range: "", Description: "If nonzero, spreads the startd's updates to the collector over that many seconds.  8 used to be the default for both TCP and UDP."},
+	{Name: "ACCOUNTANT_HOST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MATCH_TIMEOUT", Default: "120", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "KILLING_TIMEOUT", Default: "30", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "MAX_CLAIM_ALIVES_MISSED", Default: "6", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SYSTEM_STARTD_JOB_ATTRS", Default: "ImageSize, ExecutableSize, JobUniverse, NiceUser, CPUsUsage, ResidentSetSize, ProportionalSetSizeKb, MemoryUsage, DiskUsage, StdoutMtime, StderrMtime, ScratchDirFileCount", Win32Default: "", Type: "string", Range: "", Description: "Job Attributes that must be copied into the STARTD ad for HTCondor to work correctly"},
+	{Name: "STARTD_JOB_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Job Attributes to copy into the STARTD ad"},
+	{Name: "STARTD_SLOT_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Names of Attributes that should be cross-posted from each Startd slot to all of the other slots"},
+	{Name: "SYSTEM_STARTD_SLOT_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Startd Slot Attrs needed by HTCondor"},
+	{Name: "BROKEN_SLOT_CONTEXT_ATTRS", Default: "JobId,RemoteUser,RemoteScheddName", Win32Default: "", Type: "string", Range: "", Description: "Names of Attributes from the job or claim that should be saved when a slot becomes broken"},
+	{Name: "KEYBOARD_CPUS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DISCONNECTED_KEYBOARD_IDLE_BOOST", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTD_NOCLAIM_SHUTDOWN", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTD_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VALID_COD_USERS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_SHOULD_WRITE_CLAIM_ID_FILE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "START", Default: "True", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ENABLE_BACKFILL", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "BACKFILL_SYSTEM", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_SLOT_TYPES", Default: "10", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTD_CRON_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Obsolete Startd Cron Name"},
+	{Name: "STARTD_CRON_AUTOPUBLISH", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_DETECT_GPUS", Default: "-properties $(GPU_DISCOVERY_EXTRA)", Win32Default: "", Type: "string", Range: "", Description: "Enable GPUs as a resource and set arguments for condor_gpu_discovery when USE FEATURE:GPUs is not configured"},
+	{Name: "STARTD_LEFTOVER_PROCS_BREAK_SLOTS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ENVIRONMENT_FOR_AssignedGPUs", Default: "GPU_DEVICE_ORDINAL=/(CUDA|OCL)//  CUDA_VISIBLE_DEVICES=/CUDA//", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ENVIRONMENT_VALUE_FOR_UnAssignedGPUs", Default: "10000", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CLASSAD_USER_LIBS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Classad Lib Path"},
+	{Name: "CLASSAD_USER_PYTHON_LIB", Default: "$(LIBEXEC)/libclassad_python_user.so", Win32Default: "$(BIN)\\classad_python_user$(PYTHON3_MODULE_SUFFIX:.pyd)", Type: "string", Range: "", Description: "ClassAd python library path"},
+	{Name: "CLASSAD_USER_PYTHON_MODULES", Default: "", Win32Default: "", Type: "string", Range: "", Description: "ClassAd python modules"},
+	{Name: "ENABLE_CLASSAD_CACHING", Default: "true", Win32Default: "true", Type: "bool", Range: "", Description: ""},
+	{Name: "MASTER.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHADOW.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER.ENABLE_CLASSAD_CACHING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "WANT_XML_LOG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT_ALLOW_GETENV", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT_SKIP_FILECHECKS", Default: "true", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SUBMIT_GENERATE_CUSTOM_RESOURCE_REQUIREMENTS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Submit should generate requirements clauses for job attributes that begin with Request"},
+	{Name: "SUBMIT_GENERATE_CONDOR_C_REQUIREMENTS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT_DEFAULT_SHOULD_TRANSFER_FILES", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Set the default value submit should use for should_transfer_files for jobs that don't specify"},
+	{Name: "SUBMIT_SEND_RESCHEDULE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT_PUBLISH_WINDOWS_OSVERSIONINFO", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Submit should put attributes into jobs that show the Windows OSVERSIONINFO"},
+	{Name: "SUBMIT_CONTAINER_NEVER_XFER_ABSOLUTE_CMD", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DEFAULT_UNIVERSE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_IO_BUFFER_SIZE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_IO_BUFFER_BLOCK_SIZE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_INTERVAL", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_RANK_STANDARD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_RANK_STANDARD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_RANK_VANILLA", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_RANK_VANILLA", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFAULT_RANK", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_RANK", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOG_ON_NFS_IS_ERROR", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Names of config attributes that should be automatically inserted into jobs by condor_submit"},
+	{Name: "APPEND_REQ_VANILLA", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_REQ_STANDARD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_REQ_VM", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "APPEND_REQUIREMENTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "WARN_ON_UNUSED_SUBMIT_FILE_MACROS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "WINDOWS_FIREWALL_FAILURE_RETRY", Default: "2", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CREDD_CACHE_LOCALLY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CREDD_POLLING_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "Q_QUERY_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PROCD_LOG", Default: "$(LOG)/ProcLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "PROCD", Default: "$(SBIN)/condor_procd", Win32Default: "$(SBIN)\\condor_procd.exe", Type: "path", Range: "", Description: "Path to Procd binary"},
+	{Name: "PROCD_MAX_SNAPSHOT_INTERVAL", Default: "60", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PROCD_DEBUG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Debug logging options for the procd"},
+	{Name: "USE_GID_PROCESS_TRACKING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "MIN_TRACKING_GID", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MAX_TRACKING_GID", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "RESTART_PROCD_ON_ERROR", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "LOCAL_CONFIG_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ABORT_ON_EXCEPTION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "REQUIRE_LOCAL_CONFIG_FILE", Default: "true", Win32Default: "false", Type: "bool", Range: "", Description: ""},
+	{Name: "LOCAL_CONFIG_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: "List of paths to local config files"},
+	{Name: "ENABLE_RUNTIME_CONFIG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "RUNTIME_CONFIG_ADMIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "USER_CONFIG_FILE", Default: "user_config", Win32Default: "", Type: "string", Range: "", Description: "Name of User Config File"},
+	{Name: "ENABLE_PERSISTENT_CONFIG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "PERSISTENT_CONFIG_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "USE_PROCD", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "PLUGINS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PLUGIN_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JAVA", Default: "/usr/bin/java", Win32Default: "java", Type: "string", Range: "", Description: ""},
+	{Name: "JAVA_MAXHEAP_ARGUMENT", Default: "-Xmx", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JAVA_CLASSPATH_ARGUMENT", Default: "-classpath", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JAVA_CLASSPATH_SEPARATOR", Default: ":", Win32Default: ";", Type: "string", Range: "", Description: ""},
+	{Name: "JAVA_CLASSPATH_DEFAULT", Default: "$(LIB) .", Win32Default: "$(BIN) .", Type: "string", Range: "", Description: ""},
+	{Name: "TMP_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TEMP_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SSH_KEYGEN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SSH_KEYGEN_ARGS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SSHD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SSHD_ARGS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCP", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONDOR_SSH_TO_JOB_FAKE_PASSWD_ENTRY", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ENABLE_USERLOG_FSYNC", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ENABLE_USERLOG_LOCKING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ALWAYS_CLOSE_USERLOG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EVENT_LOG", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "EVENT_LOG_USE_XML", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EVENT_LOG_LOCKING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EVENT_LOG_ROTATION_LOCK", Default: "$(LOCK)/EventLogLock", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "EVENT_LOG_MAX_SIZE", Default: "1000000", Win32Default: "", Type: "long", Range: "", Description: "Maximum size of the event log in bytes"},
+	{Name: "EVENT_LOG_MAX_ROTATIONS", Default: "1", Win32Default: "", Type: "long", Range: "", Description: "Number of rotated event log files to keep"},
+	{Name: "EVENT_LOG_FSYNC", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EVENT_LOG_COUNT_EVENTS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EVENT_LOG_JOB_AD_INFORMATION_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REUSE_CONDOR_RUN_ACCOUNT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PASSWD_CACHE_REFRESH", Default: "72000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTD_CLAIM_ID_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_PASSWORD_FILE", Default: "$(SEC_PASSWORD_DIRECTORY)/POOL", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "SEC_PASSWORD_DIRECTORY", Default: "$(ETC)/passwords.d", Win32Default: "$(RELEASE_DIR)\\tokens.sk", Type: "path", Range: "", Description: ""},
+	{Name: "SEC_TOKEN_SYSTEM_DIRECTORY", Default: "$(ETC)/tokens.d", Win32Default: "$(RELEASE_DIR)\\tokens.d", Type: "path", Range: "", Description: ""},
+	{Name: "SEC_TOKEN_DIRECTORY", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Directory containing the tokens for token auth."},
+	{Name: "SEC_TOKEN_ISSUER_KEY", Default: "POOL", Win32Default: "", Type: "string", Range: "", Description: "Name of the key used to issue session tokens"},
+	{Name: "SEC_TOKEN_FETCH_ALLOWED_SIGNING_KEYS", Default: "POOL AP", Win32Default: "", Type: "string", Range: "", Description: "StringList of keys allowed to sign from condor_token_request"},
+	{Name: "SEC_TOKEN_AP_SIGNING_KEY_NAME", Default: "AP", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_TOKEN_POOL_SIGNING_KEY_FILE", Default: "$(SEC_PASSWORD_FILE)", Win32Default: "$(RELEASE_DIR)\\tokens.sk\\POOL", Type: "path", Range: "", Description: "Path to the POOL token signing key"},
+	{Name: "SEC_TOKEN_MAX_AGE", Default: "", Win32Default: "", Type: "int", Range: "", Description: "Maximum age of accepted tokens."},
+	{Name: "SEC_TOKEN_REQUEST_LIMITS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Authorization limits for token requests"},
+	{Name: "SEC_TOKEN_REVOCATION_EXPR", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Expression describing token revocation."},
+	{Name: "SEC_ISSUED_TOKEN_EXPIRATION", Default: "", Win32Default: "", Type: "int", Range: "", Description: "Default max expiration time of issued session tokens."},
+	{Name: "SEC_ENABLE_TOKEN_REQUEST", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_ENABLE_TOKEN_FETCH", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SEC_ENABLE_SCITOKEN_EXCHANGE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "EMAIL_DOMAIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_TCP_SESSION_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SEC_CLAIMTOBE_USER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SEC_CLAIMTOBE_INCLUDE_DOMAIN", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SSL_SKIP_HOST_CHECK", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Disable client host checks for SSL authentication."},
+	{Name: "AUTH_SSL_SERVER_USE_DEFAULT_CAS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_CLIENT_USE_DEFAULT_CAS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_SERVER_CAFILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Location of additonal CA bundle"},
+	{Name: "AUTH_SSL_SERVER_CADIR", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Location of additonal CA bundle"},
+	{Name: "AUTH_SSL_CLIENT_CAFILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Location of additional CA bundle"},
+	{Name: "AUTH_SSL_CLIENT_CADIR", Default: "", Win32Default: "", Type: "path", Range: "", Description: "Location of additional CA bundle"},
+	{Name: "AUTH_SSL_SERVER_CERTFILE", Default: "/etc/pki/tls/certs/localhost.crt,$(AUTH_SSL_AUTOGENERATE_CERTFILE)", Win32Default: "", Type: "path", Range: "", Description: "Location of the host X509 certificate"},
+	{Name: "AUTH_SSL_SERVER_KEYFILE", Default: "/etc/pki/tls/private/localhost.key,$(AUTH_SSL_AUTOGENERATE_KEYFILE)", Win32Default: "", Type: "path", Range: "", Description: "Location of the host private key"},
+	{Name: "AUTH_SSL_AUTOGENERATE_CERTFILE", Default: "$(ETC)/hostcert.pem", Win32Default: "", Type: "path", Range: "", Description: "Location of the auto-generated host X509 certificate"},
+	{Name: "AUTH_SSL_AUTOGENERATE_KEYFILE", Default: "$(ETC)/hostkey.pem", Win32Default: "", Type: "path", Range: "", Description: "Location of the auto-generated host private key"},
+	{Name: "AUTH_SSL_REQUIRE_CLIENT_CERTIFICATE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_REQUIRE_CLIENT_MAPPING", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_ALLOW_CLIENT_PROXY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTH_SSL_USE_CLIENT_PROXY_ENV_VAR", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "BOOTSTRAP_SSL_SERVER_TRUST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Automatically trust the remote CA over SSL"},
+	{Name: "BOOTSTRAP_SSL_SERVER_TRUST_PROMPT_USER", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR_BOOTSTRAP_SSL_CERTIFICATE", Default: "true", Win32Default: "false", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUST_DOMAIN_CAFILE", Default: "$(ETC)/trust_domain_ca.pem", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "TRUST_DOMAIN_CAKEY", Default: "$(ETC)/trust_domain_ca_privkey.pem", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "SEC_SYSTEM_KNOWN_HOSTS", Default: "$(ETC)/known_hosts", Win32Default: "$(LOCAL_DIR)\\known_hosts", Type: "path", Range: "", Description: ""},
+	{Name: "TRUST_DOMAIN", Default: "$(UID_DOMAIN)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FS_REMOTE_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FS_LOCAL_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FS_ALLOW_UNSAFE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "KERBEROS_MAP_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ENCRYPT_SECRETS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CONDOR_GATEKEEPER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTER_UPLOAD_TIMEOUT", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "VM_GAHP_SEND_ALL_CLASSAD", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_INITIAL_UPDATE_INTERVAL", Default: "8", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTER_ALWAYS_HOLD_ON_OOM", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DISABLE_EXECUTE_DIRECTORY_ENCRYPTION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ENCRYPT_EXECUTE_DIRECTORY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_JOB_ENVIRONMENT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTER_NESTED_SCRATCH", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_NUM_THREADS_ENV_VARS", Default: "CUBACORES GOMAXPROCS JULIA_NUM_THREADS MKL_NUM_THREADS NUMEXPR_NUM_THREADS OMP_NUM_THREADS OMP_THREAD_LIMIT OPENBLAS_NUM_THREADS PYTHON_CPU_COUNT ROOT_MAX_THREADS TF_LOOP_PARALLEL_ITERATIONS TF_NUM_THREADS", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTER_HIDE_GPU_DEVICES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_FILE_XFER_STALL_TIMEOUT", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JOB_RENICE_INCREMENT", Default: "0", Win32Default: "10", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_INHERITS_STARTER_ENVIRONMENT", Default: "false", Win32Default: "", Type: "string", Range: "", Description: "list of allow and deny patterns that determine what environment variables to inhert from the starter environment"},
+	{Name: "MPI_CONDOR_RSH_PATH", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOCAL_UNIVERSE_MAX_JOB_CLEANUP_RETRIES", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LOCAL_UNIVERSE_JOB_CLEANUP_RETRY_DELAY", Default: "30", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "LOCAL_UNIVERSE_CGROUP_ENFORCEMENT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_JOB_HOOK_KEYWORD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM_STATUS_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "VM_STATUS_MAX_ERROR", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "VM_GAHP_REQ_TIMEOUT", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "VM_SOFT_SUSPEND", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CONDOR_GAHP_WORKER", Default: "$(SBIN)/condor_c-gahp_worker_thread", Win32Default: "$(SBIN)\\condor_c-gahp_worker_thread.exe", Type: "path", Range: "", Description: ""},
+	{Name: "REPLICATION_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_CROSS_SLOT_PRIOS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Negotiator should advertise cross slot user prios"},
+	{Name: "NEGOTIATOR_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MIN_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_UPDATE_AFTER_CYCLE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_TRIM_SHUTDOWN_THRESHOLD", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_TIMEOUT", Default: "30", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_SOCKET_CACHE_SIZE", Default: "500", Win32Default: "", Type: "int", Range: "1,", Description: ""},
+	{Name: "NEGOTIATOR_MAX_TIME_PER_SUBMITTER", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MAX_TIME_PER_SCHEDD", Default: "120", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MAX_TIME_PER_PIESPIN", Default: "120", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MAX_TIME_PER_CYCLE", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MATCH_EXPRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_NUM_THREADS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PREEMPTION_RANK", Default: "(RemoteUserPrio * 1000000) - ifThenElse(isUndefined(TotalJobRuntime), 0, TotalJobRuntime)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_PRE_JOB_RANK", Default: "(10000000 * My.Rank) + (1000000 * (RemoteOwner =?= UNDEFINED)) - (100000 * Cpus) - Memory", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_POST_JOB_RANK", Default: "(RemoteOwner =?= UNDEFINED) * (ifthenElse(isUndefined(KFlops), 1000, Kflops) - SlotID - 1.0e10*(Offline=?=True))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_MATCHLIST_CACHING", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_CONSIDER_PREEMPTION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_CONSIDER_EARLY_PREEMPTION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_DEPTH_FIRST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_INFORM_STARTD", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_USE_NONBLOCKING_STARTD_CONTACT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_USE_WEIGHTED_DEMAND", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_SLOT_POOLSIZE_CONSTRAINT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_SLOT_CONSTRAINT", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Constrain Negotiator Query for Startd Ads"},
+	{Name: "NEGOTIATOR_JOB_CONSTRAINT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GROUP_AUTOREGROUP", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "GROUP_ACCEPT_SURPLUS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_USE_SLOT_WEIGHTS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Negotiator should use slot weights"},
+	{Name: "NEGOTIATOR_ALLOW_QUOTA_OVERSUBSCRIPTION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Negotiator should allow quota oversubscription"},
+	{Name: "GROUP_QUOTA_MAX_ALLOCATION_ROUNDS", Default: "3", Win32Default: "", Type: "int", Range: "1,1000000000", Description: ""},
+	{Name: "GROUP_QUOTA_ROUND_ROBIN_RATE", Default: "1.0e100", Win32Default: "", Type: "double", Range: "1.0,1.0e100", Description: ""},
+	{Name: "STARTD_AD_REEVAL_EXPR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_IGNORE_USER_PRIORITIES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR_HOST_FOR_NEGOTIATOR", Default: "$(FULL_HOSTNAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "RECEIVE_MSGS_DURATION", Default: "250", Win32Default: "", Type: "int", Range: "", Description: "How long daemonCore should receive messages before checking the pump"},
+	{Name: "NONBLOCKING_COLLECTOR_UPDATE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TCP_UPDATE_COLLECTORS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "UPDATE_COLLECTOR_WITH_TCP", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "UPDATE_VIEW_COLLECTOR_WITH_TCP", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DEAD_COLLECTOR_MAX_AVOIDANCE_TIME", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CM_IP_ADDR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HISTORY", Default: "$(SPOOL)/history", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_HISTORY", Default: "$(LOG)/startd_history", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_EPOCH_HISTORY", Default: "$(SPOOL)/epoch_history", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_DAEMON_HISTORY", Default: "$(SPOOL)/schedd_daemon_history", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HISTORY_CONTAINS_JOB_ENVIRONMENT", Default: "true", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREEN_ADMIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SYSTEM_VALID_SPOOL_FILES", Default: "job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_address.super, .schedd_classad, OfflineLog, startd_disk.img, checkpoint-cleanup", Win32Default: "", Type: "string", Range: "", Description: "List of files in the SPOOL directory needed by HTCondor"},
+	{Name: "VALID_SPOOL_FILES", Default: "", Win32Default: "", Type: "string", Range: "", Description: "List of user files in the SPOOL directory that should not be deleted."},
+	{Name: "INVALID_LOG_FILES", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LIB", Default: "$(RELEASE_DIR)/lib", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "SEC_PASSWORD_DOMAIN", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_SOURCE_JOB_CONSTRAINT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_JOB_MIRROR_UPDATE_LAG", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_POLLING_PERIOD", Default: "10", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_NAME", Default: "jobrouter", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_LOCK", Default: "$(LOCK)/$(JOB_ROUTER_NAME)Lock", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_ROUND_ROBIN_SELECTION", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "POLLING_PERIOD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "QUEUE_SUPER_USERS", Default: "root, condor", Win32Default: "condor, SYSTEM", Type: "string", Range: "", Description: "List of users that can edit other users jobs"},
+	{Name: "QUEUE_SUPER_USER_MAY_IMPERSONATE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "QUEUE_ALL_USERS_TRUSTED", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "All users can edit other users jobs"},
+	{Name: "SYSTEM_SECURE_JOB_ATTRS", Default: "x509userProxySubject x509UserProxyEmail x509UserProxyVOName x509UserProxyFirstFQAN x509UserProxyFQAN TotalSubmitProcs AuthTokenSubject AuthTokenIssuer AuthTokenGroups AuthTokenId AuthTokenScopes OsUser", Win32Default: "", Type: "string", Range: "", Description: "System defined list of job attributes that only the Schedd can change"},
+	{Name: "SECURE_JOB_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Admin defined list of job attributes that only the Schedd can change"},
+	{Name: "IGNORE_ATTEMPTS_TO_SET_SECURE_JOB_ATTRS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Silently ignore attempts to set SECURE_JOB_ATTRS"},
+	{Name: "SYSTEM_IMMUTABLE_JOB_ATTRS", Default: "Owner ClusterId ProcId MyType TargetType User OsUser", Win32Default: "", Type: "string", Range: "", Description: "System defined list of job attributes that may not change once set"},
+	{Name: "IMMUTABLE_JOB_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Admin defined list of job attributes that may not change once set"},
+	{Name: "SYSTEM_PROTECTED_JOB_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "System defined list of job attributes that may only be changed by a queue superuser once set"},
+	{Name: "PROTECTED_JOB_ATTRS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Admin defined list of job attributes that may only be changed by a queue superuser once set"},
+	{Name: "GLOBAL_JOB_ID_WITH_TIME", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CLAIM_RECYCLING_CONSIDER_LIMITS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "UNUSED_CLAIM_TIMEOUT", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SCHEDD_PREEMPTION_REQUIREMENTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_PREEMPTION_RANK", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_RESTART_REPORT", Default: "$(LOG)/ScheddRestartReport", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ALLOW_LATE_MATERIALIZE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Set to false to have Schedd refuse late materialization submits"},
+	{Name: "SCHEDD_ASSUME_NEGOTIATOR_GONE", Default: "2000000000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SCHEDD_NON_DURABLE_LATE_MATERIALIZE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Set to false to use slow but durable transaction semantics for each materialized job."},
+	{Name: "SCHEDD_SEND_RESCHEDULE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DEDICATED_SCHEDULER_USE_FIFO", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "MPI_MASTER_IP", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHADOW_LIST", Default: "SHADOW", Win32Default: "SHADOW", Type: "string", Range: "", Description: ""},
+	{Name: "SHADOW", Default: "$(SBIN)/condor_shadow", Win32Default: "$(SBIN)\\condor_shadow.exe", Type: "path", Range: "", Description: "Path to vanilla shadow binary"},
+	{Name: "SHADOW_RENICE_INCREMENT", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "STARTER_LOCAL", Default: "$(SBIN)/condor_starter", Win32Default: "$(SBIN)\\condor_starter.exe", Type: "path", Range: "", Description: ""},
+	{Name: "JOB_QUEUE_LOG", Default: "$(SPOOL)/job_queue.log", Win32Default: "$(SPOOL)\\job_queue.log", Type: "path", Range: "", Description: ""},
+	{Name: "LOCAL_UNIV_EXECUTE", Default: "$(SPOOL)/local_univ_execute", Win32Default: "$(SPOOL)\\local_univ_execute", Type: "path", Range: "", Description: ""},
+	{Name: "SCHED_UNIV_RENICE_INCREMENT", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SCHEDD_SEND_VACATE_VIA_TCP", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "KEEP_OUTPUT_SANDBOX", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ENABLE_HISTORY_ROTATION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "PER_JOB_HISTORY_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_MIN_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JOB_START_DELAY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "JOB_START_COUNT", Default: "1", Win32Default: "", Type: "int", Range: "1,", Description: ""},
+	{Name: "MAX_JOBS_RUNNING", Default: "MIN({$(DETECTED_MEMORY), 10000})", Win32Default: "MIN({($(DETECTED_MEMORY)-200)/10, 2000})", Type: "int", Range: "", Description: ""},
+	{Name: "CURB_MATCHMAKING", Default: "(RecentDaemonCoreDutyCycle > 0.98) || (TransferQueueNumWaitingToUpload > TransferQueueMaxUploading)", Win32Default: "", Type: "string", Range: "", Description: "Curb Expression for Matchmaking"},
+	{Name: "START_LOCAL_UNIVERSE", Default: "TotalLocalJobsRunning < 200", Win32Default: "", Type: "string", Range: "", Description: "expression that, when TRUE allows a Local universe job to start"},
+	{Name: "START_SCHEDULER_UNIVERSE", Default: "TotalSchedulerJobsRunning < 500", Win32Default: "", Type: "string", Range: "", Description: "expression that, when TRUE allows a Scheduler universe job to start"},
+	{Name: "MAX_JOBS_SUBMITTED", Default: "2147483647", Win32Default: "", Type: "int", Range: "", Description: "Max jobs in the SCHEDD's queue"},
+	{Name: "MAX_JOBS_PER_OWNER", Default: "100000", Win32Default: "", Type: "int", Range: "", Description: "Max jobs in the SCHEDD's queue from a single owner."},
+	{Name: "MAX_JOBS_PER_SUBMISSION", Default: "20000", Win32Default: "", Type: "int", Range: "", Description: "Max jobs in a single submit"},
+	{Name: "ALLOW_SUBMIT_FROM_KNOWN_USERS_ONLY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Restrict job submission to users that already have already been added using condor_qusers"},
+	{Name: "MAX_RUNNING_SCHEDULER_JOBS_PER_OWNER", Default: "200", Win32Default: "", Type: "string", Range: "", Description: "Max running DAGs for a single owner"},
+	{Name: "NEGOTIATE_ALL_JOBS_IN_CLUSTER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_CONTACT_TIMEOUT", Default: "45", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "FLOCK_COLLECTOR_HOSTS", Default: "$(FLOCK_TO)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FLOCK_NEGOTIATOR_HOSTS", Default: "$(FLOCK_TO)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FLOCK_INCREMENT", Default: "1", Win32Default: "", Type: "int", Range: "1,", Description: ""},
+	{Name: "MIN_FLOCK_LEVEL", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "FLOCK_BY_DEFAULT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHADOW_SIZE_ESTIMATE", Default: "125", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MAX_SHADOW_EXCEPTIONS", Default: "2", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MAX_PENDING_STARTD_CONTACTS", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "GRIDMANAGER_SELECTION_EXPR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_LOG_APPEND_SELECTION_EXPR", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "MAX_JOB_QUEUE_LOG_ROTATIONS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "GRIDMANAGER", Default: "$(SBIN)/condor_gridmanager", Win32Default: "$(SBIN)\\condor_gridmanager.exe", Type: "path", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_ARGS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_CRON_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_BACKUP_SPOOL", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CHOWN_JOB_SPOOL_FILES", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SCHEDD_QUERY_WORKERS", Default: "8", Win32Default: "", Type: "int", Range: "", Description: "Maximum number of schedd forked workers"},
+	{Name: "HA_LOCK_URL", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HA_LOCK_HOLD_TIME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HA_POLL_PERIOD", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MASTER_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "START_MASTER", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "START_DAEMONS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PUBLISH_OBITUARIES", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "OBITUARY_LOG_LENGTH", Default: "200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "ALLOW_ADMIN_COMMANDS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ALLOW_CLIENT", Default: "*", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREEN", Default: "$(SBIN)/condor_preen", Win32Default: "$(SBIN)\\condor_preen.exe", Type: "path", Range: "", Description: "Path to preen binary"},
+	{Name: "DC_DAEMON_LIST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MASTER_HA_LIST", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DAEMON_LIST", Default: "MASTER", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREEN_ARGS", Default: "-m -r", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "COLLECTOR_LOG", Default: "$(LOG)/CollectorLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for the Collector"},
+	{Name: "KBDD_LOG", Default: "$(LOG)/KbdLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for KBDD"},
+	{Name: "MASTER_LOG", Default: "$(LOG)/MasterLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for the Master"},
+	{Name: "NEGOTIATOR_LOG", Default: "$(LOG)/NegotiatorLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for the Negotiator"},
+	{Name: "NEGOTIATOR_MATCH_LOG", Default: "$(LOG)/MatchLog", Win32Default: "", Type: "path", Range: "", Description: "Path to Negotiator log of matches"},
+	{Name: "SCHEDD_LOG", Default: "$(LOG)/SchedLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for the Schedd"},
+	{Name: "SHADOW_LOG", Default: "$(LOG)/ShadowLog", Win32Default: "", Type: "path", Range: "", Description: "Path to Log shared by Shadows"},
+	{Name: "SHADOW_STATS_LOG", Default: "$(LOG)/XferStatsLog", Win32Default: "", Type: "path", Range: "", Description: "Log file of Xfer stats"},
+	{Name: "STARTER_STATS_LOG", Default: "$(LOG)/XferStatsLog", Win32Default: "", Type: "path", Range: "", Description: "Log file of Xfer stats"},
+	{Name: "STARTD_LOG", Default: "$(LOG)/StartLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for the Startd"},
+	{Name: "JOB_ROUTER_LOG", Default: "$(LOG)/JobRouterLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ROOSTER_LOG", Default: "$(LOG)/RoosterLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "SHARED_PORT_LOG", Default: "$(LOG)/SharedPortLog", Win32Default: "", Type: "path", Range: "", Description: "Path to log file for shared_port"},
+	{Name: "HAD_LOG", Default: "$(LOG)/HADLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "REPLICATION_LOG", Default: "$(LOG)/ReplicationLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "TRANSFERER_LOG", Default: "$(LOG)/TransfererLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ADD_WINDOWS_FIREWALL_EXCEPTION", Default: "$(CondorIsAdmin)", Win32Default: "", Type: "string", Range: "", Description: "Master should automatically open ports in the Windows firewall for HTCondor daemons"},
+	{Name: "ANNEX_COMMAND_STATE", Default: "$(LOG)/AnnexCommand.state", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ANNEX_LOG", Default: "$(LOG)/AnnexLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ANNEX_AUDIT_LOG", Default: "$(LOG)/AnnexAuditLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "ANNEX_GAHP_LOG", Default: "$(LOG)/AnnexGahpLog", Win32Default: "NUL", Type: "path", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_LEASE_DURATION", Default: "3000", Win32Default: "", Type: "long", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_UNCLAIMED_TIMEOUT", Default: "900", Win32Default: "", Type: "long", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_AWS_REGION", Default: "us-east-1", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_EC2_URL", Default: "https://ec2.us-east-1.amazonaws.com", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_CWE_URL", Default: "https://events.us-east-1.amazonaws.com", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_LAMBDA_URL", Default: "https://lambda.us-east-1.amazonaws.com", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_S3_URL", Default: "https://s3.amazonaws.com", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_CF_URL", Default: "https://cloudformation.us-east-1.amazonaws.com/", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_CONNECTIVITY_FUNCTION_ARN", Default: "HTCondorAnnex-CheckConnectivity", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_ODI_INSTANCE_TYPE", Default: "$(ANNEX_DEFAULT_ODI_INSTANCE_TYPE_V8_7_0)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ANNEX_DEFAULT_ODI_INSTANCE_TYPE_V8_7_0", Default: "m4.large", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_southeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-078d5c7afa60d8d1b", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_southeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0463306823b53e30", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_northeast_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-02c69d521a0809249", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_northeast_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0c07ed1dc0cea3608", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ap_south_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-024615f49be85226a", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "sa_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-0b9bff095bcf587b9", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ca_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_4", Default: "ami-00ca51dc8295a9e4f", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-0b0cba432f6183314", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_central_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0e5c4998acc873d00", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-04cf1a4bcdb6c2ddb", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-052abc65f9a1f2191", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-05db8a05b808cc82a", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-054747f2946a308fc", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-000db792307d7ec4c", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_west_3.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-07ed49c22921c0d31", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "eu_north_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_9_3", Default: "ami-0628aa554d3b907a5", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-02ce5672c4c88146c", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-0c2bae939a03e211a", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0582916cc08a1d81e", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-007920684150d7cb7", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-02a90df644af3b003", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_east_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-0f61cdd6883793833", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-0a6fbc8bda794e1f4", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-0f433606b5f80ccab", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_1.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-084d3d008e3740806", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID", Default: "$(us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_5", Default: "ami-0a46b14d914d27167", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_8_4", Default: "ami-05cabd3969196c5ba", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "us_west_2.ANNEX_DEFAULT_ODI_IMAGE_ID_V8_7_8", Default: "ami-00daecb4d3b8f077f", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HPC_ANNEX_ENABLED", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Boolean indicating whether or not HPC annexes have been enabled"},
+	{Name: "ANNEX_COLLECTOR", Default: "", Win32Default: "", Type: "string", Range: "", Description: "HPC annex collector address"},
+	{Name: "ANNEX_TOKEN_DOMAIN", Default: "$(TRUST_DOMAIN)", Win32Default: "", Type: "string", Range: "", Description: "HPC annex collector's token domain (i.e. the domain in annex collector tokens' sub claim)"},
+	{Name: "SCHEDD", Default: "$(SBIN)/condor_schedd", Win32Default: "$(SBIN)\\condor_schedd.exe", Type: "path", Range: "", Description: "Path to the HTCondor scheduler daemon binary"},
+	{Name: "STARTD", Default: "$(SBIN)/condor_startd", Win32Default: "$(SBIN)\\condor_startd.exe", Type: "path", Range: "", Description: "Path to the HTCondor startd daemon binary"},
+	{Name: "CCB_POLLING_TIMESLICE", Default: "0.05", Win32Default: "", Type: "double", Range: "", Description: ""},
+	{Name: "CCB_POLLING_INTERVAL", Default: "20", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CCB_POLLING_MAX_INTERVAL", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CCB_SERVER_READ_BUFFER", Default: "2048", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CCB_SERVER_WRITE_BUFFER", Default: "2048", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CCB_SWEEP_INTERVAL", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CCB_RECONNECT_FILE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRUNC_MASTER_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_NEGOTIATOR_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_COLLECTOR_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_SCHED_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_STARTER_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_SHADOW_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TRUNC_MATCH_LOG_ON_OPEN", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHADOW_LOCK", Default: "$(LOCK)/ShadowLock", Win32Default: "", Type: "path", Range: "", Description: "Lock file for locking the shadow log file"},
+	{Name: "DEBUG_TIME_FORMAT", Default: "%m/%d/%y %H:%M:%S", Win32Default: "", Type: "string", Range: "", Description: "Time format for the Debug log files"},
+	{Name: "MASTER_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHADOW_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SCHED_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_DEBUG_WAIT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_READ_CONFIG_BEFORE_CYCLE", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SCHEDD_JOB_QUEUE_LOG_FLUSH_DELAY", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DAEMON_SOCKET_DIR", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHARED_PORT_DAEMON_AD_FILE", Default: "$(LOCK)/shared_port_ad", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ALWAYS_REUSEADDR", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Set SO_REUSEADDR on all sockets"},
+	{Name: "USE_SHARED_PORT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "HAD_USE_SHARED_PORT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "REPLICATION_USE_SHARED_PORT", Default: "$(HAD_USE_SHARED_PORT)", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DAGMAN_USE_SHARED_PORT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHARED_PORT_PORT", Default: "$(COLLECTOR_PORT)", Win32Default: "", Type: "int", Range: "", Description: "TCP port used by condor_shared_port"},
+	{Name: "AUTO_INCLUDE_SHARED_PORT_IN_DAEMON_LIST", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "AUTO_INCLUDE_CREDD_IN_DAEMON_LIST", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR_USES_SHARED_PORT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHARED_PORT_DEFAULT_ID", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CCB_HEARTBEAT_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "0,", Description: "Interval between CCB protocol heartbeats"},
+	{Name: "ROOSTER_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "ROOSTER_UNHIBERNATE", Default: "Offline && Unhibernate", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ROOSTER_WAKEUP_CMD", Default: "\"$(BIN)/condor_power -d -i\"", Win32Default: "", Type: "string", Range: "", Description: "Rooster wakeup command"},
+	{Name: "ROOSTER_MAX_UNHIBERNATE", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: "Rooster max unhibernate per cycle"},
+	{Name: "ROOSTER_UNHIBERNATE_RANK", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_PROCD_LOG", Default: "1000000", Win32Default: "", Type: "string", Range: "", Description: "Max Procd Log (size rotation only)"},
+	{Name: "SHADOW_WORKLIFE", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CLAIM_WORKLIFE", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "NEGOTIATION_CYCLE_STATS_LENGTH", Default: "3", Win32Default: "", Type: "int", Range: "0,100", Description: ""},
+	{Name: "SYSTEM_JOB_MACHINE_ATTRS", Default: "Cpus,SlotWeight", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SYSTEM_JOB_MACHINE_ATTRS_HISTORY_LENGTH", Default: "1", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "CREATE_LOCKS_ON_LOCAL_DISK", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "LOCAL_DISK_LOCK_DIR", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOCK_DEBUG_LOG_TO_APPEND", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "LOG_TO_SYSLOG", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Send all logs to syslog instead of files"},
+	{Name: "LOCAL_CONFIG_DIR_EXCLUDE_REGEXP", Default: "^((\\..*)|(.*\\.pl)|(.*\\.py)|(.*\\.sh)|(.*~)|(#.*)|(.*\\.rpmsave)|(.*\\.rpmnew)|(.*\\.dpkg-old)|(.*\\.dpkg-dist)|(.*\\.cfsaved))$", Win32Default: "", Type: "string", Range: "", Description: "Regular expression matching filenames to ignore in the config directory"},
+	{Name: "PARROT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DELEGATE_JOB_GSI_CREDENTIALS_REFRESH", Default: "0.25", Win32Default: "", Type: "double", Range: "0,1", Description: ""},
+	{Name: "DELEGATE_JOB_GSI_CREDENTIALS_LIFETIME", Default: "86400", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "DELEGATE_FULL_JOB_GSI_CREDENTIALS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STATISTICS_WINDOW_QUANTUM", Default: "240", Win32Default: "", Type: "int", Range: "1,1000000000", Description: "Size of Internal Statistics Ring Buffer In Seconds"},
+	{Name: "STATISTICS_WINDOW_QUANTUM_DC", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Size of Internal Statistics Ring Buffer In Seconds for DaemonCore Stats"},
+	{Name: "STATISTICS_WINDOW_QUANTUM_SCHEDD", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Size of Internal Statistics Ring Buffer In Seconds for Scheduler Stats"},
+	{Name: "STATISTICS_WINDOW_SECONDS", Default: "1200", Win32Default: "", Type: "int", Range: "1,1000000000", Description: "Size of Recent Statistics Window"},
+	{Name: "DCSTATISTICS_TIMESPANS", Default: "4m:240 20m:1200 4h:14400", Win32Default: "", Type: "string", Range: "", Description: "List of timespans for reporting DC exponential moving average statistics"},
+	{Name: "DCSTATISTICS_WINDOW_SECONDS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Size of Recent Statistics Window for DaemonCore Stats"},
+	{Name: "TCP_KEEPALIVE_INTERVAL", Default: "360", Win32Default: "", Type: "int", Range: "-1,", Description: "Setting for TCP keepalive probe interval"},
+	{Name: "SHADOW_CHECKPROXY_INTERVAL", Default: "600", Win32Default: "", Type: "int", Range: "1,", Description: "Shadow Check Proxy Interval"},
+	{Name: "SHADOW_RUN_UNKNOWN_USER_JOBS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_ProportionalSetSizeKb", Default: "25%", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_ResidentSetSize", Default: "25%", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_ExecutableSize", Default: "25%", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_ImageSize", Default: "25%", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_DiskUsage", Default: "25%", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_ROUND_ATTR_NumCkpts", Default: "4", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "WANT_SUSPEND", Default: "False", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "WANT_VACATE", Default: "True", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SUSPEND", Default: "False", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONTINUE", Default: "True", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREEMPT", Default: "False", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "KILL", Default: "False", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MachineMaxVacateTime", Default: "$(MaxVacateTime)", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "PERIODIC_CHECKPOINT", Default: "((time() - LastPeriodicCheckpoint)/60.0) > (180.0 + $RANDOM_INTEGER(-30,30,1))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_DRAINING_START_EXPR", Default: "FALSE", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_REQUIREMENTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_CANCEL_REQUIREMENTS", Default: "$(DEFRAG_WHOLE_MACHINE_EXPR)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_WHOLE_MACHINE_EXPR", Default: "Cpus == TotalSlotCpus", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_LOG", Default: "$(LOG)/DefragLog", Win32Default: "", Type: "path", Range: "", Description: "Path to Defrag daemon Log"},
+	{Name: "DEFRAG_INTERVAL", Default: "600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DEFRAG_DRAINING_MACHINES_PER_HOUR", Default: "0", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "DEFRAG_MAX_CONCURRENT_DRAINING", Default: "-1", Win32Default: "", Type: "int", Range: "-1,", Description: ""},
+	{Name: "DEFRAG_MAX_WHOLE_MACHINES", Default: "-1", Win32Default: "", Type: "int", Range: "-1,", Description: ""},
+	{Name: "DEFRAG_RANK", Default: "-ExpectedMachineGracefulDrainingBadput", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_DRAINING_SCHEDULE", Default: "graceful", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DEFRAG_UPDATE_INTERVAL", Default: "300", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DEFRAG_NAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Name of the defrag daemon"},
+	{Name: "GROUP_SORT_EXPR", Default: "ifThenElse(AccountingGroup=?=\"<none>\",3.4e+38,ifThenElse(GroupQuota>0,GroupResourcesInUse/GroupQuota,3.3e+38))", Win32Default: "", Type: "string", Range: "", Description: "Accounting Group Sorting Expression"},
+	{Name: "CLAIM_PARTITIONABLE_LEFTOVERS", Default: "$(NEGOTIATOR_USE_SLOT_WEIGHTS)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ENABLE_CLAIMABLE_PARTITIONABLE_SLOTS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "MAX_PARTITIONABLE_SLOT_CLAIM_TIME", Default: "3600", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "MOUNT_UNDER_SCRATCH", Default: "\"/tmp,/var/tmp\"", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NAMED_CHROOT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MEMORY_USAGE_METRIC", Default: "((ResidentSetSize+1023)/1024)", Win32Default: "", Type: "string", Range: "", Description: "Expression to set MemoryUsage"},
+	{Name: "MEMORY_USAGE_METRIC_VM", Default: "((ImageSize+1023)/1024)", Win32Default: "", Type: "string", Range: "", Description: "MemoryUsage metric for VM Universe jobs"},
+	{Name: "DEFAULT_JOB_MAX_RETRIES", Default: "2", Win32Default: "", Type: "int", Range: "", Description: "Default maximum number of retries when a submit file enables retries."},
+	{Name: "JOB_DEFAULT_REQUESTMEMORY", Default: "128", Win32Default: "", Type: "string", Range: "", Description: "Default RequestMemory expression if not specified in submit file"},
+	{Name: "JOB_DEFAULT_REQUESTDISK", Default: "MAX({1024, (TransferInputSizeMB+1) * 1.25}) * 1024", Win32Default: "", Type: "string", Range: "", Description: "Default RequestDisk expression if not specified in submit file"},
+	{Name: "JOB_DEFAULT_REQUESTCPUS", Default: "1", Win32Default: "", Type: "string", Range: "", Description: "Default RequestCpus expression if not specified in submit file"},
+	{Name: "JOB_DEFAULT_LEASE_DURATION", Default: "2400", Win32Default: "", Type: "string", Range: "", Description: "Default JobLeaseDuration expression if not specified in submit file"},
+	{Name: "MUST_MODIFY_REQUEST_EXPRS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Should startd modify request exprs even if it causes match failure"},
+	{Name: "MODIFY_REQUEST_EXPR_REQUESTCPUS", Default: "quantize(RequestCpus,{1})", Win32Default: "", Type: "string", Range: "", Description: "Default modification to a cpu resource request"},
+	{Name: "MODIFY_REQUEST_EXPR_REQUESTMEMORY", Default: "quantize(RequestMemory,{128})", Win32Default: "", Type: "string", Range: "", Description: "Default modification to a memory resource request"},
+	{Name: "MODIFY_REQUEST_EXPR_REQUESTDISK", Default: "quantize(RequestDisk,{1024})", Win32Default: "", Type: "string", Range: "", Description: "Default modification to a disk resource request"},
+	{Name: "CONSUMPTION_POLICY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Does a slot ad support a consumption policy?"},
+	{Name: "CONSUMPTION_CPUS", Default: "quantize(target.RequestCpus,{1})", Win32Default: "", Type: "string", Range: "", Description: "Consumption policy for cpu resource assets"},
+	{Name: "CONSUMPTION_MEMORY", Default: "quantize(target.RequestMemory,{128})", Win32Default: "", Type: "string", Range: "", Description: "Consumption policy for memory resource assets"},
+	{Name: "CONSUMPTION_DISK", Default: "quantize(target.RequestDisk,{1024})", Win32Default: "", Type: "string", Range: "", Description: "Consumption policy for disk resource assets"},
+	{Name: "SLOT_WEIGHT", Default: "Cpus", Win32Default: "", Type: "string", Range: "", Description: "Slot weight expression, resides on SlotWeight attribute"},
+	{Name: "ENABLE_DEPRECATION_WARNINGS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable warnings about the use of deprecated features"},
+	{Name: "CLASSAD_LOG_STRICT_PARSING", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable strict parse checking of classad RHS expressions in classad log files"},
+	{Name: "CLASSAD_ENABLE_USER_HOME", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable the user_home function that looks up a user's home directory"},
+	{Name: "STARTER_RLIMIT_AS", Default: "0", Win32Default: "", Type: "int", Range: "", Description: "Classad expression used to set virtual memory rlimit for job"},
+	{Name: "INTERACTIVE_SUBMIT_FILE", Default: "$(LIBEXEC)/interactive.sub", Win32Default: "", Type: "string", Range: "", Description: "Location of default submit file for condor_submit -i"},
+	{Name: "INTERACTIVE_SUBMIT_CMD_OVERRIDE", Default: "/bin/sh", Win32Default: "", Type: "string", Range: "", Description: "override CMD when -i is used with condor_submit <subfile>"},
+	{Name: "INTERACTIVE_SUBMIT_ARGS_OVERRIDE", Default: "\"-c 'sleep 180 && while test -d ${_CONDOR_SCRATCH_DIR}/.condor_ssh_to_job_1; do /bin/sleep 3; done'\"", Win32Default: "", Type: "string", Range: "", Description: "override ARGS when -i is used with condor_submit <subfile>"},
+	{Name: "MAX_GLOBAL_JOB_PRIOS", Default: "500", Win32Default: "", Type: "int", Range: "", Description: "Maximum size of JobPrioArray in submitter ad"},
+	{Name: "USE_GLOBAL_JOB_PRIOS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Consider job priorities across multiple schedds"},
+	{Name: "TRANSFER_QUEUE_USER_EXPR", Default: "strcat(\"Owner_\",Owner)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ALLOW_TRANSFER_REMAP_TO_MKDIR", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Allow transfer output remap to make directories if they don't exist"},
+	{Name: "MAX_TRANSFER_INPUT_MB", Default: "-1", Win32Default: "", Type: "int", Range: "", Description: "Maximum size of transferred input files"},
+	{Name: "MAX_TRANSFER_OUTPUT_MB", Default: "-1", Win32Default: "", Type: "int", Range: "", Description: "Maximum size of transferred output files"},
+	{Name: "MAX_REMAP_RECURSIONS", Default: "128", Win32Default: "", Type: "int", Range: "", Description: "Maximum number of filetransfer remaps to apply before aborting"},
+	{Name: "MAX_FILE_TRANSFER_PLUGIN_LIFETIME", Default: "72000", Win32Default: "", Type: "int", Range: "", Description: "Maximum number of seconds to wait for a file-transfer plug-in to finish."},
+	{Name: "TRANSFER_IO_REPORT_INTERVAL", Default: "10", Win32Default: "", Type: "int", Range: "0,", Description: "Interval between reports from file transfer processes to the transfer queue manager"},
+	{Name: "TRANSFER_IO_REPORT_TIMESPANS", Default: "1m:60 5m:300 1h:3600 1d:86400", Win32Default: "", Type: "string", Range: "", Description: "List of timespans for reporting file transfer I/O statistics"},
+	{Name: "GRACEFULLY_REMOVE_JOBS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Determines if jobs should be removed gracefully by default."},
+	{Name: "BASE_CGROUP", Default: "htcondor", Win32Default: "", Type: "string", Range: "", Description: "Enables and names the base kernel cgroup for job management"},
+	{Name: "CGROUP_POLLING_INTERVAL", Default: "5", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "CGROUP_MEMORY_LIMIT_POLICY", Default: "hard", Win32Default: "", Type: "string", Range: "", Description: "Determines whether cgroup base memory enforcement should happen"},
+	{Name: "CGROUP_HARD_MEMORY_LIMIT_EXPR", Default: "", Win32Default: "", Type: "string", Range: "", Description: "When CGROUP_MEMORY_LIMIT_POLICY is custom, sets the cgroup hard limit"},
+	{Name: "CGROUP_LOW_MEMORY_LIMIT_EXPR", Default: "", Win32Default: "", Type: "string", Range: "", Description: "When CGROUP_MEMORY_LIMIT_POLICY is custom, sets the cgroup low limit"},
+	{Name: "CGROUP_IGNORE_CACHE_MEMORY", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Whether to include kernel memory usage in job reported memory"},
+	{Name: "DISABLE_SWAP_FOR_JOB", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Whether to configure zero swap swap for a cgroup v2 job"},
+	{Name: "BATCH_GAHP_CHECK_STATUS_ATTEMPTS", Default: "5", Win32Default: "", Type: "int", Range: "", Description: "Determines how many failed check status attempts before failing an infn gahp job."},
+	{Name: "USE_PID_NAMESPACES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "PER_JOB_NAMESPACES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Determines if job creates their own file system namespace"},
+	{Name: "CHIRP_DELAYED_UPDATE_PREFIX", Default: "Chirp*", Win32Default: "", Type: "string", Range: "", Description: "Determines the required prefix for remote updates from the running job"},
+	{Name: "CHIRP_DELAYED_UPDATE_MAX_ATTRS", Default: "100", Win32Default: "", Type: "int", Range: "0,", Description: "Maximum number of pending chirp delayed updates"},
+	{Name: "ENABLE_CHIRP_IO", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enables IO from the running job back to the submitter machine"},
+	{Name: "ENABLE_CHIRP_UPDATES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enables job updates (inserting values into the job log or qedits) from the running job back to the submitter machine"},
+	{Name: "ENABLE_CHIRP_DELAYED", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enables delayed job updates (inserting qedits) with a required prefix at little resource cost from the running job back to the submitter machine.  The safest of the ENABLE_CHIRP_* settings"},
+	{Name: "GANGLIA_GMETRIC", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Path to the Ganglia gmetric Executable"},
+	{Name: "GANGLIA_LIB", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Ganglia Library"},
+	{Name: "GANGLIA_LIB_PATH", Default: "/lib,/usr/lib,/usr/local/lib", Win32Default: "", Type: "string", Range: "", Description: "Ganglia Library Search Path"},
+	{Name: "GANGLIA_LIB64_PATH", Default: "/lib64,/usr/lib64,/usr/local/lib64", Win32Default: "", Type: "string", Range: "", Description: "Ganglia 64-bit Library Search Path"},
+	{Name: "GANGLIA_CONFIG", Default: "/etc/ganglia/gmond.conf", Win32Default: "", Type: "string", Range: "", Description: "Path to the Ganglia Configuration file"},
+	{Name: "GANGLIA_GSTAT_COMMAND", Default: "gstat --all --mpifile --gmond_ip=localhost --gmond_port=8649", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GANGLIAD_WANT_PROJECTION", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Should gangliad use an attribute projection to query the collector"},
+	{Name: "GANGLIAD_RESET_METRICS_FILE", Default: "$(SPOOL)/metricsToReset.ganglia_metrics", Win32Default: "", Type: "path", Range: "", Description: "Path to filename where gangliad stores state"},
+	{Name: "GANGLIAD_WANT_RESET_METRICS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Should gangliad reset aggregate metrics to zero on restart"},
+	{Name: "GANGLIAD_METRICS_CONFIG_DIR", Default: "$(ETC)/ganglia.d", Win32Default: "", Type: "path", Range: "", Description: "Ganglia metric config dir"},
+	{Name: "GANGLIAD_VERBOSITY", Default: "0", Win32Default: "", Type: "int", Range: "", Description: "Ganglia Verbosity"},
+	{Name: "GANGLIAD_INTERVAL", Default: "60", Win32Default: "", Type: "int", Range: "", Description: "Ganglia Interval"},
+	{Name: "GANGLIAD_LOG", Default: "$(LOG)/GangliadLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "MAX_GANGLIAD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GANGLIAD_REQUIREMENTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Requirements to be met by all ads monitored by Ganglia"},
+	{Name: "GANGLIAD_PER_EXECUTE_NODE_METRICS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Publish Ganglia metrics for individual execute nodes"},
+	{Name: "GANGLIA_SEND_DATA_FOR_ALL_HOSTS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Send Ganglia metrics for all hosts"},
+	{Name: "MAX_CONCURRENT_DOWNLOADS", Default: "100", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "MAX_CONCURRENT_UPLOADS", Default: "100", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE", Default: "2.0", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_SHORT_HORIZON", Default: "1m", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_LONG_HORIZON", Default: "5m", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FILE_TRANSFER_DISK_LOAD_THROTTLE_WAIT_BETWEEN_INCREMENTS", Default: "60", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "RUN_FILETRANSFER_PLUGINS_WITH_ROOT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Whether to invoke file transfer plugins as user or as root"},
+	{Name: "LOG_FILETRANSFER_PLUGIN_STDOUT_ON_FAILURE", Default: "D_ALWAYS", Win32Default: "", Type: "string", Range: "", Description: "What log level to use to write the stdout/err of a failed file transfer plugin into the StaterLog"},
+	{Name: "ENABLE_MULTIFILE_TRANSFER_PLUGINS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable file transfer plugins that support multiple files as input"},
+	{Name: "SIGN_S3_URLS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable to allow submit side to sign S3 URLs for file transfer."},
+	{Name: "ENABLE_HTTP_PUBLIC_FILES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Allow public input files for a job to be transferred via HTTP"},
+	{Name: "HTTP_PUBLIC_FILES_ADDRESS", Default: "127.0.0.1:80", Win32Default: "", Type: "string", Range: "", Description: "Web address (hostname + port) for HTTP public files"},
+	{Name: "HTTP_PUBLIC_FILES_ROOT_DIR", Default: "/usr/share/nginx/html", Win32Default: "", Type: "string", Range: "", Description: "Folder in the local filesystem for HTTP public file links to be served from."},
+	{Name: "HTTP_PUBLIC_FILES_STALE_AGE", Default: "604800", Win32Default: "", Type: "int", Range: "", Description: "Age (in seconds) after which preen determines this file is stale and removes it"},
+	{Name: "COLLECTOR_MAX_FILE_DESCRIPTORS", Default: "10240", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "SCHEDD_MAX_FILE_DESCRIPTORS", Default: "20000", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "SCHEDD_USE_SLOT_WEIGHT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Should Schedd obey SLOT_WEIGHT"},
+	{Name: "SCHEDD_SLOT_WEIGHT", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHARED_PORT_MAX_FILE_DESCRIPTORS", Default: "20000", Win32Default: "", Type: "int", Range: "0,", Description: ""},
+	{Name: "USE_RESOURCE_REQUEST_COUNTS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_RESOURCE_REQUEST_LIST_SIZE", Default: "200", Win32Default: "", Type: "int", Range: "1,", Description: "Size of Resource Request List"},
+	{Name: "NEGOTIATOR_PREFETCH_REQUESTS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Enable parallel prefetching of requests in the negotiator"},
+	{Name: "NEGOTIATOR_PREFETCH_REQUESTS_MAX_TIME", Default: "60", Win32Default: "", Type: "int", Range: "0,", Description: "Timeout for prefetch requests lists phase of negotiator"},
+	{Name: "HISTORY_HELPER", Default: "$(BIN)/condor_history", Win32Default: "$(BIN)\\condor_history.exe", Type: "path", Range: "", Description: "Path to condor helper executable used by remote condor_history"},
+	{Name: "HISTORY_HELPER_MAX_HISTORY", Default: "2000000000", Win32Default: "", Type: "int", Range: "0,", Description: "History Helper max number of history ads"},
+	{Name: "HISTORY_HELPER_MAX_CONCURRENCY", Default: "50", Win32Default: "", Type: "int", Range: "0,", Description: "History Helper max number of helper sub-processes"},
+	{Name: "CONDOR_Q_USE_V3_PROTOCOL", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Control use of V3 query protocol for condor_q"},
+	{Name: "CONDOR_Q_ONLY_MY_JOBS", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Whether condor_q will query all jobs, or only the current owners jobs by default"},
+	{Name: "CONDOR_Q_DASH_BATCH_IS_DEFAULT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Whether condor_q will default to -batch display"},
+	{Name: "CONDOR_Q_SHOW_OLD_SUMMARY", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Whether condor_q will show the old single line summary totals, or the new multi line one."},
+	{Name: "ENABLE_IPV6", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ENABLE_IPV4", Default: "auto", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PREFER_IPV4", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "ADVERTISE_IPV4_FIRST", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IGNORE_TARGET_PROTOCOL_PREFERENCE", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IGNORE_DNS_PROTOCOL_PREFERENCE", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string", Range: "", Description: "Ignore DNS target protocol preference"},
+	{Name: "PREFER_OUTBOUND_IPV4", Default: "$(PREFER_IPV4)", Win32Default: "", Type: "string", Range: "", Description: "Prefer to connect over IPv4"},
+	{Name: "JOB_EXECDIR_PERMISSIONS", Default: "user", Win32Default: "", Type: "string", Range: "", Description: "Permissions on the job's execute directory"},
+	{Name: "JOB_SPOOL_PERMISSIONS", Default: "user", Win32Default: "", Type: "string", Range: "", Description: "Permissions on the job's spool directory"},
+	{Name: "USE_KEYRING_SESSIONS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Should HTCondor manage AFS tokens in the kernel keyring"},
+	{Name: "KEYRING_SESSION_CREATION_TIMEOUT", Default: "20", Win32Default: "", Type: "int", Range: "", Description: "How many seconds to block creating a new keyring session before EXCEPTing"},
+	{Name: "DISCARD_SESSION_KEYRING_ON_STARTUP", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Will master discard session keyring on startup"},
+	{Name: "CONTAINER_SHARED_FS", Default: "/cvmfs", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DOCKER", Default: "/usr/bin/docker", Win32Default: "docker", Type: "string", Range: "", Description: "Path to docker binary"},
+	{Name: "DOCKER_DROP_ALL_CAPABILITIES", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DOCKER_PAT_PRODUCER", Default: "$(SBIN)/condor_docker_pat_producer", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DOCKER_PERFORM_TEST", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DOCKER_RUN_UNDER_INIT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "DOCKER_TEST_IMAGE_PATH", Default: "$(LIBEXEC)/htcondor_docker_test", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DOCKER_TEST_IMAGE_NAME", Default: "htcondor_docker_test", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DOCKER_CACHE_ADVERTISE_INTERVAL", Default: "1200", Win32Default: "0", Type: "int", Range: "", Description: "How often to query docker for the size of the image cache.  0 disables the query entirely"},
+	{Name: "DOCKER_IMAGE_CACHE_SIZE", Default: "8", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "DOCKER_TRUST_LOCAL_IMAGES", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "OPENMPI_INSTALL_PATH", Default: "/usr/lib64/openmpi", Win32Default: "", Type: "string", Range: "", Description: "Location of OpenMPI install"},
+	{Name: "OPENMPI_EXCLUDE_NETWORK_INTERFACES", Default: "docker0,virbr0", Win32Default: "", Type: "string", Range: "", Description: "Comma-delimited list of network interfaces that OpenMPI should ignore"},
+	{Name: "SUBMIT_REQUIREMENT_NAMES", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Names of the submit requirements."},
+	{Name: "IS_OWNER", Default: "False", Win32Default: "", Type: "string", Range: "", Description: "When a slot should be in Owner state"},
+	{Name: "FILETRANSFER_PLUGINS", Default: "$(LIBEXEC)/curl_plugin, $(LIBEXEC)/data_plugin, $(LIBEXEC)/box_plugin.py, $(LIBEXEC)/gdrive_plugin.py, $(LIBEXEC)/onedrive_plugin.py", Win32Default: "$(LIBEXEC)\\curl_plugin.exe, $(LIBEXEC)\\data_plugin.exe, $(LIBEXEC)\\box_plugin.py, $(LIBEXEC)/gdrive_plugin.py, $(LIBEXEC)/onedrive_plugin.py", Type: "string", Range: "", Description: "List of paths to installed file transfer plugins"},
+	{Name: "C_GAHP_LOG", Default: "/tmp/CGAHPLog.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "C_GAHP_DEBUG", Default: "D_STATS", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "C_GAHP_LOCK", Default: "/tmp/CGAHPLock.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "C_GAHP_WORKER_THREAD_LOG", Default: "/tmp/CGAHPWorkerLog.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "C_GAHP_WORKER_THREAD_LOCK", Default: "/tmp/CGAHPWorkerLock.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "KBDD", Default: "$(SBIN)/condor_kbdd", Win32Default: "$(SBIN)\\condor_kbdd.exe", Type: "path", Range: "", Description: "Path to keyboard daemon binary"},
+	{Name: "KBDD_ADDRESS_FILE", Default: "$(LOG)/.kbdd_address", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "NEGOTIATOR", Default: "$(SBIN)/condor_negotiator", Win32Default: "$(SBIN)\\condor_negotiator.exe", Type: "path", Range: "", Description: "Path to Negotiator daemon binary"},
+	{Name: "COLLECTOR", Default: "$(SBIN)/condor_collector", Win32Default: "$(SBIN)\\condor_collector.exe", Type: "path", Range: "", Description: "Path to Collector daemon binary"},
+	{Name: "JOB_ROUTER", Default: "$(LIBEXEC)/condor_job_router", Win32Default: "$(LIBEXEC)\\condor_job_router.exe", Type: "path", Range: "", Description: "Path to Job Router binary"},
+	{Name: "ROOSTER", Default: "$(LIBEXEC)/condor_rooster", Win32Default: "$(LIBEXEC)\\condor_rooster.exe", Type: "path", Range: "", Description: "Path to the Machine Hybernation/Wake daemon binary"},
+	{Name: "SHARED_PORT", Default: "$(LIBEXEC)/condor_shared_port", Win32Default: "$(LIBEXEC)\\condor_shared_port.exe", Type: "path", Range: "", Description: "Path to the Shared Port daemon binary"},
+	{Name: "HAD", Default: "$(SBIN)/condor_had", Win32Default: "$(SBIN)\\condor_had.exe", Type: "path", Range: "", Description: "Path to the High-Availability daemon binary"},
+	{Name: "HAD_SOCKET_NAME", Default: "had", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_ARGS", Default: "-sock $(HAD_SOCKET_NAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REPLICATION", Default: "$(SBIN)/condor_replication", Win32Default: "$(SBIN)\\condor_replication.exe", Type: "path", Range: "", Description: "Path to the Replication daemon binary"},
+	{Name: "REPLICATION_SOCKET_NAME", Default: "replication", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REPLICATION_ARGS", Default: "-sock $(REPLICATION_SOCKET_NAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRANSFERER", Default: "$(LIBEXEC)/condor_transferer", Win32Default: "$(LIBEXEC)\\condor_transferer.exe", Type: "path", Range: "", Description: "Path to the Transfer daemon binary"},
+	{Name: "DEFRAG", Default: "$(LIBEXEC)/condor_defrag", Win32Default: "$(LIBEXEC)\\condor_defrag.exe", Type: "path", Range: "", Description: "Path to the Machine Slot Defrag daemon binary"},
+	{Name: "GANGLIAD", Default: "$(LIBEXEC)/condor_gangliad", Win32Default: "$(LIBEXEC)\\condor_gangliad.exe", Type: "path", Range: "", Description: "Path to the Gangila daemon binary"},
+	{Name: "STARTER", Default: "$(SBIN)/condor_starter", Win32Default: "$(SBIN)\\condor_starter.exe", Type: "path", Range: "", Description: "Path to Vanilla Starter binary"},
+	{Name: "MASTER_ADDRESS_FILE", Default: "$(LOG)/.master_address", Win32Default: "", Type: "path", Range: "", Description: "Path to the master address file"},
+	{Name: "COLLECTOR_ADDRESS_FILE", Default: "$(LOG)/.collector_address", Win32Default: "", Type: "path", Range: "", Description: "Path to the collector address file"},
+	{Name: "COLLECTOR_SUPER_ADDRESS_FILE", Default: "$(LOG)/.collector_address.super", Win32Default: "", Type: "path", Range: "", Description: "Path to the collector super address file"},
+	{Name: "STARTD_ADDRESS_FILE", Default: "$(LOG)/.startd_address", Win32Default: "", Type: "path", Range: "", Description: "Path to the startd address file"},
+	{Name: "SCHEDD_ADDRESS_FILE", Default: "$(SPOOL)/.schedd_address", Win32Default: "", Type: "path", Range: "", Description: "Path to the schedd address file"},
+	{Name: "SCHEDD_SUPER_ADDRESS_FILE", Default: "$(SPOOL)/.schedd_address.super", Win32Default: "", Type: "path", Range: "", Description: "Path to the schedd super address file"},
+	{Name: "SCHEDD_DAEMON_AD_FILE", Default: "$(SPOOL)/.schedd_classad", Win32Default: "", Type: "string", Range: "", Description: "Location of the schedd classad file"},
+	{Name: "MAX_DEFAULT_LOG", Default: "10 Mb", Win32Default: "", Type: "string", Range: "", Description: "Default max size of a daemon log, with units"},
+	{Name: "COLLECTOR_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the collector"},
+	{Name: "MAX_COLLECTOR_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "KBDD_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the kbdd"},
+	{Name: "MAX_KBDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "NEGOTIATOR_DEBUG", Default: "D_MATCH", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the negotiator"},
+	{Name: "MAX_NEGOTIATOR_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_NEGOTIATOR_MATCH_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SCHEDD_DEBUG", Default: "D_PID", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the schedd"},
+	{Name: "MAX_SCHEDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHADOW_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for shadows"},
+	{Name: "MAX_SHADOW_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STARTD_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the startd"},
+	{Name: "MAX_STARTD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_STARTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MASTER_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the master"},
+	{Name: "MAX_MASTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "JOB_ROUTER_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the job_router"},
+	{Name: "MAX_JOB_ROUTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ROOSTER_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the rooster"},
+	{Name: "MAX_ROOSTER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SHARED_PORT_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the shared_port daemon"},
+	{Name: "MAX_SHARED_PORT_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HAD_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the had daemons"},
+	{Name: "MAX_HAD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "REPLICATION_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the replication daemons"},
+	{Name: "MAX_REPLICATION_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRANSFERER_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the transferer daemons"},
+	{Name: "MAX_TRANSFERER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_DEBUG", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the gridmanager"},
+	{Name: "MAX_GRIDMANAGER_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_LOG", Default: "$(LOG)/GridmanagerLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "GRIDMANAGER_LOCK", Default: "$(LOCK)/GridmanagerLock", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MAX_C_GAHP_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "EC2_GAHP_LOG", Default: "/tmp/EC2GahpLog.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "EC2_GAHP_DEBUG", Default: "D_PID", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the ec2_gahp"},
+	{Name: "EC2_GAHP", Default: "$(SBIN)/ec2_gahp", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GCE_GAHP", Default: "$(SBIN)/gce_gahp", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GCE_GAHP_LOG", Default: "/tmp/GceGahpLog.$(USERNAME)", Win32Default: "NUL", Type: "string", Range: "", Description: ""},
+	{Name: "GCE_GAHP_LIFETIME", Default: "86400", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "ANNEX_GAHP", Default: "$(EC2_GAHP)", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "AZURE_GAHP", Default: "$(SBIN)/AzureGAHPServer", Win32Default: "$(SBIN)\\AzureGAHPServer.py", Type: "path", Range: "", Description: ""},
+	{Name: "ARC_GAHP", Default: "$(SBIN)/arc_gahp", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ARC_GAHP_LOG", Default: "/tmp/ArcGahpLog.$(USERNAME)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ARC_GAHP_COMMAND_LIMIT", Default: "1000", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "ARC_GAHP_USE_THREADS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "GAHP_SSL_CAFILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "GAHP_SSL_CADIR", Default: "", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "CREDD", Default: "$(SBIN)/condor_credd", Win32Default: "$(SBIN)\\condor_credd.exe", Type: "path", Range: "", Description: ""},
+	{Name: "CREDD_ADDRESS_FILE", Default: "$(LOG)/.credd_address", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "CREDD_LOG", Default: "$(LOG)/CredLog", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "CREDD_DEBUG", Default: "D_FULLDEBUG", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for the credd"},
+	{Name: "MAX_CREDD_LOG", Default: "$(MAX_DEFAULT_LOG)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CREDD_OAUTH_MODE", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CREDMON_KRB", Default: "$(SBIN)/condor_credmon_krb", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "CREDMON_OAUTH", Default: "$(SBIN)/condor_credmon_oauth", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "LOCAL_CREDMON_TOKEN_USE_JSON", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "LOCAL_CREDMON_TOKEN_AUDIENCE", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "LOCAL_CREDMON_TOKEN_VERSION", Default: "scitoken:2.0", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "TRUSTED_VAULT_HOSTS", Default: "", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BOX_AUTHORIZATION_URL", Default: "https://account.box.com/api/oauth2/authorize", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BOX_TOKEN_URL", Default: "https://api.box.com/oauth2/token", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GDRIVE_AUTHORIZATION_URL", Default: "https://accounts.google.com/o/oauth2/v2/auth", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "GDRIVE_TOKEN_URL", Default: "https://oauth2.googleapis.com/token", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ONEDRIVE_AUTHORIZATION_URL", Default: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ONEDRIVE_TOKEN_URL", Default: "https://login.microsoftonline.com/common/oauth2/v2.0/token", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DROPBOX_AUTHORIZATION_URL", Default: "https://www.dropbox.com/oauth2/authorize", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "DROPBOX_TOKEN_URL", Default: "https://api.dropboxapi.com/oauth2/token", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BenchmarkTimer", Default: "(time() - LastBenchmark)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BENCHMARKS_JOBLIST", Default: "mips kflops", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BENCHMARKS_MAX_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BENCHMARKS_MIPS_EXECUTABLE", Default: "$(LIBEXEC)/condor_mips", Win32Default: "$(LIBEXEC)\\condor_mips.exe", Type: "path", Range: "", Description: ""},
+	{Name: "BENCHMARKS_MIPS_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BENCHMARKS_KFLOPS_EXECUTABLE", Default: "$(LIBEXEC)/condor_kflops", Win32Default: "$(LIBEXEC)\\condor_kflops.exe", Type: "path", Range: "", Description: ""},
+	{Name: "BENCHMARKS_KFLOPS_JOB_LOAD", Default: "1.0", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "FILE_TRANSFER_STATS_LOG", Default: "$(LOG)/transfer_history", Win32Default: "", Type: "path", Range: "", Description: ""},
+	{Name: "IsWindows", Default: "false", Win32Default: "true", Type: "bool", Range: "", Description: ""},
+	{Name: "IsMaster", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "MASTER.IsMaster", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsCollector", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "COLLECTOR.IsCollector", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsNegotiator", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "NEGOTIATOR.IsNegotiator", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsSchedd", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SCHEDD.IsSchedd", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsStartd", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTD.IsStartd", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsStarter", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "STARTER.IsStarter", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsShadow", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SHADOW.IsShadow", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsTool", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "TOOL.IsTool", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "IsSubmit", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "SUBMIT.IsSubmit", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: ""},
+	{Name: "CONDOR_VERSION", Default: "8.*", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "CONDOR_BUILD_PLATFORM", Default: "undefined", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PYTHON3_MODULE_SUFFIX", Default: ".pyd", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "PYTHON3_VERSION_MINOR", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "HAVE_EXT_KRB5", Default: "0", Win32Default: "", Type: "int", Range: "", Description: ""},
+	{Name: "SEC_DEFAULT_AUTHENTICATION_METHODS", Default: "FS,TOKEN", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MINUTE", Default: "60", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HOUR", Default: "3600", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "STANDARD", Default: "1", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IsStandard", Default: "(TARGET.JobUniverse == $(STANDARD))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VANILLA", Default: "5", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IsVanilla", Default: "(TARGET.JobUniverse == $(VANILLA))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MPI", Default: "8", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IsMPI", Default: "(TARGET.JobUniverse == $(MPI))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "VM", Default: "13", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "IsVM", Default: "(TARGET.JobUniverse == $(VM))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BigJob", Default: "(TARGET.ImageSize >= (50 * 1024))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MediumJob", Default: "(TARGET.ImageSize >= (15 * 1024) && TARGET.ImageSize < (50 * 1024))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "SmallJob", Default: "(TARGET.ImageSize <  (15 * 1024))", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "BackgroundLoad", Default: "0.3", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "HighLoad", Default: "0.5", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "StartIdleTime", Default: "15 * $(MINUTE)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ContinueIdleTime", Default: "5 * $(MINUTE)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MaxSuspendTime", Default: "10 * $(MINUTE)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "MaxVacateTime", Default: "10 * $(MINUTE)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "StateTimer", Default: "(time() - EnteredCurrentState)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ActivityTimer", Default: "(time() - EnteredCurrentActivity)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "ADSTASH_SAMPLE_INTERVAL", Default: "1200", Win32Default: "", Type: "int", Range: "", Description: "How often to have condor_adstash poll daemons"},
+	{Name: "ADSTASH_CHECKPOINT_FILE", Default: "$(LOG)/adstash_checkpoint.json", Win32Default: "", Type: "path", Range: "", Description: "Where condor_adstash should store its checkpoint"},
+	{Name: "ADSTASH_LOG", Default: "$(LOG)/adstash.log", Win32Default: "", Type: "path", Range: "", Description: "Where condor_adstash should store its log"},
+	{Name: "ADSTASH_DEBUG", Default: "D_ALWAYS", Win32Default: "", Type: "string", Range: "", Description: "Debug logging options for condor_adstash"},
+	{Name: "ADSTASH_NUM_THREADS", Default: "1", Win32Default: "", Type: "int", Range: "", Description: "How many parallel queries condor_adstash should use"},
+	{Name: "ADSTASH_READ_POOLS", Default: "$(CONDOR_HOST)", Win32Default: "", Type: "string", Range: "", Description: "Comma-separated list of Collectors condor_adstash should query"},
+	{Name: "ADSTASH_READ_SCHEDDS", Default: "*", Win32Default: "", Type: "string", Range: "", Description: "Comma-separated list of Schedds condor_adstash should query (* for all)"},
+	{Name: "ADSTASH_READ_STARTDS", Default: "*", Win32Default: "", Type: "string", Range: "", Description: "Comma-separated list of Startds condor_adstash should query (* for all)"},
+	{Name: "ADSTASH_SCHEDD_HISTORY", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: "Should condor_adstash query Schedd job history"},
+	{Name: "ADSTASH_STARTD_HISTORY", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: "Should condor_adstash query Startd job history"},
+	{Name: "ADSTASH_SCHEDD_JOB_EPOCH_HISTORY", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: "Should condor_adstash query Schedd job epoch history"},
+	{Name: "ADSTASH_SCHEDD_TRANSFER_EPOCH_HISTORY", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: "Should condor_adstash query Schedd transfer epoch history"},
+	{Name: "ADSTASH_SCHEDD_HISTORY_MAX_ADS", Default: "10000", Win32Default: "", Type: "int", Range: "", Description: "Stop retrieving ads from a Schedd after this many ads per poll"},
+	{Name: "ADSTASH_STARTD_HISTORY_MAX_ADS", Default: "10000", Win32Default: "", Type: "int", Range: "", Description: "Stop retrieving ads from a Startd after this many ads per poll"},
+	{Name: "ADSTASH_SCHEDD_HISTORY_TIMEOUT", Default: "240", Win32Default: "", Type: "int", Range: "", Description: "Max time in seconds condor_adstash has to process each Schedd history"},
+	{Name: "ADSTASH_STARTD_HISTORY_TIMEOUT", Default: "240", Win32Default: "", Type: "int", Range: "", Description: "Max time in seconds condor_adstash has to process each Startd history"},
+	{Name: "ADSTASH_INTERFACE", Default: "elasticsearch", Win32Default: "", Type: "string", Range: "", Description: "Which type of interface should condor_adstash push ads to"},
+	{Name: "ADSTASH_SE_HOST", Default: "$(FULL_HOSTNAME):9200", Win32Default: "", Type: "string", Range: "", Description: "Hostname[:port] for a condor_adstash search engine interface"},
+	{Name: "ADSTASH_SE_USE_HTTPS", Default: "False", Win32Default: "", Type: "bool", Range: "", Description: "Use SSL when connecting condor_adstash to a search engine"},
+	{Name: "ADSTASH_SE_USERNAME", Default: "", Win32Default: "", Type: "string", Range: "", Description: "condor_adstash search engine username"},
+	{Name: "ADSTASH_SE_PASSWORD_FILE", Default: "", Win32Default: "", Type: "path", Range: "", Description: "File containing condor_adstash search engine password on its first line"},
+	{Name: "ADSTASH_SE_BUNCH_SIZE", Default: "250", Win32Default: "", Type: "int", Range: "", Description: "How many ads should be pushed to a condor_adstash search engine at a time"},
+	{Name: "ADSTASH_SE_INDEX_NAME", Default: "htcondor-000001", Win32Default: "", Type: "string", Range: "", Description: "What search engine index should condor_adstash push ads to"},
+	{Name: "ADSTASH_SE_LOG_MAPPINGS", Default: "True", Win32Default: "", Type: "bool", Range: "", Description: "Should condor_adstash log mappings used with search engine interfaces"},
+	{Name: "ADSTASH_SE_TIMEOUT", Default: "240", Win32Default: "", Type: "int", Range: "", Description: "How long should condor_adstash wait for search engine queries"},
+	{Name: "ADSTASH_JSON_DIR", Default: "$(LOG)", Win32Default: "", Type: "path", Range: "", Description: "Where should condor_adstash place JSON files if using the jsonfile interface"},
+	{Name: "TOOLS_ASSUME_FIREWALLS", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "If a connection to a daemon using CCB is from a machine using shared port, and the connecting client can not listen on the shared port because it can not write to the daemon socket directory (e.g., it's a tool), give up -- assuming a firewall -- rather than hang after opening a listen socket."},
+	{Name: "DEFAULT_KILL_CHILDREN_ON_EXIT", Default: "true", Win32Default: "", Type: "bool", Range: "", Description: "Hidden knob.  If true, all daemon core daemons will kill all of their children on exit."},
+	{Name: "CHECKPOINT_DESTINATION_MAPFILE", Default: "$(LOCAL_DIR)/checkpoint-destination-mapfile", Win32Default: "", Type: "path", Range: "", Description: "Location of the checkpoint destination map file"},
+	{Name: "CONTAINER_IMAGES_COMMON_BY_DEFAULT", Default: "false", Win32Default: "", Type: "bool", Range: "", Description: "Should countainer images be treated as common files by default?"},
+	{Name: "$FEATURE.POLICY_EXPR_FRAGMENTS", Default: "\tPolicyExprFragments=1\n\tKeyboardBusy=(KeyboardIdle < $(MINUTE))\n\tConsoleBusy=(ConsoleIdle  < $(MINUTE))\n\tKeyboardNotBusy=($(KeyboardBusy) == False)\n\tJustCPU=($(CPUBusy) && ($(KeyboardBusy) == False))\n\tMachineBusy=($(CPUBusy) || $(KeyboardBusy))\n\tNonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n\tCpuIdle=($(NonCondorLoadAvg) <= $(BackgroundLoad))\n\tCpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n\tActivationTimer=ifThenElse(JobStart =!= UNDEFINED, (time() - JobStart), 0)\n\tLastCkpt=(time() - LastPeriodicCheckpoint)", Win32Default: "", Type: "string", Range: "", Description: "expression fragments used in desktop policy expressions"},
+	{Name: "$POLICY.DESKTOP", Default: "\tif ! defined PolicyExprFragments\n\t\tuse FEATURE : POLICY_EXPR_FRAGMENTS\n\tendif\n\tSTARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n\tCpuBusyTimer=IfThenElse(CpuBusyValue is 1, time() - CpuBusyTime, 0)\n\tWANT_SUSPEND=($(SmallJob) || $(KeyboardNotBusy) || $(IsVanilla) ) && ( $(SUSPEND))\n\tWANT_VACATE=$(ActivationTimer) > 600 || $(IsVanilla)\n\tSUSPEND=($(KeyboardBusy) || ( ($(CpuBusyTimer) > 120) && $(ActivationTimer) > 90))\n\tCONTINUE=($(CPUIdle) && ($(ActivityTimer) > 10) && (KeyboardIdle > $(ContinueIdleTime)))\n\tPREEMPT=(((Activity == \"Suspended\") && ($(ActivityTimer) > $(MaxSuspendTime))) || (SUSPEND && (WANT_SUSPEND == False)))\n\tSTART=((KeyboardIdle > $(StartIdleTime)) && ( $(CPUIdle) || (State != \"Unclaimed\" && State != \"Owner\")) )\n\tKILL=False\n\tMaxJobRetirementTime=0\n\tCLAIM_WORKLIFE=\n\tSLOTS_CONNECTED_TO_KEYBOARD=1000000\n\tSLOTS_CONNECTED_TO_CONSOLE=1000000\n\tIS_OWNER=(START =?= False)", Win32Default: "", Type: "string", Range: "", Description: "Use a preemption policy that does cycle scavenging for Desktop computers"},
+	{Name: "$FEATURE.POLICY_HOURS_TO_START", Default: "    # Desktops are never idle during the Workday, use 24 hour day, fractional hours\n    WorkdayBeginHour=$(1:8)\n    WorkdayEndHour=$(2:12+5)\n    # don't start jobs with 30 minutes of the start of day\n    WorkdayBeginMinuteStartJobs=($(WorkdayBeginHour))*60 - 29.5\n    # begin evicting jobs 10 minutes before the start of the day\n    WorkdayBeginMinuteStopJobs=($(WorkdayBeginHour))*60 - 9.5\n    WorkdayEndMinute=($(WorkdayEndHour))*60 + 0.5\n    RunJobsHours=ClockMin < $INT(WorkdayBeginMinuteStartJobs) || ClockMin > $INT(WorkdayEndMinute)\n    NoJobsHours=ClockMin > $INT(WorkdayBeginMinuteStopJobs) && ClockMin < $INT(WorkdayEndMinute)\n    if $(3?)\n       WorkweekFirstDay=$(3:1)\n       WorkweekLastDay=$(4:5)\n       IsWeekend=ClockDay < $INT(WorkweekFirstDay) || ClockDay > $INT(WorkWeekLastDay)\n       IsWorkday=ClockDay >= $INT(WorkweekFirstDay) && ClockDay <= $INT(WorkWeekLastDay)\n       RunJobsHours=$(IsWeekend) || $(RunJobsHours)\n       NoJobsHours=$(IsWorkday) && $(NoJobsHours)\n    endif\n    RunJobsHours=($(RunJobsHours))\n    NoJobsHours=($(NoJobsHours))", Win32Default: "", Type: "string", Range: "", Description: "expression fragments for running jobs only certain hours or days"},
+	{Name: "$POLICY.DESKTOP_IDLE", Default: "    if $(1?)\n        use FEATURE : POLICY_HOURS_TO_START($(1+))\n    endif\n    NonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n    CpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n    CpuIdle=($(NonCondorLoadAvg) <= $(BackgroundLoad))\n    STARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n    # the CpuBusy LATCH_EXPR produces CpuBusyValue=0 or 1, CpuBusyTime is the time that CpuBusyValue changed\n    CpuBusyTimer=(CpuBusyValue * (time() - CpuBusyTime))\n    # preempt on Keyboard activity, CpuBusy and optionally hours of day and/or day of week\n    PreemptKeyboardIdleTime=60\n    PreemptCpuBusyTime=120\n    PREEMPT=(KeyboardIdle < $(PreemptKeyboardIdleTime)) || ($(CpuBusyTimer) > $(PreemptCpuBusyTime))\n    if defined NoJobsHours\n        PREEMPT = ($(PREEMPT)) && ($(NoJobsHours))\n    endif\n    # start on KeyboardIdle, CpuIdle and optionally hours of day and/or day of week\n    START=(KeyboardIdle > $(StartIdleTime)) && $(CPUIdle)\n    if defined RunJobsHours\n        START = ($(START)) || ($(RunJobsHours))\n    endif\n    # boilerplate for evict but not suspend\n    WANT_SUSPEND=False\n    WANT_VACATE=True\n    SUSPEND=False\n    CONTINUE=True\n    KILL=False\n    MaxJobRetirementTime=0\n    CLAIM_WORKLIFE=\n    SLOTS_CONNECTED_TO_KEYBOARD=1000000\n    SLOTS_CONNECTED_TO_CONSOLE=1000000\n    IS_OWNER=(START =?= False)", Win32Default: "", Type: "string", Range: "", Description: "A preemption policy that runs jobs on idle workstations but does not suspend with optional workday hours"},
+	{Name: "$POLICY.DESKTOP_HOURS", Default: "    use FEATURE : POLICY_HOURS_TO_START($(1+))\n    # set CpuBusy latch and NonCondorLoadAvg in case admin wants to use them\n    NonCondorLoadAvg=(LoadAvg - CondorLoadAvg)\n    CpuBusy=($(NonCondorLoadAvg) >= $(HighLoad))\n    STARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n    # the CpuBusy LATCH_EXPR produces CpuBusyValue=0 or 1, CpuBusyTime is the time that CpuBusyValue changed\n    CpuBusyTimer=(CpuBusyValue * (time() - CpuBusyTime))\n    PREEMPT = $(NoJobsHours)\n    START = $(RunJobsHours)\n    # boilerplate for evict but not suspend\n    WANT_SUSPEND=False\n    WANT_VACATE=True\n    SUSPEND=False\n    CONTINUE=True\n    KILL=False\n    MaxJobRetirementTime=0\n    CLAIM_WORKLIFE=\n    SLOTS_CONNECTED_TO_KEYBOARD=1000000\n    SLOTS_CONNECTED_TO_CONSOLE=1000000\n    IS_OWNER=(START =?= False)", Win32Default: "", Type: "string", Range: "", Description: "A preemption policy that runs jobs on workstations during non-work and non-workday hours"},
+	{Name: "$POLICY.ALWAYS_RUN_JOBS", Default: "\tWANT_SUSPEND=False\n\tWANT_VACATE=True\n\tSUSPEND=False\n\tCONTINUE=True\n\tPREEMPT=False\n\tSTART=True\n\tKILL=False\n\tPREEMPTION_REQUIREMENTS=False\n\tNEGOTIATOR_CONSIDER_PREEMPTION=False\n\tMaxJobRetirementTime=2147483647\n\tCLAIM_WORKLIFE=1200", Win32Default: "", Type: "string", Range: "", Description: "Use a no-preemption policy"},
+	{Name: "$FEATURE.TESTINGMODE_POLICY_VALUES", Default: "\tTESTINGMODE_WANT_SUSPEND=False\n\tTESTINGMODE_WANT_VACATE=True\n\tTESTINGMODE_START=True\n\tTESTINGMODE_SUSPEND=False\n\tTESTINGMODE_CONTINUE=True\n\tTESTINGMODE_PREEMPT=False\n\tTESTINGMODE_KILL=False\n\tTESTINGMODE_PERIODIC_CHECKPOINT=False\n\tTESTINGMODE_PREEMPTION_REQUIREMENTS=False\n\tTESTINGMODE_PREEMPTION_RANK=0\n\tTESTINGMODE_CLAIM_WORKLIFE=1200", Win32Default: "", Type: "string", Range: "", Description: "Configuration values used by the TESTINGMODE policy from HTCondor 7.x"},
+	{Name: "$FEATURE.UWCS_DESKTOP_POLICY_VALUES", Default: "\tif ! defined PolicyExprFragments\n\t\tuse FEATURE : POLICY_EXPR_FRAGMENTS\n\tendif\n\tSTARTD_LATCH_EXPRS = $(STARTD_LATCH_EXPRS) CpuBusy\n\tCpuBusyTimer=IfThenElse(CpuBusyValue is 1, time() - CpuBusyTime, 0)\n\tUWCS_WANT_SUSPEND=( $(SmallJob) || $(KeyboardNotBusy) || $(IsVanilla) ) && ( $(SUSPEND) )\n\tUWCS_WANT_VACATE=( $(ActivationTimer) > 10 * $(MINUTE) || $(IsVanilla) )\n\tUWCS_START=( (KeyboardIdle > $(StartIdleTime)) && ( $(CPUIdle) || (State != \"Unclaimed\" && State != \"Owner\")) )\n\tUWCS_SUSPEND=( $(KeyboardBusy) || ( ($(CpuBusyTimer) > 2 * $(MINUTE)) && $(ActivationTimer) > 90 ) )\n\tUWCS_CONTINUE=( $(CPUIdle) && ($(ActivityTimer) > 10) && (KeyboardIdle > $(ContinueIdleTime)) )\n\tUWCS_PREEMPT=( ((Activity == \"Suspended\") && ($(ActivityTimer) > $(MaxSuspendTime))) || (SUSPEND && (WANT_SUSPEND == False)) )\n\tUWCS_MaxJobRetirementTime=0\n\tUWCS_KILL=false\n\tUWCS_PERIODIC_CHECKPOINT=$(LastCkpt) > (3 * $(HOUR) + $RANDOM_INTEGER(-30,30,1) * $(MINUTE) )\n\tUWCS_NEGOTIATOR_PRE_JOB_RANK=(10000000 * My.Rank) + (1000000 * (RemoteOwner =?= UNDEFINED)) - (100000 * Cpus) - Memory\n\tUWCS_NEGOTIATOR_POST_JOB_RANK=(RemoteOwner =?= UNDEFINED) * (ifthenElse(isUndefined(KFlops), 1000, Kflops) - SlotID - 1.0e10*(Offline=?=True))\n\tUWCS_PREEMPTION_REQUIREMENTS=((SubmitterGroup =?= RemoteGroup) && ($(StateTimer) > (1 * $(HOUR))) && (RemoteUserPrio > TARGET.SubmitterUserPrio * 1.2)) || (MY.NiceUser == True)\n\tUWCS_PREEMPTION_RANK=(RemoteUserPrio * 1000000) - ifThenElse(isUndefined(TotalJobRuntime), 0, TotalJobRuntime)", Win32Default: "", Type: "string", Range: "", Description: "Configuration values used UWCS_DESKTOP policy from HTCondor 7.x"},
+	{Name: "$POLICY.UWCS_DESKTOP", Default: "\tuse FEATURE : UWCS_DESKTOP_POLICY_VALUES\n\tWANT_SUSPEND=$(UWCS_WANT_SUSPEND)\n\tWANT_VACATE=$(UWCS_WANT_VACATE)\n\tSUSPEND=$(UWCS_SUSPEND)\n\tCONTINUE=$(UWCS_CONTINUE)\n\tPREEMPT=$(UWCS_PREEMPT)\n\tSTART=$(UWCS_START)\n\tKILL=$(UWCS_KILL)\n\tPERIODIC_CHECKPOINT=$(UWCS_PERIODIC_CHECKPOINT)\n\tPREEMPTION_REQUIREMENTS=$(UWCS_PREEMPTION_REQUIREMENTS)\n\tPREEMPTION_RANK=$(UWCS_PREEMPTION_RANK)\n\tNEGOTIATOR_PRE_JOB_RANK=$(UWCS_NEGOTIATOR_PRE_JOB_RANK)\n\tNEGOTIATOR_POST_JOB_RANK=$(UWCS_NEGOTIATOR_POST_JOB_RANK)\n\tMaxJobRetirementTime=$(UWCS_MaxJobRetirementTime)\n\tCLAIM_WORKLIFE=$(UWCS_CLAIM_WORKLIFE)\n\tSLOTS_CONNECTED_TO_KEYBOARD=1024*1024\n\tIS_OWNER=(START =?= False)", Win32Default: "", Type: "string", Range: "", Description: "The UWCS Policy to run jobs only when the user is away from their machine"},
+	{Name: "$POLICY.LIMIT_JOB_RUNTIMES", Default: "\tMAX_JOB_RUNTIME=$(1:24*3600)\n\tMaxJobRetirementTime=$(MAX_JOB_RUNTIME)+120\n\tPREEMPT=(Activity == \"Busy\") && ((time() - EnteredCurrentActivity) > $(MAX_JOB_RUNTIME))", Win32Default: "", Type: "string", Range: "", Description: "Limit job runtimes. args([limit_in_seconds])  default: 24 hours"},
+	{Name: "$POLICY.PREEMPT_IF_RUNTIME_EXCEEDS", Default: "\tMAX_JOB_RUNTIME = $(1:24*3600)\n\tPREEMPT_RUNTIME_EXCEEDS = (JobState == \"Running\") && ((time() - JobCurrentStartDate) > $(MAX_JOB_RUNTIME))\n\tuse policy : PREEMPT_IF( PREEMPT_RUNTIME_EXCEEDS )", Win32Default: "", Type: "string", Range: "", Description: "Limit job runtimes. args([limit_in_seconds])  default: 24 hours"},
+	{Name: "$POLICY.HOLD_IF_RUNTIME_EXCEEDS", Default: "\tMAX_JOB_RUNTIME = $(1:24*3600)\n\tHOLD_REASON_RUNTIME_EXCEEDS = runtime exceeded maximum ($(MAX_JOB_RUNTIME) seconds)\n\tHOLD_RUNTIME_EXCEEDS = (JobState == \"Running\") && ((time() - JobCurrentStartDate) > $(MAX_JOB_RUNTIME))\n\tuse policy : WANT_HOLD_IF( HOLD_RUNTIME_EXCEEDS, $(HOLD_SUBCODE_RUNTIME_EXCEEDS:103), $(HOLD_REASON_RUNTIME_EXCEEDS) )", Win32Default: "", Type: "string", Range: "", Description: "Limit job runtimes. args([limit_in_seconds])  default: 24 hours"},
+	{Name: "$POLICY.STARTD_PUBLISH_CpusUsage", Default: "  #\n  # Publish the number of CPU cores being used by the job into\n  # to slot ad as attribute \"CpusUsage\". This value will\n  # be the average number of cores used by the job over the\n  # past minute, sampling every 5 seconds\n  #\n  CpusUsage = ifthenelse(TotalLoadAvg > 0.0 && Activity!=\"Idle\", CondorLoadAvg/TotalLoadAvg*MIN({TotalLoadAvg, $(DETECTED_CORES)}), 0)\n  STARTD_ATTRS = $(STARTD_ATTRS) CpusUsage", Win32Default: "", Type: "string", Range: "", Description: "Publish a CpusUsage attribute in the STARTD"},
+	{Name: "$POLICY.PREEMPT_IF_CPUS_EXCEEDED", Default: "  use POLICY : STARTD_PUBLISH_CpusUsage\n  #\n  # If the startd sees the number of CPU cores used by the job exceed the\n  # number of cores in the slot by more than 0.8, preempt the job\n  # immediately ignoring any retirement time.\n  #\n  CPU_EXCEEDED = (CpusUsage > Cpus + 0.8)\n  use POLICY : PREEMPT_IF(CPU_EXCEEDED)", Win32Default: "", Type: "string", Range: "", Description: "Preempt jobs that use more cpu cores than in slot"},
+	{Name: "$POLICY.HOLD_IF_CPUS_EXCEEDED", Default: "  use POLICY : STARTD_PUBLISH_CpusUsage\n  #\n  # If the startd sees the number of CPU cores used by the job exceed the\n  # number of cores in the slot by more than 0.8, preempt the job\n  # immediately ignoring any retirement time.\n  #\n  CPU_EXCEEDED = (CpusUsage > Cpus + 0.8)\n  HOLD_REASON_CPU_EXCEEDED = CPU usage exceeded request_cpus\n  use POLICY : WANT_HOLD_IF( CPU_EXCEEDED, $(HOLD_SUBCODE_CPU_EXCEEDED:101), $(HOLD_REASON_CPU_EXCEEDED) )", Win32Default: "", Type: "string", Range: "", Description: "Hold jobs that use more cpu cores than in slot"},
+	{Name: "$POLICY.PREEMPT_IF_DISK_EXCEEDED", Default: "  #\n  # If the startd sees the disk usage of the job exceed the\n  # disk in the slot, preempt the job immediately ignoring\n  # any retirement time.  VM universe jobs can't go over their\n  # disk allocations, so we ignore them.\n  #\n  DISK_EXCEEDED = (JobUniverse != 13 && DiskUsage =!= UNDEFINED && DiskUsage > Disk)\n  use POLICY : PREEMPT_IF(DISK_EXCEEDED)", Win32Default: "", Type: "string", Range: "", Description: "Preempt jobs that use more disk than in slot"},
+	{Name: "$POLICY.HOLD_IF_DISK_EXCEEDED", Default: "  #\n  # If the startd preempted a job because disk usage exceeded\n  # the amount of memory in the slot, put the job on hold.  VM\n  # universe jobs can't go over their disk allocations, so\n  # we ignore them.\n  #\n  DISK_EXCEEDED = (JobUniverse != 13 && DiskUsage =!= UNDEFINED && DiskUsage > Disk)\n  HOLD_REASON_DISK_EXCEEDED = disk usage exceeded allocated disk\n  use POLICY : WANT_HOLD_IF( DISK_EXCEEDED, $(HOLD_SUBCODE_DISK_EXCEEDED:104), $(HOLD_REASON_DISK_EXCEEDED) )", Win32Default: "", Type: "string", Range: "", Description: "Hold jobs that use more disk than in slot"},
+	{Name: "$POLICY.PREEMPT_IF_MEMORY_EXCEEDED", Default: "  #\n  # If the startd sees the memory usage of the job exceed the\n  # memory in the slot, preempt the job immediately ignoring\n  # any retirement time.  VM universe jobs can't go over their\n  # memory allocations, so we ignore them.\n  #\n  MEMORY_EXCEEDED = (JobUniverse != 13 && MemoryUsage =!= UNDEFINED && MemoryUsage > Memory)\n  use POLICY : PREEMPT_IF(MEMORY_EXCEEDED)", Win32Default: "", Type: "string", Range: "", Description: "Preempt jobs that use more memory than in slot"},
+	{Name: "$POLICY.HOLD_IF_MEMORY_EXCEEDED", Default: "  #\n  # If the startd preempted a job because memory usage exceeded\n  # the amount of memory in the slot, put the job on hold.  VM\n  # universe jobs can't go over their memory allocations, so\n  # we ignore them.\n  #\n  MEMORY_EXCEEDED = (JobUniverse != 13 && MemoryUsage =!= UNDEFINED && MemoryUsage > Memory)\n  HOLD_REASON_MEMORY_EXCEEDED = memory usage exceeded request_memory\n  use POLICY : WANT_HOLD_IF( MEMORY_EXCEEDED, $(HOLD_SUBCODE_MEMORY_EXCEEDED:102), $(HOLD_REASON_MEMORY_EXCEEDED) )", Win32Default: "", Type: "string", Range: "", Description: "Hold jobs that use more memory than in slot"},
+	{Name: "$POLICY.PREEMPT_IF", Default: "  if !$(1?)\n    error : use POLICY : PREEMPT_IF requires an argument. It should be the name of a config variable that has the trigger expression.\n  endif\n  if defined PREEMPT\n    PREEMPT = $($(1)) || $(PREEMPT)\n  else\n    PREEMPT = $($(1))\n  endif\n  MAXJOBRETIREMENTTIME = ifthenelse($($(1)),-1,$(MAXJOBRETIREMENTTIME:0))\n  if defined WANT_SUSPEND\n    WANT_SUSPEND = $($(1)) =!= true && $(WANT_SUSPEND)\n  else\n    WANT_SUSPEND = $($(1)) =!= true\n  endif", Win32Default: "", Type: "string", Range: "", Description: "Configure a PREEMPT expression args:(policy_variable)"},
+	{Name: "$POLICY.WANT_HOLD_IF", Default: "  use POLICY : PREEMPT_IF($(1))\n  if ! $(3?)\n    error : use POLICY : WANT_HOLD_IF requires 3 arguments: policy_variable, subcode, reason_text\n  endif\n  if defined WANT_HOLD\n    WANT_HOLD = (JobUniverse != 1 && $($(1))) || $(WANT_HOLD)\n  else\n    WANT_HOLD = (JobUniverse != 1 && $($(1)))\n  endif\n  WANT_HOLD_SUBCODE = ifThenElse($($(1)), $(2) , $(WANT_HOLD_SUBCODE:UNDEFINED))\n  WANT_HOLD_REASON = ifThenElse($($(1)), \"$(3+)\", $(WANT_HOLD_REASON:UNDEFINED))", Win32Default: "", Type: "string", Range: "", Description: "Configure a conditional WANT_HOLD expression. args:(policy_variable, subcode, reason_text)"},
+	{Name: "$ROLE.Personal", Default: "\tCONDOR_HOST=127.0.0.1\n\tCOLLECTOR_HOST=$(CONDOR_HOST):0\n\tDAEMON_LIST=MASTER COLLECTOR NEGOTIATOR STARTD SCHEDD\n\tRunBenchmarks=0", Win32Default: "", Type: "string", Range: "", Description: "Settings for Personal HTCondor (i.e. the whole pool is on a single machine)"},
+	{Name: "$ROLE.Execute", Default: "\tDAEMON_LIST=$(DAEMON_LIST) STARTD", Win32Default: "", Type: "string", Range: "", Description: "Settings for HTCondor Execute node"},
+	{Name: "$ROLE.Submit", Default: "\tDAEMON_LIST=$(DAEMON_LIST) SCHEDD", Win32Default: "", Type: "string", Range: "", Description: "Settings for HTCondor Submit node"},
+	{Name: "$ROLE.CentralManager", Default: "\tDAEMON_LIST=$(DAEMON_LIST) COLLECTOR NEGOTIATOR", Win32Default: "", Type: "string", Range: "", Description: "Settings for HTCondor Central Manager"},
+	{Name: "$SECURITY.HOST_BASED", Default: "\tALLOW_ADMINISTRATOR=$(CONDOR_HOST)\n\tALLOW_READ=*\n\tALLOW_WRITE=$(FULL_HOSTNAME) $(IPV4_ADDRESS) $(IPV6_ADDRESS)\n\tALLOW_DAEMON=$(FULL_HOSTNAME) $(IPV4_ADDRESS) $(IPV6_ADDRESS)\n\tALLOW_NEGOTIATOR=$(CONDOR_HOST)\n\tALLOW_NEGOTIATOR_SCHEDD=$(ALLOW_NEGOTIATOR) $(FLOCK_NEGOTIATOR_HOSTS)\n\tALLOW_WRITE_COLLECTOR=$(ALLOW_WRITE) $(FLOCK_FROM)\n\tALLOW_WRITE_STARTD=$(ALLOW_WRITE) $(FLOCK_FROM)\n\tALLOW_READ_COLLECTOR=$(ALLOW_READ) $(FLOCK_FROM)\n\tALLOW_READ_STARTD=$(ALLOW_READ) $(FLOCK_FROM)\n\t# To smooth upgrades from 8.8.\n\tLEGACY_ALLOW_SEMANTICS = TRUE", Win32Default: "", Type: "string", Range: "", Description: "Default (host based) Authorization policy based on IPs and DNS names"},
+	{Name: "$SECURITY.WITH_AUTHENTICATION", Default: "\tSEC_DEFAULT_AUTHENTICATION=required\n\tSEC_DEFAULT_INTEGRITY=required", Win32Default: "", Type: "string", Range: "", Description: "Enable authentication and integrety as part of the security policy"},
+	{Name: "$SECURITY.STRONG", Default: "\tSEC_DEFAULT_ENCRYPTION=required\n\tSEC_DEFAULT_INTEGRITY=required\n\tSEC_DEFAULT_AUTHENTICATION=required", Win32Default: "", Type: "string", Range: "", Description: "Enable strong encryption as part of the security policy"},
+	{Name: "$SECURITY.USER_BASED", Default: "\tif $(CondorIsAdmin)\n\t  authuser=condor\n\telse\n\t  authuser=$(USERNAME)\n\tendif\n\tuse security:recommended($(authuser))\n\t# If we're running under a regular account, limit job submission\n\t# to this account.\n\tif ! $(CondorIsAdmin)\n\t  ALLOW_WRITE = $(authuser)@$(UID_DOMAIN)\n\tendif", Win32Default: "", Type: "string", Range: "", Description: "Default (user-based) Authorization policy based on user accounts"},
+	{Name: "$FEATURE.AssignAccountingGroup", Default: "\tif ! $(1?)\n\t  error : use FEATURE : AssignAccountingGroup requires a map_file argument\n\tendif\n\tuse FEATURE : ScheddUsermapFile(AssignAccountingGroup, $(1))\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AssignAccountingGroup\n\tJOB_TRANSFORM_AssignAccountingGroup @=end\n\t\tCOPY Owner = AcctGroupUser\n\t\tCOPY AcctGroup = RequestedAcctGroup\n\t\tEVALSET AcctGroup = usermap(\"AssignAccountingGroup\",AcctGroupUser,AcctGroup)\n\t\tEVALSET AccountingGroup = join(\".\",AcctGroup,AcctGroupUser)\n\t@end\n\t# make sure that the user can't qedit these attributes once they have been set.\n\tIMMUTABLE_JOB_ATTRS = $(IMMUTABLE_JOB_ATTRS) AcctGroup AcctGroupUser AccountingGroup\n\t# optionally check that the user requested a valid group\n\tif $(2:True)\n\t  SUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) CheckRequestedGroup\n\t  SUBMIT_REQUIREMENT_CheckRequestedGroup = RequestedAcctGroup is undefined || RequestedAcctGroup == AcctGroup\n\t  SUBMIT_REQUIREMENT_CheckRequestedGroup_REASON = strcat(\"Permission denied setting accounting_group to \", RequestedAcctGroup)\n\tendif\n\t# require that the user mapped into an accounting group\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) AssignAccountingGroup\n\tSUBMIT_REQUIREMENT_AssignAccountingGroup = AcctGroup isnt undefined && AccountingGroup isnt undefined\n\tSUBMIT_REQUIREMENT_AssignAccountingGroup_REASON = strcat(\"Submit requirement AssignAccountingGroup not met - Could not map '\", Owner, \"' to an accounting group \", RequestedAcctGroup)", Win32Default: "", Type: "string", Range: "", Description: "Set the job accounting attributes by mapping the job's Owner attribute args:(map_file [,check_request])"},
+	{Name: "$FEATURE.SetJobAttrFromUsermap", Default: "\tif ! $(3?)\n\t  error : use FEATURE : SetJobAttrFromUsermap requires at least 3 arguments: dest_attr, src_attr, map_name [,map_file]\n\tendif\n\tif $(4?)\n\t   use FEATURE : ScheddUsermapFile($(3), $(4))\n\tendif\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) Set$(1)\n\tJOB_TRANSFORM_Set$(1) = [ eval_set_$(1)=usermap(\"$(3)\",$(2),$(1)); ]\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) Require$(1)\n\tSUBMIT_REQUIREMENT_Require$(1) = $(1) isnt undefined\n\tSUBMIT_REQUIREMENT_Require$(1)_REASON = strcat(\"Submit requirement Require$(1) not met - Could not map '\", $(2)?:\"undefined\", \"' to a $(1)\")", Win32Default: "", Type: "string", Range: "", Description: "Set a job attribute from a usermap of another job attribute args:(dest_attr, src_attr, map_name [, map_file])"},
+	{Name: "$FEATURE.ScheddUsermapFile", Default: "   if ! $(2?)\n     error : use FEATURE : ScheddUsermapFile requires 2 arguments: map_name, map_file\n   endif\n   SCHEDD_CLASSAD_USER_MAP_NAMES = $(SCHEDD_CLASSAD_USER_MAP_NAMES) $(1)\n   CLASSAD_USER_MAPFILE_$(1) = $(2)", Win32Default: "", Type: "string", Range: "", Description: "Define a usermap mapping file args:(map_name , map_file)"},
+	{Name: "$FEATURE.REMOTE_RUNTIME_CONFIG", Default: "\tENABLE_RUNTIME_CONFIG=True\n\tALLOW_CONFIG=$(ALLOW_ADMINISTRATOR)\n\t# the value of this attribute defines which parameters can be set remotely\n\tif $(0#)\n\t\tSETTABLE_ATTRS_CONFIG=$(SETTABLE_ATTRS_CONFIG) $(0)\n\tendif", Win32Default: "", Type: "string", Range: "", Description: "Enable runtime configuration of some variables in HTCondor daemons, args:([variable_names])"},
+	{Name: "$FEATURE.REMOTE_CONFIG", Default: "\tENABLE_PERSISTENT_CONFIG=True\n\tPERSISTENT_CONFIG_DIR=$(SPOOL)\n\tuse FEATURE : REMOTE_RUNTIME_CONFIG($(0))", Win32Default: "", Type: "string", Range: "", Description: "Enable runtime and persistent configuration of HTCondor daemons, args:([variable_names])"},
+	{Name: "$FEATURE.GPUs", Default: "\tMACHINE_RESOURCE_INVENTORY_GPUs=$(LIBEXEC)/condor_gpu_discovery $(1) -properties $(GPU_DISCOVERY_EXTRA)\n\tENVIRONMENT_FOR_AssignedGPUs=GPU_DEVICE_ORDINAL=/(CUDA|OCL)//  CUDA_VISIBLE_DEVICES=/CUDA//\n\tENVIRONMENT_VALUE_FOR_UnAssignedGPUs=10000\n\tuse feature : GPUsMonitor", Win32Default: "", Type: "string", Range: "", Description: "Settings to detect and use GPUs as custom resources, args:(optional-discovery-args)"},
+	{Name: "$FEATURE.Monitor", Default: "\tif ! $(5?)\n\t\terror: use feature : monitor requires five or more arguments\n\tendif\n\tSTARTD_CRON_JOBLIST = $(STARTD_CRON_JOBLIST) $(1)_MONITOR\n\tSTARTD_CRON_$(1)_MONITOR_MODE = $(2)\n\tSTARTD_CRON_$(1)_MONITOR_PERIOD = $(3)\n\tSTARTD_CRON_$(1)_MONITOR_EXECUTABLE = $(4)\n\tSTARTD_CRON_$(1)_MONITOR_METRICS = $(5+)", Win32Default: "", Type: "string", Range: "", Description: "Settings to configure a custom machine resource monitor"},
+	{Name: "GPU_MONITOR", Default: "$(LIBEXEC)/condor_gpu_utilization", Win32Default: "$(LIBEXEC)\\condor_gpu_utilization.exe", Type: "string", Range: "", Description: ""},
+	{Name: "$FEATURE.GPUsMonitor", Default: "\tuse feature : Monitor( GPUs, WaitForExit, 300, $(GPU_MONITOR), SUM:GPUs, PEAK:GPUsMemory )\n\tSTARTD_CRON_GPUs_MONITOR_CONDITION = TotalGPUs > 0 && OpSys =!= \"WINDOWS\"\n\tSTARTD_JOB_ATTRS = $(STARTD_JOB_ATTRS) GPUsUsage GPUsMemoryUsage", Win32Default: "", Type: "string", Range: "", Description: "Settings to monitor GPU usage"},
+	{Name: "$FEATURE.PelicanCache", Default: "\t# NOTE: This feature is still very experimental and could have bugs and unexpected behavior\n\tPELICANCACHE=/usr/bin/pelican\n\tDAEMON_LIST=$(DAEMON_LIST) PELICANCACHE\n\tPELICANCACHE_LOG=$(LOG)/PelicanCacheLog\n\tPELICANCACHE_SIZE=$(1:256GB)\n\tPELICANCACHE_RUNLOCATION=$(2:$(LOCAL_DIR)/localcache)\n\tPELICANCACHE_FEDERATION=$(3:osg-htc.org)\n\tPELICANCACHE_HIGHWATERMARKPERCENTAGE=95\n\tPELICANCACHE_LOWWATERMARKPERCENTAGE=85\n\t# pass some args to the PELICANCACHE daemon in the environment and some in the args\n\tPELICANCACHE_ARGS=serve -d --module localcache -L $(PELICANCACHE_LOG) -f $(PELICANCACHE_FEDERATION)\n\tPELICANCACHE_ENVIRONMENT @=end\n\t   PELICAN_SERVER_ENABLEUI=false\n\t   PELICAN_LOCALCACHE_SIZE=$(PELICANCACHE_SIZE)\n\t   PELICAN_LOCALCACHE_RUNLOCATION=$(PELICANCACHE_RUNLOCATION)\n\t   PELICAN_LOCALCACHE_HIGHWATERMARKPERCENTAGE=$(PELICANCACHE_HIGHWATERMARKPERCENTAGE)\n\t   PELICAN_LOCALCACHE_LOWWATERMARKPERCENTAGE=$(PELICANCACHE_LOWWATERMARKPERCENTAGE)\n\t@end\n\t# push an environment variable into the STARTD, which the STARTER and the transfer plugins will inherit\n\t# we use the leading delimiter syntax here, with ; as the delimiter on all platforms\n\tSTARTD_ENVIRONMENT=;NEAREST_CACHE=unix:/$(PELICANCACHE_RUNLOCATION)/cache.sock;$(STARTD_ENVIRONMENT)", Win32Default: "", Type: "string", Range: "", Description: "Configure a Pelican local cache daemon, args([size [, dir [,federation]]])  default: 256GB, $(LOCAL_DIR)/localcache, osg-htc.org"},
+	{Name: "$FEATURE.PartitionableSlot", Default: "\tNUM_SLOTS_TYPE_$(1:1)=1\n\tSLOT_TYPE_$(1:1)_PARTITIONABLE=true\n\tSLOT_TYPE_$(1:1)=$(2+:100%)", Win32Default: "", Type: "string", Range: "", Description: "Configure a partitionable slot, args:([type# [, allocation]])  default: 1, 100%"},
+	{Name: "$FEATURE.StaticSlots", Default: "\tNUM_SLOTS_TYPE_$(1:1)=$(2:$(NUM_CPUS))\n\tSLOT_TYPE_$(1:1)_PARTITIONABLE=false\n\tSLOT_TYPE_$(1:1)=$(3+:1/$(NUM_SLOTS_TYPE_$(1:1)))\n\tSLOT_TYPE_$(1:1)=$(3+)", Win32Default: "", Type: "string", Range: "", Description: "Configure a set of Static slots, args:([type# [num [, allocation]]])  default: 1, $(NUM_CPUS), 1/$(NUM_CPUS)"},
+	{Name: "$FEATURE.OneShotCronHook", Default: "   if ! $(3?)\n     error : use FEATURE : OneShotCronHook requires at least 3 arguments: STARTD_CRON | SCHEDD_CRON, tag, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=OneShot\n   $(1)_$(2)_KILL=true\n   $(1)_$(2)_RECONFIG_RERUN=true\n   $(1)_$(2)_EXECUTABLE=$(3)\n   if $(4?)\n     $(1)_$(2)_ARGS=$(4)\n   endif", Win32Default: "", Type: "string", Range: "", Description: "Configure a OneShot Cron Hook, args:(STARTD_CRON | SCHEDD_CRON, tag, hook_exe [,hook_args])"},
+	{Name: "$FEATURE.PeriodicCronHook", Default: "   if ! $(4?)\n     error : use FEATURE : PeriodicCronHook requires at least 4 arguments: STARTD_CRON | SCHEDD_CRON, tag, period, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=Periodic\n   $(1)_$(2)_PERIOD=$(3)\n   $(1)_$(2)_EXECUTABLE=$(4)\n   if $(5?)\n     $(1)_$(2)_ARGS=$(5)\n   endif", Win32Default: "", Type: "string", Range: "", Description: "Configure a Periodic Cron Hook, args:(STARTD_CRON | SCHEDD_CRON , tag, period, hook_exe [,hook_args])"},
+	{Name: "$FEATURE.ContinuousCronHook", Default: "   if ! $(3?)\n     error : use FEATURE : ContinuousCronHook requires at least 3 arguments: STARTD_CRON | SCHEDD_CRON, tag, hook_exe [,hook_args]\n   endif\n   $(1)_JOBLIST=$($(1)_JOBLIST) $(2)\n   $(1)_$(2)_MODE=WaitForExit\n   $(1)_$(2)_PERIOD=0\n   $(1)_$(2)_EXECUTABLE=$(3)\n   if $(4?)\n     $(1)_$(2)_ARGS=$(4)\n   endif", Win32Default: "", Type: "string", Range: "", Description: "Configure a Continuous Cron Hook, args:(STARTD_CRON | SCHEDD_CRON , tag, hook_exe [,hook_args])"},
+	{Name: "$FEATURE.StartdCronPeriodic", Default: "use FEATURE : PeriodicCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a STARTD Cron periodic Hook, args:(tag, period, exe [, hook_args])"},
+	{Name: "$FEATURE.ScheddCronPeriodic", Default: "use FEATURE : PeriodicCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a SCHEDD Cron periodic Hook, args:(tag, period, exe [, hook_args])"},
+	{Name: "$FEATURE.StartdCronOneShot", Default: "use FEATURE : OneShotCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a STARTD Cron periodic Hook, args:(tag, exe [, hook_args])"},
+	{Name: "$FEATURE.ScheddCronOneShot", Default: "use FEATURE : OneShotCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a SCHEDD Cron periodic Hook, args:(tag, exe [, hook_args])"},
+	{Name: "$FEATURE.StartdCronContinuous", Default: "use FEATURE : ContinuousCronHook(STARTD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a STARTD Cron continuous Hook, args:(tag, exe [, hook_args])"},
+	{Name: "$FEATURE.ScheddCronContinuous", Default: "use FEATURE : ContinuousCronHook(SCHEDD_CRON, $(0))", Win32Default: "", Type: "string", Range: "", Description: "Configure a SCHEDD Cron continuous Hook, args: tag, exe [, hook_args]"},
+	{Name: "$FEATURE.ChildCollector", Default: "   COLLECTOR$(1)=$(COLLECTOR)\n   if $(2?)\n      COLLECTOR$(1)_ARGS= -p $(2)\n   else\n      COLLECTOR$(1)_ARGS= -sock collector$(1)\n   endif\n   COLLECTOR$(1).COLLECTOR_NAME=Collector$(1)\n   COLLECTOR$(1).CONDOR_VIEW_HOST=$(TOP_COLLECTOR_HOST)\n   COLLECTOR$(1).CCB_RECONNECT_FILE=$(SPOOL)/Collector$(1).ccb_reconnect\n   DAEMON_LIST=$(DAEMON_LIST) COLLECTOR$(1)\n   # change TOP_COLLECTOR_HOST to point all of the child collectors at a different top-level collector\n   TOP_COLLECTOR_HOST=$(COLLECTOR_HOST)", Win32Default: "", Type: "string", Range: "", Description: "One child node of a Collector tree, args:(id [,port])"},
+	{Name: "$SECURITY.get_htcondor_idtokens", Default: "# Standard security configuration: Authenticate all non-READ connections\n# and do encryption and integrity checks for all connections.\nuse security:recommended\n# In older versions (23.X and prior), enabling integrity/encryption\n# required authentication. To allow unauthenticated READ commands with\n# encryption, we added ANONYMOUS, an authentication method that doesn't\n# actually authenticate.\n# To work with older peers, we include that setting here.\nSEC_READ_AUTHENTICATION_METHODS = $(SEC_DEFAULT_AUTHENTICATION_METHODS),ANONYMOUS\nSEC_CLIENT_AUTHENTICATION_METHODS = $(SEC_DEFAULT_AUTHENTICATION_METHODS),ANONYMOUS\n# IDTOKENs issued by HTCondor include the identity of the issuer.  This\n# identity is used to determine which IDTOKEN(s) to present to which\n# daemon(s).  The TRUST_DOMAIN variable sets that identity.  Setting it\n# to the same thing on each machine in your pool ensures that a token\n# issued by one machine is presented to every other machine in your pool.\n#\n# When get_htcondor creates an IDTOKEN for a machine, it uses this variable\n# to create the identity for that IDTOKEN.  This makes it easy to look at\n# the authorization lists (see below) and distinguish between IDTOKENS in\n# your pool and (if you allow them) IDTOKENS from other pools.\nTRUST_DOMAIN = $(CONDOR_HOST)", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "$ROLE.get_htcondor_central_manager", Default: "use security:get_htcondor_idtokens\nuse role:CentralManager", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "$ROLE.get_htcondor_submit", Default: "use security:get_htcondor_idtokens\nuse role:submit", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "$ROLE.get_htcondor_execute", Default: "use security:get_htcondor_idtokens\nuse role:execute", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "$SECURITY.recommended_v9_0", Default: "# Make it easy to detect if this metaknob was set.\nSECURITY_MODEL = 9.0\n# Assume that FS and IDTOKENS are in SEC_DEFAULT_AUTHENTICATION_METHODS,\n# which they are by default.\nuse security:strong\n# This allows anyone who can access port 9618 to READ, which lets\n# condor_status and condor_q work over the network out of the box.\nSEC_READ_AUTHENTICATION = OPTIONAL\nSEC_READ_ENCRYPTION = OPTIONAL\nSEC_READ_INTEGRITY = OPTIONAL\nALLOW_ADMINISTRATOR = $(1:condor)@* condor_pool@* $(2:root@*) $(3+)\nALLOW_DAEMON = $(1:condor)@*  condor_pool@*\nALLOW_NEGOTIATOR = $(1:condor)@* condor_pool@*\nALLOW_WRITE = *\nALLOW_READ = *", Win32Default: "", Type: "string", Range: "", Description: "default security config for 9.0 and later args:([service [, admins]])"},
+	{Name: "$SECURITY.recommended_v24_0", Default: "# Make it easy to detect if this metaknob was set.\nSECURITY_MODEL = 24.0\n# Assume that FS and IDTOKENS are in SEC_DEFAULT_AUTHENTICATION_METHODS,\n# which they are by default.\nuse security:strong\n# This allows anyone who can access port 9618 to READ, which lets\n# condor_status and condor_q work over the network out of the box.\nSEC_READ_AUTHENTICATION = OPTIONAL\nSEC_CLIENT_AUTHENTICATION = OPTIONAL\nALLOW_ADMINISTRATOR = $(1:condor)@* condor@password $(2:root@*) $(3+)\nALLOW_DAEMON = $(1:condor)@*  condor@password\nALLOW_NEGOTIATOR = $(1:condor)@* condor@password\nALLOW_WRITE = *\nALLOW_READ = *", Win32Default: "", Type: "string", Range: "", Description: "default security config for 24.0 and later args:([service [, admins]])"},
+	{Name: "$SECURITY.recommended", Default: "# Latest revision was made for 24.0\nuse security:recommended_v24_0($(1+))", Win32Default: "", Type: "string", Range: "", Description: "current default security config args:([service [, admins]])"},
+	{Name: "$SECURITY.FIPS", Default: "FIPS = True\nuse security:strong\nSEC_DEFAULT_CRYPTO_METHODS = AES,3DES", Win32Default: "", Type: "string", Range: "", Description: ""},
+	{Name: "$EXPERIMENTAL.CollectorNode", Default: "   COLLECTOR$(1)=$(COLLECTOR)\n   if $(2?)\n      COLLECTOR$(1)_ARGS=-f -local-name COLLECTOR$(1) -p $(2)\n   else\n      COLLECTOR$(1)_ARGS=-f -local-name COLLECTOR$(1) -sock collector$(1)\n   endif\n   COLLECTOR$(1).COLLECTOR_LOG=$(LOG)/Collector$(1)Log\n   COLLECTOR$(1).CCB_RECONNECT_FILE=$(SPOOL)/Collector$(1).ccb_reconnect\n   DAEMON_LIST=$(DAEMON_LIST) COLLECTOR$(1)", Win32Default: "", Type: "string", Range: "", Description: "One node of a Collector tree, args:(id [,port])"},
+	{Name: "$EXPERIMENTAL.HAD", Default: "   DAEMON_LIST = $(DAEMON_LIST) HAD REPLICATION\n   HAD_USE_REPLICATION = TRUE\n   MASTER_HAD_BACKOFF_CONSTANT = 360", Win32Default: "", Type: "string", Range: "", Description: "Shared port stuff for a HAD node"},
+	{Name: "$EXPERIMENTAL.HADSharedPort", Default: "   use EXPERIMENTAL : HADReplication( $(1), $(2), $(SHARED_PORT_PORT), $(SHARED_PORT_PORT) )", Win32Default: "", Type: "string", Range: "", Description: "Shared port stuff for a HAD node. args:(controlee, ip_or_hostname)"},
+	{Name: "$EXPERIMENTAL.HADFixedPort", Default: "   HAD_ARGS = -p $(3)\n   REPLICATION_ARGS = -p $(4)\n   use EXPERIMENTAL : HADReplication( $(1), $(2), $(3), $(4) )", Win32Default: "", Type: "string", Range: "", Description: "Shared port stuff for a HAD node. args(controlee, ip_or_hostname, hadport, replport)"},
+	{Name: "$EXPERIMENTAL.HADReplication", Default: "   HAD_LIST = $(HAD_LIST) $(2):$(3)\n   REPLICATION_LIST = $(REPLICATION_LIST) $(2):$(4)\n   HAD_CONTROLLEE = $(1)\n   MASTER_$(1)_CONTROLLER = HAD", Win32Default: "", Type: "string", Range: "", Description: "Shared port stuff for a HAD node. args:(controlee, ip_or_hostname, hadport, replport)"},
+	{Name: "$UPGRADE.DISCARD", Default: "\tLeaseManager\n\tLeaseManger_ADDRESS_FILE\n\tLeaseManager_LOG\n\tLeaseManager_DEBUG\n\tMAX_LeaseManager_LOG\n\tLeaseManager.GETADS_INTERVAL\n\tLeaseManager.PRUNE_INTERVAL\n\tLeaseManager.DEBUG_ADS\n\tLeaseManager.CLASSAD_LOG\n\tSTORK\n\tSTORK_ADDRESS_FILE\n\tSTORK_LOG_BASE\n\tSTORK_LOG\n\tSTORK_DEBUG\n\tMAX_STORK_LOG\n\tSTORK_PORT\n\tSTORK_ARGS\n\tDEFRAG_STATE_FILE", Win32Default: "", Type: "string", Range: "", Description: "Config file knobs that should be ignored on upgrade"},
+	{Name: "$UPGRADE.DISCARDX", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Platform specific file knobs that should be ignored on upgrade"},
+	{Name: "$UPGRADE.DISCARDIF", Default: "\tRESERVED_DISK=5\n\tALL_DEBUG=\n\tMAX_COLLECTOR_LOG=1000000\n\tMAX_KBDD_LOG=1000000\n\tMAX_MASTER_LOG=1000000\n\tMAX_NEGOTIATOR_LOG=1000000\n\tMAX_NEGOTIATOR_MATCH_LOG=1000000\n\tMAX_SCHEDD_LOG=1000000\n\tMAX_SHADOW_LOG=1000000\n\tMAX_SHARED_PORT_LOG=1000000\n\tMAX_STARTD_LOG=1000000\n\tMAX_STARTER_LOG=1000000\n\tMAX_JOB_ROUTER_LOG=1000000\n\tMAX_REPLICATION_LOG=1000000\n\tMAX_ROOSTER_LOG=1000000\n\tMAX_HAD_LOG=1000000\n\tMAX_TRANSFERER_LOG=1000000\n\tMAX_GRIDMANAGER_LOG=1000000\n\tMAX_C_GAHP_LOG=1000000\n\tMAX_VM_GAHP_LOG=1000000\n\tMAX_CREDD_LOG=4000000\n\tVALID_SPOOL_FILES=job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_classad\n\tVALID_SPOOL_FILES=job_queue.log, job_queue.log.tmp, history, Accountant.log, Accountantnew.log, local_univ_execute, .pgpass, .schedd_address, .schedd_classad, OfflineLog", Win32Default: "", Type: "string", Range: "", Description: "Knobs that should be ignored on upgrade if they match the given value"},
+	{Name: "$UPGRADE.DISCARDIFX", Default: "\tQUEUE_SUPER_USERS=root, condor", Win32Default: "", Type: "string", Range: "", Description: "Platform specific knobs that should be ignored on upgrade if they match the given value"},
+	{Name: "$UPGRADE.DISCARD_VMWARE", Default: "", Win32Default: "", Type: "string", Range: "", Description: "Platform specific file knobs that should be ignored on upgrade if they match the given value"},
+	{Name: "$FEATURE.KRB", Default: "\tSEC_CREDENTIAL_DIRECTORY_KRB=/var/lib/condor/krb_credentials\n\tDAEMON_LIST=$(DAEMON_LIST),CREDMON_KRB\n\tSEC_CREDENTIAL_PRODUCER=$(LIBEXEC)/cred_producer_krb\n\tSEC_CREDENTIAL_MONITOR_KRB_LOG=$(LOG)/CredMonKrbLog\n\tAUTO_INCLUDE_CREDD_IN_DAEMON_LIST = True\n\tUSE_KEYRING_SESSIONS=True", Win32Default: "", Type: "string", Range: "", Description: "Enable the daemons and features need to support running jobs with krb tickets and AFS tokens."},
+	{Name: "$FEATURE.OAUTH", Default: "\tSEC_CREDENTIAL_DIRECTORY_OAUTH=/var/lib/condor/oauth_credentials\n\tCREDMON_OAUTH_LOG=$(LOG)/CredMonOAuthLog\n\tDAEMON_LIST=$(DAEMON_LIST),CREDMON_OAUTH\n\tAUTO_INCLUDE_CREDD_IN_DAEMON_LIST=True\n\tTRUST_CREDENTIAL_DIRECTORY=True", Win32Default: "", Type: "string", Range: "", Description: "Enable the daemons and features need to support running jobs with OAuth"},
+	{Name: "$FEATURE.ADSTASH", Default: "\tADSTASH_SCHEDD_HISTORY=True\n\tDAEMON_LIST=$(DAEMON_LIST),ADSTASH", Win32Default: "", Type: "string", Range: "", Description: "Enable sending of schedd job history ads to unauthenticated Elasticsearch on localhost"},
+	{Name: "$FEATURE.CommonCloudAttributesGoogle", Default: "\tuse FEATURE : StartdCronOneShot(cloud, $(LIBEXEC)/common-cloud-attributes-google.py, $(0))\n\tSTARTD_CRON_cloud_PREFIX = Cloud", Win32Default: "", Type: "string", Range: "", Description: "Enable an execute node to advertise common cloud attributes on Google"},
+	{Name: "$FEATURE.JobsHaveInstanceIDs", Default: "\tSYSTEM_JOB_MACHINE_ATTRS = $(SYSTEM_JOB_MACHINE_ATTRS) CloudInstanceID", Win32Default: "", Type: "string", Range: "", Description: "When a job runs on a machine in the cloud, add that machine's instance ID to the job's ClassAd"},
+	{Name: "$FEATURE.CommonCloudAttributesAWS", Default: "\tuse FEATURE : StartdCronOneShot(cloud, $(LIBEXEC)/common-cloud-attributes-aws.py, $(0))\n\tSTARTD_CRON_cloud_PREFIX = Cloud", Win32Default: "", Type: "string", Range: "", Description: "Enable an execute node to advertise common cloud attributes on AWS"},
+	{Name: "$FEATURE.HPC_ANNEX", Default: "\t# Let the command-line tool know that things are configured.\n\tHPC_ANNEX_ENABLED = true\n\t## The annex EPs need a specific port number.\n\t# This is the default value from `use role:personal`.\n\tispersonal = \"127.0.0.1:0\"==\"$(COLLECTOR_HOST)\"\n\tif $INT(ispersonal)\n\t\tCOLLECTOR_HOST = $(FULL_HOSTNAME):$(COLLECTOR_PORT)\n\tendif\n\t# This is the default value from the `make-personal-condor` script.\n\tispersonal = \"$(FULL_HOSTNAME):0\"==\"$(COLLECTOR_HOST)\"\n\tif $INT(ispersonal)\n\t\tCOLLECTOR_HOST = $(FULL_HOSTNAME):$(COLLECTOR_PORT)\n\tendif\n\t# Create an \"AP collector\".  At some point, we'd like to make such a\n\t# thing first-class.  In the short term, adding a command-line flag\n\t# to the collector to set its subsystem would be an improvement.\n\tAP_COLLECTOR = $(COLLECTOR)\n\tAP_COLLECTOR.COLLECTOR_LOG = $(LOG)/AP_Collector.log\n\tAP_COLLECTOR.CCB_RECONNECT_FILE = $(SPOOL)/AP_Collector.ccb_reconnect\n\tDAEMON_LIST = $(DAEMON_LIST) AP_COLLECTOR\n\t# Giving the AP collector a stable shared port ID means we don't\n\t# have to worry about updating annex pilots because of a restart.\n\tAP_COLLECTOR_ARGS = -f -local-name AP_COLLECTOR -sock ap_collector\n\t# For now, don't worry about quoting the Sinful brackets.\n\tANNEX_COLLECTOR = $(TCP_FORWARDING_HOST:$(FULL_HOSTNAME)):$(SHARED_PORT_PORT)?sock=ap_collector\n\t# Any user who can submit jobs can use the AP collector for annex.\n\tAP_COLLECTOR.ALLOW_ADVERTISE_STARTD = $(ALLOW_ADVERTISE_STARTD) $(ALLOW_WRITE)\n\tAP_COLLECTOR.ALLOW_ADVERTISE_MASTER = $(ALLOW_ADVERTISE_MASTER) $(ALLOW_WRITE)\n\t# Until we can give the schedd a stable shared port ID, the annex\n\t# pilot needs to be able to look the schedd up in the AP collector.\n\tif defined COLLECTOR_HOST\n\t\tSCHEDD.COLLECTOR_HOST = $(COLLECTOR_HOST) $(ANNEX_COLLECTOR)\n\telse\n\t\tSCHEDD.COLLECTOR_HOST = $(ANNEX_COLLECTOR)\n\tendif\n\t# The AP collector creates the \"AP\" signing key.\n\tANNEX_TOKEN_KEY_NAME = $(SEC_TOKEN_AP_SIGNING_KEY_NAME)\n\tANNEX_TOKEN_DOMAIN = $(UID_DOMAIN)\n\t# We assume that the token directories (passwords.d and tokens.d) are\n\t# already set.  HTCondor should create and populate the appropriately,\n\t# if so.\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AssignAnnex\n\tJOB_TRANSFORM_AssignAnnex @=end\n\t\tREQUIREMENTS TargetAnnexName =!= undefined\n\t\tmy_identity = \"$(MY.Owner)@$(ANNEX_TOKEN_DOMAIN)\"\n\t\taddl_reqs = ifThenElse(TARGET.AuthenticatedIdentity is undefined, true, $(my_identity) == TARGET.AuthenticatedIdentity) && (MY.TargetAnnexName == TARGET.AnnexName)\n\t\tif defined MY.JobMachineAttrs\n\t\t\tSET JobMachineAttrs \"$(MY.JobMachineAttrs),AnnexName\"\n\t\telse\n\t\t\tSET JobMachineAttrs \"AnnexName\"\n\t\tendif\n\t\tif defined MY.Requirements\n\t\t\tSET Requirements $(addl_reqs) && ($(MY.Requirements))\n\t\telse\n\t\t\tSET Requirements $(addl_reqs)\n\t\tendif\n\t@end\n\t# The schedd will not try to activate a directly-connected claim\n\t# with a job whose owner is not the authenticated identity of the\n\t# directly-connected EP.  This limits the damage that can be done\n\t# by a misconfigured EP to only running the annex owner's other\n\t# jobs, which I think no long justifies tranforming all other jobs.\n\tif $(ANNEX_JOBS_EXCLUSIVELY:FALSE)\n\t\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) AnnexJobsExclusively\n\t\tJOB_TRANSFORM_AnnexJobsExclusively @=end\n\t\t\tREQUIREMENTS TargetAnnexName =?= undefined\n\t\t\taddl_reqs = ifThenElse(TARGET.AuthenticatedIdentity is undefined, true, regexp(\"^condor(_pool)?@.*$\", TARGET.AuthenticatedIdentity))\n\t\t\tif defined MY.Requirements\n\t\t\t\tSET Requirements $(addl_reqs) && ($(MY.Requirements))\n\t\t\telse\n\t\t\t\tSET Requirements $(addl_reqs)\n\t\t\tendif\n\t\t@end\n\tendif", Win32Default: "", Type: "string", Range: "", Description: "Enable HPC Annex"},
+	{Name: "$POLICY.OnlyRegisteredCheckpointDestinations", Default: "\tTOOL_CLASSAD_USER_MAP_NAMES = $(TOOL_CLASSAD_USER_MAP_NAMES) checkpoint_destination_map\n\tSCHEDD_CLASSAD_USER_MAP_NAMES = $(SCHEDD_CLASSAD_USER_MAP_NAMES) checkpoint_destination_map\n\tCLASSAD_USER_MAPFILE_checkpoint_destination_map = $(CHECKPOINT_DESTINATION_MAPFILE)\n\tCLASSAD_USER_MAP_PREFIX_checkpoint_destination_map = TRUE\n\tJOB_TRANSFORM_NAMES = $(JOB_TRANSFORM_NAMES) ValidateCheckpointDestination\n\tJOB_TRANSFORM_ValidateCheckpointDestination @=end\n\t\tEVALSET CheckpointDestinationValid = ifthenelse(undefined =?= userMap(\"checkpoint_destination_map\", CheckpointDestination), undefined, True)\n\t@end\n\tIMMUTABLE_JOB_ATTRS = $(IMMUTABLE_JOB_ATTRS) CheckpointDestinationValid\n\tSUBMIT_REQUIREMENT_NAMES = $(SUBMIT_REQUIREMENT_NAMES) RejectInvalidCheckpointDestination\n\tSUBMIT_REQUIREMENT_RejectInvalidCheckpointDestination = CheckpointDestination =?= undefined || CheckpointDestinationValid =?= True\n\tSUBMIT_REQUIREMENT_RejectInvalidCheckpointDestination_REASON = \"You specified a checkpoint_destination this AP does not know how to clean up.  You may need to add a trailing / to the URL.\"", Win32Default: "", Type: "string", Range: "", Description: "Use submit requirement to reject jobs whose checkpoint destination is not registered."},
+	{Name: "$FEATURE.GANGLIA", Default: "\tDAEMON_LIST=$(DAEMON_LIST),GANGLIAD", Win32Default: "", Type: "string", Range: "", Description: "Enable condor_gangliad daemon"},
+	{Name: "$FEATURE.DefaultCheckpointDestination", Default: "    if ! defined DEFAULT_CHECKPOINT_DESTINATION\n        if defined DEFAULT_CHECKPOINT_DESTINATION_PREFIX\n            DEFAULT_CHECKPOINT_DESTINATION = \"$(DEFAULT_CHECKPOINT_DESTINATION_PREFIX)/$(MY.Owner)\"\n        else\n            warning: You must set DEFAULT_CHECKPOINT_DESTINATION_PREFIX or DEFAULT_CHECKPOINT_DESTINATION to use feature:DefaultCheckpointDestination.\n        endif\n    endif\n    if defined DEFAULT_CHECKPOINT_DESTINATION\n        EXTENDED_SUBMIT_COMMANDS @=end\n            checkpoint_storage = \"'default' or 'spool' or a checkpoint_destination\"\n            $(EXTENDED_SUBMIT_COMMANDS)\n        @end\n        JOB_TRANSFORM_CheckpointStorage @=end\n            REQUIREMENTS (MY.CheckpointDestination =?= undefined) && (MY.SuccessCheckpointExitCode =!= undefined)\n            ShouldSetCheckpointDestination = TRUE\n            SetCheckpointDestination = $(DEFAULT_CHECKPOINT_DESTINATION)\n            if defined MY.checkpoint_storage\n                EVALMACRO IsSpool (checkpoint_storage == \"spool\")\n                EVALMACRO IsDefault (checkpoint_storage == \"default\")\n                if $(IsSpool)\n                    ShouldSetCheckpointDestination = FALSE\n                elif ! $(IsDefault)\n                    SetCheckpointDestination = checkpoint_storage\n                endif\n            endif\n            if $(ShouldSetCheckpointDestination)\n                EVALSET CheckpointDestination $(SetCheckpointDestination)\n            endif\n        @end\n        # This should almost certainly come before the validity check.\n        JOB_TRANSFORM_NAMES = CheckpointStorage $(JOB_TRANSFORM_NAMES)\n    endif", Win32Default: "", Type: "string", Range: "", Description: "Use a job transform so the admin can set a default (other than SPOOL) for checkpoint storage."},
 }
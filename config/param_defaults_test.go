@@ -131,3 +131,48 @@ func TestDefaultSubsystem(t *testing.T) {
 		t.Errorf("SUBSYSTEM = %q, want 'TOOL'", val)
 	}
 }
+
+func TestParamInfo(t *testing.T) {
+	meta, ok := ParamInfo("SHUTDOWN_FAST_TIMEOUT")
+	if !ok {
+		t.Fatal("SHUTDOWN_FAST_TIMEOUT not found")
+	}
+	if meta.Type != "int" || meta.Default != "300" || meta.HasRange() {
+		t.Errorf("SHUTDOWN_FAST_TIMEOUT = %+v, want an int defaulting to 300 with no range", meta)
+	}
+
+	// Names match regardless of case
+	meta, ok = ParamInfo("collector_port")
+	if !ok {
+		t.Fatal("COLLECTOR_PORT not found")
+	}
+	if meta.Name != "COLLECTOR_PORT" || meta.Type != "int" || meta.Default != "9618" {
+		t.Errorf("COLLECTOR_PORT = %+v, want an int defaulting to 9618", meta)
+	}
+	if meta.Min != "0" || meta.Max != "65535" || meta.Description == "" {
+		t.Errorf("COLLECTOR_PORT range = [%q, %q], description %q; want [0, 65535] and a description", meta.Min, meta.Max, meta.Description)
+	}
+
+	// An open-ended range
+	meta, ok = ParamInfo("COLLECTOR_QUERY_WORKERS")
+	if !ok || meta.Min != "0" || meta.Max != "" {
+		t.Errorf("COLLECTOR_QUERY_WORKERS = %+v, want a range of 0 upwards", meta)
+	}
+
+	// Declared without a default: described, but not loaded as a value
+	meta, ok = ParamInfo("SCHEDD_INTERVAL")
+	if !ok || meta.Default != "" {
+		t.Errorf("SCHEDD_INTERVAL = %+v, %v; want a param with no default", meta, ok)
+	}
+	cfg, err := New()
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	if _, ok := cfg.Get("SCHEDD_INTERVAL"); ok {
+		t.Error("SCHEDD_INTERVAL should not be set by the defaults")
+	}
+
+	if _, ok := ParamInfo("NOT_A_REAL_PARAM"); ok {
+		t.Error("Expected NOT_A_REAL_PARAM not to be found")
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"sync"
+)
+
+// ParamMeta describes a parameter as declared in HTCondor's param_info.in
+type ParamMeta struct {
+	Name         string // Parameter name, as declared
+	Type         string // Declared type: bool, int, long, double, path or string
+	Default      string // Default value, unexpanded; empty if there is none
+	Win32Default string // Default on Windows, if it differs
+	// Min and Max are the bounds of the declared range of a numeric
+	// parameter, as written (e.g. "0" and "65535"); an open end is empty
+	Min         string
+	Max         string
+	Description string
+}
+
+// HasRange reports whether the parameter declares a range of valid values
+func (m *ParamMeta) HasRange() bool {
+	return m.Min != "" || m.Max != ""
+}
+
+// paramIndex maps upper-cased parameter names to their paramDefaults entry
+var paramIndex = sync.OnceValue(func() map[string]*ParamDefault {
+	index := make(map[string]*ParamDefault, len(paramDefaults))
+	for i := range paramDefaults {
+		// Later declarations win, as when the defaults are loaded
+		index[strings.ToUpper(paramDefaults[i].Name)] = &paramDefaults[i]
+	}
+	return index
+})
+
+// ParamInfo returns the metadata of the parameter declared as name in
+// param_info.in, matching the name regardless of case like HTCondor does.
+// It reports false for parameters the table does not declare.
+func ParamInfo(name string) (*ParamMeta, bool) {
+	pd, ok := paramIndex()[strings.ToUpper(name)]
+	if !ok {
+		return nil, false
+	}
+	meta := &ParamMeta{
+		Name:         pd.Name,
+		Type:         pd.Type,
+		Default:      pd.Default,
+		Win32Default: pd.Win32Default,
+		Description:  pd.Description,
+	}
+	if pd.Range != "" {
+		lo, hi, _ := strings.Cut(pd.Range, ",")
+		meta.Min, meta.Max = strings.TrimSpace(lo), strings.TrimSpace(hi)
+	}
+	return meta, true
+}
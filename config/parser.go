@@ -3,15 +3,16 @@
 //line parser.y:2
 package config
 
+import __yyfmt__ "fmt"
+
+//line parser.y:2
+
 import (
 	"fmt"
-	__yyfmt__ "fmt"
 	"strings"
 )
 
 // Statement represents a parsed configuration statement
-//
-//line parser.y:2
 type Statement interface {
 	statement()
 }
@@ -20,6 +21,7 @@ type Statement interface {
 type Assignment struct {
 	Name  string
 	Value string
+	Line  int // Line the assignment starts on
 }
 
 func (a *Assignment) statement() {}
@@ -51,6 +53,7 @@ type ElseIf struct {
 // UseDirective represents a use ROLE statement
 type UseDirective struct {
 	Role string
+	Line int // Line of the use keyword
 }
 
 func (u *UseDirective) statement() {}
@@ -80,7 +83,7 @@ type QueueStatement struct {
 
 func (q *QueueStatement) statement() {}
 
-//line parser.y:81
+//line parser.y:83
 type yySymType struct {
 	yys     int
 	str     string
@@ -90,6 +93,7 @@ type yySymType struct {
 	elseif  ElseIf
 	intval  int
 	strlist []string
+	line    int
 }
 
 const IDENT = 57346
@@ -173,35 +177,108 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:490
+//line parser.y:510
+
+// ParseError is a syntax error in the input
+type ParseError struct {
+	Line    int // Line of the error, or 0 if unknown
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return "parse error: " + e.Message
+	}
+	return fmt.Sprintf("parse error: line %d: %s", e.Line, e.Message)
+}
+
+// ParseErrors lists the syntax errors of an input in order. Parse skips the
+// rest of the line after an error and carries on, so one call reports every
+// error it can find.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
 
 // parser holds the state for the parser
 type parser struct {
 	lexer  *Lexer
 	result []Statement
-	errors []error
+	errors ParseErrors
+	last   *TokenInfo // The token read most recently, for error positions
 }
 
 // Lex is required by the goyacc-generated parser
 func (p *parser) Lex(lval *yySymType) int {
 	tok := p.lexer.NextToken()
+	p.last = tok
+	lval.line = tok.Line
 
 	// Set the string value for tokens that have literal values
 	switch tok.Token {
 	case IDENT, STRING, NUMBER, ASSIGN:
 		lval.str = tok.Lit
+	case EOF:
+		// The end of the input (or segment) for the parser
+		return 0
 	}
 
 	return int(tok.Token)
 }
 
-// Error is required by the goyacc-generated parser
+// Error is required by the goyacc-generated parser. Besides recording the
+// error, it skips the rest of the offending line so parsing resumes with
+// the next one.
 func (p *parser) Error(s string) {
-	p.errors = append(p.errors, fmt.Errorf("parse error: %s", s))
+	if p.last == nil {
+		p.errors = append(p.errors, &ParseError{Message: s})
+		return
+	}
+	if p.last.Token == EOF && len(p.lexer.ifLines) > 0 {
+		line := p.lexer.ifLines[len(p.lexer.ifLines)-1]
+		p.errors = append(p.errors, &ParseError{Line: line, Message: "if without matching endif"})
+		return
+	}
+	p.errors = append(p.errors, &ParseError{Line: p.last.Line, Message: s})
+	if p.last.Token != EOF {
+		p.lexer.skipLine(p.last.Line)
+	}
 }
 
-// Parse parses the input and returns the list of statements
+// Parse parses the input and returns the list of statements.
+// A submit file may contain several queue statements. The lexer ends a
+// segment after each top-level queue statement, and parsing resumes with
+// the statements that follow it.
+//
+// A syntax error does not stop the parse: the statements around it are
+// still returned, along with a ParseErrors listing every error found.
+// Statements cut short by an error, including an if block left open at the
+// end of a segment, are dropped.
 func Parse(lexer *Lexer) ([]Statement, error) {
+	stmts := []Statement{}
+	var errs ParseErrors
+	for {
+		segment, segmentErrs := parseSegment(lexer)
+		errs = append(errs, segmentErrs...)
+		stmts = append(stmts, segment...)
+		if !lexer.endOfSegment() {
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return stmts, errs
+	}
+	return stmts, nil
+}
+
+// parseSegment parses statements up to the end of input or the end of a
+// queue statement
+func parseSegment(lexer *Lexer) ([]Statement, ParseErrors) {
 	p := &parser{
 		lexer:  lexer,
 		result: nil,
@@ -210,19 +287,8 @@ func Parse(lexer *Lexer) ([]Statement, error) {
 
 	yyParse(p)
 
-	// If we got results (even empty), return them if there were no critical errors
-	// This allows graceful handling of minor syntax issues and empty input
-	if p.result != nil {
-		return p.result, nil
-	}
-
-	// If we have explicit errors, return the first one
-	if len(p.errors) > 0 {
-		return nil, p.errors[0]
-	}
-
-	// No result and no errors - return empty list for empty input
-	return []Statement{}, nil
+	// The result is nil if the parser gave up before reaching the end
+	return p.result, p.errors
 }
 
 //line yacctab:1
@@ -230,114 +296,105 @@ var yyExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 24,
-	7, 19,
-	-2, 35,
-	-1, 64,
-	7, 15,
-	-2, 2,
-	-1, 65,
-	7, 16,
-	-2, 41,
-	-1, 94,
-	7, 16,
-	-2, 40,
-	-1, 98,
+	-1, 2,
+	1, 1,
+	-2, 0,
+	-1, 22,
 	7, 16,
-	-2, 38,
+	-2, 32,
+	-1, 93,
+	9, 42,
+	10, 42,
+	11, 42,
+	-2, 0,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 154
+const yyLast = 152
 
 var yyAct = [...]int8{
-	2, 76, 66, 44, 18, 85, 91, 92, 13, 19,
-	20, 21, 22, 23, 24, 14, 25, 26, 27, 28,
-	15, 16, 29, 30, 31, 17, 96, 70, 71, 97,
-	58, 59, 47, 89, 46, 60, 77, 53, 60, 34,
-	33, 54, 39, 47, 49, 48, 84, 56, 32, 69,
-	37, 38, 82, 79, 80, 42, 45, 99, 100, 41,
-	36, 86, 87, 78, 35, 83, 81, 57, 74, 75,
-	72, 73, 68, 88, 61, 62, 52, 51, 55, 40,
-	93, 90, 18, 43, 50, 95, 13, 19, 20, 98,
-	22, 23, 24, 14, 25, 26, 27, 28, 15, 16,
-	29, 30, 31, 17, 18, 11, 12, 63, 13, 19,
-	20, 94, 22, 23, 24, 14, 25, 26, 27, 28,
-	15, 16, 29, 30, 31, 17, 18, 10, 9, 8,
-	13, 67, 64, 65, 22, 23, 24, 14, 25, 26,
-	27, 28, 15, 16, 29, 30, 31, 17, 7, 6,
-	5, 4, 3, 1,
+	2, 75, 34, 65, 11, 94, 19, 83, 95, 76,
+	14, 66, 63, 64, 20, 21, 22, 15, 23, 24,
+	25, 26, 16, 17, 27, 28, 29, 18, 11, 42,
+	19, 52, 89, 90, 14, 51, 45, 96, 20, 21,
+	22, 15, 23, 24, 25, 26, 16, 17, 27, 28,
+	29, 18, 68, 69, 70, 56, 57, 32, 31, 87,
+	55, 58, 61, 58, 81, 37, 80, 47, 46, 82,
+	30, 54, 86, 35, 36, 11, 45, 19, 44, 91,
+	40, 14, 33, 93, 92, 20, 21, 22, 15, 23,
+	24, 25, 26, 16, 17, 27, 28, 29, 18, 11,
+	43, 19, 66, 78, 79, 14, 97, 98, 39, 20,
+	21, 22, 15, 23, 24, 25, 26, 16, 17, 27,
+	28, 29, 18, 84, 85, 73, 74, 71, 72, 59,
+	60, 50, 49, 77, 67, 53, 38, 88, 41, 48,
+	12, 13, 62, 10, 9, 8, 7, 6, 5, 4,
+	3, 1,
 }
 
 var yyPact = [...]int16{
-	-1000, -1000, 0, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 41, 35, 38, 75, 54, 50, 28, -1000, -1000,
-	-1000, -1000, -1000, -1000, 22, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 72, -1000, 11, 74, -1000,
-	-1000, -1000, -1000, 39, 4, 70, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 122, 68, 44, 1, 66, 64, 6,
-	59, -1000, -1000, 43, -1000, -1000, -1000, 38, -26, -1000,
-	57, 6, -1000, -1000, -1000, -1000, -1000, 2, -1000, -1000,
-	-1000, -1000, 38, 100, -1000, -1000, -1000, -1000, -1000, -1000,
-	-5, -1000, -1000, 78, -1000, 0, -1000, 53, -1000, -1000,
-	-1000,
+	-1000, -1000, 97, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 63, 53, 61, 132, 103, 75, 72, -1000,
+	-1000, -1000, 45, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 127, -1000, 1, 131, -1000, -1000, -1000,
+	-1000, 32, 29, 125, -1000, -1000, -1000, 39, -1000, -1000,
+	-1000, 2, 130, 47, 27, 123, 121, -21, 129, -1000,
+	-1000, -1000, 93, -1000, -1000, -1000, 61, -24, -1000, 119,
+	-21, -1000, -1000, -1000, -1000, -1000, 28, -1000, -1000, -1000,
+	-1000, 73, -1000, -1000, -1000, -1000, -1000, -1000, -26, -1000,
+	-1000, 26, -1000, 97, -1000, 102, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]uint8{
-	0, 153, 0, 152, 151, 150, 149, 148, 129, 128,
-	127, 107, 2, 46, 106, 105, 84, 83, 3, 1,
-	81,
+	0, 151, 0, 150, 149, 148, 147, 146, 145, 144,
+	143, 142, 3, 2, 141, 140, 139, 138, 29, 1,
+	137,
 }
 
 var yyR1 = [...]int8{
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
-	3, 4, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	5, 5, 5, 16, 16, 14, 14, 14, 6, 6,
-	6, 6, 11, 11, 12, 13, 13, 13, 7, 8,
-	9, 10, 10, 10, 10, 10, 10, 10, 10, 10,
-	10, 10, 10, 17, 18, 18, 19, 19, 20, 20,
-	20, 20,
+	3, 3, 4, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 5, 5, 5,
+	16, 16, 14, 14, 14, 14, 6, 6, 6, 6,
+	11, 11, 12, 13, 13, 13, 7, 8, 9, 10,
+	10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+	10, 17, 18, 18, 19, 19, 20, 20, 20, 20,
 }
 
 var yyR2 = [...]int8{
 	0, 1, 0, 2, 1, 1, 1, 1, 1, 1,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 3, 1, 1, 1, 2, 2, 7, 5,
-	6, 4, 1, 2, 3, 4, 3, 1, 2, 2,
-	2, 1, 2, 4, 4, 5, 5, 4, 5, 3,
-	3, 4, 4, 1, 1, 3, 2, 3, 1, 1,
-	3, 3,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 3,
+	1, 1, 1, 2, 2, 3, 7, 5, 6, 4,
+	1, 2, 3, 4, 3, 1, 2, 2, 2, 1,
+	2, 4, 4, 5, 5, 4, 5, 3, 3, 4,
+	4, 1, 1, 3, 2, 3, 1, 1, 3, 3,
 }
 
 var yyChk = [...]int16{
 	-1000, -1, -2, -3, -4, -5, -6, -7, -8, -9,
-	-10, -15, -14, 8, 15, 20, 21, 25, 4, 9,
-	10, 11, 12, 13, 14, 16, 17, 18, 19, 22,
-	23, 24, 7, 5, 4, 29, -13, 12, 13, 4,
-	4, 5, 5, -17, -18, 28, 6, 4, 23, 22,
-	-16, 5, 4, -2, 30, 4, -18, 28, 26, 27,
-	34, 4, 5, -11, 10, 11, -12, 9, 4, 5,
-	26, 27, 4, 5, 4, 5, -19, 30, 4, 10,
-	11, -12, 9, -2, -13, 31, 4, 5, -19, 31,
-	-20, 4, 5, -2, 11, -2, 31, 34, 11, 4,
-	5,
+	-10, 2, -15, -14, 8, 15, 20, 21, 25, 4,
+	12, 13, 14, 16, 17, 18, 19, 22, 23, 24,
+	7, 5, 4, 29, -13, 12, 13, 4, 4, 5,
+	5, -17, -18, 28, 6, 4, 23, 22, -16, 5,
+	4, -2, 30, 4, -18, 28, 26, 27, 34, 4,
+	5, 23, -11, 10, 11, -12, 9, 4, 5, 26,
+	27, 4, 5, 4, 5, -19, 30, 4, 10, 11,
+	-12, -2, -13, 31, 4, 5, -19, 31, -20, 4,
+	5, -2, 11, -2, 31, 34, 11, 4, 5,
 }
 
 var yyDef = [...]int8{
-	2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 0, 0, 13, 20, 25, 26, 51, 12, 14,
-	15, 16, 17, 18, -2, 21, 22, 23, 24, 27,
-	28, 29, 11, 30, 31, 0, 2, 0, 0, 47,
-	48, 49, 50, 52, 0, 0, 63, 64, 36, 37,
-	32, 33, 34, 0, 0, 0, 0, 0, 0, 0,
-	0, 59, 60, 0, -2, -2, 42, 14, 0, 46,
-	0, 0, 61, 62, 53, 54, 57, 0, 65, 2,
-	39, 43, 0, 0, 2, 45, 55, 56, 58, 66,
-	0, 68, 69, 0, -2, 0, 67, 0, -2, 70,
-	71,
+	2, -2, -2, 3, 4, 5, 6, 7, 8, 9,
+	10, 11, 0, 0, 0, 17, 22, 23, 49, 13,
+	14, 15, -2, 18, 19, 20, 21, 24, 25, 26,
+	12, 27, 28, 0, 2, 0, 0, 45, 46, 47,
+	48, 50, 0, 0, 61, 62, 33, 34, 29, 30,
+	31, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	58, 35, 0, 2, 39, 40, 0, 0, 44, 0,
+	0, 59, 60, 51, 52, 55, 0, 63, 2, 37,
+	41, 0, 2, 43, 53, 54, 56, 64, 0, 66,
+	67, 0, 38, -2, 65, 0, 36, 68, 69,
 }
 
 var yyTok1 = [...]int8{
@@ -694,203 +751,197 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:110
+//line parser.y:113
 		{
 			yylex.(*parser).result = yyDollar[1].stmts
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:116
+//line parser.y:119
 		{
 			yyVAL.stmts = []Statement{}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:120
+//line parser.y:123
 		{
-			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[2].stmt)
+			yyVAL.stmts = yyDollar[1].stmts
+			if yyDollar[2].stmt != nil {
+				yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[2].stmt)
+			}
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:126
+//line parser.y:132
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:130
+//line parser.y:136
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:134
+//line parser.y:140
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:138
+//line parser.y:144
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:142
+//line parser.y:148
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:146
+//line parser.y:152
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:150
+//line parser.y:156
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:160
+		{
+			// Error has recorded the syntax error and skipped the rest of its
+			// line. Drop the offending token too and resume normally with the
+			// next line (yyclearin and yyerrok), unless it is the end of input.
+			if yylex.(*parser).last.Token != EOF {
+				yyrcvr.char = -1
+				yytoken = -1
+				Errflag = 0
+			}
+			yyVAL.stmt = nil
+		}
+	case 12:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:156
+//line parser.y:174
 		{
 			// The value is stored in $2 (ASSIGN token's Lit field)
 			yyVAL.stmt = &Assignment{
 				Name:  yyDollar[1].str,
 				Value: yyDollar[2].str,
+				Line:  yyDollar[1].line,
 			}
 		}
-	case 12:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:166
-		{
-			yyVAL.str = yyDollar[1].str
-		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:169
+//line parser.y:185
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:170
+//line parser.y:188
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:171
+//line parser.y:189
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:172
+//line parser.y:190
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:173
+//line parser.y:191
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:174
+//line parser.y:192
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:175
+//line parser.y:193
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:176
+//line parser.y:194
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:177
+//line parser.y:195
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:178
+//line parser.y:196
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:179
+//line parser.y:197
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:180
+//line parser.y:198
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:181
+//line parser.y:199
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:182
+//line parser.y:200
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 27:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:183
-		{
-			yyVAL.str = yyDollar[1].str
-		}
-	case 28:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:184
-		{
-			yyVAL.str = yyDollar[1].str
-		}
-	case 29:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:185
-		{
-			yyVAL.str = yyDollar[1].str
-		}
-	case 30:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:189
+//line parser.y:204
 		{
 			yyVAL.stmt = &IncludeDirective{
 				Type: yyDollar[1].str,
 				Path: yyDollar[2].str,
 			}
 		}
-	case 31:
+	case 28:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:196
+//line parser.y:211
 		{
 			yyVAL.stmt = &IncludeDirective{
 				Type: yyDollar[1].str,
 				Path: yyDollar[2].str,
 			}
 		}
-	case 32:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:203
+//line parser.y:218
 		{
 			// Check if path ends with | to determine if it's a command
 			path := yyDollar[3].str
@@ -909,39 +960,45 @@ yydefault:
 				Path: path,
 			}
 		}
-	case 33:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:224
+//line parser.y:239
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 34:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:228
+//line parser.y:243
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 35:
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:234
+//line parser.y:249
 		{
 			yyVAL.str = "include"
 		}
-	case 36:
+	case 33:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:238
+//line parser.y:253
 		{
 			yyVAL.str = "include_command"
 		}
-	case 37:
+	case 34:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:242
+//line parser.y:257
 		{
 			yyVAL.str = "include_ifexist"
 		}
-	case 38:
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:261
+		{
+			yyVAL.str = "include_ifexist_command"
+		}
+	case 36:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:248
+//line parser.y:267
 		{
 			yyVAL.stmt = &Conditional{
 				Condition:   yyDollar[2].str,
@@ -950,9 +1007,9 @@ yydefault:
 				ElseBlock:   yyDollar[6].stmts,
 			}
 		}
-	case 39:
+	case 37:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:257
+//line parser.y:276
 		{
 			yyVAL.stmt = &Conditional{
 				Condition:   yyDollar[2].str,
@@ -961,9 +1018,9 @@ yydefault:
 				ElseBlock:   nil,
 			}
 		}
-	case 40:
+	case 38:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.y:266
+//line parser.y:285
 		{
 			yyVAL.stmt = &Conditional{
 				Condition:   yyDollar[2].str,
@@ -972,9 +1029,9 @@ yydefault:
 				ElseBlock:   yyDollar[5].stmts,
 			}
 		}
-	case 41:
+	case 39:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:275
+//line parser.y:294
 		{
 			yyVAL.stmt = &Conditional{
 				Condition:   yyDollar[2].str,
@@ -983,90 +1040,91 @@ yydefault:
 				ElseBlock:   nil,
 			}
 		}
-	case 42:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:286
+//line parser.y:305
 		{
 			yyVAL.elseifs = []ElseIf{yyDollar[1].elseif}
 		}
-	case 43:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:290
+//line parser.y:309
 		{
 			yyVAL.elseifs = append(yyDollar[1].elseifs, yyDollar[2].elseif)
 		}
-	case 44:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:296
+//line parser.y:315
 		{
 			yyVAL.elseif = ElseIf{
 				Condition: yyDollar[2].str,
 				Block:     yyDollar[3].stmts,
 			}
 		}
-	case 45:
+	case 43:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:305
+//line parser.y:324
 		{
 			yyVAL.str = fmt.Sprintf("defined(%s)", yyDollar[3].str)
 		}
-	case 46:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:309
+//line parser.y:328
 		{
 			yyVAL.str = fmt.Sprintf("version %s %s", yyDollar[2].str, yyDollar[3].str)
 		}
-	case 47:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:313
+//line parser.y:332
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 48:
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:319
+//line parser.y:338
 		{
 			yyVAL.stmt = &UseDirective{
 				Role: yyDollar[2].str,
+				Line: yyDollar[1].line,
 			}
 		}
-	case 49:
+	case 47:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:327
+//line parser.y:347
 		{
 			yyVAL.stmt = &ErrorDirective{
 				Message: yyDollar[2].str,
 			}
 		}
-	case 50:
+	case 48:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:335
+//line parser.y:355
 		{
 			yyVAL.stmt = &WarningDirective{
 				Message: yyDollar[2].str,
 			}
 		}
-	case 51:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:343
+//line parser.y:363
 		{
 			// Simple "queue" with default count of 1
 			yyVAL.stmt = &QueueStatement{
 				Count: 1,
 			}
 		}
-	case 52:
+	case 50:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:350
+//line parser.y:370
 		{
 			// "queue N" - queue N jobs
 			yyVAL.stmt = &QueueStatement{
 				Count: yyDollar[2].intval,
 			}
 		}
-	case 53:
+	case 51:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:357
+//line parser.y:377
 		{
 			// "queue var1, var2 from file"
 			yyVAL.stmt = &QueueStatement{
@@ -1075,9 +1133,9 @@ yydefault:
 				File:     yyDollar[4].str,
 			}
 		}
-	case 54:
+	case 52:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:366
+//line parser.y:386
 		{
 			// "queue var1, var2 from file" (with quoted path)
 			yyVAL.stmt = &QueueStatement{
@@ -1086,9 +1144,9 @@ yydefault:
 				File:     yyDollar[4].str,
 			}
 		}
-	case 55:
+	case 53:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:375
+//line parser.y:395
 		{
 			// "queue N var1, var2 from file"
 			yyVAL.stmt = &QueueStatement{
@@ -1097,9 +1155,9 @@ yydefault:
 				File:     yyDollar[5].str,
 			}
 		}
-	case 56:
+	case 54:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:384
+//line parser.y:404
 		{
 			// "queue N var1, var2 from file" (with quoted path)
 			yyVAL.stmt = &QueueStatement{
@@ -1108,9 +1166,9 @@ yydefault:
 				File:     yyDollar[5].str,
 			}
 		}
-	case 57:
+	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:393
+//line parser.y:413
 		{
 			// "queue var in (item1, item2, item3)"
 			yyVAL.stmt = &QueueStatement{
@@ -1119,9 +1177,9 @@ yydefault:
 				Items:    yyDollar[4].strlist,
 			}
 		}
-	case 58:
+	case 56:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:402
+//line parser.y:422
 		{
 			// "queue N var in (item1, item2)"
 			yyVAL.stmt = &QueueStatement{
@@ -1130,9 +1188,9 @@ yydefault:
 				Items:    yyDollar[5].strlist,
 			}
 		}
-	case 59:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:411
+//line parser.y:431
 		{
 			// "queue matching pattern"
 			yyVAL.stmt = &QueueStatement{
@@ -1140,9 +1198,9 @@ yydefault:
 				File:  yyDollar[3].str, // Pattern stored in File field
 			}
 		}
-	case 60:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:419
+//line parser.y:439
 		{
 			// "queue matching pattern" (with quoted pattern)
 			yyVAL.stmt = &QueueStatement{
@@ -1150,9 +1208,9 @@ yydefault:
 				File:  yyDollar[3].str, // Pattern stored in File field
 			}
 		}
-	case 61:
+	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:427
+//line parser.y:447
 		{
 			// "queue N matching pattern"
 			yyVAL.stmt = &QueueStatement{
@@ -1160,9 +1218,9 @@ yydefault:
 				File:  yyDollar[4].str, // Pattern stored in File field
 			}
 		}
-	case 62:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:435
+//line parser.y:455
 		{
 			// "queue N matching pattern" (with quoted pattern)
 			yyVAL.stmt = &QueueStatement{
@@ -1170,60 +1228,60 @@ yydefault:
 				File:  yyDollar[4].str, // Pattern stored in File field
 			}
 		}
-	case 63:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:445
+//line parser.y:465
 		{
 			// Convert string number to int
 			var count int
 			fmt.Sscanf(yyDollar[1].str, "%d", &count)
 			yyVAL.intval = count
 		}
-	case 64:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:454
+//line parser.y:474
 		{
 			yyVAL.strlist = []string{yyDollar[1].str}
 		}
-	case 65:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:458
+//line parser.y:478
 		{
 			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
 		}
-	case 66:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:464
+//line parser.y:484
 		{
 			yyVAL.strlist = []string{}
 		}
-	case 67:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:468
+//line parser.y:488
 		{
 			yyVAL.strlist = yyDollar[2].strlist
 		}
-	case 68:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:474
+//line parser.y:494
 		{
 			yyVAL.strlist = []string{yyDollar[1].str}
 		}
-	case 69:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:478
+//line parser.y:498
 		{
 			yyVAL.strlist = []string{yyDollar[1].str}
 		}
-	case 70:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:482
+//line parser.y:502
 		{
 			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
 		}
-	case 71:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:486
+//line parser.y:506
 		{
 			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
 		}
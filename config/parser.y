@@ -15,6 +15,7 @@ type Statement interface {
 type Assignment struct {
 	Name  string
 	Value string
+	Line  int // Line the assignment starts on
 }
 
 func (a *Assignment) statement() {}
@@ -46,6 +47,7 @@ type ElseIf struct {
 // UseDirective represents a use ROLE statement
 type UseDirective struct {
 	Role string
+	Line int // Line of the use keyword
 }
 
 func (u *UseDirective) statement() {}
@@ -86,6 +88,7 @@ func (q *QueueStatement) statement() {}
 	elseif     ElseIf
 	intval     int
 	strlist    []string
+	line       int
 }
 
 %token <str> IDENT STRING NUMBER ASSIGN
@@ -118,7 +121,10 @@ statement_list:
 	}
 	| statement_list statement
 	{
-		$$ = append($1, $2)
+		$$ = $1
+		if $2 != nil {
+			$$ = append($1, $2)
+		}
 	}
 
 statement:
@@ -150,6 +156,18 @@ statement:
 	{
 		$$ = $1
 	}
+	| error
+	{
+		// Error has recorded the syntax error and skipped the rest of its
+		// line. Drop the offending token too and resume normally with the
+		// next line (yyclearin and yyerrok), unless it is the end of input.
+		if yylex.(*parser).last.Token != EOF {
+			yyrcvr.char = -1
+			yytoken = -1
+			Errflag = 0
+		}
+		$$ = nil
+	}
 
 assignment:
 	identifier ASSIGN
@@ -158,6 +176,7 @@ assignment:
 		$$ = &Assignment{
 			Name:  $1,
 			Value: $2,
+			Line:  $<line>1,
 		}
 	}
 
@@ -166,10 +185,6 @@ identifier:
 	{
 		$$ = $1
 	}
-	| IF      { $$ = $1 }
-	| ELIF    { $$ = $1 }
-	| ELSE    { $$ = $1 }
-	| ENDIF   { $$ = $1 }
 	| DEFINED { $$ = $1 }
 	| VERSION { $$ = $1 }
 	| INCLUDE { $$ = $1 }
@@ -242,6 +257,10 @@ include_type:
 	{
 		$$ = "include_ifexist"
 	}
+	| INCLUDE IFEXIST COMMAND
+	{
+		$$ = "include_ifexist_command"
+	}
 
 conditional:
 	IF condition statement_list elif_clauses ELSE statement_list ENDIF
@@ -319,6 +338,7 @@ use_directive:
 	{
 		$$ = &UseDirective{
 			Role: $2,
+			Line: $<line>1,
 		}
 	}
 
@@ -489,33 +509,106 @@ item_list_items:
 
 %%
 
+// ParseError is a syntax error in the input
+type ParseError struct {
+	Line    int // Line of the error, or 0 if unknown
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return "parse error: " + e.Message
+	}
+	return fmt.Sprintf("parse error: line %d: %s", e.Line, e.Message)
+}
+
+// ParseErrors lists the syntax errors of an input in order. Parse skips the
+// rest of the line after an error and carries on, so one call reports every
+// error it can find.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // parser holds the state for the parser
 type parser struct {
 	lexer  *Lexer
 	result []Statement
-	errors []error
+	errors ParseErrors
+	last   *TokenInfo // The token read most recently, for error positions
 }
 
 // Lex is required by the goyacc-generated parser
 func (p *parser) Lex(lval *yySymType) int {
 	tok := p.lexer.NextToken()
+	p.last = tok
+	lval.line = tok.Line
 
 	// Set the string value for tokens that have literal values
 	switch tok.Token {
 	case IDENT, STRING, NUMBER, ASSIGN:
 		lval.str = tok.Lit
+	case EOF:
+		// The end of the input (or segment) for the parser
+		return 0
 	}
 
 	return int(tok.Token)
 }
 
-// Error is required by the goyacc-generated parser
+// Error is required by the goyacc-generated parser. Besides recording the
+// error, it skips the rest of the offending line so parsing resumes with
+// the next one.
 func (p *parser) Error(s string) {
-	p.errors = append(p.errors, fmt.Errorf("parse error: %s", s))
+	if p.last == nil {
+		p.errors = append(p.errors, &ParseError{Message: s})
+		return
+	}
+	if p.last.Token == EOF && len(p.lexer.ifLines) > 0 {
+		line := p.lexer.ifLines[len(p.lexer.ifLines)-1]
+		p.errors = append(p.errors, &ParseError{Line: line, Message: "if without matching endif"})
+		return
+	}
+	p.errors = append(p.errors, &ParseError{Line: p.last.Line, Message: s})
+	if p.last.Token != EOF {
+		p.lexer.skipLine(p.last.Line)
+	}
 }
 
-// Parse parses the input and returns the list of statements
+// Parse parses the input and returns the list of statements.
+// A submit file may contain several queue statements. The lexer ends a
+// segment after each top-level queue statement, and parsing resumes with
+// the statements that follow it.
+//
+// A syntax error does not stop the parse: the statements around it are
+// still returned, along with a ParseErrors listing every error found.
+// Statements cut short by an error, including an if block left open at the
+// end of a segment, are dropped.
 func Parse(lexer *Lexer) ([]Statement, error) {
+	stmts := []Statement{}
+	var errs ParseErrors
+	for {
+		segment, segmentErrs := parseSegment(lexer)
+		errs = append(errs, segmentErrs...)
+		stmts = append(stmts, segment...)
+		if !lexer.endOfSegment() {
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return stmts, errs
+	}
+	return stmts, nil
+}
+
+// parseSegment parses statements up to the end of input or the end of a
+// queue statement
+func parseSegment(lexer *Lexer) ([]Statement, ParseErrors) {
 	p := &parser{
 		lexer:  lexer,
 		result: nil,
@@ -524,17 +617,6 @@ func Parse(lexer *Lexer) ([]Statement, error) {
 
 	yyParse(p)
 
-	// If we got results (even empty), return them if there were no critical errors
-	// This allows graceful handling of minor syntax issues and empty input
-	if p.result != nil {
-		return p.result, nil
-	}
-
-	// If we have explicit errors, return the first one
-	if len(p.errors) > 0 {
-		return nil, p.errors[0]
-	}
-
-	// No result and no errors - return empty list for empty input
-	return []Statement{}, nil
+	// The result is nil if the parser gave up before reaching the end
+	return p.result, p.errors
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"errors"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -117,6 +119,73 @@ endif
 	}
 }
 
+func TestConditionalBranches(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"if taken", "if defined A\nB = if\nelif defined A\nB = elif\nelse\nB = else\nendif\n", "if"},
+		{"elif taken", "if defined X\nB = if\nelif defined A\nB = elif\nendif\n", "elif"},
+		{"second elif", "if defined X\nB = if\nelif defined Y\nB = y\nelif version >= 8.0\nB = version\nelse\nB = else\nendif\n", "version"},
+		{"else taken", "if defined X\nB = if\nelif $(FALSE_MACRO)\nB = elif\nelse\nB = else\nendif\n", "else"},
+		{"nested", "if defined A\n  if defined X\n    B = inner\n  else\n    B = inner else\n  endif\nelse\n  B = outer else\nendif\n", "inner else"},
+		{"keywords as names", "else = 5\nB = $(else)\n", "5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := NewFromReader(strings.NewReader("A = 1\nFALSE_MACRO = false\n" + tt.input))
+			if err != nil {
+				t.Fatalf("Failed to load config: %v", err)
+			}
+			if got, _ := cfg.Get("B"); got != tt.want {
+				t.Errorf("B = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUnterminatedIf(t *testing.T) {
+	input := "A = 1\n\nif defined A\n  if defined B\n  endif\n  C = 2\n"
+	_, err := Parse(NewLexer(strings.NewReader(input)))
+	if err == nil || !strings.Contains(err.Error(), "line 3") || !strings.Contains(err.Error(), "endif") {
+		t.Errorf("Expected an unterminated if error at line 3, got %v", err)
+	}
+}
+
+// TestParseErrorRecovery verifies parsing carries on past a syntax error,
+// reporting each one and keeping the statements around them
+func TestParseErrorRecovery(t *testing.T) {
+	input := "A = 1\nB oops\nC = 3\nendif\nqueue\nD ) E\nqueue 2\n"
+	stmts, err := Parse(NewLexer(strings.NewReader(input)))
+
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ParseErrors, got %v", err)
+	}
+	var lines []int
+	for _, e := range errs {
+		lines = append(lines, e.Line)
+	}
+	if !slices.Equal(lines, []int{2, 4, 6}) {
+		t.Errorf("Expected errors on lines 2, 4 and 6, got %v (%v)", lines, err)
+	}
+
+	var names []string
+	var queues []int
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *Assignment:
+			names = append(names, s.Name)
+		case *QueueStatement:
+			queues = append(queues, s.Count)
+		}
+	}
+	if !slices.Equal(names, []string{"A", "C"}) || !slices.Equal(queues, []int{1, 2}) {
+		t.Errorf("Expected assignments A and C and queues 1 and 2, got %v and %v", names, queues)
+	}
+}
+
 func TestParseIncludeDirective(t *testing.T) {
 	input := `include "/etc/condor/config.d/*.config"`
 	lex := NewLexer(strings.NewReader(input))
@@ -166,3 +166,43 @@ queue 3 matching files
 		t.Errorf("Expected pattern 'files', got %s", queueStmt.File)
 	}
 }
+
+func TestQueueMultipleStatements(t *testing.T) {
+	input := `
+executable = /bin/echo
+queue 2
+arguments = second
+queue color in (
+  red,
+  blue
+)
+# trailing comment
+request_memory = 1024
+queue
+`
+	stmts, err := parseQueueStatements(input)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	// assignment, queue, assignment, queue, assignment, queue
+	if len(stmts) != 6 {
+		t.Fatalf("Expected 6 statements, got %d", len(stmts))
+	}
+
+	if q, ok := stmts[1].(*QueueStatement); !ok || q.Count != 2 {
+		t.Errorf("Expected 'queue 2' as statement 1, got %#v", stmts[1])
+	}
+	if a, ok := stmts[2].(*Assignment); !ok || a.Name != "arguments" {
+		t.Errorf("Expected arguments assignment as statement 2, got %#v", stmts[2])
+	}
+	if q, ok := stmts[3].(*QueueStatement); !ok || len(q.Items) != 2 {
+		t.Errorf("Expected 'queue color in (...)' with 2 items as statement 3, got %#v", stmts[3])
+	}
+	if a, ok := stmts[4].(*Assignment); !ok || a.Name != "request_memory" {
+		t.Errorf("Expected request_memory assignment as statement 4, got %#v", stmts[4])
+	}
+	if _, ok := stmts[5].(*QueueStatement); !ok {
+		t.Errorf("Expected final queue statement, got %T", stmts[5])
+	}
+}
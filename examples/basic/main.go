@@ -48,12 +48,26 @@ func main() {
 		}
 	}
 
-	// Example 2: Create a schedd instance
-	fmt.Println("\n\nExample 2: Creating Schedd client")
-	fmt.Println("----------------------------------")
-	_ = htcondor.NewSchedd("test_schedd", "schedd.example.com:9618")
+	// Example 2: Query a schedd for jobs
+	fmt.Println("\n\nExample 2: Querying schedd for jobs")
+	fmt.Println("-----------------------------------")
+	schedd := htcondor.NewSchedd("test_schedd", "schedd.example.com:9618")
 	fmt.Printf("Created schedd: %s at %s\n", "test_schedd", "schedd.example.com:9618")
-	fmt.Println("Note: Schedd query implementation is still in progress")
+
+	// An empty constraint selects all jobs; the projection limits the attributes returned
+	jobs, err := schedd.Query(ctx, "", []string{"ClusterId", "ProcId", "Owner", "JobStatus"})
+	if err != nil {
+		log.Printf("Query error: %v\n", err)
+		log.Println("Note: This requires a reachable schedd that authorizes the query")
+	} else {
+		fmt.Printf("✓ Found %d jobs\n", len(jobs))
+		for _, job := range jobs {
+			cluster, _ := job.EvaluateAttrInt("ClusterId")
+			proc, _ := job.EvaluateAttrInt("ProcId")
+			owner, _ := job.EvaluateAttrString("Owner")
+			fmt.Printf("  - Job %d.%d owned by %s\n", cluster, proc, owner)
+		}
+	}
 
 	fmt.Println("\n✓ Examples complete!")
 	fmt.Println("\nFor a more detailed query demo, see query_demo.go")
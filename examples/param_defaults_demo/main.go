@@ -49,6 +49,12 @@ func main() {
 	showParam(cfg, "STARTER_DIAGNOSTIC_send_ep_logs")
 	fmt.Println()
 
+	// Example 4: Param metadata, e.g. for a config editor
+	fmt.Println("4. Looking up param metadata...")
+	showParamInfo("SHUTDOWN_FAST_TIMEOUT")
+	showParamInfo("COLLECTOR_PORT")
+	fmt.Println()
+
 	fmt.Println("=== Demo Complete ===")
 }
 
@@ -60,3 +66,16 @@ func showParam(cfg *config.Config, name string) {
 		fmt.Printf("   %s = (not set)\n", name)
 	}
 }
+
+func showParamInfo(name string) {
+	meta, ok := config.ParamInfo(name)
+	if !ok {
+		fmt.Printf("   %s: (not declared)\n", name)
+		return
+	}
+	fmt.Printf("   %s: type %s, default %q", meta.Name, meta.Type, meta.Default)
+	if meta.HasRange() {
+		fmt.Printf(", range [%s, %s]", meta.Min, meta.Max)
+	}
+	fmt.Println()
+}
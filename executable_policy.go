@@ -0,0 +1,156 @@
+package htcondor
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// ErrExecutableNotAllowed is returned (wrapped) when a job's executable is
+// rejected by an ExecutablePolicy
+var ErrExecutableNotAllowed = errors.New("executable not allowed")
+
+// ErrImageNotPinned is returned (wrapped) when an ExecutablePolicy requires
+// digest-pinned container images and a job's image is not. It wraps
+// ErrExecutableNotAllowed.
+var ErrImageNotPinned = fmt.Errorf("%w: container image not pinned by digest", ErrExecutableNotAllowed)
+
+// TransferredExecutableMode selects how an ExecutablePolicy treats executables
+// that are transferred with the job (transfer_executable = true, the default)
+// rather than run from a path on the execute host
+type TransferredExecutableMode int
+
+const (
+	// TransferredExecutableAllow skips the allowlist for transferred executables (default)
+	TransferredExecutableAllow TransferredExecutableMode = iota
+	// TransferredExecutableCheck applies the allowlist to transferred executables too
+	TransferredExecutableCheck
+	// TransferredExecutableDeny rejects every job that transfers its executable
+	TransferredExecutableDeny
+)
+
+// ParseTransferredExecutableMode parses "allow", "check" or "deny"
+func ParseTransferredExecutableMode(s string) (TransferredExecutableMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "allow", "":
+		return TransferredExecutableAllow, nil
+	case "check":
+		return TransferredExecutableCheck, nil
+	case "deny":
+		return TransferredExecutableDeny, nil
+	default:
+		return TransferredExecutableAllow, fmt.Errorf("invalid transferred executable mode %q (expected allow, check or deny)", s)
+	}
+}
+
+// ExecutablePolicy restricts which executables submitted jobs may run, for
+// servers that submit on behalf of untrusted users.
+//
+// The job's executable (Cmd) is checked, which for container jobs is also the
+// command run inside the container. Paths are cleaned before matching, so
+// "/usr/bin/../../bin/sh" does not match "/usr/bin/*".
+type ExecutablePolicy struct {
+	// Allowed lists glob patterns (path.Match syntax, e.g. "/usr/bin/*") of
+	// permitted executables. Empty allows every executable.
+	Allowed []string
+	// Transferred selects how executables transferred with the job are handled
+	Transferred TransferredExecutableMode
+	// RequireImageDigest rejects container jobs whose image is not pinned by
+	// digest ("repo:tag@sha256:<hex>", or a tag plus container_image_sha256),
+	// so a re-pushed tag cannot change what runs
+	RequireImageDigest bool
+}
+
+// Validate checks that every allowlist pattern is well-formed
+func (p *ExecutablePolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for _, pattern := range p.Allowed {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid executable pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// enabled reports whether the policy restricts anything
+func (p *ExecutablePolicy) enabled() bool {
+	return p != nil && (len(p.Allowed) > 0 || p.Transferred == TransferredExecutableDeny || p.RequireImageDigest)
+}
+
+// CheckSubmitFile checks the executable of every proc in a submit file.
+// A nil policy allows everything. Rejections wrap ErrExecutableNotAllowed;
+// other errors mean the submit file could not be parsed.
+//
+// The check expands the submit file separately from any later submission,
+// which can come out differently (e.g. with $RANDOM_CHOICE), so it only
+// previews the result. To enforce the policy, submit with it set in
+// SubmitFileOptions.ExecutablePolicy.
+func (p *ExecutablePolicy) CheckSubmitFile(submitFile string) error {
+	if !p.enabled() {
+		return nil
+	}
+
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submitFile),
+		&SubmitFileOptions{OutputCollisions: OutputCollisionIgnore, ExecutablePolicy: p})
+	if err != nil {
+		return fmt.Errorf("failed to parse submit file: %w", err)
+	}
+	if _, err := sf.Submit(0); err != nil {
+		if errors.Is(err, ErrExecutableNotAllowed) {
+			return err
+		}
+		return fmt.Errorf("failed to parse submit file: %w", err)
+	}
+	return nil
+}
+
+// CheckJobAd checks the executable, and with RequireImageDigest the container
+// image, of a single job ad. A nil policy allows everything.
+func (p *ExecutablePolicy) CheckJobAd(ad *classad.ClassAd) error {
+	if !p.enabled() {
+		return nil
+	}
+
+	if p.RequireImageDigest {
+		for _, attr := range []string{"ContainerImage", "DockerImage"} {
+			image, ok := ad.EvaluateAttrString(attr)
+			if !ok || image == "" {
+				continue
+			}
+			if digest, err := imageDigest(image); err != nil || digest == "" {
+				return fmt.Errorf("%w: %s", ErrImageNotPinned, image)
+			}
+		}
+	}
+
+	cmd, _ := ad.EvaluateAttrString("Cmd")
+	transferred, ok := ad.EvaluateAttrBool("TransferExecutable")
+	if !ok {
+		transferred = true
+	}
+
+	if transferred {
+		switch p.Transferred {
+		case TransferredExecutableAllow:
+			return nil
+		case TransferredExecutableDeny:
+			return fmt.Errorf("%w: %s is transferred with the job and transferred executables are not permitted", ErrExecutableNotAllowed, cmd)
+		}
+	}
+
+	if len(p.Allowed) == 0 {
+		return nil
+	}
+	cleaned := path.Clean(cmd)
+	for _, pattern := range p.Allowed {
+		if matched, err := path.Match(pattern, cleaned); err == nil && matched {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s does not match any allowed executable pattern", ErrExecutableNotAllowed, cmd)
+}
@@ -147,7 +147,7 @@ func (ftc *FileTransferClient) UploadFile(ctx context.Context, item FileTransfer
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return fmt.Errorf("security handshake failed: %w", err)
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// 5. Send FILETRANS_UPLOAD command
@@ -251,7 +251,7 @@ func (ftc *FileTransferClient) DownloadFile(ctx context.Context, remotePath stri
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return fmt.Errorf("security handshake failed: %w", err)
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// 4. Send FILETRANS_DOWNLOAD command
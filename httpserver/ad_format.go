@@ -0,0 +1,71 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// Output formats for ad-listing endpoints, selected with the format query parameter
+const (
+	adFormatJSON = "json"
+	adFormatXML  = "xml"
+)
+
+// parseAdFormat returns the output format requested by the format query
+// parameter, defaulting to JSON
+func parseAdFormat(r *http.Request) (string, error) {
+	switch format := strings.ToLower(r.URL.Query().Get("format")); format {
+	case "", adFormatJSON:
+		return adFormatJSON, nil
+	case adFormatXML:
+		return adFormatXML, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q; supported formats are json and xml", format)
+	}
+}
+
+// writeAdsXML writes ads as an HTCondor ClassAd XML document (the condor_q -xml format)
+func (s *Server) writeAdsXML(w http.ResponseWriter, statusCode int, ads []*classad.ClassAd) {
+	s.redactor.Load().Redact(ads...)
+	data, err := htcondor.AdsToXML(ads)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to encode XML: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		s.logger.Error(logging.DestinationHTTP, "Error writing XML response", "error", err, "status_code", statusCode)
+	}
+}
+
+// adResponse is implemented by response bodies that carry ads, so writeJSON
+// can redact them
+type adResponse interface {
+	responseAds() []*classad.ClassAd
+}
+
+func (r JobListResponse) responseAds() []*classad.ClassAd { return r.Jobs }
+func (r HistoryResponse) responseAds() []*classad.ClassAd { return r.Jobs }
+
+// redactResponse removes the configured secret attributes from the ads in a
+// response body
+func (s *Server) redactResponse(data interface{}) {
+	switch data := data.(type) {
+	case *classad.ClassAd:
+		s.redactor.Load().Redact(data)
+	case []*classad.ClassAd:
+		s.redactor.Load().Redact(data...)
+	case adResponse:
+		s.redactor.Load().Redact(data.responseAds()...)
+	case map[string]interface{}:
+		s.redactor.Load().RedactMaps(data)
+	case CollectorAdsResponse:
+		s.redactor.Load().RedactMaps(data.Ads...)
+	}
+}
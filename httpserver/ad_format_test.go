@@ -0,0 +1,69 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+func TestParseAdFormat(t *testing.T) {
+	tests := []struct {
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{"/api/v1/jobs", adFormatJSON, false},
+		{"/api/v1/jobs?format=json", adFormatJSON, false},
+		{"/api/v1/jobs?format=XML", adFormatXML, false},
+		{"/api/v1/jobs?format=yaml", "", true},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+		got, err := parseAdFormat(req)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: unexpected error state: %v", tt.target, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.target, tt.want, got)
+		}
+	}
+}
+
+func TestWriteAdsXML(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	s := &Server{logger: logger}
+
+	ad := classad.New()
+	_ = ad.Set("ClusterId", 7)
+	_ = ad.Set("Owner", "alice")
+
+	w := httptest.NewRecorder()
+	s.writeAdsXML(w, http.StatusOK, []*classad.ClassAd{ad})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/xml" {
+		t.Errorf("Expected Content-Type application/xml, got %q", got)
+	}
+
+	ads, err := htcondor.AdsFromXML(w.Body.Bytes())
+	if err != nil {
+		t.Fatalf("Response is not valid ClassAd XML: %v\n%s", err, w.Body.String())
+	}
+	if len(ads) != 1 {
+		t.Fatalf("Expected 1 ad, got %d", len(ads))
+	}
+	if owner, _ := ads[0].EvaluateAttrString("Owner"); owner != "alice" {
+		t.Errorf("Expected Owner alice, got %q", owner)
+	}
+}
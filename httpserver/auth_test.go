@@ -14,6 +14,11 @@ import (
 
 // Helper function to create a test JWT token
 func createTestJWTToken(validForSeconds int) string {
+	return createTestJWTTokenFor("alice@test.domain", validForSeconds)
+}
+
+// createTestJWTTokenFor creates a test JWT token for subject
+func createTestJWTTokenFor(subject string, validForSeconds int) string {
 	// Create JWT header with kid (key ID)
 	header := map[string]interface{}{
 		"alg": "HS256",
@@ -26,7 +31,7 @@ func createTestJWTToken(validForSeconds int) string {
 	// Create JWT payload with current timestamps
 	now := time.Now().Unix()
 	payload := map[string]interface{}{
-		"sub": "alice@test.domain",
+		"sub": subject,
 		"iss": "test.domain",
 		"iat": now,
 		"exp": now + int64(validForSeconds),
@@ -0,0 +1,85 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// Action is an operation an API request performs, as passed to an Authorizer
+type Action string
+
+// Actions of the REST API
+const (
+	ActionSubmit         Action = "submit"          // POST /api/v1/jobs
+	ActionQuery          Action = "query"           // Job lists, single jobs, match status and usage
+	ActionHistory        Action = "history"         // GET /api/v1/history
+	ActionEdit           Action = "edit"            // PATCH /api/v1/jobs and /api/v1/jobs/{id}
+	ActionHold           Action = "hold"            // POST /api/v1/jobs/hold and /api/v1/jobs/{id}/hold
+	ActionRelease        Action = "release"         // POST /api/v1/jobs/release and /api/v1/jobs/{id}/release
+	ActionRemove         Action = "remove"          // DELETE /api/v1/jobs and /api/v1/jobs/{id}
+	ActionRerun          Action = "rerun"           // POST /api/v1/jobs/{id}/rerun
+	ActionUploadInput    Action = "upload_input"    // PUT /api/v1/jobs/{id}/input
+	ActionDownloadOutput Action = "download_output" // GET /api/v1/jobs/{id}/output
+	ActionReadUserLog    Action = "read_userlog"    // GET /api/v1/jobs/{id}/userlog
+	ActionQueryPriority  Action = "query_priority"  // GET /api/v1/priorities
+	ActionSetPriority    Action = "set_priority"    // PUT /api/v1/priorities/{name}
+	ActionQueryCollector Action = "query_collector" // GET /api/v1/collector/ads[/{type}[/{name}]]
+	ActionListTransfers  Action = "list_transfers"  // GET /api/v1/transfers
+	ActionCancelTransfer Action = "cancel_transfer" // DELETE /api/v1/transfers/{id}
+	ActionEvaluate       Action = "evaluate"        // POST /api/v1/evaluate
+)
+
+// Resource describes what an action applies to. Fields that do not apply
+// to the action are empty.
+type Resource struct {
+	Schedd     string // Schedd the request selected with ?schedd=; empty for the default schedd
+	JobID      string // Job acted on, e.g. "1.0"
+	Constraint string // ClassAd constraint selecting the jobs queried or acted on
+	Name       string // Submitter or accounting group whose priority is set, or collector ad queried
+	AdType     string // Collector ad type queried, e.g. "StartdAd"
+	TransferID string // Transfer cancelled
+}
+
+// Authorizer decides whether user may perform action on resource. It is
+// called after the request is authenticated and validated, before the
+// operation reaches HTCondor; a non-nil error rejects the request with 403
+// and is included in the error message. HTCondor's own authorization still
+// applies to requests the Authorizer allows.
+type Authorizer func(ctx context.Context, user string, action Action, resource Resource) error
+
+// authorize asks the configured Authorizer whether the request's user may
+// perform action on resource, writing the 403 response and returning false
+// if not
+func (s *Server) authorize(ctx context.Context, w http.ResponseWriter, r *http.Request, action Action, resource Resource) bool {
+	if s.authorizer == nil {
+		return true
+	}
+	resource.Schedd = r.URL.Query().Get(scheddParam)
+	user := htcondor.GetAuthenticatedUserFromContext(ctx)
+	if err := s.authorizer(ctx, user, action, resource); err != nil {
+		s.logger.Info(logging.DestinationHTTP, "Request denied by authorizer",
+			"user", user, "action", string(action), "error", err)
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodeForbidden, fmt.Sprintf("Not authorized to %s: %v", action, err), nil)
+		return false
+	}
+	return true
+}
+
+// authorizeRequest is authorize for endpoints that do not otherwise need the
+// user's credentials: the request is only authenticated, to name the user to
+// the Authorizer, if one is configured
+func (s *Server) authorizeRequest(w http.ResponseWriter, r *http.Request, action Action, resource Resource) bool {
+	if s.authorizer == nil {
+		return true
+	}
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return false
+	}
+	return s.authorize(ctx, w, r, action, resource)
+}
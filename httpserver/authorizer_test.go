@@ -0,0 +1,251 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/compose"
+)
+
+// TestAuthorizer checks an authorizer that denies removals turns them into
+// 403s before they reach the schedd, while queries it allows go through
+func TestAuthorizer(t *testing.T) {
+	s := newFallbackTestServer(t, errTestAuthFailed, true, writeFakeCondorQ(t))
+	s.tokenCache = NewTokenCache()
+	token := createTestJWTToken(3600) // alice@test.domain
+
+	type call struct {
+		user     string
+		action   Action
+		resource Resource
+	}
+	var calls []call
+	s.authorizer = func(_ context.Context, user string, action Action, resource Resource) error {
+		calls = append(calls, call{user, action, resource})
+		if action == ActionRemove {
+			return errors.New("removals are disabled for this user")
+		}
+		return nil
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs?constraint="+url.QueryEscape(`Owner == "alice"`), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.handleJobs(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected the query to be allowed, got %d: %s", w.Code, w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/jobs/7.0", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w = httptest.NewRecorder()
+	s.handleJobByID(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("Expected the removal to be denied with 403, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.ErrorCode != ErrCodeForbidden {
+		t.Errorf("Expected error code %q, got %q", ErrCodeForbidden, resp.ErrorCode)
+	}
+
+	want := []call{
+		{"alice@test.domain", ActionQuery, Resource{Constraint: `Owner == "alice"`}},
+		{"alice@test.domain", ActionRemove, Resource{JobID: "7.0"}},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected authorizer calls %+v, got %+v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Call %d: expected %+v, got %+v", i, want[i], calls[i])
+		}
+	}
+}
+
+// newMCPAccessToken stores an access token of the provider for username
+// with the given scopes, as the token endpoint would issue it
+func newMCPAccessToken(t *testing.T, p *OAuth2Provider, username string, scopes ...string) string {
+	t.Helper()
+	ctx := context.Background()
+	client := &fosite.DefaultClient{ID: "test-client-" + username, Scopes: scopes, GrantTypes: []string{"authorization_code"}}
+	if err := p.GetStorage().CreateClient(ctx, client); err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	session := DefaultOpenIDConnectSession(username)
+	session.SetExpiresAt(fosite.AccessToken, time.Now().Add(time.Hour))
+	request := fosite.NewRequest()
+	request.Client = client
+	request.Session = session
+	for _, scope := range scopes {
+		request.GrantScope(scope)
+	}
+	token, signature, err := compose.NewOAuth2HMACStrategy(p.config).GenerateAccessToken(ctx, request)
+	if err != nil {
+		t.Fatalf("Failed to generate access token: %v", err)
+	}
+	if err := p.GetStorage().CreateAccessTokenSession(ctx, signature, request); err != nil {
+		t.Fatalf("Failed to store access token: %v", err)
+	}
+	return token
+}
+
+// TestAuthorizerMCP checks MCP tool calls are passed to the authorizer with
+// the action of the REST endpoint doing the same
+func TestAuthorizerMCP(t *testing.T) {
+	s := newFallbackTestServer(t, errTestAuthFailed, true, writeFakeCondorQ(t))
+	provider, err := NewOAuth2Provider(filepath.Join(t.TempDir(), "oauth2.db"), "https://htcondor.example.com")
+	if err != nil {
+		t.Fatalf("Failed to create OAuth2 provider: %v", err)
+	}
+	t.Cleanup(func() { _ = provider.Close() })
+	s.oauth2Provider = provider
+	token := newMCPAccessToken(t, provider, "alice", "mcp:read", "mcp:write")
+
+	var calls []Resource
+	s.authorizer = func(_ context.Context, user string, action Action, resource Resource) error {
+		if user != "alice" {
+			t.Errorf("Expected user alice, got %q", user)
+		}
+		calls = append(calls, resource)
+		if action == ActionRemove {
+			return errors.New("removals are disabled for this user")
+		}
+		return nil
+	}
+
+	call := func(method, params string) *httptest.ResponseRecorder {
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "` + method + `", "params": ` + params + `}`
+		req := httptest.NewRequest(http.MethodPost, "/mcp/message", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleMCPMessage(w, req)
+		return w
+	}
+
+	for _, params := range []string{
+		`{"name": "remove_job", "arguments": {"job_id": "7.0"}}`,
+		`{"name": "remove_jobs", "arguments": {"constraint": "Owner == \"alice\""}}`,
+	} {
+		w := call("tools/call", params)
+		if w.Code != http.StatusForbidden {
+			t.Errorf("%s: expected the removal to be denied with 403, got %d: %s", params, w.Code, w.Body.String())
+		}
+		assertErrorCode(t, w, ErrCodeForbidden)
+	}
+	if w := call("tools/list", `{}`); w.Code != http.StatusOK {
+		t.Errorf("Expected tools/list to be allowed, got %d: %s", w.Code, w.Body.String())
+	}
+
+	want := []Resource{{JobID: "7.0"}, {Constraint: `Owner == "alice"`}}
+	if !slices.Equal(calls, want) {
+		t.Errorf("Expected authorizer calls for %+v, got %+v", want, calls)
+	}
+}
+
+// TestMCPReadScopeTools checks read-only tools pass the scope and read-only
+// mode checks with an mcp:read token, and reach the authorizer, while
+// mutating tools do not
+func TestMCPReadScopeTools(t *testing.T) {
+	s := newFallbackTestServer(t, errTestAuthFailed, true, writeFakeCondorQ(t))
+	provider, err := NewOAuth2Provider(filepath.Join(t.TempDir(), "oauth2.db"), "https://htcondor.example.com")
+	if err != nil {
+		t.Fatalf("Failed to create OAuth2 provider: %v", err)
+	}
+	t.Cleanup(func() { _ = provider.Close() })
+	s.oauth2Provider = provider
+	token := newMCPAccessToken(t, provider, "alice", "mcp:read")
+
+	// The authorizer ends each call, so only the checks before it run
+	var authorized []Action
+	s.authorizer = func(_ context.Context, _ string, action Action, _ Resource) error {
+		authorized = append(authorized, action)
+		return errors.New("stop here")
+	}
+	call := func(tool string) *httptest.ResponseRecorder {
+		body := `{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "` + tool + `", "arguments": {"job_id": "7.0"}}}`
+		req := httptest.NewRequest(http.MethodPost, "/mcp/message", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleMCPMessage(w, req)
+		return w
+	}
+
+	for _, readOnly := range []bool{false, true} {
+		s.SetReadOnly(readOnly)
+		authorized = nil
+		for _, tool := range []string{"query_jobs", "get_job", "analyze_job"} {
+			if w := call(tool); w.Code != http.StatusForbidden {
+				t.Errorf("%s (read-only %v): expected the authorizer's 403, got %d: %s", tool, readOnly, w.Code, w.Body.String())
+			}
+		}
+		if len(authorized) != 3 {
+			t.Errorf("Read-only %v: expected every read-only tool to reach the authorizer, got %v", readOnly, authorized)
+		}
+
+		authorized = nil
+		if w := call("remove_job"); w.Code == http.StatusOK {
+			t.Errorf("Read-only %v: expected remove_job to be rejected with an mcp:read token", readOnly)
+		}
+		if len(authorized) != 0 {
+			t.Errorf("Read-only %v: expected remove_job to be rejected before the authorizer, got %v", readOnly, authorized)
+		}
+	}
+}
+
+// TestAuthorizerOtherEndpoints checks the collector, transfer and evaluate
+// endpoints are passed to the authorizer
+func TestAuthorizerOtherEndpoints(t *testing.T) {
+	s := newFallbackTestServer(t, errTestAuthFailed, true, writeFakeCondorQ(t))
+	s.tokenCache = NewTokenCache()
+	s.collector = htcondor.NewCollector("127.0.0.1:1")
+	token := createTestJWTToken(3600)
+
+	var actions []Action
+	s.authorizer = func(_ context.Context, _ string, action Action, _ Resource) error {
+		actions = append(actions, action)
+		return errors.New("denied")
+	}
+
+	tests := []struct {
+		method  string
+		path    string
+		body    string
+		handler http.HandlerFunc
+	}{
+		{http.MethodGet, "/api/v1/collector/ads", "", s.handleCollectorPath},
+		{http.MethodGet, "/api/v1/collector/ads/schedd", "", s.handleCollectorPath},
+		{http.MethodGet, "/api/v1/collector/ads/schedd/name", "", s.handleCollectorPath},
+		{http.MethodGet, "/api/v1/transfers", "", s.handleTransfers},
+		{http.MethodDelete, "/api/v1/transfers/abc", "", s.handleTransferByID},
+		{http.MethodPost, "/api/v1/evaluate", `{"expression": "1 + 1"}`, s.handleEvaluate},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		tt.handler(w, req)
+		if w.Code != http.StatusForbidden {
+			t.Errorf("%s %s: expected 403, got %d: %s", tt.method, tt.path, w.Code, w.Body.String())
+		}
+	}
+
+	want := []Action{ActionQueryCollector, ActionQueryCollector, ActionQueryCollector,
+		ActionListTransfers, ActionCancelTransfer, ActionEvaluate}
+	if !slices.Equal(actions, want) {
+		t.Errorf("Expected actions %v, got %v", want, actions)
+	}
+}
@@ -0,0 +1,115 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bbockelm/cedar/commands"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/ratelimit"
+)
+
+// Machine-readable error codes returned in ErrorResponse.ErrorCode. They are part
+// of the API: clients branch on them, so existing values must not change.
+const (
+	ErrCodeBadRequest            = "bad_request"
+	ErrCodeUnauthorized          = "unauthorized"
+	ErrCodeForbidden             = "forbidden"
+	ErrCodeNotFound              = "not_found"
+	ErrCodeMethodNotAllowed      = "method_not_allowed"
+	ErrCodeRateLimited           = "rate_limited"
+	ErrCodeInternal              = "internal_error"
+	ErrCodeNotImplemented        = "not_implemented"
+	ErrCodeUnavailable           = "unavailable"
+	ErrCodeJobNotFound           = "job_not_found"
+	ErrCodeScheddUnreachable     = "schedd_unreachable"
+	ErrCodeSubmitRejected        = "submit_rejected"
+	ErrCodeExecutableNotAllowed  = "executable_not_allowed"
+	ErrCodeImageNotPinned        = "image_not_pinned"
+	ErrCodeEditRejected          = "edit_rejected"
+	ErrCodePermissionDenied      = "permission_denied"
+	ErrCodeEvaluationFailed      = "evaluation_failed"
+	ErrCodeReadOnly              = "read_only"
+	ErrCodeScheddNotFound        = "schedd_not_found"
+	ErrCodeJobNotFinished        = "job_not_finished"
+	ErrCodeTransferQuotaExceeded = "transfer_quota_exceeded"
+	ErrCodeTransferCancelled     = "transfer_cancelled"
+)
+
+// defaultErrorCode returns the error code used for a status without a more
+// specific code
+func defaultErrorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrCodeForbidden
+	case http.StatusNotFound:
+		return ErrCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrCodeMethodNotAllowed
+	case http.StatusTooManyRequests:
+		return ErrCodeRateLimited
+	case http.StatusNotImplemented:
+		return ErrCodeNotImplemented
+	case http.StatusServiceUnavailable:
+		return ErrCodeUnavailable
+	}
+	if statusCode >= 400 && statusCode < 500 {
+		return ErrCodeBadRequest
+	}
+	return ErrCodeInternal
+}
+
+// writeBackendError writes an error returned by the schedd or collector.
+// Rate limiting, an unreachable schedd, authentication failures (401) and
+// authorization failures (403) have their own status and code; any other error is reported with statusCode
+// and code, its message prefixed with what failed.
+func (s *Server) writeBackendError(w http.ResponseWriter, err error, statusCode int, code, what string) {
+	var connErr *htcondor.ConnectError
+	var notAuthorized *htcondor.ErrNotAuthorized
+	var quotaErr *htcondor.TransferQuotaError
+	switch {
+	case ratelimit.IsRateLimitError(err):
+		s.writeErrorCode(w, http.StatusTooManyRequests, ErrCodeRateLimited, fmt.Sprintf("Rate limit exceeded: %v", err), nil)
+	case errors.As(err, &connErr):
+		s.writeErrorCode(w, http.StatusServiceUnavailable, ErrCodeScheddUnreachable,
+			fmt.Sprintf("Schedd unreachable: %v", err), map[string]any{"address": connErr.Address})
+	case errors.As(err, &notAuthorized):
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodePermissionDenied, fmt.Sprintf("Not authorized: %v", err),
+			map[string]any{"command": commands.GetCommandName(notAuthorized.Command)})
+	case errors.Is(err, htcondor.ErrAuthenticationFailed):
+		s.writeErrorCode(w, http.StatusUnauthorized, ErrCodeUnauthorized, fmt.Sprintf("Authentication failed: %v", err), nil)
+	case errors.Is(err, htcondor.ErrImageNotPinned):
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodeImageNotPinned, err.Error(), nil)
+	case errors.Is(err, htcondor.ErrExecutableNotAllowed):
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodeExecutableNotAllowed, err.Error(), nil)
+	case errors.Is(err, htcondor.ErrInvalidSubmitFile):
+		s.writeErrorCode(w, http.StatusBadRequest, ErrCodeSubmitRejected, err.Error(), nil)
+	case errors.As(err, &quotaErr):
+		s.writeErrorCode(w, http.StatusRequestEntityTooLarge, ErrCodeTransferQuotaExceeded, err.Error(),
+			map[string]any{"attribute": quotaErr.Attribute, "limit_mb": quotaErr.LimitMB})
+	default:
+		s.writeErrorCode(w, statusCode, code, fmt.Sprintf("%s: %v", what, err), nil)
+	}
+}
+
+// writeEditError writes an error from editing jobs: attributes that cannot
+// be edited are rejected with 403 edit_rejected, edits the schedd refused
+// get 403 permission_denied and a missing job 404; other errors are written
+// as by writeBackendError
+func (s *Server) writeEditError(w http.ResponseWriter, err error, what string) {
+	switch {
+	case errors.Is(err, htcondor.ErrAttributeNotEditable):
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodeEditRejected, fmt.Sprintf("%s: %v", what, err), nil)
+	case errors.Is(err, htcondor.ErrPermissionDenied):
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodePermissionDenied, fmt.Sprintf("Permission denied: %v", err), nil)
+	case errors.Is(err, htcondor.ErrJobNotFound):
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, fmt.Sprintf("Job not found: %v", err), nil)
+	default:
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, what)
+	}
+}
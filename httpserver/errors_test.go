@@ -0,0 +1,171 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bbockelm/cedar/commands"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+	"github.com/bbockelm/golang-htcondor/ratelimit"
+)
+
+// assertErrorCode checks that a recorded response is a JSON error with the given code
+func assertErrorCode(t *testing.T, w *httptest.ResponseRecorder, wantCode string) {
+	t.Helper()
+
+	var resp ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode error response %q: %v", w.Body.String(), err)
+	}
+	if resp.ErrorCode != wantCode {
+		t.Errorf("Expected error code %q, got %q (message: %s)", wantCode, resp.ErrorCode, resp.Message)
+	}
+	if resp.Code != w.Code {
+		t.Errorf("Expected status %d in body, got %d", w.Code, resp.Code)
+	}
+}
+
+func newErrorTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return &Server{logger: logger, tokenCache: NewTokenCache()}
+}
+
+// TestWriteBackendErrorCodes verifies backend errors map to their documented codes
+func TestWriteBackendErrorCodes(t *testing.T) {
+	s := newErrorTestServer(t)
+	connErr := &htcondor.ConnectError{Address: "schedd.example.com:9618", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"rate limited", fmt.Errorf("query: %w", &ratelimit.Error{Message: "too many queries"}), http.StatusTooManyRequests, ErrCodeRateLimited},
+		{"schedd unreachable", fmt.Errorf("failed to connect to schedd at %s: %w", connErr.Address, connErr), http.StatusServiceUnavailable, ErrCodeScheddUnreachable},
+		{"authentication", fmt.Errorf("authentication handshake failed: %w", htcondor.ErrAuthenticationFailed), http.StatusUnauthorized, ErrCodeUnauthorized},
+		{"authentication failed", fmt.Errorf("security handshake failed: %w", htcondor.ErrAuthenticationFailed), http.StatusUnauthorized, ErrCodeUnauthorized},
+		{"not authorized", fmt.Errorf("security handshake failed: %w", &htcondor.ErrNotAuthorized{Command: commands.QMGMT_WRITE_CMD, Err: errors.New("authentication failed: DENIED")}), http.StatusForbidden, ErrCodePermissionDenied},
+		{"executable policy", fmt.Errorf("%w: /bin/sh", htcondor.ErrExecutableNotAllowed), http.StatusForbidden, ErrCodeExecutableNotAllowed},
+		{"transfer quota", fmt.Errorf("failed to send files from tar: %w", &htcondor.TransferQuotaError{Attribute: "MaxTransferInputMB", LimitMB: 1, Size: 2 << 20}), http.StatusRequestEntityTooLarge, ErrCodeTransferQuotaExceeded},
+		{"other", errors.New("NewCluster failed with error code 13"), http.StatusInternalServerError, ErrCodeSubmitRejected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.writeBackendError(w, tt.err, http.StatusInternalServerError, ErrCodeSubmitRejected, "Job submission failed")
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+// TestWriteEditErrorCodes verifies edit errors are classified by type
+func TestWriteEditErrorCodes(t *testing.T) {
+	s := newErrorTestServer(t)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"not editable", htcondor.ValidateAttributeForEdit("Owner", nil), http.StatusForbidden, ErrCodeEditRejected},
+		{"refused by schedd", fmt.Errorf("failed to set attribute: %w", &htcondor.QmgmtError{Op: "SetAttribute JobPrio", Errno: 13}), http.StatusForbidden, ErrCodePermissionDenied},
+		{"job not found", fmt.Errorf("%w: 1.0", htcondor.ErrJobNotFound), http.StatusNotFound, ErrCodeJobNotFound},
+		{"other schedd error", &htcondor.QmgmtError{Op: "SetAttribute JobPrio", Errno: 22}, http.StatusInternalServerError, ErrCodeInternal},
+		{"message mentions permission", errors.New("permission to edit protected attribute"), http.StatusInternalServerError, ErrCodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.writeEditError(w, tt.err, "Failed to edit job")
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+// TestErrorCodesFromHandlers verifies handler error paths return their documented codes
+func TestErrorCodesFromHandlers(t *testing.T) {
+	s := newErrorTestServer(t)
+	// Nothing listens on port 1, so connecting to the schedd fails
+	s.schedd = htcondor.NewSchedd("unreachable", "127.0.0.1:1")
+	token := createTestJWTToken(3600)
+
+	submitBody := `{"submit_file": "executable = /bin/true\nqueue\n"}`
+
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		method     string
+		path       string
+		body       string
+		token      string
+		wantStatus int
+		wantCode   string
+	}{
+		{"method not allowed", s.handleJobs, http.MethodPut, "/api/v1/jobs", "", token, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed},
+		{"missing token", s.handleSubmitJob, http.MethodPost, "/api/v1/jobs", submitBody, "", http.StatusUnauthorized, ErrCodeUnauthorized},
+		{"bad request body", s.handleSubmitJob, http.MethodPost, "/api/v1/jobs", "{", token, http.StatusBadRequest, ErrCodeBadRequest},
+		{"schedd unreachable", s.handleSubmitJob, http.MethodPost, "/api/v1/jobs", submitBody, token, http.StatusServiceUnavailable, ErrCodeScheddUnreachable},
+		{"collector not configured", s.handleCollectorAds, http.MethodGet, "/api/v1/collector/ads", "", "", http.StatusNotImplemented, ErrCodeNotImplemented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+// TestJobNotFoundCode verifies constraint misses report job_not_found with the constraint
+func TestJobNotFoundCode(t *testing.T) {
+	s := newErrorTestServer(t)
+	w := httptest.NewRecorder()
+
+	s.handleBulkActionResults(w, &htcondor.JobActionResults{}, "Owner == \"nobody\"", "held")
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+	assertErrorCode(t, w, ErrCodeJobNotFound)
+
+	var resp ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+	if resp.Details["constraint"] != "Owner == \"nobody\"" {
+		t.Errorf("Expected the constraint in details, got %v", resp.Details)
+	}
+}
@@ -0,0 +1,312 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// evaluateTimeout bounds how long a single expression may take to evaluate
+const evaluateTimeout = 2 * time.Second
+
+// maxEvaluateRequestSize bounds the size of an evaluate request body
+const maxEvaluateRequestSize = 1 << 20
+
+// maxEvaluateExpressionSize bounds the length of the expression evaluated
+// and of each expression of the ads
+const maxEvaluateExpressionSize = 16 << 10
+
+// maxEvaluateDepth bounds the nesting of parentheses, lists and nested ads
+// in the expression and ads, which the parser and evaluator recurse into
+const maxEvaluateDepth = 64
+
+// evaluateSlots bounds the evaluations running at once. An evaluation
+// abandoned after evaluateTimeout keeps its slot until it finishes, so slow
+// expressions cannot pile up in the background.
+var evaluateSlots = make(chan struct{}, max(runtime.NumCPU(), 2))
+
+// errEvaluationBusy is returned when every evaluation slot is taken
+var errEvaluationBusy = errors.New("too many evaluations in progress")
+
+// EvaluateRequest represents an expression evaluation request.
+// Ads may be given as JSON objects (as returned by the job and collector
+// endpoints) or as strings in ClassAd syntax.
+type EvaluateRequest struct {
+	Expression string          `json:"expression"`       // ClassAd expression to evaluate
+	Ad         json.RawMessage `json:"ad,omitempty"`     // MY ad the expression is evaluated in
+	Target     json.RawMessage `json:"target,omitempty"` // Optional TARGET ad, e.g. a machine ad for job requirements
+}
+
+// EvaluateResponse represents the result of evaluating an expression
+type EvaluateResponse struct {
+	Value any    `json:"value"` // Evaluated value; null for undefined and error
+	Type  string `json:"type"`  // undefined, error, boolean, integer, real, string, list or classad
+}
+
+// handleEvaluate handles POST /api/v1/evaluate, which evaluates a ClassAd
+// expression against an ad (and optionally a target ad) as an aid to
+// writing requirements and periodic expressions. No daemon is contacted,
+// but the request must be authenticated.
+func (s *Server) handleEvaluate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	authCtx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	var req EvaluateRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxEvaluateRequestSize)).Decode(&req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
+		return
+	}
+	if req.Expression == "" {
+		s.writeError(w, http.StatusBadRequest, "expression is required")
+		return
+	}
+
+	if err := checkEvaluateExpr(req.Expression); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid expression: %v", err))
+		return
+	}
+	expr, err := classad.ParseExpr(req.Expression)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid expression: %v", err))
+		return
+	}
+	ad, err := parseEvaluateAd(req.Ad)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid ad: %v", err))
+		return
+	}
+	var target *classad.ClassAd
+	if len(req.Target) > 0 {
+		if target, err = parseEvaluateAd(req.Target); err != nil {
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid target ad: %v", err))
+			return
+		}
+	}
+
+	if !s.authorize(authCtx, w, r, ActionEvaluate, Resource{}) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), evaluateTimeout)
+	defer cancel()
+	value, err := evaluateWithContext(ctx, expr, ad, target)
+	if errors.Is(err, errEvaluationBusy) {
+		s.writeErrorCode(w, http.StatusTooManyRequests, ErrCodeRateLimited, "Too many evaluations in progress", nil)
+		return
+	}
+	if err != nil {
+		s.writeErrorCode(w, http.StatusUnprocessableEntity, ErrCodeEvaluationFailed, err.Error(), nil)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, EvaluateResponse{
+		Value: valueToJSON(value),
+		Type:  valueTypeName(value),
+	})
+}
+
+// parseEvaluateAd parses an ad given as a JSON object or a ClassAd string.
+// A missing ad is an empty one.
+func parseEvaluateAd(raw json.RawMessage) (*classad.ClassAd, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return classad.New(), nil
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if exprDepth(text) > maxEvaluateDepth {
+			return nil, fmt.Errorf("nested more than %d deep", maxEvaluateDepth)
+		}
+		return classad.Parse(text)
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	if err := checkEvaluateJSON(value, 0); err != nil {
+		return nil, err
+	}
+
+	ad := classad.New()
+	if err := json.Unmarshal(raw, ad); err != nil {
+		return nil, err
+	}
+	return ad, nil
+}
+
+// checkEvaluateExpr checks an expression is within the evaluate endpoint's
+// bounds on size and nesting
+func checkEvaluateExpr(text string) error {
+	if len(text) > maxEvaluateExpressionSize {
+		return fmt.Errorf("longer than %d bytes", maxEvaluateExpressionSize)
+	}
+	if exprDepth(text) > maxEvaluateDepth {
+		return fmt.Errorf("nested more than %d deep", maxEvaluateDepth)
+	}
+	return nil
+}
+
+// checkEvaluateJSON checks the expressions of an ad given as JSON, written
+// as "/Expr(...)/" strings, and the nesting of its lists and nested ads
+func checkEvaluateJSON(value any, depth int) error {
+	if depth > maxEvaluateDepth {
+		return fmt.Errorf("nested more than %d deep", maxEvaluateDepth)
+	}
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "/Expr(") {
+			return checkEvaluateExpr(v)
+		}
+	case []any:
+		for _, item := range v {
+			if err := checkEvaluateJSON(item, depth+1); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for name, item := range v {
+			if err := checkEvaluateJSON(item, depth+1); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// exprDepth returns how deeply the parentheses, lists and nested ads of
+// ClassAd text nest, not counting those in string literals and quoted
+// attribute names
+func exprDepth(text string) int {
+	depth, maxDepth := 0, 0
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if quote != 0 {
+			switch c {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '(', '[', '{':
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case ')', ']', '}':
+			depth--
+		}
+	}
+	return maxDepth
+}
+
+// evaluateWithContext evaluates expr with ad as MY and target (if any) as
+// TARGET. The library's evaluator cannot be interrupted, so an evaluation
+// that outlives ctx is abandoned and left to finish in the background,
+// holding its evaluation slot; errEvaluationBusy is returned if there is no
+// free slot.
+func evaluateWithContext(ctx context.Context, expr *classad.Expr, ad, target *classad.ClassAd) (classad.Value, error) {
+	type result struct {
+		value classad.Value
+		err   error
+	}
+	done := make(chan result, 1)
+
+	select {
+	case evaluateSlots <- struct{}{}:
+	default:
+		return classad.Value{}, errEvaluationBusy
+	}
+	go func() {
+		defer func() { <-evaluateSlots }()
+		defer func() {
+			if p := recover(); p != nil {
+				done <- result{err: fmt.Errorf("evaluation failed: %v", p)}
+			}
+		}()
+		if target != nil {
+			done <- result{value: expr.EvalWithContext(ad, target)}
+		} else {
+			done <- result{value: expr.Eval(ad)}
+		}
+	}()
+
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-ctx.Done():
+		return classad.Value{}, fmt.Errorf("evaluation did not finish: %w", ctx.Err())
+	}
+}
+
+// valueTypeName returns the name of a value's type as reported by the evaluate endpoint
+func valueTypeName(v classad.Value) string {
+	switch v.Type() {
+	case classad.UndefinedValue:
+		return "undefined"
+	case classad.ErrorValue:
+		return "error"
+	case classad.BooleanValue:
+		return "boolean"
+	case classad.IntegerValue:
+		return "integer"
+	case classad.RealValue:
+		return "real"
+	case classad.StringValue:
+		return "string"
+	case classad.ListValue:
+		return "list"
+	case classad.ClassAdValue:
+		return "classad"
+	default:
+		return "unknown"
+	}
+}
+
+// valueToJSON converts an evaluated value to a JSON-encodable value
+func valueToJSON(v classad.Value) any {
+	switch v.Type() {
+	case classad.BooleanValue:
+		b, _ := v.BoolValue()
+		return b
+	case classad.IntegerValue:
+		n, _ := v.IntValue()
+		return n
+	case classad.RealValue:
+		f, _ := v.RealValue()
+		return f
+	case classad.StringValue:
+		str, _ := v.StringValue()
+		return str
+	case classad.ListValue:
+		items, _ := v.ListValue()
+		list := make([]any, len(items))
+		for i, item := range items {
+			list[i] = valueToJSON(item)
+		}
+		return list
+	case classad.ClassAdValue:
+		ad, _ := v.ClassAdValue()
+		return ad
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleEvaluate(t *testing.T) {
+	s := newErrorTestServer(t)
+
+	tests := []struct {
+		name      string
+		body      string
+		wantValue any
+		wantType  string
+	}{
+		{"arithmetic", `{"expression": "RequestMemory * 2 + 512", "ad": {"RequestMemory": 1024}}`, float64(2560), "integer"},
+		{"real arithmetic", `{"expression": "RequestCpus / 4.0", "ad": "[RequestCpus = 2]"}`, 0.5, "real"},
+		{"member", `{"expression": "member(Arch, {\"X86_64\", \"aarch64\"})", "ad": {"Arch": "aarch64"}}`, true, "boolean"},
+		{"member miss", `{"expression": "member(3, {1, 2})"}`, false, "boolean"},
+		{"target", `{"expression": "MY.RequestMemory <= TARGET.Memory", "ad": {"RequestMemory": 2048}, "target": "[Memory = 1024]"}`, false, "boolean"},
+		{"undefined", `{"expression": "NoSuchAttr + 1"}`, nil, "undefined"},
+		{"list", `{"expression": "{1, \"a\"}"}`, []any{float64(1), "a"}, "list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/evaluate", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+createTestJWTToken(3600))
+			w := httptest.NewRecorder()
+
+			s.handleEvaluate(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			var resp EvaluateResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if resp.Type != tt.wantType {
+				t.Errorf("Expected type %s, got %s", tt.wantType, resp.Type)
+			}
+			got, _ := json.Marshal(resp.Value)
+			want, _ := json.Marshal(tt.wantValue)
+			if string(got) != string(want) {
+				t.Errorf("Expected value %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestHandleEvaluateErrors(t *testing.T) {
+	s := newErrorTestServer(t)
+	deep := strings.Repeat("(", maxEvaluateDepth+1) + "1" + strings.Repeat(")", maxEvaluateDepth+1)
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		anonymous  bool
+		wantStatus int
+		wantCode   string
+	}{
+		{"wrong method", http.MethodGet, "", false, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed},
+		{"unauthenticated", http.MethodPost, `{"expression": "1"}`, true, http.StatusUnauthorized, ErrCodeUnauthorized},
+		{"missing expression", http.MethodPost, `{"ad": {}}`, false, http.StatusBadRequest, ErrCodeBadRequest},
+		{"bad expression", http.MethodPost, `{"expression": "1 +"}`, false, http.StatusBadRequest, ErrCodeBadRequest},
+		{"bad ad", http.MethodPost, `{"expression": "1", "ad": "[A = ]"}`, false, http.StatusBadRequest, ErrCodeBadRequest},
+		{"long expression", http.MethodPost, `{"expression": "` + strings.Repeat("1+", maxEvaluateExpressionSize) + `1"}`, false,
+			http.StatusBadRequest, ErrCodeBadRequest},
+		{"deep expression", http.MethodPost, `{"expression": "` + deep + `"}`, false, http.StatusBadRequest, ErrCodeBadRequest},
+		{"deep ad", http.MethodPost, `{"expression": "A", "ad": "[A = ` + deep + `]"}`, false, http.StatusBadRequest, ErrCodeBadRequest},
+		{"deep JSON ad", http.MethodPost, `{"expression": "A", "ad": {"A": "/Expr(` + deep + `)/"}}`, false,
+			http.StatusBadRequest, ErrCodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/v1/evaluate", strings.NewReader(tt.body))
+			if !tt.anonymous {
+				req.Header.Set("Authorization", "Bearer "+createTestJWTToken(3600))
+			}
+			w := httptest.NewRecorder()
+
+			s.handleEvaluate(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+func TestHandleEvaluateBusy(t *testing.T) {
+	s := newErrorTestServer(t)
+
+	// Evaluations abandoned after timing out still hold every slot
+	for range cap(evaluateSlots) {
+		evaluateSlots <- struct{}{}
+	}
+	defer func() {
+		for range cap(evaluateSlots) {
+			<-evaluateSlots
+		}
+	}()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/evaluate", strings.NewReader(`{"expression": "1 + 1"}`))
+	req.Header.Set("Authorization", "Bearer "+createTestJWTToken(3600))
+	w := httptest.NewRecorder()
+	s.handleEvaluate(w, req)
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected status 429, got %d: %s", w.Code, w.Body.String())
+	}
+	assertErrorCode(t, w, ErrCodeRateLimited)
+}
+
+func TestExprDepth(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"1 + 2", 0},
+		{"(A + (B * C))", 2},
+		{"{[A = (1)], 2}", 3},
+		{`strcat("(((", A)`, 1},
+		{`'odd)name' + (1)`, 1},
+	}
+	for _, tt := range tests {
+		if got := exprDepth(tt.text); got != tt.want {
+			t.Errorf("exprDepth(%q) = %d, expected %d", tt.text, got, tt.want)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package httpserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,9 +19,15 @@ import (
 
 // JobSubmitRequest represents a job submission request
 type JobSubmitRequest struct {
-	SubmitFile string `json:"submit_file"` // Submit file content
+	SubmitFile string   `json:"submit_file"`       // Submit file content
+	Append     []string `json:"append,omitempty"`  // Extra submit commands, like condor_submit -a
+	Profile    string   `json:"profile,omitempty"` // Name of a submit profile from Config.SubmitProfiles
 }
 
+// submitAppendHeader carries extra submit commands, one per header value,
+// applied after those in the request body's append array
+const submitAppendHeader = "X-Submit-Append"
+
 // JobSubmitResponse represents a job submission response
 type JobSubmitResponse struct {
 	ClusterID int      `json:"cluster_id"`
@@ -31,6 +39,110 @@ type JobListResponse struct {
 	Jobs []*classad.ClassAd `json:"jobs"`
 }
 
+// JobActionRequest is the optional body of a single-job hold or release
+type JobActionRequest struct {
+	Reason string `json:"reason,omitempty"` // Hold or release reason
+}
+
+// BulkActionRequest selects the jobs of a bulk remove, hold or release
+type BulkActionRequest struct {
+	Constraint string `json:"constraint"`       // ClassAd constraint selecting the jobs
+	Reason     string `json:"reason,omitempty"` // Reason recorded with the action
+}
+
+// BulkEditRequest represents a constraint-based edit of job attributes
+type BulkEditRequest struct {
+	Constraint string           `json:"constraint"` // ClassAd constraint selecting the jobs
+	Attributes map[string]any   `json:"attributes"` // Attributes to set, as JSON values
+	Options    *BulkEditOptions `json:"options,omitempty"`
+}
+
+// BulkEditOptions controls a bulk edit
+type BulkEditOptions struct {
+	AllowProtectedAttrs bool `json:"allow_protected_attrs,omitempty"` // Allow editing protected attributes (requires queue superuser)
+	Force               bool `json:"force,omitempty"`                 // Skip immutable attribute checks
+}
+
+// JobActionResponse reports the result of removing, holding or releasing one job
+type JobActionResponse struct {
+	Message string          `json:"message"`
+	JobID   string          `json:"job_id"`
+	Results JobActionCounts `json:"results"`
+}
+
+// JobActionCounts counts the jobs a single-job action affected
+type JobActionCounts struct {
+	Total   int `json:"total"`
+	Success int `json:"success"`
+}
+
+// BulkActionResponse reports the result of a bulk remove, hold or release
+type BulkActionResponse struct {
+	Message    string           `json:"message"`
+	Constraint string           `json:"constraint"`
+	Results    BulkActionCounts `json:"results"`
+}
+
+// BulkActionCounts counts the outcome of a bulk action per job
+type BulkActionCounts struct {
+	Total            int `json:"total"`
+	Success          int `json:"success"`
+	NotFound         int `json:"not_found"`
+	PermissionDenied int `json:"permission_denied"`
+	BadStatus        int `json:"bad_status"`
+	AlreadyDone      int `json:"already_done"`
+	Error            int `json:"error"`
+}
+
+// newBulkActionCounts converts schedd action results for a response
+func newBulkActionCounts(results *htcondor.JobActionResults) BulkActionCounts {
+	return BulkActionCounts{
+		Total:            results.TotalJobs,
+		Success:          results.Success,
+		NotFound:         results.NotFound,
+		PermissionDenied: results.PermissionDenied,
+		BadStatus:        results.BadStatus,
+		AlreadyDone:      results.AlreadyDone,
+		Error:            results.Error,
+	}
+}
+
+// JobEditResponse reports a successful edit of one job
+type JobEditResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	JobID   string `json:"job_id"`
+}
+
+// BulkEditResponse reports a successful constraint-based edit
+type BulkEditResponse struct {
+	Status     string `json:"status"`
+	Message    string `json:"message"`
+	Constraint string `json:"constraint"`
+	JobsEdited int    `json:"jobs_edited"`
+}
+
+// JobInputResponse reports a successful input sandbox upload
+type JobInputResponse struct {
+	Message string `json:"message"`
+	JobID   string `json:"job_id"`
+}
+
+// JobRerunResponse reports the new job created by rerunning a finished one
+type JobRerunResponse struct {
+	ClusterID int      `json:"cluster_id"`
+	JobIDs    []string `json:"job_ids"`  // Array of "cluster.proc" strings
+	RerunOf   string   `json:"rerun_of"` // ID of the job that was rerun
+	// AwaitingInput is set when the new job is held until its input files
+	// are uploaded to /api/v1/jobs/{id}/input, as after a submission
+	AwaitingInput bool `json:"awaiting_input,omitempty"`
+}
+
+// HealthResponse is returned by the health and readiness checks
+type HealthResponse struct {
+	Status string `json:"status"` // "ok" or "ready"
+}
+
 // handleJobs handles /api/v1/jobs endpoint (GET for list, POST for submit, DELETE/PATCH for bulk operations)
 func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -56,35 +168,42 @@ func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Get query parameters
 	constraint := r.URL.Query().Get("constraint")
 	if constraint == "" {
 		constraint = "true" // Default: all jobs
 	}
+	if _, err := classad.ParseExpr(constraint); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid constraint: %v", err))
+		return
+	}
 
-	projectionStr := r.URL.Query().Get("projection")
-	var projection []string
-	if projectionStr != "" {
-		projection = strings.Split(projectionStr, ",")
-		for i := range projection {
-			projection[i] = strings.TrimSpace(projection[i])
-		}
+	projection := s.jobListProjection(r.URL.Query().Get("projection"))
+
+	format, err := parseAdFormat(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionQuery, Resource{Constraint: constraint}) {
+		return
 	}
 
 	// Query schedd
-	jobAds, err := s.schedd.Query(ctx, constraint, projection)
+	jobAds, err := s.queryJobs(ctx, schedd, constraint, projection)
 	if err != nil {
-		// Check if it's a rate limit error
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
-			return
-		}
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Query failed")
+		return
+	}
+
+	if format == adFormatXML {
+		s.writeAdsXML(w, http.StatusOK, jobAds)
 		return
 	}
 
@@ -92,6 +211,54 @@ func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
 	s.writeJSON(w, http.StatusOK, JobListResponse{Jobs: jobAds})
 }
 
+// jobListProjection returns the attributes a job list returns: the
+// server's default projection plus those requested in projectionStr, or
+// every attribute (nil) if there is no default or "*" is requested
+func (s *Server) jobListProjection(projectionStr string) []string {
+	var requested []string
+	for _, attr := range strings.Split(projectionStr, ",") {
+		attr = strings.TrimSpace(attr)
+		if attr == "*" {
+			return nil
+		}
+		if attr != "" {
+			requested = append(requested, attr)
+		}
+	}
+	if len(s.defaultJobProjection) == 0 {
+		return requested
+	}
+
+	projection := slices.Clone(s.defaultJobProjection)
+	for _, attr := range requested {
+		if !slices.ContainsFunc(projection, func(p string) bool { return strings.EqualFold(p, attr) }) {
+			projection = append(projection, attr)
+		}
+	}
+	return projection
+}
+
+// submitOptions returns the options submit files from clients are parsed
+// with. Includes are disabled, as they would read files or run commands on
+// the server, and $ENV() sees no environment: the server's is not the
+// submitter's. With no environment, use_x509userproxy needs the proxy named
+// with x509userproxy rather than defaulting to the server's uid.
+func (s *Server) submitOptions() *htcondor.SubmitFileOptions {
+	return &htcondor.SubmitFileOptions{
+		EnvLookup:        noEnvironment,
+		DisableIncludes:  true,
+		JobLeaseDuration: s.jobLeaseDuration,
+		ExecutablePolicy: s.executablePolicy.Load(),
+		OAuthServices:    s.oauthServices,
+		Logger:           s.logger,
+	}
+}
+
+// noEnvironment is an EnvLookup with no variables
+func noEnvironment(string) (string, bool) {
+	return "", false
+}
+
 // handleSubmitJob handles POST /api/v1/jobs
 func (s *Server) handleSubmitJob(w http.ResponseWriter, r *http.Request) {
 	// Create authenticated context
@@ -101,6 +268,11 @@ func (s *Server) handleSubmitJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse request body
 	var req JobSubmitRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -113,15 +285,29 @@ func (s *Server) handleSubmitJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Submit job using SubmitRemote
-	clusterID, procAds, err := s.schedd.SubmitRemote(ctx, req.SubmitFile)
-	if err != nil {
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+	// Appended commands go before the queue statement, overriding the submit file
+	commands := append(req.Append, r.Header.Values(submitAppendHeader)...)
+	var submitFile string
+	if req.Profile != "" {
+		profile := s.submitProfile(req.Profile)
+		if profile == nil {
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown submit profile %q", req.Profile))
 			return
 		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Job submission failed: %v", err))
+		submitFile = profile.apply(req.SubmitFile, commands)
+	} else {
+		submitFile = htcondor.AppendSubmitCommands(req.SubmitFile, commands)
+	}
+
+	if !s.authorize(ctx, w, r, ActionSubmit, Resource{}) {
+		return
+	}
+
+	// Submit job using SubmitRemote; the executable policy is checked on
+	// the job ads it submits
+	clusterID, procAds, err := schedd.SubmitRemoteWithOptions(ctx, submitFile, s.submitOptions())
+	if err != nil {
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeSubmitRejected, "Job submission failed")
 		return
 	}
 
@@ -186,6 +372,18 @@ func (s *Server) handleJobByID(w http.ResponseWriter, r *http.Request) {
 		case "release":
 			s.handleJobRelease(w, r, jobID)
 			return
+		case "rerun":
+			s.handleJobRerun(w, r, jobID)
+			return
+		case "userlog":
+			s.handleJobUserLog(w, r, jobID)
+			return
+		case "match-status":
+			s.handleJobMatchStatus(w, r, jobID)
+			return
+		case "usage":
+			s.handleJobUsage(w, r, jobID)
+			return
 		}
 	}
 
@@ -211,6 +409,11 @@ func (s *Server) handleGetJob(w http.ResponseWriter, r *http.Request, jobID stri
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse job ID
 	cluster, proc, err := parseJobID(jobID)
 	if err != nil {
@@ -221,23 +424,19 @@ func (s *Server) handleGetJob(w http.ResponseWriter, r *http.Request, jobID stri
 	// Build constraint for specific job
 	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
 
+	if !s.authorize(ctx, w, r, ActionQuery, Resource{JobID: jobID, Constraint: constraint}) {
+		return
+	}
+
 	// Query for the specific job
-	jobAds, err := s.schedd.Query(ctx, constraint, nil)
+	jobAds, err := s.queryJobs(ctx, schedd, constraint, nil)
 	if err != nil {
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
-			return
-		}
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Query failed")
 		return
 	}
 
 	if len(jobAds) == 0 {
-		s.writeError(w, http.StatusNotFound, "Job not found")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
 		return
 	}
 
@@ -254,6 +453,11 @@ func (s *Server) handleDeleteJob(w http.ResponseWriter, r *http.Request, jobID s
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse job ID
 	cluster, proc, err := parseJobID(jobID)
 	if err != nil {
@@ -261,24 +465,35 @@ func (s *Server) handleDeleteJob(w http.ResponseWriter, r *http.Request, jobID s
 		return
 	}
 
-	// Build constraint for specific job
-	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
-
-	// Remove the job using the schedd RemoveJobs method
-	results, err := s.schedd.RemoveJobs(ctx, constraint, "Removed via HTTP API")
-	if err != nil {
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+	// force=true shuts the job down fast even if it wants graceful removal
+	force := false
+	if forceStr := r.URL.Query().Get("force"); forceStr != "" {
+		if force, err = strconv.ParseBool(forceStr); err != nil {
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid force value %q", forceStr))
 			return
 		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Job removal failed: %v", err))
+	}
+
+	if !s.authorize(ctx, w, r, ActionRemove, Resource{JobID: jobID}) {
+		return
+	}
+
+	results, err := schedd.RemoveJob(ctx, htcondor.JobID{Cluster: cluster, Proc: proc}, &htcondor.RemoveJobOptions{
+		Reason: "Removed via HTTP API",
+		Force:  force,
+	})
+	if errors.Is(err, htcondor.ErrJobNotFound) {
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
+		return
+	}
+	if err != nil {
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Job removal failed")
 		return
 	}
 
 	// Check if job was found and removed
 	if results.NotFound > 0 {
-		s.writeError(w, http.StatusNotFound, "Job not found")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
 		return
 	}
 
@@ -298,13 +513,10 @@ func (s *Server) handleDeleteJob(w http.ResponseWriter, r *http.Request, jobID s
 	}
 
 	// Success
-	s.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"message": "Job removed successfully",
-		"job_id":  jobID,
-		"results": map[string]int{
-			"total":   results.TotalJobs,
-			"success": results.Success,
-		},
+	s.writeJSON(w, http.StatusOK, JobActionResponse{
+		Message: "Job removed successfully",
+		JobID:   jobID,
+		Results: JobActionCounts{Total: results.TotalJobs, Success: results.Success},
 	})
 }
 
@@ -317,6 +529,11 @@ func (s *Server) handleEditJob(w http.ResponseWriter, r *http.Request, jobID str
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse job ID
 	cluster, proc, err := parseJobID(jobID)
 	if err != nil {
@@ -379,36 +596,21 @@ func (s *Server) handleEditJob(w http.ResponseWriter, r *http.Request, jobID str
 		Force:               false,
 	}
 
-	if err := s.schedd.EditJob(ctx, cluster, proc, attributes, opts); err != nil {
-		// Check if it's a validation error (immutable/protected attribute)
-		if strings.Contains(err.Error(), "immutable") || strings.Contains(err.Error(), "protected") {
-			s.writeError(w, http.StatusForbidden, fmt.Sprintf("Cannot edit job: %v", err))
-			return
-		}
-		// Check if it's a permission error
-		if strings.Contains(err.Error(), "permission") || strings.Contains(err.Error(), "EACCES") {
-			s.writeError(w, http.StatusForbidden, fmt.Sprintf("Permission denied: %v", err))
-			return
-		}
-		// Check if job doesn't exist
-		if strings.Contains(err.Error(), "ENOENT") || strings.Contains(err.Error(), "nonexistent") {
-			s.writeError(w, http.StatusNotFound, fmt.Sprintf("Job not found: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to edit job: %v", err))
+	if !s.authorize(ctx, w, r, ActionEdit, Resource{JobID: jobID}) {
 		return
 	}
 
-	// Return success response
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(map[string]interface{}{
-		"status":  "success",
-		"message": fmt.Sprintf("Successfully edited job %s", jobID),
-		"job_id":  jobID,
-	}); err != nil {
-		s.logger.Error(logging.DestinationHTTP, "Failed to encode response", "error", err, "job_id", jobID)
+	if err := schedd.EditJob(ctx, cluster, proc, attributes, opts); err != nil {
+		s.writeEditError(w, err, "Failed to edit job")
+		return
 	}
+
+	// Return success response
+	s.writeJSON(w, http.StatusOK, JobEditResponse{
+		Status:  "success",
+		Message: fmt.Sprintf("Successfully edited job %s", jobID),
+		JobID:   jobID,
+	})
 }
 
 // handleBulkDeleteJobs handles DELETE /api/v1/jobs with constraint-based bulk removal
@@ -420,11 +622,13 @@ func (s *Server) handleBulkDeleteJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Parse request body
-	var req struct {
-		Constraint string `json:"constraint"`
-		Reason     string `json:"reason,omitempty"`
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
 	}
+
+	// Parse request body
+	var req BulkActionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
 		return
@@ -440,36 +644,28 @@ func (s *Server) handleBulkDeleteJobs(w http.ResponseWriter, r *http.Request) {
 		req.Reason = "Removed via HTTP API bulk operation"
 	}
 
+	if !s.authorize(ctx, w, r, ActionRemove, Resource{Constraint: req.Constraint}) {
+		return
+	}
+
 	// Remove jobs by constraint
-	results, err := s.schedd.RemoveJobs(ctx, req.Constraint, req.Reason)
+	results, err := schedd.RemoveJobs(ctx, req.Constraint, req.Reason)
 	if err != nil {
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Bulk job removal failed: %v", err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Bulk job removal failed")
 		return
 	}
 
 	// Check results
 	if results.TotalJobs == 0 {
-		s.writeError(w, http.StatusNotFound, "No jobs matched the constraint")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "No jobs matched the constraint", map[string]any{"constraint": req.Constraint})
 		return
 	}
 
 	// Return success with statistics
-	s.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"message":    "Bulk job removal completed",
-		"constraint": req.Constraint,
-		"results": map[string]int{
-			"total":             results.TotalJobs,
-			"success":           results.Success,
-			"not_found":         results.NotFound,
-			"permission_denied": results.PermissionDenied,
-			"bad_status":        results.BadStatus,
-			"error":             results.Error,
-		},
+	s.writeJSON(w, http.StatusOK, BulkActionResponse{
+		Message:    "Bulk job removal completed",
+		Constraint: req.Constraint,
+		Results:    newBulkActionCounts(results),
 	})
 }
 
@@ -482,15 +678,13 @@ func (s *Server) handleBulkEditJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Parse request body
-	var req struct {
-		Constraint string                 `json:"constraint"`
-		Attributes map[string]interface{} `json:"attributes"`
-		Options    *struct {
-			AllowProtectedAttrs bool `json:"allow_protected_attrs,omitempty"`
-			Force               bool `json:"force,omitempty"`
-		} `json:"options,omitempty"`
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
 	}
+
+	// Parse request body
+	var req BulkEditRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
 		return
@@ -552,43 +746,34 @@ func (s *Server) handleBulkEditJobs(w http.ResponseWriter, r *http.Request) {
 		opts.Force = req.Options.Force
 	}
 
+	if !s.authorize(ctx, w, r, ActionEdit, Resource{Constraint: req.Constraint}) {
+		return
+	}
+
 	// Edit jobs matching constraint
-	count, err := s.schedd.EditJobs(ctx, req.Constraint, attributes, opts)
+	count, err := schedd.EditJobs(ctx, req.Constraint, attributes, opts)
 	if err != nil {
-		// Check if it's a validation error (immutable/protected attribute)
-		if strings.Contains(err.Error(), "immutable") || strings.Contains(err.Error(), "protected") {
-			s.writeError(w, http.StatusForbidden, fmt.Sprintf("Cannot edit jobs: %v", err))
-			return
-		}
-		// Check if it's a permission error
-		if strings.Contains(err.Error(), "permission") || strings.Contains(err.Error(), "EACCES") {
-			s.writeError(w, http.StatusForbidden, fmt.Sprintf("Permission denied: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to edit jobs: %v", err))
+		s.writeEditError(w, err, "Failed to edit jobs")
 		return
 	}
 
 	if count == 0 {
-		s.writeError(w, http.StatusNotFound, "No jobs matched the constraint")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "No jobs matched the constraint", map[string]any{"constraint": req.Constraint})
 		return
 	}
 
 	// Return success response
-	s.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"status":      "success",
-		"message":     fmt.Sprintf("Successfully edited %d job(s)", count),
-		"constraint":  req.Constraint,
-		"jobs_edited": count,
+	s.writeJSON(w, http.StatusOK, BulkEditResponse{
+		Status:     "success",
+		Message:    fmt.Sprintf("Successfully edited %d job(s)", count),
+		Constraint: req.Constraint,
+		JobsEdited: count,
 	})
 }
 
 // parseBulkActionRequest parses constraint and reason from request body for bulk operations
 func (s *Server) parseBulkActionRequest(r *http.Request, actionName string) (constraint, reason string, err error) {
-	var req struct {
-		Constraint string `json:"constraint"`
-		Reason     string `json:"reason,omitempty"`
-	}
+	var req BulkActionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return "", "", fmt.Errorf("invalid request body: %w", err)
 	}
@@ -609,23 +794,15 @@ func (s *Server) parseBulkActionRequest(r *http.Request, actionName string) (con
 func (s *Server) handleBulkActionResults(w http.ResponseWriter, results *htcondor.JobActionResults, constraint, actionName string) {
 	// Check results
 	if results.TotalJobs == 0 {
-		s.writeError(w, http.StatusNotFound, "No jobs matched the constraint")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "No jobs matched the constraint", map[string]any{"constraint": constraint})
 		return
 	}
 
 	// Return success with statistics
-	s.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"message":    fmt.Sprintf("Bulk job %s completed", actionName),
-		"constraint": constraint,
-		"results": map[string]int{
-			"total":             results.TotalJobs,
-			"success":           results.Success,
-			"not_found":         results.NotFound,
-			"permission_denied": results.PermissionDenied,
-			"bad_status":        results.BadStatus,
-			"already_done":      results.AlreadyDone,
-			"error":             results.Error,
-		},
+	s.writeJSON(w, http.StatusOK, BulkActionResponse{
+		Message:    fmt.Sprintf("Bulk job %s completed", actionName),
+		Constraint: constraint,
+		Results:    newBulkActionCounts(results),
 	})
 }
 
@@ -633,7 +810,7 @@ func (s *Server) handleBulkActionResults(w http.ResponseWriter, results *htcondo
 type JobActionFunc func(ctx context.Context, constraint, reason string) (*htcondor.JobActionResults, error)
 
 // handleBulkJobAction is a generic handler for bulk job actions (hold, release, etc.)
-func (s *Server) handleBulkJobAction(w http.ResponseWriter, r *http.Request, actionName, actionVerb string, actionFunc JobActionFunc) {
+func (s *Server) handleBulkJobAction(w http.ResponseWriter, r *http.Request, action Action, actionName, actionVerb string, actionFunc JobActionFunc) {
 	// Create authenticated context
 	ctx, err := s.createAuthenticatedContext(r)
 	if err != nil {
@@ -648,15 +825,14 @@ func (s *Server) handleBulkJobAction(w http.ResponseWriter, r *http.Request, act
 		return
 	}
 
+	if !s.authorize(ctx, w, r, action, Resource{Constraint: constraint}) {
+		return
+	}
+
 	// Perform action
 	results, err := actionFunc(ctx, constraint, reason)
 	if err != nil {
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Bulk job %s failed: %v", actionVerb, err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, fmt.Sprintf("Bulk job %s failed", actionVerb))
 		return
 	}
 
@@ -665,12 +841,157 @@ func (s *Server) handleBulkJobAction(w http.ResponseWriter, r *http.Request, act
 
 // handleBulkHoldJobs handles POST /api/v1/jobs/hold with constraint-based bulk hold
 func (s *Server) handleBulkHoldJobs(w http.ResponseWriter, r *http.Request) {
-	s.handleBulkJobAction(w, r, "Held", "hold", s.schedd.HoldJobs)
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+	s.handleBulkJobAction(w, r, ActionHold, "Held", "hold", schedd.HoldJobs)
 }
 
 // handleBulkReleaseJobs handles POST /api/v1/jobs/release with constraint-based bulk release
 func (s *Server) handleBulkReleaseJobs(w http.ResponseWriter, r *http.Request) {
-	s.handleBulkJobAction(w, r, "Released", "release", s.schedd.ReleaseJobs)
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+	s.handleBulkJobAction(w, r, ActionRelease, "Released", "release", schedd.ReleaseJobs)
+}
+
+// handleJobRerun handles POST /api/v1/jobs/{id}/rerun, which resubmits a
+// completed or removed job as a new cluster
+func (s *Server) handleJobRerun(w http.ResponseWriter, r *http.Request, jobID string) {
+	if r.Method != http.MethodPost {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	cluster, proc, err := parseJobID(jobID)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid job ID: %v", err))
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionRerun, Resource{JobID: jobID}) {
+		return
+	}
+
+	result, err := schedd.RerunJobWithOptions(ctx, htcondor.JobID{Cluster: cluster, Proc: proc},
+		&htcondor.RerunOptions{ExecutablePolicy: s.executablePolicy.Load()})
+	switch {
+	case errors.Is(err, htcondor.ErrJobNotFound):
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
+		return
+	case errors.Is(err, htcondor.ErrJobNotFinished):
+		s.writeErrorCode(w, http.StatusConflict, ErrCodeJobNotFinished, err.Error(), nil)
+		return
+	case err != nil:
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeSubmitRejected, "Job rerun failed")
+		return
+	}
+
+	newProc, _ := result.ProcAds[0].EvaluateAttrInt("ProcId")
+	status, _ := result.ProcAds[0].EvaluateAttrInt("JobStatus")
+	s.writeJSON(w, http.StatusCreated, JobRerunResponse{
+		ClusterID:     result.ClusterID,
+		JobIDs:        []string{fmt.Sprintf("%d.%d", result.ClusterID, newProc)},
+		RerunOf:       jobID,
+		AwaitingInput: status == 5, // HELD for input spooling
+	})
+}
+
+// handleJobMatchStatus handles GET /api/v1/jobs/{id}/match-status, which
+// reports the outcome of the job's last negotiation
+func (s *Server) handleJobMatchStatus(w http.ResponseWriter, r *http.Request, jobID string) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	cluster, proc, err := parseJobID(jobID)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid job ID: %v", err))
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionQuery, Resource{JobID: jobID}) {
+		return
+	}
+
+	status, err := schedd.MatchStatus(ctx, htcondor.JobID{Cluster: cluster, Proc: proc}, s.collector)
+	switch {
+	case errors.Is(err, htcondor.ErrJobNotFound):
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
+		return
+	case err != nil:
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Match status query failed")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, status)
+}
+
+// handleJobUsage handles GET /api/v1/jobs/{id}/usage, which reports the
+// job's resource usage from the queue or, once it has finished, the history
+func (s *Server) handleJobUsage(w http.ResponseWriter, r *http.Request, jobID string) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	cluster, proc, err := parseJobID(jobID)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid job ID: %v", err))
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionQuery, Resource{JobID: jobID}) {
+		return
+	}
+
+	usage, err := schedd.GetJobUsage(ctx, htcondor.JobID{Cluster: cluster, Proc: proc})
+	switch {
+	case errors.Is(err, htcondor.ErrJobNotFound):
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
+		return
+	case err != nil:
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Usage query failed")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, usage)
 }
 
 // handleJobInput handles PUT /api/v1/jobs/{id}/input
@@ -687,6 +1008,11 @@ func (s *Server) handleJobInput(w http.ResponseWriter, r *http.Request, jobID st
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse job ID
 	cluster, proc, err := parseJobID(jobID)
 	if err != nil {
@@ -694,45 +1020,45 @@ func (s *Server) handleJobInput(w http.ResponseWriter, r *http.Request, jobID st
 		return
 	}
 
+	if !s.authorize(ctx, w, r, ActionUploadInput, Resource{JobID: jobID}) {
+		return
+	}
+
 	// First, query for the job to get its proc ad
 	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
-	jobAds, err := s.schedd.Query(ctx, constraint, nil)
+	jobAds, err := schedd.Query(ctx, constraint, nil)
 	if err != nil {
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
-			return
-		}
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Query failed")
 		return
 	}
 
 	if len(jobAds) == 0 {
-		s.writeError(w, http.StatusNotFound, "Job not found")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
 		return
 	}
 
+	// Register the transfer so it can be listed and cancelled
+	transfer := s.transfers.start(ctx, htcondor.GetAuthenticatedUserFromContext(ctx), jobID, TransferDirectionInput)
+	defer transfer.done()
+
 	// Read tarfile from request body
 	// Note: We should limit the size to prevent abuse
-	limitedReader := io.LimitReader(r.Body, 1024*1024*1024) // 1GB limit
+	limitedReader := io.LimitReader(transfer.reader(s.transferBody(w, r)), 1024*1024*1024) // 1GB limit
 
 	// Spool job files from tar
-	err = s.schedd.SpoolJobFilesFromTar(ctx, jobAds, limitedReader)
+	err = schedd.SpoolJobFilesFromTar(transfer.ctx, jobAds, limitedReader)
 	if err != nil {
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		if transfer.cancelled() {
+			s.writeErrorCode(w, http.StatusConflict, ErrCodeTransferCancelled, "Transfer cancelled", nil)
 			return
 		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to spool job files: %v", err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Failed to spool job files")
 		return
 	}
 
-	s.writeJSON(w, http.StatusOK, map[string]string{
-		"message": "Job input files uploaded successfully",
-		"job_id":  jobID,
+	s.writeJSON(w, http.StatusOK, JobInputResponse{
+		Message: "Job input files uploaded successfully",
+		JobID:   jobID,
 	})
 }
 
@@ -750,6 +1076,11 @@ func (s *Server) handleJobOutput(w http.ResponseWriter, r *http.Request, jobID s
 		return
 	}
 
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
 	// Parse job ID
 	cluster, proc, err := parseJobID(jobID)
 	if err != nil {
@@ -760,16 +1091,35 @@ func (s *Server) handleJobOutput(w http.ResponseWriter, r *http.Request, jobID s
 	// Build constraint for specific job
 	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
 
+	if !s.authorize(ctx, w, r, ActionDownloadOutput, Resource{JobID: jobID, Constraint: constraint}) {
+		return
+	}
+
 	// Set up response as tar stream
 	w.Header().Set("Content-Type", "application/x-tar")
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"job-%s-output.tar\"", jobID))
 	w.WriteHeader(http.StatusOK)
 
+	// Register the transfer so it can be listed and cancelled
+	transfer := s.transfers.start(ctx, htcondor.GetAuthenticatedUserFromContext(ctx), jobID, TransferDirectionOutput)
+	defer transfer.done()
+
 	// Start receiving job sandbox
-	errChan := s.schedd.ReceiveJobSandbox(ctx, constraint, w)
+	errChan := schedd.ReceiveJobSandbox(transfer.ctx, constraint, transfer.writer(s.transferWriter(w)))
 
 	// Wait for transfer to complete
 	if err := <-errChan; err != nil {
+		if transfer.cancelled() {
+			// The client sees an incomplete tar
+			s.logger.Info(logging.DestinationSchedd, "Job output transfer cancelled", "job_id", jobID, "transfer_id", transfer.info.ID)
+			return
+		}
+		var elsewhere *htcondor.OutputDestinationError
+		if errors.As(err, &elsewhere) {
+			// The client gets an empty archive; nothing went wrong
+			s.logger.Info(logging.DestinationSchedd, "Job output was sent to its output destination", "job_id", jobID, "destination", elsewhere.Destination)
+			return
+		}
 		// Error occurred, but we've already started writing the response
 		// Log the error and the client will see an incomplete tar
 		s.logger.Error(logging.DestinationSchedd, "Error receiving job sandbox", "job_id", jobID, "error", err)
@@ -832,9 +1182,7 @@ func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Health check always returns OK if the server is running
-	s.writeJSON(w, http.StatusOK, map[string]string{
-		"status": "ok",
-	})
+	s.writeJSON(w, http.StatusOK, HealthResponse{Status: "ok"})
 }
 
 // handleReadyz handles GET /readyz endpoint for readiness checks
@@ -847,9 +1195,7 @@ func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
 	// Readiness check returns OK if the server is ready to accept traffic
 	// Currently just checks if the server is running, but could be extended
 	// to check schedd connectivity or other dependencies
-	s.writeJSON(w, http.StatusOK, map[string]string{
-		"status": "ready",
-	})
+	s.writeJSON(w, http.StatusOK, HealthResponse{Status: "ready"})
 }
 
 // parseJobActionRequest parses job ID and optional reason for single job actions
@@ -861,9 +1207,7 @@ func (s *Server) parseJobActionRequest(r *http.Request, jobID, defaultAction str
 	}
 
 	// Parse optional reason from request body
-	var req struct {
-		Reason string `json:"reason,omitempty"`
-	}
+	var req JobActionRequest
 	if r.Body != nil && r.Body != http.NoBody {
 		if decodeErr := json.NewDecoder(r.Body).Decode(&req); decodeErr != nil {
 			// If body can't be decoded, just use empty reason
@@ -883,7 +1227,7 @@ func (s *Server) parseJobActionRequest(r *http.Request, jobID, defaultAction str
 func (s *Server) handleJobActionResults(w http.ResponseWriter, results *htcondor.JobActionResults, jobID, actionName string) {
 	// Check if job was found
 	if results.NotFound > 0 {
-		s.writeError(w, http.StatusNotFound, "Job not found")
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
 		return
 	}
 
@@ -912,18 +1256,15 @@ func (s *Server) handleJobActionResults(w http.ResponseWriter, results *htcondor
 	}
 
 	// Success
-	s.writeJSON(w, http.StatusOK, map[string]interface{}{
-		"message": fmt.Sprintf("Job %s successfully", actionName+"ed"),
-		"job_id":  jobID,
-		"results": map[string]int{
-			"total":   results.TotalJobs,
-			"success": results.Success,
-		},
+	s.writeJSON(w, http.StatusOK, JobActionResponse{
+		Message: fmt.Sprintf("Job %s successfully", actionName+"ed"),
+		JobID:   jobID,
+		Results: JobActionCounts{Total: results.TotalJobs, Success: results.Success},
 	})
 }
 
 // handleSingleJobAction is a generic handler for single job actions (hold, release, etc.)
-func (s *Server) handleSingleJobAction(w http.ResponseWriter, r *http.Request, jobID, actionName, actionVerb string, actionFunc JobActionFunc) {
+func (s *Server) handleSingleJobAction(w http.ResponseWriter, r *http.Request, jobID string, action Action, actionName, actionVerb string, actionFunc JobActionFunc) {
 	if r.Method != http.MethodPost {
 		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
@@ -946,15 +1287,14 @@ func (s *Server) handleSingleJobAction(w http.ResponseWriter, r *http.Request, j
 	// Build constraint for specific job
 	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
 
+	if !s.authorize(ctx, w, r, action, Resource{JobID: jobID, Constraint: constraint}) {
+		return
+	}
+
 	// Perform action
 	results, err := actionFunc(ctx, constraint, reason)
 	if err != nil {
-		// Check if it's an authentication error
-		if strings.Contains(err.Error(), "authentication") || strings.Contains(err.Error(), "security") {
-			s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Job %s failed: %v", actionVerb, err))
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, fmt.Sprintf("Job %s failed", actionVerb))
 		return
 	}
 
@@ -963,17 +1303,25 @@ func (s *Server) handleSingleJobAction(w http.ResponseWriter, r *http.Request, j
 
 // handleJobHold handles POST /api/v1/jobs/{id}/hold
 func (s *Server) handleJobHold(w http.ResponseWriter, r *http.Request, jobID string) {
-	s.handleSingleJobAction(w, r, jobID, "Held", "hold", s.schedd.HoldJobs)
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+	s.handleSingleJobAction(w, r, jobID, ActionHold, "Held", "hold", schedd.HoldJobs)
 }
 
 // handleJobRelease handles POST /api/v1/jobs/{id}/release
 func (s *Server) handleJobRelease(w http.ResponseWriter, r *http.Request, jobID string) {
-	s.handleSingleJobAction(w, r, jobID, "Released", "release", s.schedd.ReleaseJobs)
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+	s.handleSingleJobAction(w, r, jobID, ActionRelease, "Released", "release", schedd.ReleaseJobs)
 }
 
 // CollectorAdsResponse represents collector ads listing response
 type CollectorAdsResponse struct {
-	Ads []*classad.ClassAd `json:"ads"`
+	Ads []map[string]interface{} `json:"ads"`
 }
 
 // handleCollectorAds handles /api/v1/collector/ads endpoint
@@ -988,8 +1336,6 @@ func (s *Server) handleCollectorAds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := r.Context()
-
 	// Get query parameters
 	constraint := r.URL.Query().Get("constraint")
 	if constraint == "" {
@@ -1006,19 +1352,19 @@ func (s *Server) handleCollectorAds(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Query collector for all ads (using "Machine" which queries STARTD ads)
-	// In a more complete implementation, we'd query all ad types
-	ads, err := s.collector.QueryAdsWithProjection(ctx, "StartdAd", constraint, projection)
+	format, err := parseAdFormat(r)
 	if err != nil {
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	s.writeJSON(w, http.StatusOK, CollectorAdsResponse{Ads: ads})
+	if !s.authorizeRequest(w, r, ActionQueryCollector, Resource{AdType: "StartdAd", Constraint: constraint}) {
+		return
+	}
+
+	// Query collector for all ads (using "Machine" which queries STARTD ads)
+	// In a more complete implementation, we'd query all ad types
+	s.writeCollectorAds(w, r, "StartdAd", constraint, projection, format)
 }
 
 // handleCollectorAdsByType handles /api/v1/collector/ads/{adType} endpoint
@@ -1033,8 +1379,6 @@ func (s *Server) handleCollectorAdsByType(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	ctx := r.Context()
-
 	// Get query parameters
 	constraint := r.URL.Query().Get("constraint")
 	if constraint == "" {
@@ -1051,6 +1395,12 @@ func (s *Server) handleCollectorAdsByType(w http.ResponseWriter, r *http.Request
 		}
 	}
 
+	format, err := parseAdFormat(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// Map common ad type names
 	var queryAdType string
 	switch strings.ToLower(adType) {
@@ -1075,20 +1425,42 @@ func (s *Server) handleCollectorAdsByType(w http.ResponseWriter, r *http.Request
 		queryAdType = adType
 	}
 
-	// Query collector
-	ads, err := s.collector.QueryAdsWithProjection(ctx, queryAdType, constraint, projection)
-	if err != nil {
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
+	if !s.authorizeRequest(w, r, ActionQueryCollector, Resource{AdType: queryAdType, Constraint: constraint}) {
+		return
+	}
+
+	s.writeCollectorAds(w, r, queryAdType, constraint, projection, format)
+}
+
+// writeCollectorAds queries the collector and writes the ads in format
+func (s *Server) writeCollectorAds(w http.ResponseWriter, r *http.Request, adType, constraint string, projection []string, format string) {
+	if format == adFormatXML {
+		ads, err := s.collector.QueryAdsWithProjection(r.Context(), adType, constraint, projection)
+		if err != nil {
+			s.writeCollectorQueryError(w, err)
 			return
 		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeAdsXML(w, http.StatusOK, ads)
 		return
 	}
 
+	ads, err := s.collector.QueryAdsAsMaps(r.Context(), adType, constraint, projection)
+	if err != nil {
+		s.writeCollectorQueryError(w, err)
+		return
+	}
 	s.writeJSON(w, http.StatusOK, CollectorAdsResponse{Ads: ads})
 }
 
+// writeCollectorQueryError writes the response for a failed collector query
+func (s *Server) writeCollectorQueryError(w http.ResponseWriter, err error) {
+	if ratelimit.IsRateLimitError(err) {
+		s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
+		return
+	}
+	s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+}
+
 // handleCollectorAdByName handles /api/v1/collector/ads/{adType}/{name} endpoint
 func (s *Server) handleCollectorAdByName(w http.ResponseWriter, r *http.Request, adType, name string) {
 	if r.Method != http.MethodGet {
@@ -1141,16 +1513,15 @@ func (s *Server) handleCollectorAdByName(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Build constraint for specific ad by name
-	constraint := fmt.Sprintf("%s == %q", nameAttr, name)
+	constraint := fmt.Sprintf("%s == %s", nameAttr, classad.Quote(name))
+	if !s.authorizeRequest(w, r, ActionQueryCollector, Resource{AdType: queryAdType, Name: name}) {
+		return
+	}
 
 	// Query collector
-	ads, err := s.collector.QueryAdsWithProjection(ctx, queryAdType, constraint, projection)
+	ads, err := s.collector.QueryAdsAsMaps(ctx, queryAdType, constraint, projection)
 	if err != nil {
-		if ratelimit.IsRateLimitError(err) {
-			s.writeError(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded: %v", err))
-			return
-		}
-		s.writeError(w, http.StatusInternalServerError, fmt.Sprintf("Query failed: %v", err))
+		s.writeCollectorQueryError(w, err)
 		return
 	}
 
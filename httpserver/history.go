@@ -0,0 +1,135 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// Page sizes for GET /api/v1/history
+const (
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 1000
+)
+
+// HistoryResponse represents a page of job history
+type HistoryResponse struct {
+	Jobs       []*classad.ClassAd `json:"jobs"`
+	NextOffset int                `json:"next_offset,omitempty"` // Offset of the next page; omitted on the last page
+}
+
+// handleHistory handles GET /api/v1/history, which returns the caller's
+// jobs that have left the queue, newest first. Query parameters:
+// constraint, projection, since (CompletionDate lower bound as Unix
+// seconds or RFC 3339), limit and offset.
+func (s *Server) handleHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	constraint := query.Get("constraint")
+	if constraint == "" {
+		constraint = "true"
+	}
+	if _, err := classad.ParseExpr(constraint); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid constraint: %v", err))
+		return
+	}
+	if sinceStr := query.Get("since"); sinceStr != "" {
+		since, err := parseHistorySince(sinceStr)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		constraint = fmt.Sprintf("(%s) && (CompletionDate >= %d)", constraint, since.Unix())
+	}
+
+	var projection []string
+	if projectionStr := query.Get("projection"); projectionStr != "" {
+		projection = strings.Split(projectionStr, ",")
+		for i := range projection {
+			projection[i] = strings.TrimSpace(projection[i])
+		}
+	}
+
+	limit, err := parseHistoryInt(query.Get("limit"), "limit", defaultHistoryLimit)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if limit == 0 || limit > maxHistoryLimit {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxHistoryLimit))
+		return
+	}
+	offset, err := parseHistoryInt(query.Get("offset"), "offset", 0)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionHistory, Resource{Constraint: constraint}) {
+		return
+	}
+
+	// Fetch one ad past the page to tell whether there is another page
+	ads, err := schedd.History(ctx, constraint, projection, &htcondor.HistoryOptions{
+		Limit:       offset + limit + 1,
+		OwnJobsOnly: true,
+	})
+	if err != nil {
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "History query failed")
+		return
+	}
+
+	resp := HistoryResponse{Jobs: []*classad.ClassAd{}}
+	if offset < len(ads) {
+		resp.Jobs = ads[offset:]
+	}
+	if len(resp.Jobs) > limit {
+		resp.Jobs = resp.Jobs[:limit]
+		resp.NextOffset = offset + limit
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// parseHistoryInt parses a non-negative integer query parameter
+func parseHistoryInt(value, name string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", name)
+	}
+	return n, nil
+}
+
+// parseHistorySince parses the since parameter as Unix seconds or an RFC 3339 time
+func parseHistorySince(value string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("since must be Unix seconds or an RFC 3339 time")
+	}
+	return t, nil
+}
@@ -0,0 +1,60 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// TestHistoryParameters verifies history query parameters are validated
+// before the schedd is contacted
+func TestHistoryParameters(t *testing.T) {
+	s := newErrorTestServer(t)
+	// Nothing listens on port 1, so valid requests fail with schedd_unreachable
+	s.schedd = htcondor.NewSchedd("unreachable", "127.0.0.1:1")
+	token := createTestJWTToken(3600)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCode   string
+	}{
+		{"bad constraint", "?constraint=Owner%20%3D%3D", http.StatusBadRequest, ErrCodeBadRequest},
+		{"bad since", "?since=yesterday", http.StatusBadRequest, ErrCodeBadRequest},
+		{"zero limit", "?limit=0", http.StatusBadRequest, ErrCodeBadRequest},
+		{"limit too large", "?limit=5000", http.StatusBadRequest, ErrCodeBadRequest},
+		{"negative offset", "?offset=-1", http.StatusBadRequest, ErrCodeBadRequest},
+		{"valid", "?since=2024-01-02T15:04:05Z&limit=10&offset=20", http.StatusServiceUnavailable, ErrCodeScheddUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/history"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+
+			s.handleHistory(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+func TestParseHistorySince(t *testing.T) {
+	for _, value := range []string{"1704207845", "2024-01-02T15:04:05Z"} {
+		since, err := parseHistorySince(value)
+		if err != nil {
+			t.Fatalf("parseHistorySince(%q) failed: %v", value, err)
+		}
+		if !since.Equal(time.Unix(1704207845, 0)) {
+			t.Errorf("parseHistorySince(%q) = %v", value, since)
+		}
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -967,6 +970,157 @@ queue`
 	_ = server
 }
 
+// TestJobRedactionIntegration submits a job carrying a secret attribute and
+// checks the GET response leaves it out
+func TestJobRedactionIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	_, _, baseURL, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	testUser := "testuser"
+
+	submitFile := `executable = /bin/sleep
+arguments = 60
++EC2SecretAccessKey = "/home/testuser/.ec2/secret"
++RedactionCheck = "visible"
+queue`
+	_, jobID := submitJob(t, client, baseURL, testUser, submitFile)
+	defer removeJob(t, client, baseURL, testUser, jobID)
+
+	jobAd := getJob(t, client, baseURL, testUser, jobID)
+	if jobAd["RedactionCheck"] != "visible" {
+		t.Fatalf("Expected RedactionCheck in the job ad, got %+v", jobAd)
+	}
+	for attr := range jobAd {
+		if strings.EqualFold(attr, "EC2SecretAccessKey") {
+			t.Errorf("Expected EC2SecretAccessKey to be redacted, got %v", jobAd[attr])
+		}
+	}
+}
+
+// TestJobMatchStatusIntegration submits a job no machine can run and checks
+// match-status reports it rejected with a reason
+func TestJobMatchStatusIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	tempDir, _, baseURL, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	testUser := "testuser"
+
+	submitFile := `executable = /bin/sleep
+arguments = 60
+request_memory = 100000000
+queue`
+	_, jobID := submitJob(t, client, baseURL, testUser, submitFile)
+	defer removeJob(t, client, baseURL, testUser, jobID)
+
+	// Release the job from its initial hold by spooling its input
+	uploadInputTarball(t, client, baseURL, testUser, jobID, createSimpleInputTarball(t))
+
+	// Wait until the job is idle and the startd has advertised itself
+	var status htcondor.MatchStatus
+	deadline := time.Now().Add(60 * time.Second)
+	for time.Now().Before(deadline) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/jobs/%s/match-status", baseURL, jobID), nil)
+		req.Header.Set("X-Test-User", testUser)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to get match status: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			printHTCondorLogs(tempDir, t)
+			t.Fatalf("Match status failed with status %d: %s", resp.StatusCode, string(body))
+		}
+		if err := json.Unmarshal(body, &status); err != nil {
+			t.Fatalf("Failed to decode match status: %v", err)
+		}
+		if status.Status == htcondor.MatchStatusRejected && (status.Analysis == nil || status.Analysis.TotalMachines > 0) {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Logf("Match status of %s: %+v", jobID, status)
+	if status.Status != htcondor.MatchStatusRejected {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Expected the job to be rejected, got %s (%s)", status.Status, status.Reason)
+	}
+	if status.Reason == "" {
+		t.Error("Expected a rejection reason")
+	}
+}
+
+// TestJobUsageIntegration runs a job that burns some CPU and checks usage
+// reports its CPU time and memory once it has completed
+func TestJobUsageIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	tempDir, _, baseURL, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	testUser := "testuser"
+
+	submitFile := `executable = /bin/bash
+arguments = script.sh
+transfer_input_files = script.sh
+transfer_executable = NO
+should_transfer_files = YES
+when_to_transfer_output = ON_EXIT
+queue`
+	_, jobID := submitJob(t, client, baseURL, testUser, submitFile)
+	uploadInputTarball(t, client, baseURL, testUser, jobID, createInputTarball(t, map[string]string{
+		"script.sh": "#!/bin/bash\nend=$((SECONDS + 5))\nwhile [ $SECONDS -lt $end ]; do :; done\n",
+	}))
+	waitForJobCompletion(t, client, baseURL, testUser, jobID, tempDir, 120*time.Second)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/jobs/%s/usage", baseURL, jobID), nil)
+	req.Header.Set("X-Test-User", testUser)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to get usage: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Usage failed with status %d: %s", resp.StatusCode, string(body))
+	}
+	var usage htcondor.JobUsage
+	if err := json.Unmarshal(body, &usage); err != nil {
+		t.Fatalf("Failed to decode usage: %v", err)
+	}
+
+	t.Logf("Usage of %s: %+v", jobID, usage)
+	if usage.JobStatus != 4 {
+		t.Errorf("Expected a completed job, got status %d", usage.JobStatus)
+	}
+	if usage.RemoteUserCpu+usage.RemoteSysCpu < 1 {
+		t.Errorf("Expected the job to have used CPU time, got %+v", usage)
+	}
+	if usage.RemoteWallClockTime < 5 {
+		t.Errorf("Expected at least 5s of wall clock time, got %v", usage.RemoteWallClockTime)
+	}
+	if usage.MemoryUsage <= 0 && usage.ResidentSetSize <= 0 {
+		t.Errorf("Expected memory usage to be reported, got %+v", usage)
+	}
+}
+
 // TestBulkJobOperationsIntegration tests bulk hold and release by constraint
 func TestBulkJobOperationsIntegration(t *testing.T) {
 	// Skip if condor_master is not available
@@ -1141,6 +1295,282 @@ func TestCollectorQueryIntegration(t *testing.T) {
 	_ = server
 }
 
+// TestHistoryIntegration runs a quick job to completion, removes it from the
+// queue and retrieves it from history, scoped to its owner
+func TestHistoryIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	tempDir, server, baseURL, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	testUser := "testuser"
+
+	submitFile := `executable = /bin/true
+transfer_executable = false
+queue`
+	clusterID, jobID := submitJob(t, client, baseURL, testUser, submitFile)
+	uploadInputTarball(t, client, baseURL, testUser, jobID, createSimpleInputTarball(t))
+	waitForJobCompletion(t, client, baseURL, testUser, jobID, tempDir, 60*time.Second)
+
+	// Spooled jobs stay in the queue after completing; removing them moves them to history
+	removeJob(t, client, baseURL, testUser, jobID)
+
+	getHistory := func(user, query string) HistoryResponse {
+		req, _ := http.NewRequest("GET", baseURL+"/api/v1/history?"+query, nil)
+		req.Header.Set("X-Test-User", user)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to query history: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			printHTCondorLogs(tempDir, t)
+			t.Fatalf("History query failed with status %d: %s", resp.StatusCode, string(body))
+		}
+		var history HistoryResponse
+		if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
+			t.Fatalf("Failed to decode history: %v", err)
+		}
+		return history
+	}
+	constraint := fmt.Sprintf("constraint=ClusterId%%20%%3D%%3D%%20%d", clusterID)
+
+	var history HistoryResponse
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		history = getHistory(testUser, constraint)
+		if len(history.Jobs) > 0 {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	if len(history.Jobs) != 1 {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Expected job %s in history, got %d jobs", jobID, len(history.Jobs))
+	}
+	if owner, _ := history.Jobs[0].EvaluateAttrString("Owner"); owner != testUser {
+		t.Errorf("Expected owner %s, got %s", testUser, owner)
+	}
+	if history.NextOffset != 0 {
+		t.Errorf("Expected a single page, got next_offset %d", history.NextOffset)
+	}
+
+	// Other users do not see the job, even when asking for it by owner
+	other := getHistory("otheruser", constraint+"%20%26%26%20Owner%20%3D%3D%20%22testuser%22")
+	if len(other.Jobs) != 0 {
+		t.Errorf("Expected other users to see none of %s's history, got %d jobs", testUser, len(other.Jobs))
+	}
+
+	// The job completed before now, so a later since excludes it
+	future := getHistory(testUser, fmt.Sprintf("%s&since=%d", constraint, time.Now().Add(time.Hour).Unix()))
+	if len(future.Jobs) != 0 {
+		t.Errorf("Expected no jobs completed after now, got %d", len(future.Jobs))
+	}
+
+	_ = server
+}
+
+// TestUserPrioritiesIntegration reads the user priorities from the mini
+// pool's negotiator and sets a priority factor, as condor_userprio does
+func TestUserPrioritiesIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	tempDir, server, baseURL, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	testUser := "testuser"
+
+	// getPriorities returns the status and, on success, the priorities
+	getPriorities := func() (int, PrioritiesResponse) {
+		req, _ := http.NewRequest("GET", baseURL+"/api/v1/priorities", nil)
+		req.Header.Set("X-Test-User", testUser)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to query priorities: %v", err)
+		}
+		defer resp.Body.Close()
+		var priorities PrioritiesResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&priorities); err != nil {
+				t.Fatalf("Failed to decode priorities: %v", err)
+			}
+		}
+		return resp.StatusCode, priorities
+	}
+
+	// The negotiator is found once it has advertised itself to the collector
+	var status int
+	deadline := time.Now().Add(60 * time.Second)
+	for time.Now().Before(deadline) {
+		if status, _ = getPriorities(); status != http.StatusServiceUnavailable {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	if status != http.StatusOK {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Priority query failed with status %d", status)
+	}
+
+	// Setting a factor creates the submitter's entry in the accountant
+	name := "priotest@example.com"
+	req, _ := http.NewRequest(http.MethodPut, baseURL+"/api/v1/priorities/"+name,
+		strings.NewReader(`{"priority_factor": 5000}`))
+	req.Header.Set("X-Test-User", testUser)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to set priority factor: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Setting priority factor failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	// The negotiator does not acknowledge the request, so poll for the change
+	var found *htcondor.UserPriority
+	deadline = time.Now().Add(30 * time.Second)
+	for found == nil && time.Now().Before(deadline) {
+		_, priorities := getPriorities()
+		for i := range priorities.Priorities {
+			if priorities.Priorities[i].Name == name {
+				found = &priorities.Priorities[i]
+			}
+		}
+		if found == nil {
+			time.Sleep(time.Second)
+		}
+	}
+	if found == nil {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Expected %s in the priorities", name)
+	}
+	if math.Abs(found.PriorityFactor-5000) > 0.01 {
+		t.Errorf("Expected priority factor 5000, got %g", found.PriorityFactor)
+	}
+	t.Logf("Priority of %s: %+v", name, *found)
+
+	_ = server
+}
+
+// TestQmgmtIntegration scripts a submission with the raw QMGMT primitives
+// against the mini schedd, so their opcodes and reply layouts are checked
+// against a real schedd, and checks a refused DestroyCluster is reported as
+// ErrPermissionDenied
+func TestQmgmtIntegration(t *testing.T) {
+	// Skip if condor_master is not available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH, skipping integration test")
+	}
+
+	tempDir, server, _, cleanup := setupIntegrationTest(t)
+	defer cleanup()
+
+	// Authenticate as the server would for a request from user
+	userContext := func(user string) context.Context {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Test-User", user)
+		ctx, err := server.createAuthenticatedContext(req)
+		if err != nil {
+			t.Fatalf("Failed to authenticate as %s: %v", user, err)
+		}
+		return ctx
+	}
+	ctx := userContext("testuser")
+
+	qmgmt, err := server.schedd.Qmgmt(ctx)
+	if err != nil {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("Qmgmt failed: %v", err)
+	}
+	defer qmgmt.Close()
+
+	clusterID, err := qmgmt.NewCluster(ctx)
+	if err != nil {
+		t.Fatalf("NewCluster failed: %v", err)
+	}
+	procID, err := qmgmt.NewProc(ctx, clusterID)
+	if err != nil {
+		t.Fatalf("NewProc failed: %v", err)
+	}
+	sf, err := htcondor.ParseSubmitFile(strings.NewReader("executable = /bin/true\nhold = true\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(clusterID)
+	if err != nil {
+		t.Fatalf("Failed to generate job ad: %v", err)
+	}
+	if err := qmgmt.SendJobAttributes(ctx, clusterID, procID, result.ProcAds[0]); err != nil {
+		t.Fatalf("SendJobAttributes failed: %v", err)
+	}
+	if err := qmgmt.SetAttribute(ctx, clusterID, procID, "QmgmtTag", `"manual"`, 0); err != nil {
+		t.Fatalf("SetAttribute failed: %v", err)
+	}
+
+	// Uncommitted changes are visible on the connection
+	value, err := qmgmt.GetAttribute(ctx, clusterID, procID, "QmgmtTag")
+	if err != nil {
+		t.Fatalf("GetAttribute failed: %v", err)
+	}
+	if value != `"manual"` {
+		t.Errorf("Expected QmgmtTag \"manual\", got %s", value)
+	}
+	if _, err := qmgmt.GetAttribute(ctx, clusterID, procID, "NoSuchAttribute"); err == nil {
+		t.Error("Expected an error getting a missing attribute")
+	}
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		printHTCondorLogs(tempDir, t)
+		t.Fatalf("CommitTransaction failed: %v", err)
+	}
+
+	jobs, err := server.schedd.Query(ctx, fmt.Sprintf("ClusterId == %d && ProcId == %d", clusterID, procID), []string{"Owner", "QmgmtTag"})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("Expected the committed job in the queue, got %d jobs", len(jobs))
+	}
+	if tag, _ := jobs[0].EvaluateAttrString("QmgmtTag"); tag != "manual" {
+		t.Errorf("Expected committed QmgmtTag manual, got %q", tag)
+	}
+
+	// Another user may not destroy the cluster
+	otherCtx := userContext("otheruser")
+	other, err := server.schedd.Qmgmt(otherCtx)
+	if err != nil {
+		t.Fatalf("Qmgmt as otheruser failed: %v", err)
+	}
+	err = other.DestroyCluster(otherCtx, clusterID)
+	_ = other.AbortTransaction(otherCtx)
+	_ = other.Close()
+	if !errors.Is(err, htcondor.ErrPermissionDenied) {
+		t.Errorf("Expected ErrPermissionDenied destroying another user's cluster, got %v", err)
+	}
+
+	// The owner may, in a second transaction on the same connection
+	if err := qmgmt.BeginTransaction(ctx); err != nil {
+		t.Fatalf("BeginTransaction failed: %v", err)
+	}
+	if err := qmgmt.DestroyCluster(ctx, clusterID); err != nil {
+		t.Fatalf("DestroyCluster failed: %v", err)
+	}
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		t.Fatalf("CommitTransaction failed: %v", err)
+	}
+}
+
 // setupIntegrationTest is a helper to set up a test environment with mini condor and HTTP server
 func setupIntegrationTest(t *testing.T) (tempDir string, server *Server, baseURL string, cleanup func()) {
 	// Create temporary directory for mini condor
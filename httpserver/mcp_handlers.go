@@ -2,12 +2,12 @@ package httpserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -94,6 +94,18 @@ func (s *Server) handleMCPMessage(w http.ResponseWriter, r *http.Request) {
 		s.writeOAuthError(w, http.StatusForbidden, "insufficient_scope", "Insufficient permissions for requested operation")
 		return
 	}
+	if s.ReadOnly() && s.methodRequiresWrite(&mcpRequest) {
+		s.writeReadOnlyError(w)
+		return
+	}
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if !s.authorizeMCP(htcondor.WithAuthenticatedUser(r.Context(), username), w, r, &mcpRequest) {
+		return
+	}
 
 	// Create context with security config for HTCondor operations
 	ctx := r.Context()
@@ -127,11 +139,14 @@ func (s *Server) handleMCPMessage(w http.ResponseWriter, r *http.Request) {
 	// IMPORTANT: Reuse the HTTP server's schedd connection to avoid redundant
 	// authentication and key exchange on every MCP request
 	mcpServer, err := mcpserver.NewServer(mcpserver.Config{
-		Schedd:         s.schedd,
-		SigningKeyPath: s.signingKeyPath,
-		TrustDomain:    s.trustDomain,
-		UIDDomain:      s.uidDomain,
-		Logger:         s.logger,
+		Schedd:           schedd,
+		SigningKeyPath:   s.signingKeyPath,
+		TrustDomain:      s.trustDomain,
+		UIDDomain:        s.uidDomain,
+		Logger:           s.logger,
+		ExecutablePolicy: s.executablePolicy.Load(),
+		Redactor:         s.redactor.Load(),
+		SubmitOptions:    s.submitOptions(),
 	})
 	if err != nil {
 		s.logger.Error(logging.DestinationHTTP, "Failed to create MCP server", "error", err)
@@ -605,6 +620,46 @@ func (s *Server) isMethodAllowedByScopes(token fosite.AccessRequester, mcpReques
 	return false
 }
 
+// mcpToolActions are the Authorizer actions of the MCP tools: those of the
+// REST endpoints doing the same
+var mcpToolActions = map[string]Action{
+	"submit_job":  ActionSubmit,
+	"query_jobs":  ActionQuery,
+	"get_job":     ActionQuery,
+	"analyze_job": ActionQuery,
+	"remove_job":  ActionRemove,
+	"remove_jobs": ActionRemove,
+	"edit_job":    ActionEdit,
+	"hold_job":    ActionHold,
+	"release_job": ActionRelease,
+}
+
+// authorizeMCP asks the configured Authorizer whether the user of ctx may
+// call the tool of an MCP tools/call request, writing the 403 response and
+// returning false if not. Other MCP methods only describe the server and
+// are not authorized.
+func (s *Server) authorizeMCP(ctx context.Context, w http.ResponseWriter, r *http.Request, mcpRequest *mcpserver.MCPMessage) bool {
+	if s.authorizer == nil || mcpRequest.Method != "tools/call" {
+		return true
+	}
+	var params struct {
+		Name      string         `json:"name"`
+		Arguments map[string]any `json:"arguments"`
+	}
+	if err := json.Unmarshal(mcpRequest.Params, &params); err != nil {
+		s.writeError(w, http.StatusBadRequest, "Invalid MCP message format")
+		return false
+	}
+	action, ok := mcpToolActions[params.Name]
+	if !ok {
+		// Unknown tools are rejected by the MCP server
+		return true
+	}
+	jobID, _ := params.Arguments["job_id"].(string)
+	constraint, _ := params.Arguments["constraint"].(string)
+	return s.authorize(ctx, w, r, action, Resource{JobID: jobID, Constraint: constraint})
+}
+
 // methodRequiresWrite determines if an MCP method requires write access
 func (s *Server) methodRequiresWrite(mcpRequest *mcpserver.MCPMessage) bool {
 	// Read-only methods
@@ -628,8 +683,9 @@ func (s *Server) methodRequiresWrite(mcpRequest *mcpserver.MCPMessage) bool {
 		if err := json.Unmarshal(mcpRequest.Params, &params); err == nil {
 			// Read-only tools
 			readOnlyTools := map[string]bool{
-				"query_jobs": true,
-				"get_job":    true,
+				"query_jobs":  true,
+				"get_job":     true,
+				"analyze_job": true,
 			}
 			if readOnlyTools[params.Name] {
 				return false
@@ -643,7 +699,7 @@ func (s *Server) methodRequiresWrite(mcpRequest *mcpserver.MCPMessage) bool {
 
 // generateHTCondorTokenWithScopes generates an HTCondor token with scope-based permissions
 func (s *Server) generateHTCondorTokenWithScopes(username string, scopes []string) (string, error) {
-	if s.signingKeyPath == "" {
+	if s.signingKeys == nil {
 		return "", fmt.Errorf("signing key path not configured")
 	}
 
@@ -689,15 +745,7 @@ func (s *Server) generateHTCondorTokenWithScopes(username string, scopes []strin
 		"scopes", scopes,
 		"signing_key_path", s.signingKeyPath,
 	)
-	token, err := security.GenerateJWT(
-		filepath.Dir(s.signingKeyPath),
-		filepath.Base(s.signingKeyPath),
-		username,
-		s.trustDomain,
-		iat,
-		exp,
-		authz,
-	)
+	token, err := s.signingKeys.GenerateToken(username, s.trustDomain, iat, exp, authz)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate JWT: %w", err)
 	}
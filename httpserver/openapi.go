@@ -2,1066 +2,161 @@ package httpserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
 	"github.com/bbockelm/golang-htcondor/logging"
 )
 
-// OpenAPI schema for the HTCondor RESTful API
-const openAPISchema = `{
-  "openapi": "3.0.0",
-  "info": {
-    "title": "HTCondor RESTful API",
-    "description": "RESTful API for managing HTCondor jobs",
-    "version": "1.0.0"
-  },
-  "servers": [
-    {
-      "url": "/api/v1",
-      "description": "API v1"
-    }
-  ],
-  "security": [
-    {
-      "bearerAuth": []
-    }
-  ],
-  "components": {
-    "securitySchemes": {
-      "bearerAuth": {
-        "type": "http",
-        "scheme": "bearer",
-        "bearerFormat": "TOKEN",
-        "description": "HTCondor TOKEN authentication. The bearer token is used to authenticate with the schedd on behalf of the user."
-      }
-    },
-    "schemas": {
-      "Error": {
-        "type": "object",
-        "properties": {
-          "error": {
-            "type": "string",
-            "description": "Error type"
-          },
-          "message": {
-            "type": "string",
-            "description": "Error message"
-          },
-          "code": {
-            "type": "integer",
-            "description": "HTTP status code"
-          }
-        }
-      },
-      "JobSubmitRequest": {
-        "type": "object",
-        "required": ["submit_file"],
-        "properties": {
-          "submit_file": {
-            "type": "string",
-            "description": "HTCondor submit file content"
-          }
-        }
-      },
-      "JobSubmitResponse": {
-        "type": "object",
-        "properties": {
-          "cluster_id": {
-            "type": "integer",
-            "description": "Cluster ID of submitted job(s)"
-          },
-          "job_ids": {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "Array of job IDs in cluster.proc format"
-          }
-        }
-      },
-      "JobListResponse": {
-        "type": "object",
-        "properties": {
-          "jobs": {
-            "type": "array",
-            "items": {
-              "type": "object",
-              "description": "Job ClassAd as a JSON object"
-            },
-            "description": "Array of job ClassAds"
-          }
-        }
-      }
-    }
-  },
-  "paths": {
-    "/jobs": {
-      "get": {
-        "summary": "List jobs",
-        "description": "Query the schedd for jobs matching the constraint",
-        "operationId": "listJobs",
-        "parameters": [
-          {
-            "name": "constraint",
-            "in": "query",
-            "description": "ClassAd constraint expression (default: 'true' for all jobs)",
-            "required": false,
-            "schema": {
-              "type": "string",
-              "default": "true"
-            }
-          },
-          {
-            "name": "projection",
-            "in": "query",
-            "description": "Comma-separated list of attributes to return (default: all attributes)",
-            "required": false,
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "List of jobs",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/JobListResponse"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Internal server error",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      },
-      "post": {
-        "summary": "Submit a job",
-        "description": "Submit a new job to the schedd using SubmitRemote. Jobs are submitted with input file spooling enabled and start in HELD status until input files are uploaded.",
-        "operationId": "submitJob",
-        "requestBody": {
-          "required": true,
-          "content": {
-            "application/json": {
-              "schema": {
-                "$ref": "#/components/schemas/JobSubmitRequest"
-              }
-            }
-          }
-        },
-        "responses": {
-          "201": {
-            "description": "Job submitted successfully",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/JobSubmitResponse"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid request",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Job submission failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/{jobId}": {
-      "get": {
-        "summary": "Get job details",
-        "description": "Retrieve the ClassAd for a specific job",
-        "operationId": "getJob",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Job ClassAd",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "description": "Job ClassAd as a JSON object"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid job ID",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Job not found",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Query failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      },
-      "delete": {
-        "summary": "Remove a job",
-        "description": "Remove a job from the schedd (NOT YET IMPLEMENTED)",
-        "operationId": "deleteJob",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "501": {
-            "description": "Not implemented",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      },
-      "patch": {
-        "summary": "Edit a job",
-        "description": "Edit job attributes (NOT YET IMPLEMENTED)",
-        "operationId": "editJob",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "requestBody": {
-          "required": true,
-          "content": {
-            "application/json": {
-              "schema": {
-                "type": "object",
-                "description": "Job attributes to update"
-              }
-            }
-          }
-        },
-        "responses": {
-          "501": {
-            "description": "Not implemented",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/{jobId}/input": {
-      "put": {
-        "summary": "Upload job input files",
-        "description": "Upload a tarfile containing the job's input sandbox. This triggers input file spooling and releases the job from HELD status.",
-        "operationId": "uploadJobInput",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "requestBody": {
-          "required": true,
-          "content": {
-            "application/x-tar": {
-              "schema": {
-                "type": "string",
-                "format": "binary"
-              }
-            }
-          }
-        },
-        "responses": {
-          "200": {
-            "description": "Input files uploaded successfully",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "message": {
-                      "type": "string"
-                    },
-                    "job_id": {
-                      "type": "string"
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid job ID",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Job not found",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Failed to spool job files",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/{jobId}/output": {
-      "get": {
-        "summary": "Download job output files",
-        "description": "Download the job's output sandbox as a tarfile",
-        "operationId": "downloadJobOutput",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Job output tarfile",
-            "content": {
-              "application/x-tar": {
-                "schema": {
-                  "type": "string",
-                  "format": "binary"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid job ID",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/{jobId}/hold": {
-      "post": {
-        "summary": "Hold a job",
-        "description": "Hold a specific job by its ID",
-        "operationId": "holdJob",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "requestBody": {
-          "required": false,
-          "content": {
-            "application/json": {
-              "schema": {
-                "type": "object",
-                "properties": {
-                  "reason": {
-                    "type": "string",
-                    "description": "Optional reason for holding the job"
-                  }
-                }
-              }
-            }
-          }
-        },
-        "responses": {
-          "200": {
-            "description": "Job held successfully",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "message": {
-                      "type": "string"
-                    },
-                    "job_id": {
-                      "type": "string"
-                    },
-                    "results": {
-                      "type": "object",
-                      "properties": {
-                        "total": {"type": "integer"},
-                        "success": {"type": "integer"}
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid job ID or job cannot be held",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Job not found",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/{jobId}/release": {
-      "post": {
-        "summary": "Release a held job",
-        "description": "Release a specific held job by its ID",
-        "operationId": "releaseJob",
-        "parameters": [
-          {
-            "name": "jobId",
-            "in": "path",
-            "required": true,
-            "description": "Job ID in cluster.proc format (e.g., 23.4)",
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "requestBody": {
-          "required": false,
-          "content": {
-            "application/json": {
-              "schema": {
-                "type": "object",
-                "properties": {
-                  "reason": {
-                    "type": "string",
-                    "description": "Optional reason for releasing the job"
-                  }
-                }
-              }
-            }
-          }
-        },
-        "responses": {
-          "200": {
-            "description": "Job released successfully",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "message": {
-                      "type": "string"
-                    },
-                    "job_id": {
-                      "type": "string"
-                    },
-                    "results": {
-                      "type": "object",
-                      "properties": {
-                        "total": {"type": "integer"},
-                        "success": {"type": "integer"}
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid job ID or job cannot be released",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Authentication failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Job not found",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/hold": {
-      "post": {
-        "summary": "Hold jobs by constraint",
-        "description": "Hold multiple jobs matching a ClassAd constraint",
-        "operationId": "bulkHoldJobs",
-        "requestBody": {
-          "required": true,
-          "content": {
-            "application/json": {
-              "schema": {
-                "type": "object",
-                "required": ["constraint"],
-                "properties": {
-                  "constraint": {
-                    "type": "string",
-                    "description": "ClassAd constraint expression"
-                  },
-                  "reason": {
-                    "type": "string",
-                    "description": "Optional reason for holding the jobs"
-                  }
-                }
-              }
-            }
-          }
-        },
-        "responses": {
-          "200": {
-            "description": "Bulk hold operation completed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "message": {
-                      "type": "string"
-                    },
-                    "constraint": {
-                      "type": "string"
-                    },
-                    "results": {
-                      "type": "object",
-                      "properties": {
-                        "total": {"type": "integer"},
-                        "success": {"type": "integer"},
-                        "not_found": {"type": "integer"},
-                        "permission_denied": {"type": "integer"},
-                        "bad_status": {"type": "integer"},
-                        "already_done": {"type": "integer"},
-                        "error": {"type": "integer"}
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid request",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "No jobs matched the constraint",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/jobs/release": {
-      "post": {
-        "summary": "Release jobs by constraint",
-        "description": "Release multiple held jobs matching a ClassAd constraint",
-        "operationId": "bulkReleaseJobs",
-        "requestBody": {
-          "required": true,
-          "content": {
-            "application/json": {
-              "schema": {
-                "type": "object",
-                "required": ["constraint"],
-                "properties": {
-                  "constraint": {
-                    "type": "string",
-                    "description": "ClassAd constraint expression"
-                  },
-                  "reason": {
-                    "type": "string",
-                    "description": "Optional reason for releasing the jobs"
-                  }
-                }
-              }
-            }
-          }
-        },
-        "responses": {
-          "200": {
-            "description": "Bulk release operation completed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "message": {
-                      "type": "string"
-                    },
-                    "constraint": {
-                      "type": "string"
-                    },
-                    "results": {
-                      "type": "object",
-                      "properties": {
-                        "total": {"type": "integer"},
-                        "success": {"type": "integer"},
-                        "not_found": {"type": "integer"},
-                        "permission_denied": {"type": "integer"},
-                        "bad_status": {"type": "integer"},
-                        "already_done": {"type": "integer"},
-                        "error": {"type": "integer"}
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Invalid request",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "No jobs matched the constraint",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/collector/ads": {
-      "get": {
-        "summary": "Query collector for all ads",
-        "description": "Query the HTCondor collector for daemon advertisements",
-        "operationId": "listCollectorAds",
-        "parameters": [
-          {
-            "name": "constraint",
-            "in": "query",
-            "description": "ClassAd constraint expression (default: 'true' for all ads)",
-            "required": false,
-            "schema": {
-              "type": "string",
-              "default": "true"
-            }
-          },
-          {
-            "name": "projection",
-            "in": "query",
-            "description": "Comma-separated list of attributes to return (default: all attributes)",
-            "required": false,
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "List of collector ads",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "ads": {
-                      "type": "array",
-                      "items": {
-                        "type": "object",
-                        "description": "ClassAd as a JSON object"
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Query failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "501": {
-            "description": "Collector not configured",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/collector/ads/{adType}": {
-      "get": {
-        "summary": "Query collector for ads of specific type",
-        "description": "Query the HTCondor collector for daemon advertisements of a specific type",
-        "operationId": "listCollectorAdsByType",
-        "parameters": [
-          {
-            "name": "adType",
-            "in": "path",
-            "required": true,
-            "description": "Ad type (e.g., 'startd', 'schedd', 'master', 'all')",
-            "schema": {
-              "type": "string",
-              "enum": ["all", "startd", "schedd", "master", "submitter", "negotiator", "collector", "machines", "schedds", "masters", "submitters", "negotiators", "collectors"]
-            }
-          },
-          {
-            "name": "constraint",
-            "in": "query",
-            "description": "ClassAd constraint expression (default: 'true' for all ads of this type)",
-            "required": false,
-            "schema": {
-              "type": "string",
-              "default": "true"
-            }
-          },
-          {
-            "name": "projection",
-            "in": "query",
-            "description": "Comma-separated list of attributes to return (default: all attributes)",
-            "required": false,
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "List of collector ads of specified type",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "properties": {
-                    "ads": {
-                      "type": "array",
-                      "items": {
-                        "type": "object",
-                        "description": "ClassAd as a JSON object"
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Query failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "501": {
-            "description": "Collector not configured",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/collector/ads/{adType}/{name}": {
-      "get": {
-        "summary": "Get specific collector ad by name",
-        "description": "Retrieve a specific daemon advertisement from the collector by ad type and name",
-        "operationId": "getCollectorAdByName",
-        "parameters": [
-          {
-            "name": "adType",
-            "in": "path",
-            "required": true,
-            "description": "Ad type (e.g., 'startd', 'schedd', 'master')",
-            "schema": {
-              "type": "string",
-              "enum": ["startd", "schedd", "master", "submitter", "negotiator", "collector", "machines", "schedds", "masters", "submitters", "negotiators", "collectors"]
-            }
-          },
-          {
-            "name": "name",
-            "in": "path",
-            "required": true,
-            "description": "Name of the daemon",
-            "schema": {
-              "type": "string"
-            }
-          },
-          {
-            "name": "projection",
-            "in": "query",
-            "description": "Comma-separated list of attributes to return (default: all attributes)",
-            "required": false,
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Daemon ClassAd",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "type": "object",
-                  "description": "ClassAd as a JSON object"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Ad not found",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "Query failed",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          },
-          "501": {
-            "description": "Collector not configured",
-            "content": {
-              "application/json": {
-                "schema": {
-                  "$ref": "#/components/schemas/Error"
-                }
-              }
-            }
-          }
-        }
-      }
-    }
-  }
-}`
+// OpenAPI versions the server can describe its API in (Config.OpenAPIVersion)
+const (
+	// OpenAPIVersion30 serves an OpenAPI 3.0 document (the default)
+	OpenAPIVersion30 = "3.0"
+
+	// OpenAPIVersion31 serves an OpenAPI 3.1 document, whose schemas are
+	// JSON Schema 2020-12
+	OpenAPIVersion31 = "3.1"
+)
+
+// apiBasePath is the server URL the /api/v1 paths are relative to in the
+// OpenAPI document; other paths, such as /healthz, override it with "/"
+const apiBasePath = "/api/v1"
+
+// openAPIObject is a JSON object in the OpenAPI document
+type openAPIObject = map[string]any
+
+// apiOperation documents one method of one path in the OpenAPI document
+type apiOperation struct {
+	method string        // HTTP method, e.g. http.MethodGet
+	path   string        // Path template, e.g. /api/v1/jobs/{jobId}
+	public bool          // Served without authentication
+	spec   openAPIObject // OpenAPI operation object
+}
+
+// openAPIModels are the shared request and response models published as
+// component schemas
+var openAPIModels = []struct {
+	name        string
+	model       any
+	description string
+}{
+	{"Error", ErrorResponse{}, "Error response; error_code is stable and machine-readable, message is meant for people"},
+	{"JobSubmitRequest", JobSubmitRequest{}, "Job submission request"},
+	{"JobSubmitResponse", JobSubmitResponse{}, "Submitted jobs"},
+	{"JobListResponse", JobListResponse{}, "Jobs in the queue"},
+	{"JobActionRequest", JobActionRequest{}, "Optional body of a single-job hold or release"},
+	{"JobActionResponse", JobActionResponse{}, "Result of removing, holding or releasing one job"},
+	{"JobActionCounts", JobActionCounts{}, "Jobs a single-job action affected"},
+	{"BulkActionRequest", BulkActionRequest{}, "Jobs selected for a bulk remove, hold or release"},
+	{"BulkActionResponse", BulkActionResponse{}, "Result of a bulk remove, hold or release"},
+	{"BulkActionCounts", BulkActionCounts{}, "Outcome of a bulk action per job"},
+	{"BulkEditRequest", BulkEditRequest{}, "Constraint-based edit of job attributes"},
+	{"BulkEditOptions", BulkEditOptions{}, "Options of a bulk edit"},
+	{"JobEditResponse", JobEditResponse{}, "Result of editing one job"},
+	{"BulkEditResponse", BulkEditResponse{}, "Result of a bulk edit"},
+	{"JobInputResponse", JobInputResponse{}, "Result of an input sandbox upload"},
+	{"JobRerunResponse", JobRerunResponse{}, "Job created by rerunning a finished job"},
+	{"JobEvent", JobEventResponse{}, "An event from a job's user log"},
+	{"MatchStatus", htcondor.MatchStatus{}, "Outcome of a job's last negotiation"},
+	{"JobID", htcondor.JobID{}, "Cluster and proc of a job"},
+	{"JobUsage", htcondor.JobUsage{}, "Resource usage of a job"},
+	{"MatchAnalysis", htcondor.MatchAnalysis{}, "How many machines satisfy each clause of a job's Requirements"},
+	{"ConditionAnalysis", htcondor.ConditionAnalysis{}, "How one clause of a job's Requirements fares against the pool"},
+	{"HistoryResponse", HistoryResponse{}, "A page of job history"},
+	{"CollectorAdsResponse", CollectorAdsResponse{}, "Ads from the collector"},
+	{"PrioritiesResponse", PrioritiesResponse{}, "Fair-share priorities of the pool's submitters and accounting groups"},
+	{"UserPriority", htcondor.UserPriority{}, "Priority and usage of one submitter or accounting group"},
+	{"PriorityFactorRequest", PriorityFactorRequest{}, "New priority factor"},
+	{"TransfersResponse", TransfersResponse{}, "Sandbox transfers in progress"},
+	{"TransferInfo", TransferInfo{}, "A sandbox transfer in progress"},
+	{"EvaluateRequest", EvaluateRequest{}, "Expression to evaluate and the ads to evaluate it in"},
+	{"EvaluateResponse", EvaluateResponse{}, "Evaluated value"},
+	{"HealthResponse", HealthResponse{}, "Health or readiness status"},
+}
+
+// openAPIFieldDocs describes model fields, keyed by schema and JSON name
+var openAPIFieldDocs = map[string]string{
+	"Error.error":                           "HTTP status text",
+	"Error.message":                         "Error message",
+	"Error.code":                            "HTTP status code",
+	"Error.error_code":                      "Machine-readable error code, e.g. job_not_found, rate_limited, schedd_unreachable, submit_rejected",
+	"Error.details":                         "Additional error-specific information",
+	"JobSubmitRequest.submit_file":          "HTCondor submit file content",
+	"JobSubmitRequest.append":               "Extra submit commands inserted before the first queue statement, like condor_submit -a",
+	"JobSubmitRequest.profile":              "Name of a site-defined submit profile whose commands are added to the submit file",
+	"JobSubmitResponse.cluster_id":          "Cluster ID of submitted job(s)",
+	"JobSubmitResponse.job_ids":             "Job IDs in cluster.proc format",
+	"JobActionRequest.reason":               "Hold or release reason",
+	"JobRerunResponse.cluster_id":           "Cluster ID of the new job",
+	"JobRerunResponse.job_ids":              "Job ID of the new job in cluster.proc format",
+	"JobRerunResponse.rerun_of":             "ID of the job that was rerun",
+	"JobRerunResponse.awaiting_input":       "The new job is held until its input files are uploaded, as after a submission",
+	"MatchStatus.status":                    "matched, rejected, pending (idle, no outcome known yet) or not_idle (e.g. held)",
+	"MatchStatus.reason":                    "Why, e.g. the negotiator's rejection reason",
+	"MatchStatus.time":                      "When the outcome was recorded, in Unix seconds",
+	"MatchStatus.source":                    "negotiator (recorded in the job ad) or analysis (predicted from the pool's machine ads)",
+	"JobUsage.source":                       "queue, or history once the job has left the queue",
+	"JobUsage.remote_user_cpu":              "CPU seconds used in user mode, over all runs",
+	"JobUsage.remote_sys_cpu":               "CPU seconds used in system mode, over all runs",
+	"JobUsage.remote_wall_clock_time":       "Wall clock seconds of all runs",
+	"JobUsage.cpus_usage":                   "Average number of cores used in the last run",
+	"JobUsage.memory_usage":                 "Peak memory, in MB",
+	"JobUsage.resident_set_size":            "Peak resident set size, in KiB",
+	"JobUsage.disk_usage":                   "Peak sandbox size, in KiB",
+	"JobUsage.completion_date":              "When the job completed, in Unix seconds",
+	"JobEvent.type":                         "Event number, as in the user log",
+	"JobEvent.name":                         "Event name, e.g. SUBMIT, EXECUTE, JOB_TERMINATED",
+	"JobEvent.job_id":                       "Job ID in cluster.proc format",
+	"JobEvent.time":                         "Time of the event (RFC 3339)",
+	"JobEvent.message":                      "Rest of the event's first line, e.g. Job terminated.",
+	"JobEvent.details":                      "Remaining lines of the event",
+	"BulkActionRequest.constraint":          "ClassAd constraint expression selecting the jobs",
+	"BulkActionRequest.reason":              "Reason recorded with the action",
+	"BulkEditRequest.constraint":            "ClassAd constraint expression selecting the jobs",
+	"BulkEditRequest.attributes":            "Attributes to set; strings are set as ClassAd strings, null as UNDEFINED",
+	"BulkEditOptions.allow_protected_attrs": "Allow editing protected attributes (requires queue superuser)",
+	"BulkEditOptions.force":                 "Skip immutable attribute checks",
+	"HistoryResponse.next_offset":           "Offset of the next page; omitted on the last page",
+	"UserPriority.name":                     "Submitter (user@uid_domain) or accounting group name",
+	"UserPriority.is_accounting_group":      "The entry is an accounting group",
+	"UserPriority.accounting_group":         "Accounting group the submitter's usage is charged to",
+	"UserPriority.priority":                 "Effective user priority; lower is better",
+	"UserPriority.priority_factor":          "Factor scaling the real priority into the effective one",
+	"UserPriority.resources_used":           "Slots in use now",
+	"UserPriority.weighted_resources_used":  "Slots in use now, weighted by SlotWeight",
+	"UserPriority.accumulated_usage":        "Weighted usage since begin_usage_time, in seconds",
+	"UserPriority.begin_usage_time":         "When usage was first recorded, in Unix seconds (0 if never)",
+	"UserPriority.last_usage_time":          "When resources were last in use, in Unix seconds (0 if never)",
+	"PriorityFactorRequest.priority_factor": "New priority factor, at least 1",
+	"TransferInfo.id":                       "Transfer ID, used to cancel it",
+	"TransferInfo.job_id":                   "Job ID in cluster.proc format",
+	"TransferInfo.user":                     "User who started the transfer",
+	"TransferInfo.direction":                "input (upload of input files) or output (download of output files)",
+	"TransferInfo.bytes":                    "Bytes of the tar stream transferred so far",
+	"TransferInfo.start_time":               "When the transfer started",
+	"EvaluateRequest.expression":            "ClassAd expression to evaluate",
+	"EvaluateRequest.ad":                    "Ad the expression is evaluated in (MY), as a JSON object or a string in ClassAd syntax",
+	"EvaluateRequest.target":                "Optional TARGET ad, e.g. a machine ad when evaluating job requirements",
+	"EvaluateResponse.value":                "Evaluated value; null for undefined and error",
+	"EvaluateResponse.type":                 "Type of the value",
+	"HealthResponse.status":                 "ok for /healthz, ready for /readyz",
+}
+
+// openAPIFieldSchemas replaces the schemas derived from a model field's Go
+// type where they cannot express what the field accepts
+var openAPIFieldSchemas = map[string]openAPIObject{
+	"CollectorAdsResponse.ads": {"type": "array", "items": schemaRef("ClassAd")},
+	"EvaluateRequest.ad":       {"oneOf": []any{openAPIObject{"type": "object"}, openAPIObject{"type": "string"}}},
+	"EvaluateRequest.target":   {"oneOf": []any{openAPIObject{"type": "object"}, openAPIObject{"type": "string"}}},
+	"EvaluateResponse.type": {
+		"type": "string",
+		"enum": []any{"undefined", "error", "boolean", "integer", "real", "string", "list", "classad"},
+	},
+}
 
 // handleOpenAPISchema serves the OpenAPI schema
 func (s *Server) handleOpenAPISchema(w http.ResponseWriter, r *http.Request) {
@@ -1073,16 +168,376 @@ func (s *Server) handleOpenAPISchema(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
-	// Parse and re-encode to ensure valid JSON and pretty printing
-	var schema interface{}
-	if err := json.Unmarshal([]byte(openAPISchema), &schema); err != nil {
-		s.writeError(w, http.StatusInternalServerError, "Failed to parse OpenAPI schema")
-		return
-	}
-
 	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(schema); err != nil {
+	if err := encoder.Encode(s.openAPIDocument()); err != nil {
 		s.logger.Error(logging.DestinationHTTP, "Failed to encode OpenAPI schema", "error", err)
 	}
 }
+
+// validateOpenAPIVersion checks Config.OpenAPIVersion and applies the default
+func validateOpenAPIVersion(version string) (string, error) {
+	switch version {
+	case "":
+		return OpenAPIVersion30, nil
+	case OpenAPIVersion30, OpenAPIVersion31:
+		return version, nil
+	default:
+		return "", fmt.Errorf("unsupported OpenAPI version %q; supported versions are %s and %s", version, OpenAPIVersion30, OpenAPIVersion31)
+	}
+}
+
+// openAPIDocument generates the OpenAPI document describing the server's
+// routes. It is built as OpenAPI 3.1 and converted if 3.0 is configured.
+func (s *Server) openAPIDocument() openAPIObject {
+	paths := openAPIObject{}
+	for _, rt := range s.routes() {
+		for _, op := range rt.operations {
+			path, inAPI := strings.CutPrefix(op.path, apiBasePath)
+			item, ok := paths[path].(openAPIObject)
+			if !ok {
+				item = openAPIObject{}
+				if !inAPI {
+					item["servers"] = []any{openAPIObject{"url": "/"}}
+				}
+				paths[path] = item
+			}
+			item[strings.ToLower(op.method)] = completeOperation(op, inAPI)
+		}
+	}
+
+	doc := openAPIObject{
+		"openapi": "3.1.0",
+		"info": openAPIObject{
+			"title":       "HTCondor RESTful API",
+			"description": "RESTful API for managing HTCondor jobs",
+			"version":     "1.0.0",
+		},
+		"servers": []any{
+			openAPIObject{"url": apiBasePath, "description": "API v1"},
+		},
+		"security": s.openAPISecurity(),
+		"paths":    paths,
+		"components": openAPIObject{
+			"securitySchemes": s.openAPISecuritySchemes(),
+			"parameters":      openAPIParameters(),
+			"responses": openAPIObject{
+				"Error": errorResponse("Error; see the error_code for the reason"),
+			},
+			"schemas": openAPISchemas(),
+		},
+	}
+
+	if s.openAPIVersion != OpenAPIVersion31 {
+		downgradeOpenAPI(doc)
+	}
+	return doc
+}
+
+// completeOperation adds what every operation of its kind shares: API
+// version negotiation and error responses for /api/v1 operations, and the
+// 401 response or the opt-out of authentication
+func completeOperation(op apiOperation, inAPI bool) openAPIObject {
+	spec := make(openAPIObject, len(op.spec)+1)
+	for k, v := range op.spec {
+		spec[k] = v
+	}
+	responses := openAPIObject{}
+	if r, ok := op.spec["responses"].(openAPIObject); ok {
+		for code, resp := range r {
+			responses[code] = resp
+		}
+	}
+	spec["responses"] = responses
+
+	if op.public {
+		if _, ok := spec["security"]; !ok {
+			spec["security"] = []any{}
+		}
+	} else if _, ok := responses["401"]; !ok {
+		responses["401"] = errorResponse("Authentication failed")
+	}
+
+	if inAPI {
+		params, _ := spec["parameters"].([]any)
+		spec["parameters"] = append(append([]any{}, params...), parameterRef("APIVersion"))
+		if _, ok := responses["406"]; !ok {
+			responses["406"] = errorResponse("Unsupported API version requested")
+		}
+		if _, ok := responses["default"]; !ok {
+			responses["default"] = openAPIObject{"$ref": "#/components/responses/Error"}
+		}
+	}
+	return spec
+}
+
+// openAPISecurity lists the ways requests may authenticate
+func (s *Server) openAPISecurity() []any {
+	security := []any{openAPIObject{"bearerAuth": []any{}}}
+	if s.userHeader != "" {
+		security = append(security, openAPIObject{"userHeader": []any{}})
+	}
+	return security
+}
+
+// openAPISecuritySchemes describes the configured authentication methods
+func (s *Server) openAPISecuritySchemes() openAPIObject {
+	schemes := openAPIObject{
+		"bearerAuth": openAPIObject{
+			"type":         "http",
+			"scheme":       "bearer",
+			"bearerFormat": "TOKEN",
+			"description":  "HTCondor TOKEN authentication. The bearer token is used to authenticate with the schedd on behalf of the user.",
+		},
+	}
+	if s.userHeader != "" {
+		schemes["userHeader"] = openAPIObject{
+			"type":        "apiKey",
+			"in":          "header",
+			"name":        s.userHeader,
+			"description": "Name of the user, set by a trusted front-end proxy. The server mints an HTCondor token for the user when no bearer token is given.",
+		}
+	}
+	if s.oauth2Provider != nil {
+		issuer := s.oauth2Provider.config.AccessTokenIssuer
+		schemes["oauth2"] = openAPIObject{
+			"type":        "oauth2",
+			"description": "OAuth2 access tokens for the MCP endpoint",
+			"flows": openAPIObject{
+				"authorizationCode": openAPIObject{
+					"authorizationUrl": issuer + "/mcp/oauth2/authorize",
+					"tokenUrl":         issuer + "/mcp/oauth2/token",
+					"refreshUrl":       issuer + "/mcp/oauth2/token",
+					"scopes": openAPIObject{
+						"openid":    "OpenID Connect sign-in",
+						"profile":   "User profile",
+						"email":     "User email address",
+						"mcp:read":  "Query jobs and the pool through MCP tools",
+						"mcp:write": "Submit and change jobs through MCP tools",
+					},
+				},
+			},
+		}
+	}
+	return schemes
+}
+
+// openAPIParameters returns the parameters shared by several operations
+func openAPIParameters() openAPIObject {
+	return openAPIObject{
+		"Schedd": queryParam("schedd", "Name of the schedd the operation targets, one of the server's configured schedds (default: the primary schedd)",
+			openAPIObject{"type": "string"}),
+		"APIVersion": queryParam("api_version", "Response schema version, e.g. 1; also selectable with the Accept-Version header or an application/vnd.htcondor.v1+json Accept type",
+			openAPIObject{"type": "string"}),
+		"JobID": pathParam("jobId", "Job ID in cluster.proc format (e.g., 23.4)",
+			openAPIObject{"type": "string", "pattern": `^\d+\.\d+$`}),
+		"Projection": queryParam("projection", "Comma-separated list of attributes to return (default: all attributes)",
+			openAPIObject{"type": "string"}),
+		"Format": queryParam("format", "Response format: 'json' (default) or 'xml' for HTCondor ClassAd XML as produced by condor_q -xml",
+			openAPIObject{"type": "string", "enum": []any{"json", "xml"}, "default": "json"}),
+	}
+}
+
+// openAPISchemas derives the component schemas from the shared models
+func openAPISchemas() openAPIObject {
+	names := make(map[reflect.Type]string, len(openAPIModels))
+	for _, m := range openAPIModels {
+		names[reflect.TypeOf(m.model)] = m.name
+	}
+
+	schemas := openAPIObject{
+		"ClassAd": openAPIObject{
+			"type":        "object",
+			"description": "ClassAd as a JSON object, mapping attribute names to their values",
+		},
+	}
+	for _, m := range openAPIModels {
+		schema := structSchema(m.name, reflect.TypeOf(m.model), names)
+		schema["description"] = m.description
+		schemas[m.name] = schema
+	}
+	return schemas
+}
+
+// classAdType is the type of the ads in responses, published as the ClassAd schema
+var classAdType = reflect.TypeOf(classad.ClassAd{})
+
+// structSchema derives the schema of a model struct from its JSON encoding:
+// fields without omitempty are always present (or, in requests, required)
+func structSchema(name string, t reflect.Type, names map[reflect.Type]string) openAPIObject {
+	properties := openAPIObject{}
+	var required []any
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		jsonName, opts, _ := strings.Cut(tag, ",")
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+
+		key := name + "." + jsonName
+		schema, ok := openAPIFieldSchemas[key]
+		if !ok {
+			schema = typeSchema(field.Type, names)
+		}
+		if doc, ok := openAPIFieldDocs[key]; ok {
+			schema = withDescription(schema, doc)
+		}
+		properties[jsonName] = schema
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, jsonName)
+		}
+	}
+
+	schema := openAPIObject{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// typeSchema returns the schema of a Go type's JSON encoding
+func typeSchema(t reflect.Type, names map[reflect.Type]string) openAPIObject {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if name, ok := names[t]; ok {
+		return schemaRef(name)
+	}
+	if t == classAdType {
+		return schemaRef("ClassAd")
+	}
+	if t == reflect.TypeOf(json.RawMessage{}) {
+		return openAPIObject{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return openAPIObject{"type": "string"}
+	case reflect.Bool:
+		return openAPIObject{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return openAPIObject{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return openAPIObject{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return openAPIObject{"type": "array", "items": typeSchema(t.Elem(), names)}
+	case reflect.Map:
+		schema := openAPIObject{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = typeSchema(t.Elem(), names)
+		}
+		return schema
+	default:
+		// Interfaces hold any JSON value
+		return openAPIObject{}
+	}
+}
+
+// withDescription returns schema with a description added, without
+// modifying the original (which may be shared)
+func withDescription(schema openAPIObject, description string) openAPIObject {
+	described := make(openAPIObject, len(schema)+1)
+	for k, v := range schema {
+		described[k] = v
+	}
+	described["description"] = description
+	return described
+}
+
+// schemaRef references a component schema
+func schemaRef(name string) openAPIObject {
+	return openAPIObject{"$ref": "#/components/schemas/" + name}
+}
+
+// parameterRef references a component parameter
+func parameterRef(name string) openAPIObject {
+	return openAPIObject{"$ref": "#/components/parameters/" + name}
+}
+
+// queryParam describes an optional query parameter
+func queryParam(name, description string, schema openAPIObject) openAPIObject {
+	return openAPIObject{"name": name, "in": "query", "required": false, "description": description, "schema": schema}
+}
+
+// pathParam describes a path parameter
+func pathParam(name, description string, schema openAPIObject) openAPIObject {
+	return openAPIObject{"name": name, "in": "path", "required": true, "description": description, "schema": schema}
+}
+
+// jsonBody describes a JSON request body
+func jsonBody(schema openAPIObject, required bool) openAPIObject {
+	return openAPIObject{
+		"required": required,
+		"content":  openAPIObject{"application/json": openAPIObject{"schema": schema}},
+	}
+}
+
+// jsonResponse describes a JSON response
+func jsonResponse(description string, schema openAPIObject) openAPIObject {
+	return openAPIObject{
+		"description": description,
+		"content":     openAPIObject{"application/json": openAPIObject{"schema": schema}},
+	}
+}
+
+// adsResponse describes a response listing ads as JSON or, with
+// format=xml, as ClassAd XML
+func adsResponse(description string, schema openAPIObject) openAPIObject {
+	resp := jsonResponse(description, schema)
+	resp["content"].(openAPIObject)["application/xml"] = openAPIObject{
+		"schema": openAPIObject{"type": "string", "description": "HTCondor ClassAd XML"},
+	}
+	return resp
+}
+
+// errorResponse describes an error response
+func errorResponse(description string) openAPIObject {
+	return jsonResponse(description, schemaRef("Error"))
+}
+
+// binarySchema describes a binary body of the given media type
+func binarySchema(mediaType string) openAPIObject {
+	return openAPIObject{"type": "string", "contentMediaType": mediaType}
+}
+
+// downgradeOpenAPI converts a generated OpenAPI 3.1 document to 3.0: binary
+// bodies use format: binary, and $ref siblings (ignored in 3.0) move into
+// an allOf wrapper
+func downgradeOpenAPI(doc openAPIObject) {
+	doc["openapi"] = "3.0.3"
+	var convert func(v any) any
+	convert = func(v any) any {
+		switch v := v.(type) {
+		case openAPIObject:
+			converted := make(openAPIObject, len(v))
+			for k, child := range v {
+				converted[k] = convert(child)
+			}
+			if _, ok := converted["contentMediaType"]; ok {
+				delete(converted, "contentMediaType")
+				converted["format"] = "binary"
+			}
+			if ref, ok := converted["$ref"]; ok && len(converted) > 1 && strings.HasPrefix(ref.(string), "#/components/schemas/") {
+				delete(converted, "$ref")
+				converted["allOf"] = []any{openAPIObject{"$ref": ref}}
+			}
+			return converted
+		case []any:
+			converted := make([]any, len(v))
+			for i, child := range v {
+				converted[i] = convert(child)
+			}
+			return converted
+		default:
+			return v
+		}
+	}
+	for k, v := range doc {
+		doc[k] = convert(v)
+	}
+}
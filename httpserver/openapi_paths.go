@@ -0,0 +1,781 @@
+package httpserver
+
+import "net/http"
+
+// The operations below document each route registered in routes(); the
+// OpenAPI document is generated from them, so a route added without
+// operations fails TestOpenAPICoversRoutes.
+
+// constraintParam is the constraint query parameter of the list endpoints
+func constraintParam(what string) openAPIObject {
+	return queryParam("constraint", "ClassAd constraint expression (default: 'true' for all "+what+")",
+		openAPIObject{"type": "string", "default": "true"})
+}
+
+// collectorAdTypes are the ad types accepted in collector paths
+var collectorAdTypes = []any{"startd", "schedd", "master", "submitter", "negotiator", "collector",
+	"machines", "schedds", "masters", "submitters", "negotiators", "collectors"}
+
+// openAPISchemaOperations documents GET /openapi.json
+func openAPISchemaOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/openapi.json", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"meta"},
+			"summary":     "OpenAPI document",
+			"description": "This document, describing the server's API as configured",
+			"operationId": "getOpenAPISchema",
+			"responses": openAPIObject{
+				"200": jsonResponse("OpenAPI document", openAPIObject{"type": "object"}),
+			},
+		},
+	}}
+}
+
+// jobsOperations documents /api/v1/jobs
+func jobsOperations() []apiOperation {
+	return []apiOperation{
+		{
+			method: http.MethodGet, path: "/api/v1/jobs",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "List jobs",
+				"description": "Query the schedd for jobs matching the constraint",
+				"operationId": "listJobs",
+				"parameters": []any{
+					parameterRef("Schedd"), constraintParam("jobs"),
+					queryParam("projection", "Comma-separated list of attributes to return in addition to the server's default job projection, or * for every attribute (default: the server's default projection, or all attributes if it has none)",
+						openAPIObject{"type": "string"}),
+					parameterRef("Format"),
+				},
+				"responses": openAPIObject{
+					"200": adsResponse("List of jobs", schemaRef("JobListResponse")),
+					"400": errorResponse("Invalid constraint"),
+					"500": errorResponse("Query failed"),
+				},
+			},
+		},
+		{
+			method: http.MethodPost, path: "/api/v1/jobs",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Submit a job",
+				"description": "Submit a new job to the schedd using SubmitRemote. Jobs are submitted with input file spooling enabled and start in HELD status until input files are uploaded.",
+				"operationId": "submitJob",
+				"parameters": []any{
+					parameterRef("Schedd"),
+					openAPIObject{
+						"name":        submitAppendHeader,
+						"in":          "header",
+						"required":    false,
+						"description": "Extra submit command, applied after the request body's append entries; may be repeated",
+						"schema":      openAPIObject{"type": "string"},
+					},
+				},
+				"requestBody": jsonBody(schemaRef("JobSubmitRequest"), true),
+				"responses": openAPIObject{
+					"201": jsonResponse("Job submitted successfully", schemaRef("JobSubmitResponse")),
+					"400": errorResponse("Invalid request"),
+					"403": errorResponse("Submit file rejected by the server's submit policy"),
+					"500": errorResponse("Job submission failed"),
+				},
+			},
+		},
+		{
+			method: http.MethodDelete, path: "/api/v1/jobs",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Remove jobs by constraint",
+				"description": "Remove all jobs matching a ClassAd constraint",
+				"operationId": "bulkRemoveJobs",
+				"parameters":  []any{parameterRef("Schedd")},
+				"requestBody": jsonBody(schemaRef("BulkActionRequest"), true),
+				"responses": openAPIObject{
+					"200": jsonResponse("Bulk removal completed", schemaRef("BulkActionResponse")),
+					"400": errorResponse("Invalid request"),
+					"404": errorResponse("No jobs matched the constraint"),
+				},
+			},
+		},
+		{
+			method: http.MethodPatch, path: "/api/v1/jobs",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Edit jobs by constraint",
+				"description": "Set attributes of all jobs matching a ClassAd constraint",
+				"operationId": "bulkEditJobs",
+				"parameters":  []any{parameterRef("Schedd")},
+				"requestBody": jsonBody(schemaRef("BulkEditRequest"), true),
+				"responses": openAPIObject{
+					"200": jsonResponse("Jobs edited", schemaRef("BulkEditResponse")),
+					"400": errorResponse("Invalid request"),
+					"403": errorResponse("Edit rejected or permission denied"),
+					"404": errorResponse("No jobs matched the constraint"),
+				},
+			},
+		},
+	}
+}
+
+// jobByIDOperations documents the paths under /api/v1/jobs/
+func jobByIDOperations() []apiOperation {
+	jobID := parameterRef("JobID")
+	bulkAction := func(action, verb, operationID string) apiOperation {
+		return apiOperation{
+			method: http.MethodPost, path: "/api/v1/jobs/" + action,
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     verb + " jobs by constraint",
+				"description": verb + " all jobs matching a ClassAd constraint",
+				"operationId": operationID,
+				"parameters":  []any{parameterRef("Schedd")},
+				"requestBody": jsonBody(schemaRef("BulkActionRequest"), true),
+				"responses": openAPIObject{
+					"200": jsonResponse("Bulk "+action+" completed", schemaRef("BulkActionResponse")),
+					"400": errorResponse("Invalid request"),
+					"404": errorResponse("No jobs matched the constraint"),
+				},
+			},
+		}
+	}
+	jobAction := func(action, verb, operationID string) apiOperation {
+		return apiOperation{
+			method: http.MethodPost, path: "/api/v1/jobs/{jobId}/" + action,
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     verb + " a job",
+				"description": verb + " a specific job by its ID",
+				"operationId": operationID,
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"requestBody": jsonBody(schemaRef("JobActionRequest"), false),
+				"responses": openAPIObject{
+					"200": jsonResponse(verb+" succeeded", schemaRef("JobActionResponse")),
+					"400": errorResponse("Invalid job ID, or the job is in a state that does not allow it"),
+					"404": errorResponse("Job not found"),
+				},
+			},
+		}
+	}
+
+	return []apiOperation{
+		{
+			method: http.MethodGet, path: "/api/v1/jobs/{jobId}",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Get job details",
+				"description": "Retrieve the ClassAd for a specific job",
+				"operationId": "getJob",
+				"parameters":  []any{jobID, parameterRef("Schedd"), parameterRef("Projection"), parameterRef("Format")},
+				"responses": openAPIObject{
+					"200": adsResponse("Job ClassAd", schemaRef("ClassAd")),
+					"400": errorResponse("Invalid job ID"),
+					"404": errorResponse("Job not found"),
+					"500": errorResponse("Query failed"),
+				},
+			},
+		},
+		{
+			method: http.MethodDelete, path: "/api/v1/jobs/{jobId}",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Remove a job",
+				"description": "Remove a job from the schedd. A running job submitted with want_graceful_removal = true is shut down gracefully (soft kill signal and grace period) unless force is set.",
+				"operationId": "deleteJob",
+				"parameters": []any{jobID, parameterRef("Schedd"),
+					queryParam("force", "Shut the job down fast even if it wants graceful removal",
+						openAPIObject{"type": "boolean", "default": false}),
+				},
+				"responses": openAPIObject{
+					"200": jsonResponse("Job removed", schemaRef("JobActionResponse")),
+					"400": errorResponse("Invalid job ID or job cannot be removed"),
+					"404": errorResponse("Job not found"),
+				},
+			},
+		},
+		{
+			method: http.MethodPatch, path: "/api/v1/jobs/{jobId}",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Edit a job",
+				"description": "Set job attributes; strings are set as ClassAd strings",
+				"operationId": "editJob",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"requestBody": jsonBody(openAPIObject{"type": "object", "description": "Job attributes to update", "minProperties": 1}, true),
+				"responses": openAPIObject{
+					"200": jsonResponse("Job edited", schemaRef("JobEditResponse")),
+					"400": errorResponse("Invalid job ID or attributes"),
+					"403": errorResponse("Edit rejected or permission denied"),
+					"404": errorResponse("Job not found"),
+				},
+			},
+		},
+		{
+			method: http.MethodPut, path: "/api/v1/jobs/{jobId}/input",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Upload job input files",
+				"description": "Upload a tarfile containing the job's input sandbox. This triggers input file spooling and releases the job from HELD status.",
+				"operationId": "uploadJobInput",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"requestBody": openAPIObject{
+					"required": true,
+					"content": openAPIObject{
+						"application/x-tar": openAPIObject{"schema": binarySchema("application/x-tar")},
+					},
+				},
+				"responses": openAPIObject{
+					"200": jsonResponse("Input files uploaded successfully", schemaRef("JobInputResponse")),
+					"400": errorResponse("Invalid job ID"),
+					"404": errorResponse("Job not found"),
+					"500": errorResponse("Failed to spool job files"),
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/jobs/{jobId}/output",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Download job output files",
+				"description": "Download the job's output sandbox as a tarfile",
+				"operationId": "downloadJobOutput",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"responses": openAPIObject{
+					"200": openAPIObject{
+						"description": "Job output tarfile",
+						"content": openAPIObject{
+							"application/x-tar": openAPIObject{"schema": binarySchema("application/x-tar")},
+						},
+					},
+					"400": errorResponse("Invalid job ID"),
+				},
+			},
+		},
+		{
+			method: http.MethodPost, path: "/api/v1/jobs/{jobId}/rerun",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Rerun a finished job",
+				"description": "Resubmit a completed or removed job, found in the queue or the history, as a new cluster with the same attributes. If the job's input files were spooled, the new job is held until they are uploaded again.",
+				"operationId": "rerunJob",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"responses": openAPIObject{
+					"201": jsonResponse("Job resubmitted", schemaRef("JobRerunResponse")),
+					"400": errorResponse("Invalid job ID"),
+					"403": errorResponse("Job rejected by the server's submit policy"),
+					"404": errorResponse("Job not found"),
+					"409": errorResponse("Job has not finished"),
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/jobs/{jobId}/userlog",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Stream job events",
+				"description": "Stream the job's events from its user log, one JobEvent per line (NDJSON) or as server-sent events. With follow=true the stream stays open until the job terminates or is removed. Requires the server to run on the schedd's host.",
+				"operationId": "streamJobUserLog",
+				"parameters": []any{
+					jobID,
+					parameterRef("Schedd"),
+					queryParam("follow", "Keep streaming new events until the job terminates or is removed",
+						openAPIObject{"type": "boolean", "default": false}),
+					queryParam("format", "sse for server-sent events (also chosen by Accept: text/event-stream)",
+						openAPIObject{"type": "string", "enum": []any{"ndjson", "sse"}, "default": "ndjson"}),
+				},
+				"responses": openAPIObject{
+					"200": openAPIObject{
+						"description": "Job events",
+						"content": openAPIObject{
+							"application/x-ndjson": openAPIObject{"schema": schemaRef("JobEvent")},
+							"text/event-stream": openAPIObject{
+								"schema": openAPIObject{"type": "string", "description": "Server-sent events named after the event type, with a JobEvent as data"},
+							},
+						},
+					},
+					"400": errorResponse("Invalid job ID or parameters"),
+					"404": errorResponse("Job not found or has no user log"),
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/jobs/{jobId}/match-status",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Get a job's match status",
+				"description": "Report the outcome of the job's last negotiation: matched, or rejected with the negotiator's reason. For an idle job the negotiator has not reported on, the outcome is predicted by analyzing the job's Requirements against the pool's machines, when the server has a collector.",
+				"operationId": "getJobMatchStatus",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"responses": openAPIObject{
+					"200": jsonResponse("Match status", schemaRef("MatchStatus")),
+					"400": errorResponse("Invalid job ID"),
+					"404": errorResponse("Job not found"),
+					"500": errorResponse("Query failed"),
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/jobs/{jobId}/usage",
+			spec: openAPIObject{
+				"tags":        []any{"jobs"},
+				"summary":     "Get a job's resource usage",
+				"description": "Report the CPU time, wall clock time, memory and disk the job used, alongside what it requested. The figures come from the job's ad in the queue (as of the starter's last update, for a running job) or, once it has finished and left the queue, from the history.",
+				"operationId": "getJobUsage",
+				"parameters":  []any{jobID, parameterRef("Schedd")},
+				"responses": openAPIObject{
+					"200": jsonResponse("Resource usage", schemaRef("JobUsage")),
+					"400": errorResponse("Invalid job ID"),
+					"404": errorResponse("Job not found in the queue or history"),
+					"500": errorResponse("Query failed"),
+				},
+			},
+		},
+		jobAction("hold", "Hold", "holdJob"),
+		jobAction("release", "Release", "releaseJob"),
+		bulkAction("hold", "Hold", "bulkHoldJobs"),
+		bulkAction("release", "Release", "bulkReleaseJobs"),
+	}
+}
+
+// historyOperations documents GET /api/v1/history
+func historyOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/api/v1/history",
+		spec: openAPIObject{
+			"tags":        []any{"jobs"},
+			"summary":     "Query job history",
+			"description": "List the authenticated user's jobs that have left the queue (completed or removed), newest first",
+			"operationId": "listHistory",
+			"parameters": []any{
+				parameterRef("Schedd"),
+				constraintParam("jobs"),
+				parameterRef("Projection"),
+				queryParam("since", "Only jobs with CompletionDate at or after this time, as Unix seconds or RFC 3339",
+					openAPIObject{"type": "string"}),
+				queryParam("limit", "Maximum number of jobs to return",
+					openAPIObject{"type": "integer", "minimum": 1, "maximum": maxHistoryLimit, "default": defaultHistoryLimit}),
+				queryParam("offset", "Number of jobs to skip, e.g. the next_offset of the previous page",
+					openAPIObject{"type": "integer", "minimum": 0, "default": 0}),
+			},
+			"responses": openAPIObject{
+				"200": jsonResponse("A page of job history", schemaRef("HistoryResponse")),
+				"400": errorResponse("Invalid query parameters"),
+				"503": errorResponse("Schedd unreachable"),
+			},
+		},
+	}}
+}
+
+// collectorOperations documents the paths under /api/v1/collector/
+func collectorOperations() []apiOperation {
+	notConfigured := errorResponse("Collector not configured")
+	return []apiOperation{
+		{
+			method: http.MethodGet, path: "/api/v1/collector/ads", public: true,
+			spec: openAPIObject{
+				"tags":        []any{"collector"},
+				"summary":     "Query collector for all ads",
+				"description": "Query the HTCondor collector for daemon advertisements",
+				"operationId": "listCollectorAds",
+				"parameters":  []any{constraintParam("ads"), parameterRef("Projection"), parameterRef("Format")},
+				"responses": openAPIObject{
+					"200": adsResponse("List of collector ads", schemaRef("CollectorAdsResponse")),
+					"500": errorResponse("Query failed"),
+					"501": notConfigured,
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/collector/ads/{adType}", public: true,
+			spec: openAPIObject{
+				"tags":        []any{"collector"},
+				"summary":     "Query collector for ads of specific type",
+				"description": "Query the HTCondor collector for daemon advertisements of a specific type",
+				"operationId": "listCollectorAdsByType",
+				"parameters": []any{
+					pathParam("adType", "Ad type (e.g., 'startd', 'schedd', 'master', 'all')",
+						openAPIObject{"type": "string", "enum": append([]any{"all"}, collectorAdTypes...)}),
+					constraintParam("ads of this type"), parameterRef("Projection"), parameterRef("Format"),
+				},
+				"responses": openAPIObject{
+					"200": adsResponse("List of collector ads of specified type", schemaRef("CollectorAdsResponse")),
+					"500": errorResponse("Query failed"),
+					"501": notConfigured,
+				},
+			},
+		},
+		{
+			method: http.MethodGet, path: "/api/v1/collector/ads/{adType}/{name}", public: true,
+			spec: openAPIObject{
+				"tags":        []any{"collector"},
+				"summary":     "Get specific collector ad by name",
+				"description": "Retrieve a specific daemon advertisement from the collector by ad type and name",
+				"operationId": "getCollectorAdByName",
+				"parameters": []any{
+					pathParam("adType", "Ad type (e.g., 'startd', 'schedd', 'master')",
+						openAPIObject{"type": "string", "enum": collectorAdTypes}),
+					pathParam("name", "Name of the daemon", openAPIObject{"type": "string"}),
+					parameterRef("Projection"),
+				},
+				"responses": openAPIObject{
+					"200": jsonResponse("Daemon ClassAd", schemaRef("ClassAd")),
+					"404": errorResponse("Ad not found"),
+					"500": errorResponse("Query failed"),
+					"501": notConfigured,
+				},
+			},
+		},
+	}
+}
+
+// prioritiesOperations documents GET /api/v1/priorities
+func prioritiesOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/api/v1/priorities",
+		spec: openAPIObject{
+			"tags":        []any{"priorities"},
+			"summary":     "List user priorities",
+			"description": "Fair-share priority, priority factor and usage of every submitter and accounting group, as condor_userprio -all shows them",
+			"operationId": "listUserPriorities",
+			"responses": openAPIObject{
+				"200": jsonResponse("User priorities", schemaRef("PrioritiesResponse")),
+				"501": errorResponse("Collector not configured"),
+				"503": errorResponse("Negotiator not found or unreachable"),
+			},
+		},
+	}}
+}
+
+// priorityByNameOperations documents the paths under /api/v1/priorities/
+func priorityByNameOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodPut, path: "/api/v1/priorities/{name}",
+		spec: openAPIObject{
+			"tags":        []any{"priorities"},
+			"summary":     "Set a priority factor",
+			"description": "Set the priority factor of a submitter or accounting group, like condor_userprio -setfactor. Requires ADMINISTRATOR authorization at the negotiator.",
+			"operationId": "setUserPriorityFactor",
+			"parameters": []any{
+				pathParam("name", "Submitter (user@uid_domain) or accounting group name", openAPIObject{"type": "string"}),
+			},
+			"requestBody": jsonBody(schemaRef("PriorityFactorRequest"), true),
+			"responses": openAPIObject{
+				"204": openAPIObject{"description": "Priority factor set"},
+				"400": errorResponse("Invalid priority factor"),
+				"403": errorResponse("Not authorized"),
+				"501": errorResponse("Collector not configured"),
+				"503": errorResponse("Negotiator not found or unreachable"),
+			},
+		},
+	}}
+}
+
+// transfersOperations documents GET /api/v1/transfers
+func transfersOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/api/v1/transfers",
+		spec: openAPIObject{
+			"tags":        []any{"transfers"},
+			"summary":     "List sandbox transfers",
+			"description": "List the input uploads and output downloads this server has in progress for the authenticated user, oldest first. Admin users (see AdminUsers) see every user's transfers.",
+			"operationId": "listTransfers",
+			"responses": openAPIObject{
+				"200": jsonResponse("Transfers in progress", schemaRef("TransfersResponse")),
+			},
+		},
+	}}
+}
+
+// transferByIDOperations documents the paths under /api/v1/transfers/
+func transferByIDOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodDelete, path: "/api/v1/transfers/{transferId}",
+		spec: openAPIObject{
+			"tags":        []any{"transfers"},
+			"summary":     "Cancel a sandbox transfer",
+			"description": "Cancel a transfer in progress. A cancelled upload fails with 409 and code transfer_cancelled; a cancelled download ends with an incomplete tar stream. Users may cancel only their own transfers, unless they are admin users.",
+			"operationId": "cancelTransfer",
+			"parameters": []any{
+				pathParam("transferId", "Transfer ID, from the transfer list", openAPIObject{"type": "string"}),
+			},
+			"responses": openAPIObject{
+				"204": openAPIObject{"description": "Transfer cancelled"},
+				"404": errorResponse("No such transfer in progress for the user"),
+			},
+		},
+	}}
+}
+
+// evaluateOperations documents POST /api/v1/evaluate
+func evaluateOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodPost, path: "/api/v1/evaluate",
+		spec: openAPIObject{
+			"tags":        []any{"classads"},
+			"summary":     "Evaluate a ClassAd expression",
+			"description": "Evaluate an expression against an ad and optional target ad, e.g. to test requirements or periodic expressions. No daemon is contacted; evaluation is limited to 2 seconds, and expressions to 16 KiB and 64 levels of nesting.",
+			"operationId": "evaluateExpression",
+			"requestBody": jsonBody(schemaRef("EvaluateRequest"), true),
+			"responses": openAPIObject{
+				"200": jsonResponse("Evaluated value", schemaRef("EvaluateResponse")),
+				"400": errorResponse("Invalid, too long or too deeply nested expression or ad"),
+				"422": errorResponse("Evaluation failed or timed out"),
+				"429": errorResponse("Too many evaluations in progress"),
+			},
+		},
+	}}
+}
+
+// oauth2MetadataOperations documents the authorization server metadata
+func oauth2MetadataOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/.well-known/oauth-authorization-server", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     "OAuth2 authorization server metadata",
+			"description": "Authorization server metadata for MCP clients (RFC 8414)",
+			"operationId": "getOAuth2Metadata",
+			"responses": openAPIObject{
+				"200": jsonResponse("Authorization server metadata", openAPIObject{"type": "object"}),
+			},
+		},
+	}}
+}
+
+// oauth2ProtectedResourceOperations documents the protected resource metadata
+func oauth2ProtectedResourceOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/.well-known/oauth-protected-resource", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     "OAuth2 protected resource metadata",
+			"description": "Metadata of the MCP endpoint as an OAuth2 protected resource (RFC 9728)",
+			"operationId": "getOAuth2ProtectedResourceMetadata",
+			"responses": openAPIObject{
+				"200": jsonResponse("Protected resource metadata", openAPIObject{"type": "object"}),
+			},
+		},
+	}}
+}
+
+// oauth2AuthorizeOperations documents the authorization endpoint
+func oauth2AuthorizeOperations() []apiOperation {
+	params := []any{
+		queryParam("response_type", "Must be code", openAPIObject{"type": "string", "enum": []any{"code"}}),
+		queryParam("client_id", "Registered client ID", openAPIObject{"type": "string"}),
+		queryParam("redirect_uri", "One of the client's registered redirect URIs", openAPIObject{"type": "string"}),
+		queryParam("scope", "Space-separated scopes", openAPIObject{"type": "string"}),
+		queryParam("state", "Opaque value returned to the client", openAPIObject{"type": "string"}),
+		queryParam("code_challenge", "PKCE code challenge", openAPIObject{"type": "string"}),
+		queryParam("code_challenge_method", "PKCE code challenge method", openAPIObject{"type": "string", "enum": []any{"S256", "plain"}}),
+	}
+	return []apiOperation{{
+		method: http.MethodGet, path: "/mcp/oauth2/authorize", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     "OAuth2 authorization endpoint",
+			"description": "Start the authorization code flow. The user authenticates through the configured identity provider or the user header; the response redirects to the client with an authorization code.",
+			"operationId": "oauth2Authorize",
+			"parameters":  params,
+			"responses": openAPIObject{
+				"302": openAPIObject{"description": "Redirect to the identity provider or back to the client"},
+				"400": openAPIObject{"description": "Invalid authorization request"},
+			},
+		},
+	}}
+}
+
+// oauth2CallbackOperations documents the SSO callback
+func oauth2CallbackOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/mcp/oauth2/callback", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     "Identity provider callback",
+			"description": "Completes a pending authorization once the identity provider has authenticated the user",
+			"operationId": "oauth2Callback",
+			"parameters": []any{
+				queryParam("code", "Authorization code from the identity provider", openAPIObject{"type": "string"}),
+				queryParam("state", "State of the pending authorization", openAPIObject{"type": "string"}),
+				queryParam("error", "Error reported by the identity provider", openAPIObject{"type": "string"}),
+			},
+			"responses": openAPIObject{
+				"302": openAPIObject{"description": "Redirect back to the client with an authorization code"},
+				"400": errorResponse("Invalid or expired state, or an error from the identity provider"),
+			},
+		},
+	}}
+}
+
+// oauth2FormOperation documents an OAuth2 endpoint taking a form body
+func oauth2FormOperation(path, operationID, summary string, fields openAPIObject, required []any, ok openAPIObject) apiOperation {
+	return apiOperation{
+		method: http.MethodPost, path: path, public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     summary,
+			"operationId": operationID,
+			"requestBody": openAPIObject{
+				"required": true,
+				"content": openAPIObject{
+					"application/x-www-form-urlencoded": openAPIObject{
+						"schema": openAPIObject{"type": "object", "properties": fields, "required": required},
+					},
+				},
+			},
+			"responses": openAPIObject{
+				"200": ok,
+				"400": openAPIObject{"description": "Invalid request (RFC 6749 error response)"},
+				"401": openAPIObject{"description": "Client authentication failed"},
+			},
+		},
+	}
+}
+
+// oauth2TokenOperations documents the token endpoint
+func oauth2TokenOperations() []apiOperation {
+	str := openAPIObject{"type": "string"}
+	return []apiOperation{oauth2FormOperation("/mcp/oauth2/token", "oauth2Token", "OAuth2 token endpoint",
+		openAPIObject{
+			"grant_type":    openAPIObject{"type": "string", "enum": []any{"authorization_code", "refresh_token"}},
+			"code":          str,
+			"redirect_uri":  str,
+			"code_verifier": str,
+			"refresh_token": str,
+			"client_id":     str,
+			"client_secret": str,
+		},
+		[]any{"grant_type"},
+		jsonResponse("Access token", openAPIObject{
+			"type": "object",
+			"properties": openAPIObject{
+				"access_token":  str,
+				"token_type":    str,
+				"expires_in":    openAPIObject{"type": "integer"},
+				"refresh_token": str,
+				"scope":         str,
+				"id_token":      str,
+			},
+			"required": []any{"access_token", "token_type"},
+		}),
+	)}
+}
+
+// oauth2IntrospectOperations documents the token introspection endpoint
+func oauth2IntrospectOperations() []apiOperation {
+	str := openAPIObject{"type": "string"}
+	return []apiOperation{oauth2FormOperation("/mcp/oauth2/introspect", "oauth2Introspect", "OAuth2 token introspection (RFC 7662)",
+		openAPIObject{"token": str, "token_type_hint": str},
+		[]any{"token"},
+		jsonResponse("Token status", openAPIObject{
+			"type":       "object",
+			"properties": openAPIObject{"active": openAPIObject{"type": "boolean"}},
+			"required":   []any{"active"},
+		}),
+	)}
+}
+
+// oauth2RevokeOperations documents the token revocation endpoint
+func oauth2RevokeOperations() []apiOperation {
+	str := openAPIObject{"type": "string"}
+	return []apiOperation{oauth2FormOperation("/mcp/oauth2/revoke", "oauth2Revoke", "OAuth2 token revocation (RFC 7009)",
+		openAPIObject{"token": str, "token_type_hint": str},
+		[]any{"token"},
+		openAPIObject{"description": "Token revoked, or it was not valid"},
+	)}
+}
+
+// oauth2RegisterOperations documents dynamic client registration
+func oauth2RegisterOperations() []apiOperation {
+	stringList := openAPIObject{"type": "array", "items": openAPIObject{"type": "string"}}
+	return []apiOperation{{
+		method: http.MethodPost, path: "/mcp/oauth2/register", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"oauth2"},
+			"summary":     "Dynamic client registration (RFC 7591)",
+			"operationId": "oauth2Register",
+			"requestBody": jsonBody(openAPIObject{
+				"type": "object",
+				"properties": openAPIObject{
+					"redirect_uris":  stringList,
+					"grant_types":    stringList,
+					"response_types": stringList,
+					"scope":          openAPIObject{"type": "string"},
+					"client_name":    openAPIObject{"type": "string"},
+				},
+				"required": []any{"redirect_uris"},
+			}, true),
+			"responses": openAPIObject{
+				"201": jsonResponse("Registered client, including its client_id and client_secret", openAPIObject{"type": "object"}),
+				"400": errorResponse("Invalid client metadata"),
+			},
+		},
+	}}
+}
+
+// mcpMessageOperations documents the MCP protocol endpoint
+func mcpMessageOperations() []apiOperation {
+	message := openAPIObject{
+		"type":        "object",
+		"description": "JSON-RPC 2.0 message",
+		"properties": openAPIObject{
+			"jsonrpc": openAPIObject{"type": "string", "enum": []any{"2.0"}},
+			"id":      openAPIObject{},
+			"method":  openAPIObject{"type": "string"},
+			"params":  openAPIObject{},
+			"result":  openAPIObject{},
+			"error":   openAPIObject{"type": "object"},
+		},
+		"required": []any{"jsonrpc"},
+	}
+	return []apiOperation{{
+		method: http.MethodPost, path: "/mcp/message",
+		spec: openAPIObject{
+			"tags":        []any{"mcp"},
+			"summary":     "MCP message",
+			"description": "Model Context Protocol request, answered with a JSON-RPC response",
+			"operationId": "mcpMessage",
+			"security":    []any{openAPIObject{"oauth2": []any{"mcp:read"}}, openAPIObject{"oauth2": []any{"mcp:write"}}},
+			"requestBody": jsonBody(message, true),
+			"responses": openAPIObject{
+				"200": jsonResponse("JSON-RPC response", message),
+				"400": errorResponse("Invalid MCP message"),
+				"401": openAPIObject{"description": "Invalid or missing OAuth2 token"},
+			},
+		},
+	}}
+}
+
+// metricsOperations documents GET /metrics
+func metricsOperations() []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: "/metrics", public: true,
+		spec: openAPIObject{
+			"tags":        []any{"meta"},
+			"summary":     "Prometheus metrics",
+			"operationId": "getMetrics",
+			"responses": openAPIObject{
+				"200": openAPIObject{
+					"description": "Metrics in the Prometheus text exposition format",
+					"content":     openAPIObject{"text/plain": openAPIObject{"schema": openAPIObject{"type": "string"}}},
+				},
+				"500": errorResponse("Failed to export metrics"),
+			},
+		},
+	}}
+}
+
+// healthOperations documents a health or readiness check
+func healthOperations(path, operationID, summary, status string) []apiOperation {
+	return []apiOperation{{
+		method: http.MethodGet, path: path, public: true,
+		spec: openAPIObject{
+			"tags":        []any{"meta"},
+			"summary":     summary,
+			"description": "Returns status " + status + " while the server is serving",
+			"operationId": operationID,
+			"responses": openAPIObject{
+				"200": jsonResponse("Server is "+status, schemaRef("HealthResponse")),
+			},
+		},
+	}}
+}
@@ -0,0 +1,188 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bbockelm/golang-htcondor/metricsd"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// newOpenAPITestServer creates a server with every optional route enabled
+func newOpenAPITestServer(t *testing.T, version string) *Server {
+	t.Helper()
+
+	s := newErrorTestServer(t)
+	s.userHeader = "X-Remote-User"
+	s.openAPIVersion = version
+	s.prometheusExporter = metricsd.NewPrometheusExporter(metricsd.NewRegistry())
+
+	provider, err := NewOAuth2Provider(filepath.Join(t.TempDir(), "oauth2.db"), "https://htcondor.example.com")
+	if err != nil {
+		t.Fatalf("Failed to create OAuth2 provider: %v", err)
+	}
+	t.Cleanup(func() { _ = provider.Close() })
+	s.oauth2Provider = provider
+	return s
+}
+
+// fetchOpenAPIDocument serves GET /openapi.json and returns the raw document
+func fetchOpenAPIDocument(t *testing.T, s *Server) []byte {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	s.setupRoutes(mux)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json returned %d: %s", w.Code, w.Body.String())
+	}
+	return w.Body.Bytes()
+}
+
+func TestOpenAPIDocumentValidates(t *testing.T) {
+	s := newOpenAPITestServer(t, OpenAPIVersion31)
+	raw := fetchOpenAPIDocument(t, s)
+
+	metaSchema, err := os.ReadFile("testdata/openapi-3.1-schema.json")
+	if err != nil {
+		t.Fatalf("Failed to read OpenAPI meta-schema: %v", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("https://spec.openapis.org/oas/3.1/schema/2022-10-07", bytes.NewReader(metaSchema)); err != nil {
+		t.Fatalf("Failed to load OpenAPI meta-schema: %v", err)
+	}
+	schema, err := compiler.Compile("https://spec.openapis.org/oas/3.1/schema/2022-10-07")
+	if err != nil {
+		t.Fatalf("Failed to compile OpenAPI meta-schema: %v", err)
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("Failed to decode OpenAPI document: %v", err)
+	}
+	if err := schema.Validate(doc); err != nil {
+		t.Fatalf("OpenAPI document does not validate: %#v", err)
+	}
+
+	// The meta-schema checks schema objects only loosely: compile each
+	// component schema as JSON Schema 2020-12, which also resolves its $refs
+	schemas := doc.(map[string]any)["components"].(map[string]any)["schemas"].(map[string]any)
+	modelCompiler := jsonschema.NewCompiler()
+	modelCompiler.Draft = jsonschema.Draft2020
+	if err := modelCompiler.AddResource("openapi.json", bytes.NewReader(raw)); err != nil {
+		t.Fatalf("Failed to load OpenAPI document: %v", err)
+	}
+	for name := range schemas {
+		if _, err := modelCompiler.Compile("openapi.json#/components/schemas/" + name); err != nil {
+			t.Errorf("Schema %s is invalid: %v", name, err)
+		}
+	}
+
+	// Every other reference must resolve within the document
+	var checkRefs func(v any)
+	checkRefs = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				target := any(doc)
+				for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+					obj, _ := target.(map[string]any)
+					target = obj[part]
+				}
+				if target == nil {
+					t.Errorf("Unresolved reference %s", ref)
+				}
+			}
+			for _, child := range v {
+				checkRefs(child)
+			}
+		case []any:
+			for _, child := range v {
+				checkRefs(child)
+			}
+		}
+	}
+	checkRefs(doc)
+
+	securitySchemes := doc.(map[string]any)["components"].(map[string]any)["securitySchemes"].(map[string]any)
+	for _, name := range []string{"bearerAuth", "userHeader", "oauth2"} {
+		if _, ok := securitySchemes[name]; !ok {
+			t.Errorf("Expected security scheme %s", name)
+		}
+	}
+}
+
+func TestOpenAPICoversRoutes(t *testing.T) {
+	s := newOpenAPITestServer(t, OpenAPIVersion31)
+
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(fetchOpenAPIDocument(t, s), &doc); err != nil {
+		t.Fatalf("Failed to decode OpenAPI document: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	s.setupRoutes(mux)
+	placeholders := strings.NewReplacer("{jobId}", "23.4", "{adType}", "startd", "{name}", "slot1@host.example.com")
+
+	operationIDs := make(map[string]bool)
+	for _, rt := range s.routes() {
+		if len(rt.operations) == 0 {
+			t.Errorf("Route %s has no documented operations", rt.pattern)
+		}
+		for _, op := range rt.operations {
+			// The documented path must be served by the route documenting it
+			req := httptest.NewRequest(op.method, placeholders.Replace(op.path), nil)
+			if _, pattern := mux.Handler(req); pattern != rt.pattern {
+				t.Errorf("%s %s is served by %q, but documented with route %q", op.method, op.path, pattern, rt.pattern)
+			}
+
+			path := strings.TrimPrefix(op.path, apiBasePath)
+			raw, ok := doc.Paths[path][strings.ToLower(op.method)]
+			if !ok {
+				t.Errorf("%s %s missing from the OpenAPI document", op.method, path)
+				continue
+			}
+			var operation struct {
+				OperationID string `json:"operationId"`
+			}
+			if err := json.Unmarshal(raw, &operation); err != nil || operation.OperationID == "" {
+				t.Errorf("%s %s has no operationId", op.method, path)
+			}
+			if operationIDs[operation.OperationID] {
+				t.Errorf("Duplicate operationId %s", operation.OperationID)
+			}
+			operationIDs[operation.OperationID] = true
+		}
+	}
+}
+
+func TestOpenAPIVersion30(t *testing.T) {
+	s := newOpenAPITestServer(t, OpenAPIVersion30)
+	raw := fetchOpenAPIDocument(t, s)
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("Failed to decode OpenAPI document: %v", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.0.") {
+		t.Errorf("Expected an OpenAPI 3.0 document, got version %s", doc.OpenAPI)
+	}
+	if bytes.Contains(raw, []byte("contentMediaType")) {
+		t.Error("OpenAPI 3.0 document uses contentMediaType")
+	}
+
+	if _, err := validateOpenAPIVersion("2.0"); err == nil {
+		t.Error("Expected an error for OpenAPI version 2.0")
+	}
+}
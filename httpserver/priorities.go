@@ -0,0 +1,132 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// PrioritiesResponse lists the pool's user priorities, as condor_userprio -all
+type PrioritiesResponse struct {
+	Priorities []htcondor.UserPriority `json:"priorities"`
+}
+
+// PriorityFactorRequest is the body of PUT /api/v1/priorities/{name}
+type PriorityFactorRequest struct {
+	PriorityFactor float64 `json:"priority_factor"`
+}
+
+// handlePriorities handles GET /api/v1/priorities, which returns the
+// priority and usage of every submitter and accounting group known to the
+// pool's negotiator
+func (s *Server) handlePriorities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionQueryPriority, Resource{}) {
+		return
+	}
+
+	negotiator, ok := s.negotiatorForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	priorities, err := negotiator.QueryUserPriorities(ctx)
+	if err != nil {
+		s.writeNegotiatorError(w, err, "Priority query failed")
+		return
+	}
+	if priorities == nil {
+		priorities = []htcondor.UserPriority{}
+	}
+	s.writeJSON(w, http.StatusOK, PrioritiesResponse{Priorities: priorities})
+}
+
+// handlePriorityByName handles PUT /api/v1/priorities/{name}, which sets
+// the priority factor of a submitter or accounting group. The negotiator
+// only accepts this from ADMINISTRATOR-authorized users.
+func (s *Server) handlePriorityByName(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/api/v1/priorities/")
+	if name == "" || strings.Contains(name, "/") {
+		s.writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+	if r.Method != http.MethodPut {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	var req PriorityFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
+		return
+	}
+	if req.PriorityFactor < 1 {
+		s.writeError(w, http.StatusBadRequest, "priority_factor must be at least 1")
+		return
+	}
+
+	if !s.authorize(ctx, w, r, ActionSetPriority, Resource{Name: name}) {
+		return
+	}
+
+	negotiator, ok := s.negotiatorForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := negotiator.SetUserPriorityFactor(ctx, name, req.PriorityFactor); err != nil {
+		s.writeNegotiatorError(w, err, "Setting priority factor failed")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// negotiatorForRequest locates the pool's negotiator through the collector,
+// writing the error response and returning false if it cannot
+func (s *Server) negotiatorForRequest(w http.ResponseWriter, r *http.Request) (*htcondor.Negotiator, bool) {
+	if s.collector == nil {
+		s.writeError(w, http.StatusNotImplemented, "Collector not configured")
+		return nil, false
+	}
+	negotiator, err := s.collector.LocateNegotiator(r.Context())
+	if err != nil {
+		s.writeNegotiatorError(w, err, "Locating the negotiator failed")
+		return nil, false
+	}
+	return negotiator, true
+}
+
+// writeNegotiatorError writes the response for a failed negotiator request;
+// a missing or unreachable negotiator makes the endpoint unavailable
+func (s *Server) writeNegotiatorError(w http.ResponseWriter, err error, what string) {
+	var connErr *htcondor.ConnectError
+	switch {
+	case errors.Is(err, htcondor.ErrNegotiatorNotFound):
+		s.writeErrorCode(w, http.StatusServiceUnavailable, ErrCodeUnavailable, err.Error(), nil)
+	case errors.As(err, &connErr):
+		s.writeErrorCode(w, http.StatusServiceUnavailable, ErrCodeUnavailable,
+			fmt.Sprintf("%s: %v", what, err), map[string]any{"address": connErr.Address})
+	default:
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, what)
+	}
+}
@@ -0,0 +1,60 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// TestPrioritiesErrors verifies requests are validated before the negotiator
+// is located, and that an unreachable pool makes the endpoints unavailable
+func TestPrioritiesErrors(t *testing.T) {
+	token := createTestJWTToken(3600)
+	unreachable := htcondor.NewCollectorWithTransport("collector:9618", failingTransport{
+		err: &htcondor.ConnectError{Address: "collector:9618", Err: errors.New("connection refused")},
+	})
+
+	tests := []struct {
+		name       string
+		collector  *htcondor.Collector
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"list without collector", nil, http.MethodGet, "/api/v1/priorities", "", http.StatusNotImplemented, ErrCodeNotImplemented},
+		{"list unreachable", unreachable, http.MethodGet, "/api/v1/priorities", "", http.StatusServiceUnavailable, ErrCodeUnavailable},
+		{"list wrong method", unreachable, http.MethodPost, "/api/v1/priorities", "", http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed},
+		{"set invalid body", unreachable, http.MethodPut, "/api/v1/priorities/alice@example.com", "{", http.StatusBadRequest, ErrCodeBadRequest},
+		{"set factor below 1", unreachable, http.MethodPut, "/api/v1/priorities/alice@example.com", `{"priority_factor": 0.5}`, http.StatusBadRequest, ErrCodeBadRequest},
+		{"set without collector", nil, http.MethodPut, "/api/v1/priorities/alice@example.com", `{"priority_factor": 10}`, http.StatusNotImplemented, ErrCodeNotImplemented},
+		{"set unreachable", unreachable, http.MethodPut, "/api/v1/priorities/alice@example.com", `{"priority_factor": 10}`, http.StatusServiceUnavailable, ErrCodeUnavailable},
+		{"set without name", unreachable, http.MethodPut, "/api/v1/priorities/", `{"priority_factor": 10}`, http.StatusNotFound, ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newErrorTestServer(t)
+			s.collector = tt.collector
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+
+			if tt.path == "/api/v1/priorities" {
+				s.handlePriorities(w, req)
+			} else {
+				s.handlePriorityByName(w, req)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// queryJobs queries a schedd over CEDAR. If the schedd does not accept the
+// user's credentials and the CLI fallback is enabled, it retries by running
+// `condor_q -json` as the server process, limited to the user's own jobs.
+// A user the schedd authenticated but denied the query gets no fallback.
+func (s *Server) queryJobs(ctx context.Context, schedd *htcondor.Schedd, constraint string, projection []string) ([]*classad.ClassAd, error) {
+	jobAds, err := schedd.Query(ctx, constraint, projection)
+	if err == nil || !s.allowCLIFallback || !errors.Is(err, htcondor.ErrAuthenticationFailed) {
+		return jobAds, err
+	}
+	var notAuthorized *htcondor.ErrNotAuthorized
+	if errors.As(err, &notAuthorized) {
+		return nil, err
+	}
+	owner := htcondor.OwnerOfUser(htcondor.GetAuthenticatedUserFromContext(ctx))
+	if owner == "" {
+		return nil, err
+	}
+
+	s.logger.Warn(logging.DestinationSchedd, "CEDAR job query denied, falling back to condor_q", "owner", owner, "error", err)
+	if constraint == "" {
+		constraint = "true"
+	}
+	ownConstraint := fmt.Sprintf("(%s) && (Owner == %s)", constraint, classad.Quote(owner))
+	cliAds, cliErr := schedd.QueryCLI(ctx, s.condorQPath, ownConstraint, withOwner(projection))
+	if cliErr != nil {
+		s.logger.Error(logging.DestinationSchedd, "condor_q fallback failed", "error", cliErr)
+		// Report the original error; it is the more useful one to the client
+		return nil, err
+	}
+
+	// condor_q runs as the server, which may see every user's jobs: keep
+	// only the user's own, whatever condor_q made of the constraint
+	ownAds := cliAds[:0]
+	for _, ad := range cliAds {
+		if adOwner, _ := ad.EvaluateAttrString("Owner"); adOwner == owner {
+			ownAds = append(ownAds, ad)
+		}
+	}
+	return ownAds, nil
+}
+
+// withOwner returns projection with Owner added, so the fallback can check
+// the owner of the ads it returns; an empty projection already has it
+func withOwner(projection []string) []string {
+	if len(projection) == 0 || slices.Contains(projection, "Owner") {
+		return projection
+	}
+	return append(slices.Clone(projection), "Owner")
+}
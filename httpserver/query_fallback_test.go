@@ -0,0 +1,131 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/bbockelm/cedar/commands"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// errTestAuthFailed is a connection error for a schedd that does not accept
+// the server's credentials
+var errTestAuthFailed = fmt.Errorf("%w: token rejected", htcondor.ErrAuthenticationFailed)
+
+// failingTransport is a transport whose connections always fail with err
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) Connect(_ context.Context, _ string) (htcondor.Connection, error) {
+	return nil, t.err
+}
+
+// writeFakeCondorQ writes a condor_q replacement that prints a single job ad
+func writeFakeCondorQ(t *testing.T) string {
+	t.Helper()
+	return writeFakeCondorQOutput(t, `[{"ClusterId": 7, "ProcId": 0, "Owner": "alice"}]`)
+}
+
+// writeFakeCondorQOutput writes a condor_q replacement that prints output,
+// which must not contain single quotes
+func writeFakeCondorQOutput(t *testing.T, output string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("Requires a POSIX shell")
+	}
+	path := filepath.Join(t.TempDir(), "condor_q")
+	script := "#!/bin/sh\necho '" + output + "'\n"
+	//nolint:gosec // Test script must be executable
+	if err := os.WriteFile(path, []byte(script), 0700); err != nil {
+		t.Fatalf("Failed to write fake condor_q: %v", err)
+	}
+	return path
+}
+
+func newFallbackTestServer(t *testing.T, connectErr error, allow bool, condorQPath string) *Server {
+	t.Helper()
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return &Server{
+		schedd:           htcondor.NewScheddWithTransport("", "localhost:9618", failingTransport{err: connectErr}),
+		logger:           logger,
+		allowCLIFallback: allow,
+		condorQPath:      condorQPath,
+	}
+}
+
+func TestQueryJobsCLIFallback(t *testing.T) {
+	condorQ := writeFakeCondorQ(t)
+	aliceCtx := htcondor.WithAuthenticatedUser(context.Background(), "alice@test.domain")
+	notAuthorized := &htcondor.ErrNotAuthorized{Command: commands.QUERY_JOB_ADS, Err: errors.New("DENIED")}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		connectErr error
+		allow      bool
+		wantJobs   int
+		wantErr    bool
+	}{
+		{"fallback on auth error", aliceCtx, errTestAuthFailed, true, 1, false},
+		{"fallback disabled", aliceCtx, errTestAuthFailed, false, 0, true},
+		{"no fallback on other errors", aliceCtx, errors.New("authentication failed: connection refused"), true, 0, true},
+		{"no fallback when not authorized", aliceCtx, notAuthorized, true, 0, true},
+		{"no fallback without a user", context.Background(), errTestAuthFailed, true, 0, true},
+		{"no fallback for unmapped user", htcondor.WithAuthenticatedUser(context.Background(), "unauthenticated@unmapped"), errTestAuthFailed, true, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFallbackTestServer(t, tt.connectErr, tt.allow, condorQ)
+			ads, err := s.queryJobs(tt.ctx, s.schedd, "true", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("queryJobs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(ads) != tt.wantJobs {
+				t.Errorf("Expected %d jobs, got %d", tt.wantJobs, len(ads))
+			}
+		})
+	}
+}
+
+// TestQueryJobsCLIFallbackOwnJobsOnly verifies the fallback returns only the
+// caller's jobs, even if condor_q returns other users' jobs
+func TestQueryJobsCLIFallbackOwnJobsOnly(t *testing.T) {
+	condorQ := writeFakeCondorQOutput(t, `[{"ClusterId": 7, "ProcId": 0, "Owner": "alice"}, {"ClusterId": 8, "ProcId": 0, "Owner": "bob"}]`)
+	s := newFallbackTestServer(t, errTestAuthFailed, true, condorQ)
+	ctx := htcondor.WithAuthenticatedUser(context.Background(), "alice@test.domain")
+
+	ads, err := s.queryJobs(ctx, s.schedd, "true", []string{"ClusterId"})
+	if err != nil {
+		t.Fatalf("queryJobs() error = %v", err)
+	}
+	if len(ads) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(ads))
+	}
+	if cluster, _ := ads[0].EvaluateAttrInt("ClusterId"); cluster != 7 {
+		t.Errorf("Expected alice's job 7, got cluster %d", cluster)
+	}
+}
+
+func TestQueryJobsCLIFallbackFailureKeepsOriginalError(t *testing.T) {
+	s := newFallbackTestServer(t, errTestAuthFailed, true, filepath.Join(t.TempDir(), "missing"))
+	ctx := htcondor.WithAuthenticatedUser(context.Background(), "alice@test.domain")
+
+	_, err := s.queryJobs(ctx, s.schedd, "true", nil)
+	if err == nil {
+		t.Fatal("Expected error when the fallback also fails")
+	}
+	if !errors.Is(err, htcondor.ErrAuthenticationFailed) {
+		t.Errorf("Expected original authentication error, got %v", err)
+	}
+}
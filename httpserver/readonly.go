@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// readOnlyMessage is returned for mutations rejected in read-only mode
+const readOnlyMessage = "The API is in read-only mode for maintenance; job submission and changes are temporarily disabled"
+
+// SetReadOnly turns read-only mode on or off. In read-only mode, requests
+// that change the queue (submit, hold, release, remove, edit and input
+// upload, including the equivalent MCP tools) are rejected with 503 while
+// queries and output downloads keep working, e.g. during schedd
+// maintenance. It is safe to call while the server is running.
+func (s *Server) SetReadOnly(readOnly bool) {
+	if s.readOnly.Swap(readOnly) != readOnly {
+		s.logger.Info(logging.DestinationHTTP, "Read-only mode changed", "read_only", readOnly)
+	}
+}
+
+// ReadOnly reports whether the server is in read-only mode
+func (s *Server) ReadOnly() bool {
+	return s.readOnly.Load()
+}
+
+// isMutatingMethod reports whether an HTTP method changes state on the
+// job endpoints
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// writeReadOnlyError rejects a mutation made in read-only mode
+func (s *Server) writeReadOnlyError(w http.ResponseWriter) {
+	s.writeErrorCode(w, http.StatusServiceUnavailable, ErrCodeReadOnly, readOnlyMessage, nil)
+}
+
+// readOnlyMiddleware rejects mutating requests while the server is in
+// read-only mode
+func (s *Server) readOnlyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.ReadOnly() && isMutatingMethod(r.Method) {
+			s.writeReadOnlyError(w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
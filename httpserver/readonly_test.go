@@ -0,0 +1,71 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// TestReadOnlyMode verifies read-only mode rejects mutations while queries,
+// downloads and expression evaluation keep working, and can be turned off again
+func TestReadOnlyMode(t *testing.T) {
+	s := newErrorTestServer(t)
+	// Nothing listens on port 1, so requests that reach the schedd fail with schedd_unreachable
+	s.schedd = htcondor.NewSchedd("unreachable", "127.0.0.1:1")
+	mux := http.NewServeMux()
+	s.setupRoutes(mux)
+	token := createTestJWTToken(3600)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		return w
+	}
+	submitBody := `{"submit_file": "executable = /bin/true\nqueue\n"}`
+
+	s.SetReadOnly(true)
+	if !s.ReadOnly() {
+		t.Fatal("Expected the server to be read-only")
+	}
+
+	mutations := []struct {
+		method, path, body string
+	}{
+		{http.MethodPost, "/api/v1/jobs", submitBody},
+		{http.MethodDelete, "/api/v1/jobs", `{"constraint": "true"}`},
+		{http.MethodPatch, "/api/v1/jobs/1.0", `{"JobPrio": 5}`},
+		{http.MethodDelete, "/api/v1/jobs/1.0", ""},
+		{http.MethodPost, "/api/v1/jobs/1.0/hold", ""},
+		{http.MethodPost, "/api/v1/jobs/1.0/release", ""},
+		{http.MethodPost, "/api/v1/jobs/hold", `{"constraint": "true"}`},
+		{http.MethodPut, "/api/v1/jobs/1.0/input", "tarball"},
+	}
+	for _, m := range mutations {
+		w := do(m.method, m.path, m.body)
+		if w.Code != http.StatusServiceUnavailable {
+			t.Errorf("%s %s: expected status 503, got %d", m.method, m.path, w.Code)
+		}
+		assertErrorCode(t, w, ErrCodeReadOnly)
+	}
+
+	// Reads still reach the (unreachable) schedd rather than being rejected
+	for _, path := range []string{"/api/v1/jobs", "/api/v1/jobs/1.0"} {
+		w := do(http.MethodGet, path, "")
+		assertErrorCode(t, w, ErrCodeScheddUnreachable)
+	}
+	if w := do(http.MethodGet, "/api/v1/jobs/1.0/output", ""); strings.Contains(w.Body.String(), ErrCodeReadOnly) {
+		t.Errorf("Expected output downloads to work in read-only mode, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := do(http.MethodPost, "/api/v1/evaluate", `{"expression": "1 + 1"}`); w.Code != http.StatusOK {
+		t.Errorf("Expected evaluate to work in read-only mode, got %d: %s", w.Code, w.Body.String())
+	}
+
+	s.SetReadOnly(false)
+	w := do(http.MethodPost, "/api/v1/jobs", submitBody)
+	assertErrorCode(t, w, ErrCodeScheddUnreachable)
+}
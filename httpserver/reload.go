@@ -0,0 +1,108 @@
+package httpserver
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// reloadableConfigFields are the Config fields Reload may change; any other
+// field takes effect only when the server is restarted
+var reloadableConfigFields = map[string]bool{
+	"RateLimits":             true,
+	"RedactAttributes":       true,
+	"LogVerbosity":           true,
+	"AllowedExecutables":     true,
+	"TransferredExecutables": true,
+	"RequireImageDigest":     true,
+	"SubmitProfiles":         true,
+}
+
+// Reload applies the mutable settings of cfg to the running server: query
+// rate limits, redacted attributes, log verbosity, the executable
+// allowlist and the submit profiles. cfg must otherwise match the server's configuration; a change
+// to any other setting, such as ListenAddr, is rejected and nothing is
+// applied. Requests in flight finish under the previous settings.
+func (s *Server) Reload(cfg Config) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	if changed := immutableConfigChanges(s.config, cfg); len(changed) > 0 {
+		return fmt.Errorf("cannot reload settings that require a restart: %s", strings.Join(changed, ", "))
+	}
+	if err := s.applyMutableConfig(cfg); err != nil {
+		return err
+	}
+	s.config = cfg
+	s.logger.Info(logging.DestinationGeneral, "Reloaded server configuration")
+	return nil
+}
+
+// applyMutableConfig validates the reloadable settings of cfg and, only if
+// they are all valid, applies them
+func (s *Server) applyMutableConfig(cfg Config) error {
+	var verbosity *logging.Verbosity
+	if cfg.LogVerbosity != "" {
+		v, err := logging.ParseVerbosity(cfg.LogVerbosity)
+		if err != nil {
+			return err
+		}
+		verbosity = &v
+	}
+
+	var policy *htcondor.ExecutablePolicy
+	if len(cfg.AllowedExecutables) > 0 || cfg.TransferredExecutables == htcondor.TransferredExecutableDeny || cfg.RequireImageDigest {
+		policy = &htcondor.ExecutablePolicy{
+			Allowed:            cfg.AllowedExecutables,
+			Transferred:        cfg.TransferredExecutables,
+			RequireImageDigest: cfg.RequireImageDigest,
+		}
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	profiles, err := newSubmitProfiles(cfg.SubmitProfiles)
+	if err != nil {
+		return err
+	}
+
+	redactAttributes := cfg.RedactAttributes
+	if redactAttributes == nil {
+		redactAttributes = htcondor.DefaultRedactedAttributes
+	}
+
+	s.redactor.Store(htcondor.NewAttributeRedactor(redactAttributes))
+	s.executablePolicy.Store(policy)
+	s.submitProfiles.Store(&profiles)
+	if verbosity != nil {
+		s.logger.SetVerbosity(*verbosity)
+	}
+	if cfg.RateLimits != nil {
+		htcondor.SetRateLimitManager(cfg.RateLimits)
+	}
+	return nil
+}
+
+// immutableConfigChanges returns the names of the fields other than the
+// reloadable ones that differ between old and updated. Func fields, such as
+// Authorizer, cannot be compared and are not checked: a reload keeps the
+// server's original function.
+func immutableConfigChanges(old, updated Config) []string {
+	var changed []string
+	oldValue, updatedValue := reflect.ValueOf(old), reflect.ValueOf(updated)
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		name := field.Name
+		if reloadableConfigFields[name] || field.Type.Kind() == reflect.Func {
+			continue
+		}
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,142 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+	"github.com/bbockelm/golang-htcondor/ratelimit"
+)
+
+// TestReloadRateLimits verifies a reloaded rate limit applies to the next
+// schedd query
+func TestReloadRateLimits(t *testing.T) {
+	t.Cleanup(func() { htcondor.SetRateLimitManager(nil) })
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	cfg := Config{
+		ListenAddr: "127.0.0.1:0",
+		ScheddName: "test",
+		ScheddAddr: "schedd.example.com:9618",
+		Logger:     logger,
+		// A single query, then none for a long while
+		RateLimits: ratelimit.NewManager(0.001, 0, 0, 0),
+	}
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	s.schedd = htcondor.NewScheddWithTransport("test", "schedd.example.com:9618", failingTransport{
+		err: &htcondor.ConnectError{Address: "schedd.example.com:9618", Err: errors.New("connection refused")},
+	})
+	token := createTestJWTToken(3600)
+
+	queryJobs := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleJobs(w, req)
+		return w
+	}
+
+	if w := queryJobs(); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("First query: expected status %d, got %d: %s", http.StatusServiceUnavailable, w.Code, w.Body.String())
+	}
+	if w := queryJobs(); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("Second query: expected status %d, got %d: %s", http.StatusTooManyRequests, w.Code, w.Body.String())
+	}
+
+	cfg.RateLimits = ratelimit.NewManager(0, 0, 0, 0)
+	if err := s.Reload(cfg); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if w := queryJobs(); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Query after reload: expected status %d, got %d: %s", http.StatusServiceUnavailable, w.Code, w.Body.String())
+	}
+}
+
+// TestReloadRejectsImmutableSettings verifies a reload changing a setting
+// that needs a restart fails without applying anything
+func TestReloadRejectsImmutableSettings(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	cfg := Config{
+		ListenAddr: "127.0.0.1:0",
+		ScheddName: "test",
+		ScheddAddr: "schedd.example.com:9618",
+		Logger:     logger,
+	}
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	updated := cfg
+	updated.ListenAddr = "127.0.0.1:8443"
+	updated.AllowedExecutables = []string{"/usr/bin/*"}
+	err = s.Reload(updated)
+	if err == nil || !strings.Contains(err.Error(), "ListenAddr") {
+		t.Fatalf("Expected an error naming ListenAddr, got %v", err)
+	}
+	if s.executablePolicy.Load() != nil {
+		t.Error("Expected the executable allowlist not to be applied")
+	}
+
+	updated = cfg
+	updated.LogVerbosity = "LOUD"
+	if err := s.Reload(updated); err == nil {
+		t.Error("Expected an error for an unknown log verbosity")
+	}
+
+	updated = cfg
+	updated.AllowedExecutables = []string{"/usr/bin/*"}
+	updated.RedactAttributes = []string{}
+	updated.LogVerbosity = "warn"
+	if err := s.Reload(updated); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if err := s.executablePolicy.Load().CheckSubmitFile("executable = /bin/sh\ntransfer_executable = false\nqueue\n"); err == nil {
+		t.Error("Expected the reloaded allowlist to reject /bin/sh")
+	}
+}
+
+// TestReloadWithAuthorizer verifies an Authorizer, which cannot be compared,
+// does not make every reload fail
+func TestReloadWithAuthorizer(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	cfg := Config{
+		ListenAddr: "127.0.0.1:0",
+		ScheddName: "test",
+		ScheddAddr: "schedd.example.com:9618",
+		Logger:     logger,
+		Authorizer: func(context.Context, string, Action, Resource) error { return nil },
+	}
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	updated := cfg
+	updated.LogVerbosity = "warn"
+	if err := s.Reload(updated); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+
+	updated.ListenAddr = "127.0.0.1:8443"
+	if err := s.Reload(updated); err == nil || !strings.Contains(err.Error(), "ListenAddr") || strings.Contains(err.Error(), "Authorizer") {
+		t.Errorf("Expected an error naming only ListenAddr, got %v", err)
+	}
+}
@@ -6,8 +6,27 @@ import (
 	"github.com/bbockelm/golang-htcondor/logging"
 )
 
+// route is a pattern registered on the server's mux together with the API
+// operations served under it, which the OpenAPI document is generated from
+type route struct {
+	pattern    string
+	handler    http.Handler
+	operations []apiOperation
+}
+
 // setupRoutes sets up all HTTP routes
 func (s *Server) setupRoutes(mux *http.ServeMux) {
+	for _, rt := range s.routes() {
+		mux.Handle(rt.pattern, rt.handler)
+	}
+	if s.oauth2Provider != nil {
+		s.logger.Info(logging.DestinationHTTP, "MCP endpoints enabled", "path_prefix", "/mcp")
+	}
+}
+
+// routes returns the routes the server registers, which depend on whether
+// MCP and metrics are enabled
+func (s *Server) routes() []route {
 	// CORS middleware: allow all origins
 	cors := func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -22,42 +41,60 @@ func (s *Server) setupRoutes(mux *http.ServeMux) {
 		})
 	}
 
-	// OpenAPI schema
-	mux.Handle("/openapi.json", cors(http.HandlerFunc(s.handleOpenAPISchema)))
+	routes := []route{
+		// OpenAPI schema
+		{"/openapi.json", cors(http.HandlerFunc(s.handleOpenAPISchema)), openAPISchemaOperations()},
 
-	// Job management endpoints
-	mux.Handle("/api/v1/jobs", cors(http.HandlerFunc(s.handleJobs)))
-	mux.Handle("/api/v1/jobs/", cors(http.HandlerFunc(s.handleJobByID))) // Pattern with trailing slash catches /api/v1/jobs/{id}
+		// Job management endpoints
+		{"/api/v1/jobs", cors(s.apiVersionMiddleware(s.readOnlyMiddleware(http.HandlerFunc(s.handleJobs)))), jobsOperations()},
+		{"/api/v1/jobs/", cors(s.apiVersionMiddleware(s.readOnlyMiddleware(http.HandlerFunc(s.handleJobByID)))), jobByIDOperations()}, // Pattern with trailing slash catches /api/v1/jobs/{id}
 
-	// Collector endpoints
-	mux.HandleFunc("/api/v1/collector/", s.handleCollectorPath) // Pattern with trailing slash catches /api/v1/collector/* paths
+		// Job history (completed and removed jobs)
+		{"/api/v1/history", cors(s.apiVersionMiddleware(http.HandlerFunc(s.handleHistory))), historyOperations()},
+
+		// Collector endpoints
+		{"/api/v1/collector/", s.apiVersionMiddleware(http.HandlerFunc(s.handleCollectorPath)), collectorOperations()}, // Pattern with trailing slash catches /api/v1/collector/* paths
+
+		// Fair-share user priorities, from the negotiator
+		{"/api/v1/priorities", cors(s.apiVersionMiddleware(http.HandlerFunc(s.handlePriorities))), prioritiesOperations()},
+		{"/api/v1/priorities/", cors(s.apiVersionMiddleware(s.readOnlyMiddleware(http.HandlerFunc(s.handlePriorityByName)))), priorityByNameOperations()},
+
+		// Sandbox transfers in progress
+		{"/api/v1/transfers", cors(s.apiVersionMiddleware(http.HandlerFunc(s.handleTransfers))), transfersOperations()},
+		{"/api/v1/transfers/", cors(s.apiVersionMiddleware(http.HandlerFunc(s.handleTransferByID))), transferByIDOperations()},
+
+		// Expression evaluation (authoring aid; contacts no daemon)
+		{"/api/v1/evaluate", cors(s.apiVersionMiddleware(http.HandlerFunc(s.handleEvaluate))), evaluateOperations()},
+	}
 
 	// MCP endpoints (OAuth2 protected)
 	if s.oauth2Provider != nil {
-		// OAuth2 metadata discovery (RFC 8414 and RFC 9068)
-		mux.HandleFunc("/.well-known/oauth-authorization-server", s.handleOAuth2Metadata)
-		mux.HandleFunc("/.well-known/oauth-protected-resource", s.handleOAuth2ProtectedResourceMetadata)
-
-		// OAuth2 endpoints
-		mux.HandleFunc("/mcp/oauth2/authorize", s.handleOAuth2Authorize)
-		mux.HandleFunc("/mcp/oauth2/callback", s.handleOAuth2Callback) // SSO callback
-		mux.HandleFunc("/mcp/oauth2/token", s.handleOAuth2Token)
-		mux.HandleFunc("/mcp/oauth2/introspect", s.handleOAuth2Introspect)
-		mux.HandleFunc("/mcp/oauth2/revoke", s.handleOAuth2Revoke)
-		mux.HandleFunc("/mcp/oauth2/register", s.handleOAuth2Register) // Dynamic client registration (RFC 7591)
+		routes = append(routes,
+			// OAuth2 metadata discovery (RFC 8414 and RFC 9068)
+			route{"/.well-known/oauth-authorization-server", http.HandlerFunc(s.handleOAuth2Metadata), oauth2MetadataOperations()},
+			route{"/.well-known/oauth-protected-resource", http.HandlerFunc(s.handleOAuth2ProtectedResourceMetadata), oauth2ProtectedResourceOperations()},
 
-		// MCP protocol endpoint
-		mux.HandleFunc("/mcp/message", s.handleMCPMessage)
+			// OAuth2 endpoints
+			route{"/mcp/oauth2/authorize", http.HandlerFunc(s.handleOAuth2Authorize), oauth2AuthorizeOperations()},
+			route{"/mcp/oauth2/callback", http.HandlerFunc(s.handleOAuth2Callback), oauth2CallbackOperations()}, // SSO callback
+			route{"/mcp/oauth2/token", http.HandlerFunc(s.handleOAuth2Token), oauth2TokenOperations()},
+			route{"/mcp/oauth2/introspect", http.HandlerFunc(s.handleOAuth2Introspect), oauth2IntrospectOperations()},
+			route{"/mcp/oauth2/revoke", http.HandlerFunc(s.handleOAuth2Revoke), oauth2RevokeOperations()},
+			route{"/mcp/oauth2/register", http.HandlerFunc(s.handleOAuth2Register), oauth2RegisterOperations()}, // Dynamic client registration (RFC 7591)
 
-		s.logger.Info(logging.DestinationHTTP, "MCP endpoints enabled", "path_prefix", "/mcp")
+			// MCP protocol endpoint
+			route{"/mcp/message", http.HandlerFunc(s.handleMCPMessage), mcpMessageOperations()},
+		)
 	}
 
 	// Metrics endpoint (if enabled)
 	if s.prometheusExporter != nil {
-		mux.HandleFunc("/metrics", s.handleMetrics)
+		routes = append(routes, route{"/metrics", http.HandlerFunc(s.handleMetrics), metricsOperations()})
 	}
 
 	// Health and readiness endpoints for Kubernetes
-	mux.HandleFunc("/healthz", s.handleHealthz)
-	mux.HandleFunc("/readyz", s.handleReadyz)
+	return append(routes,
+		route{"/healthz", http.HandlerFunc(s.handleHealthz), healthOperations("/healthz", "healthCheck", "Liveness check", "ok")},
+		route{"/readyz", http.HandlerFunc(s.handleReadyz), healthOperations("/readyz", "readinessCheck", "Readiness check", "ready")},
+	)
 }
@@ -0,0 +1,104 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// scheddParam is the query parameter selecting the schedd a request targets
+const scheddParam = "schedd"
+
+// scheddDiscoveryTimeout bounds the collector lookup of a selected schedd
+// that was configured without an address
+const scheddDiscoveryTimeout = 5 * time.Second
+
+// newSchedd creates a schedd connected to through transport, or through the
+// default transport if transport is nil
+func newSchedd(name, addr string, transport htcondor.Transport) *htcondor.Schedd {
+	if transport == nil {
+		return htcondor.NewSchedd(name, addr)
+	}
+	return htcondor.NewScheddWithTransport(name, addr, transport)
+}
+
+// newScheddSet records the schedds requests may select besides the primary
+// one. Schedds configured with an address are created right away; the rest
+// are looked up in the collector the first time they are selected.
+func (s *Server) newScheddSet(schedds map[string]string) error {
+	s.schedds = make(map[string]*htcondor.Schedd, len(schedds))
+	for name, addr := range schedds {
+		if name == "" {
+			return fmt.Errorf("schedd with address %q has no name", addr)
+		}
+		if addr == "" && s.collector == nil {
+			return fmt.Errorf("schedd %q has no address and no collector is configured for discovery", name)
+		}
+		if addr == "" {
+			s.schedds[name] = nil
+			continue
+		}
+		s.schedds[name] = newSchedd(name, addr, s.scheddTransport)
+	}
+	return nil
+}
+
+// scheddNames returns the names of the schedds requests may select, the
+// primary schedd first
+func (s *Server) scheddNames() []string {
+	s.scheddsMu.Lock()
+	names := make([]string, 0, len(s.schedds))
+	for name := range s.schedds {
+		if name != s.schedd.Name() {
+			names = append(names, name)
+		}
+	}
+	s.scheddsMu.Unlock()
+	sort.Strings(names)
+	return append([]string{s.schedd.Name()}, names...)
+}
+
+// scheddForRequest returns the schedd selected by the request's schedd
+// query parameter, or the primary schedd when none is given. If the
+// selection is unknown or cannot be located it writes an error response and
+// returns false.
+func (s *Server) scheddForRequest(w http.ResponseWriter, r *http.Request) (*htcondor.Schedd, bool) {
+	name := r.URL.Query().Get(scheddParam)
+	if name == "" || name == s.schedd.Name() {
+		return s.schedd, true
+	}
+
+	s.scheddsMu.Lock()
+	schedd, known := s.schedds[name]
+	s.scheddsMu.Unlock()
+	if !known {
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeScheddNotFound,
+			fmt.Sprintf("Unknown schedd %q", name), map[string]any{"schedds": s.scheddNames()})
+		return nil, false
+	}
+	if schedd != nil {
+		return schedd, true
+	}
+
+	addr, err := discoverSchedd(s.collector, name, scheddDiscoveryTimeout, s.logger)
+	if err != nil {
+		s.logger.Warn(logging.DestinationSchedd, "Failed to discover selected schedd", "schedd", name, "error", err)
+		s.writeErrorCode(w, http.StatusServiceUnavailable, ErrCodeScheddUnreachable,
+			fmt.Sprintf("Schedd %q could not be located: %v", name, err), nil)
+		return nil, false
+	}
+	schedd = newSchedd(name, addr, s.scheddTransport)
+
+	s.scheddsMu.Lock()
+	if existing := s.schedds[name]; existing != nil {
+		schedd = existing
+	} else {
+		s.schedds[name] = schedd
+	}
+	s.scheddsMu.Unlock()
+	return schedd, true
+}
@@ -0,0 +1,157 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// demoSchedd listens like a schedd and counts connections, dropping each
+// one before the handshake
+type demoSchedd struct {
+	listener net.Listener
+	conns    atomic.Int32
+}
+
+func newDemoSchedd(t *testing.T) *demoSchedd {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	d := &demoSchedd{listener: listener}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			d.conns.Add(1)
+			_ = conn.Close()
+		}
+	}()
+	return d
+}
+
+// TestScheddSelection verifies requests are routed to the schedd named by
+// the schedd query parameter
+func TestScheddSelection(t *testing.T) {
+	primary := newDemoSchedd(t)
+	secondary := newDemoSchedd(t)
+
+	s := newErrorTestServer(t)
+	s.schedd = htcondor.NewSchedd("primary", primary.listener.Addr().String())
+	if err := s.newScheddSet(map[string]string{"secondary": secondary.listener.Addr().String()}); err != nil {
+		t.Fatalf("newScheddSet failed: %v", err)
+	}
+	token := createTestJWTToken(3600)
+
+	tests := []struct {
+		name          string
+		query         string
+		wantPrimary   bool
+		wantSecondary bool
+	}{
+		{"default", "", true, false},
+		{"primary by name", "?schedd=primary", true, false},
+		{"secondary", "?schedd=secondary", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary.conns.Store(0)
+			secondary.conns.Store(0)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			s.handleListJobs(w, req)
+
+			// The demo schedds drop the connection, so the query itself fails
+			if w.Code == http.StatusOK {
+				t.Fatalf("Expected the query to fail, got %d", w.Code)
+			}
+			if got := primary.conns.Load() > 0; got != tt.wantPrimary {
+				t.Errorf("Expected primary contacted=%v, got %v", tt.wantPrimary, got)
+			}
+			if got := secondary.conns.Load() > 0; got != tt.wantSecondary {
+				t.Errorf("Expected secondary contacted=%v, got %v", tt.wantSecondary, got)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs?schedd=other", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleListJobs(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d: %s", http.StatusNotFound, w.Code, w.Body.String())
+		}
+		assertErrorCode(t, w, ErrCodeScheddNotFound)
+	})
+}
+
+func TestScheddSetRequiresCollectorForDiscovery(t *testing.T) {
+	s := newErrorTestServer(t)
+	if err := s.newScheddSet(map[string]string{"secondary": ""}); err == nil {
+		t.Error("Expected error for a schedd without address or collector")
+	}
+}
+
+// recordingTransport records the addresses it is asked to connect to and
+// fails every connection
+type recordingTransport struct {
+	addrs chan string
+}
+
+func (t recordingTransport) Connect(_ context.Context, address string) (htcondor.Connection, error) {
+	t.addrs <- address
+	return nil, errors.New("connection refused")
+}
+
+// TestScheddSetUsesScheddTransport verifies the selectable schedds connect
+// through the server's schedd transport, e.g. the ScheddProxy tunnel
+func TestScheddSetUsesScheddTransport(t *testing.T) {
+	transport := recordingTransport{addrs: make(chan string, 10)}
+	s := newErrorTestServer(t)
+	s.scheddTransport = transport
+	s.schedd = newSchedd("primary", "primary.example.com:9618", transport)
+	if err := s.newScheddSet(map[string]string{"secondary": "secondary.example.com:9618"}); err != nil {
+		t.Fatalf("newScheddSet failed: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs?schedd=secondary", nil)
+	req.Header.Set("Authorization", "Bearer "+createTestJWTToken(3600))
+	w := httptest.NewRecorder()
+	s.handleListJobs(w, req)
+
+	select {
+	case addr := <-transport.addrs:
+		if addr != "secondary.example.com:9618" {
+			t.Errorf("Expected connection to secondary.example.com:9618, got %s", addr)
+		}
+	default:
+		t.Error("Expected the secondary schedd to connect through the schedd transport")
+	}
+}
+
+func TestNewServerInvalidScheddProxy(t *testing.T) {
+	_, err := NewServer(Config{
+		ListenAddr:  "127.0.0.1:0",
+		ScheddName:  "test",
+		ScheddAddr:  "schedd.example.com:9618",
+		ScheddProxy: "ftp://proxy.example.com",
+	})
+	if err == nil {
+		t.Error("Expected error for an unsupported proxy scheme")
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PelicanPlatform/classad/classad"
@@ -16,6 +19,8 @@ import (
 	htcondor "github.com/bbockelm/golang-htcondor"
 	"github.com/bbockelm/golang-htcondor/logging"
 	"github.com/bbockelm/golang-htcondor/metricsd"
+	"github.com/bbockelm/golang-htcondor/ratelimit"
+	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/oauth2"
 )
 
@@ -32,16 +37,56 @@ type Server struct {
 	logger              *logging.Logger
 	metricsRegistry     *metricsd.Registry
 	prometheusExporter  *metricsd.PrometheusExporter
-	tokenCache          *TokenCache       // Cache of validated tokens and their session caches (includes username)
-	oauth2Provider      *OAuth2Provider   // OAuth2 provider for MCP endpoints
-	oauth2Config        *oauth2.Config    // OAuth2 client config for SSO
-	oauth2StateStore    *OAuth2StateStore // State storage for OAuth2 SSO flow
-	oauth2UserInfoURL   string            // User info endpoint for SSO
-	oauth2UsernameClaim string            // Claim name for username (default: "sub")
-	oauth2GroupsClaim   string            // Claim name for group information (default: "groups")
-	mcpAccessGroup      string            // Group required for any MCP access (empty = all authenticated users)
-	mcpReadGroup        string            // Group required for read access (empty = all users have read)
-	mcpWriteGroup       string            // Group required for write access (empty = all users have write)
+	tokenCache          *TokenCache        // Cache of validated tokens and their session caches (includes username)
+	oauth2Provider      *OAuth2Provider    // OAuth2 provider for MCP endpoints
+	oauth2Config        *oauth2.Config     // OAuth2 client config for SSO
+	oauth2StateStore    *OAuth2StateStore  // State storage for OAuth2 SSO flow
+	oauth2UserInfoURL   string             // User info endpoint for SSO
+	oauth2UsernameClaim string             // Claim name for username (default: "sub")
+	oauth2GroupsClaim   string             // Claim name for group information (default: "groups")
+	mcpAccessGroup      string             // Group required for any MCP access (empty = all authenticated users)
+	mcpReadGroup        string             // Group required for read access (empty = all users have read)
+	mcpWriteGroup       string             // Group required for write access (empty = all users have write)
+	allowCLIFallback    bool               // Fall back to condor_q -json when the CEDAR query is denied
+	condorQPath         string             // Path to condor_q for the CLI fallback
+	scheddTransport     htcondor.Transport // Transport schedd connections use (nil = default)
+	transferTimeout     time.Duration      // First-byte and stall deadline for file transfer bodies
+	openAPIVersion      string             // OpenAPI version of /openapi.json (OpenAPIVersion30 or OpenAPIVersion31)
+	// executablePolicy restricts submitted executables (nil = unrestricted);
+	// replaced by Reload
+	executablePolicy atomic.Pointer[htcondor.ExecutablePolicy]
+	// redactor strips secret attributes from ads in responses (nil = none);
+	// replaced by Reload
+	redactor atomic.Pointer[htcondor.AttributeRedactor]
+	// submitProfiles holds the named submit profiles by name; replaced by
+	// Reload
+	submitProfiles atomic.Pointer[map[string]*submitProfile]
+	// config is the configuration last applied by NewServer or Reload
+	config   Config
+	reloadMu sync.Mutex
+	// signingKeys mints tokens with the key at signingKeyPath, following
+	// rotations (nil if no key is configured)
+	signingKeys *htcondor.SigningKeyWatcher
+	// stopSigningKeys stops the background reload of signingKeys
+	stopSigningKeys context.CancelFunc
+	// readOnly rejects queue changes while set (see SetReadOnly)
+	readOnly atomic.Bool
+	// schedds holds the other schedds requests may select by name; nil
+	// entries have not been discovered from the collector yet
+	schedds   map[string]*htcondor.Schedd
+	scheddsMu sync.Mutex
+	// defaultJobProjection limits the attributes of job lists (nil = all)
+	defaultJobProjection []string
+	// adminUsers may list and cancel every user's transfers
+	adminUsers map[string]bool
+	// jobLeaseDuration is the lease of submitted jobs that set none (0 = default)
+	jobLeaseDuration time.Duration
+	// oauthServices are the OAuth services submitted jobs may request (nil = any)
+	oauthServices []string
+	// authorizer vets operations before they are performed (nil = all allowed)
+	authorizer Authorizer
+	// transfers tracks the sandbox transfers in progress
+	transfers transferRegistry
 }
 
 // Config holds server configuration
@@ -58,6 +103,9 @@ type Config struct {
 	ReadTimeout         time.Duration       // HTTP read timeout (default: 30s)
 	WriteTimeout        time.Duration       // HTTP write timeout (default: 30s)
 	IdleTimeout         time.Duration       // HTTP idle timeout (default: 120s)
+	ReadHeaderTimeout   time.Duration       // Time allowed to read request headers (default: 10s)
+	MaxHeaderBytes      int                 // Maximum size of request headers in bytes (default: 64 KiB)
+	TransferTimeout     time.Duration       // First-byte and stall deadline for file transfer bodies (default: 30s)
 	Collector           *htcondor.Collector // Collector for metrics (optional)
 	EnableMetrics       bool                // Enable /metrics endpoint (default: true if Collector is set)
 	MetricsCacheTTL     time.Duration       // Metrics cache TTL (default: 10s)
@@ -77,8 +125,77 @@ type Config struct {
 	MCPAccessGroup      string              // Group required for any MCP access (empty = all authenticated)
 	MCPReadGroup        string              // Group required for read operations (empty = all have read)
 	MCPWriteGroup       string              // Group required for write operations (empty = all have write)
+	AllowCLIFallback    bool                // Fall back to `condor_q -json` if the CEDAR job query is denied (default: false)
+	CondorQPath         string              // Path to condor_q for the CLI fallback (default: "condor_q")
+	// AllowedExecutables lists glob patterns (e.g. "/usr/bin/*") of executables
+	// users may submit; submissions of other executables are rejected with 403.
+	// Empty allows every executable.
+	AllowedExecutables []string
+	// TransferredExecutables selects how AllowedExecutables treats executables
+	// transferred with the job (default: not checked)
+	TransferredExecutables htcondor.TransferredExecutableMode
+	// RequireImageDigest rejects container submissions whose image is not
+	// pinned by digest (e.g. "repo:tag@sha256:...") with 403
+	RequireImageDigest bool
+	// KeyRotationWindow is how long tokens signed with the previous key
+	// keep verifying after SigningKeyPath changes (default: 1h)
+	KeyRotationWindow time.Duration
+	// Schedds maps the names of further schedds requests may select with
+	// ?schedd=<name> to their addresses. An empty address is discovered from
+	// the collector the first time the schedd is selected.
+	Schedds map[string]string
+	// OpenAPIVersion selects the OpenAPI version /openapi.json is served in:
+	// OpenAPIVersion30 (default) or OpenAPIVersion31
+	OpenAPIVersion string
+	// RedactAttributes lists attributes removed from every ad returned over
+	// HTTP or MCP (default: htcondor.DefaultRedactedAttributes). An empty,
+	// non-nil slice returns ads unredacted.
+	RedactAttributes []string
+	// RateLimits replaces the schedd and collector query rate limits, which
+	// are otherwise read from the HTCondor configuration (optional)
+	RateLimits *ratelimit.Manager
+	// LogVerbosity sets the logger's minimum verbosity ("ERROR", "WARN",
+	// "INFO" or "DEBUG"); empty keeps the logger's own
+	LogVerbosity string
+	// SubmitProfiles maps profile names, which submit requests select with
+	// their "profile" field, to submit commands and their values. The
+	// commands are added to the top of the submit file, so the user's
+	// submit file may override them, except for commands whose name is
+	// prefixed with "!" (e.g. "!accounting_group"), which are enforced.
+	SubmitProfiles map[string]map[string]string
+	// DefaultJobProjection lists the attributes GET /api/v1/jobs returns
+	// (e.g. ClusterId, ProcId, JobStatus, Cmd, Owner, QDate) unless the
+	// client asks for more with ?projection=, or for every attribute with
+	// ?projection=*. Empty returns full job ads. GET /api/v1/jobs/{id}
+	// always returns the full ad.
+	DefaultJobProjection []string
+	// AdminUsers lists the users who may list and cancel every user's
+	// sandbox transfers; other users see only their own
+	AdminUsers []string
+	// Authorizer, if set, is asked before every REST API job, history and
+	// priority operation whether the authenticated user may perform it, for
+	// permissions finer than HTCondor's own (e.g. users who may query but
+	// not remove jobs). MCP tools are governed by the MCP groups instead.
+	Authorizer Authorizer
+	// JobLeaseDuration is the JobLeaseDuration of submitted vanilla, java,
+	// vm and docker universe jobs that do not set job_lease_duration: how long
+	// they keep running while the schedd is unreachable, e.g. restarting
+	// (default: htcondor.DefaultJobLeaseDuration). Must not be negative.
+	JobLeaseDuration time.Duration
+	// OAuthServices are the OAuth services the pool's credmon issues tokens
+	// for; submitted jobs requesting others in use_oauth_services or as
+	// registry credentials are rejected. nil accepts any service, and an empty list none.
+	OAuthServices []string
+	// ScheddProxy is the URL of an HTTP CONNECT or SOCKS5 proxy (e.g.
+	// "socks5://proxy.example.com:1080") the connections to every schedd
+	// are tunneled through (optional; see htcondor.NewProxyTransport)
+	ScheddProxy string
 }
 
+// signingKeyWatchInterval is how often the signing key file is checked for
+// rotation
+const signingKeyWatchInterval = 30 * time.Second
+
 // NewServer creates a new HTTP API server
 func NewServer(cfg Config) (*Server, error) {
 	// Initialize logger if not provided
@@ -94,6 +211,15 @@ func NewServer(cfg Config) (*Server, error) {
 		}
 	}
 
+	var scheddTransport htcondor.Transport
+	if cfg.ScheddProxy != "" {
+		var err error
+		scheddTransport, err = htcondor.NewProxyTransport(cfg.ScheddProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ScheddProxy: %w", err)
+		}
+	}
+
 	// Discover schedd address if not provided
 	scheddAddr := cfg.ScheddAddr
 	if scheddAddr == "" {
@@ -111,18 +237,46 @@ func NewServer(cfg Config) (*Server, error) {
 	}
 
 	// Create schedd with the address as-is (can be host:port or sinful string)
-	schedd := htcondor.NewSchedd(cfg.ScheddName, scheddAddr)
+	schedd := newSchedd(cfg.ScheddName, scheddAddr, scheddTransport)
 
 	s := &Server{
-		schedd:         schedd,
-		collector:      cfg.Collector,
-		trustDomain:    cfg.TrustDomain,
-		uidDomain:      cfg.UIDDomain,
-		userHeader:     cfg.UserHeader,
-		signingKeyPath: cfg.SigningKeyPath,
-		logger:         logger,
-		tokenCache:     NewTokenCache(), // Initialize token cache (includes username for rate limiting)
+		schedd:           schedd,
+		collector:        cfg.Collector,
+		trustDomain:      cfg.TrustDomain,
+		uidDomain:        cfg.UIDDomain,
+		userHeader:       cfg.UserHeader,
+		signingKeyPath:   cfg.SigningKeyPath,
+		logger:           logger,
+		tokenCache:       NewTokenCache(), // Initialize token cache (includes username for rate limiting)
+		allowCLIFallback: cfg.AllowCLIFallback,
+		condorQPath:      cfg.CondorQPath,
+		scheddTransport:  scheddTransport,
+	}
+	if err := s.newScheddSet(cfg.Schedds); err != nil {
+		return nil, err
+	}
+	s.defaultJobProjection = slices.Clone(cfg.DefaultJobProjection)
+	s.adminUsers = make(map[string]bool, len(cfg.AdminUsers))
+	for _, user := range cfg.AdminUsers {
+		s.adminUsers[user] = true
+	}
+	s.authorizer = cfg.Authorizer
+	if cfg.JobLeaseDuration < 0 {
+		return nil, fmt.Errorf("JobLeaseDuration must not be negative, got %v", cfg.JobLeaseDuration)
 	}
+	s.jobLeaseDuration = cfg.JobLeaseDuration
+	s.oauthServices = slices.Clone(cfg.OAuthServices)
+
+	openAPIVersion, err := validateOpenAPIVersion(cfg.OpenAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	s.openAPIVersion = openAPIVersion
+
+	if err := s.applyMutableConfig(cfg); err != nil {
+		return nil, err
+	}
+	s.config = cfg
 
 	// Setup OAuth2 provider if MCP is enabled
 	if cfg.EnableMCP {
@@ -244,18 +398,62 @@ func NewServer(cfg Config) (*Server, error) {
 	if idleTimeout == 0 {
 		idleTimeout = 120 * time.Second
 	}
+	// A short header timeout, independent of ReadTimeout, protects against
+	// slowloris clients trickling in headers to hold connections open
+	readHeaderTimeout := cfg.ReadHeaderTimeout
+	if readHeaderTimeout == 0 {
+		readHeaderTimeout = 10 * time.Second
+	}
+	maxHeaderBytes := cfg.MaxHeaderBytes
+	if maxHeaderBytes == 0 {
+		maxHeaderBytes = 64 << 10
+	}
+	s.transferTimeout = cfg.TransferTimeout
+	if s.transferTimeout == 0 {
+		s.transferTimeout = 30 * time.Second
+	}
+
+	if cfg.SigningKeyPath != "" {
+		s.watchSigningKey(cfg.SigningKeyPath, cfg.KeyRotationWindow)
+	}
 
 	s.httpServer = &http.Server{
-		Addr:         cfg.ListenAddr,
-		Handler:      handler,
-		ReadTimeout:  readTimeout,
-		WriteTimeout: writeTimeout,
-		IdleTimeout:  idleTimeout,
+		Addr:              cfg.ListenAddr,
+		Handler:           handler,
+		ReadTimeout:       readTimeout,
+		ReadHeaderTimeout: readHeaderTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+		MaxHeaderBytes:    maxHeaderBytes,
 	}
 
 	return s, nil
 }
 
+// watchSigningKey creates s.signingKeys for the key at path and reloads it in
+// the background until Shutdown, logging rotations
+func (s *Server) watchSigningKey(path string, rotationWindow time.Duration) {
+	s.signingKeys = htcondor.NewSigningKeyWatcher(path, rotationWindow)
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopSigningKeys = cancel
+
+	var lastErr string
+	go s.signingKeys.Watch(ctx, signingKeyWatchInterval, func(changed bool, err error) {
+		if err != nil {
+			// Log a failing key file once, not on every check
+			if err.Error() != lastErr {
+				s.logger.Warn(logging.DestinationSecurity, "Failed to reload signing key", "path", path, "error", err)
+			}
+			lastErr = err.Error()
+			return
+		}
+		lastErr = ""
+		if changed {
+			s.logger.Info(logging.DestinationSecurity, "Loaded signing key", "path", path)
+		}
+	})
+}
+
 // Start starts the HTTP server
 func (s *Server) Start() error {
 	s.logger.Info(logging.DestinationHTTP, "Starting HTCondor API server", "address", s.httpServer.Addr)
@@ -292,6 +490,10 @@ func (s *Server) StartTLS(certFile, keyFile string) error {
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info(logging.DestinationHTTP, "Shutting down HTTP server")
 
+	if s.stopSigningKeys != nil {
+		s.stopSigningKeys()
+	}
+
 	// Close OAuth2 provider if enabled
 	if s.oauth2Provider != nil {
 		if err := s.oauth2Provider.Close(); err != nil {
@@ -337,6 +539,12 @@ func (rw *responseWriter) WriteHeader(statusCode int) {
 	rw.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap returns the underlying ResponseWriter, so http.ResponseController
+// can reach it to adjust connection deadlines
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func (rw *responseWriter) Write(b []byte) (int, error) {
 	if rw.statusCode == 0 {
 		rw.statusCode = http.StatusOK
@@ -413,15 +621,26 @@ func (s *Server) accessLogMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// ErrorResponse represents an error response
+// ErrorResponse represents an error response. Code is the HTTP status code,
+// as it always has been; ErrorCode is a stable, machine-readable error code
+// (see the ErrCode constants) for clients to branch on. Message is meant
+// for people and may change.
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
-	Code    int    `json:"code"`
+	Error     string         `json:"error"`
+	Message   string         `json:"message,omitempty"`
+	Code      int            `json:"code"`
+	ErrorCode string         `json:"error_code"`
+	Details   map[string]any `json:"details,omitempty"`
 }
 
-// writeError writes an error response
+// writeError writes an error response with the default code for statusCode
 func (s *Server) writeError(w http.ResponseWriter, statusCode int, message string) {
+	s.writeErrorCode(w, statusCode, defaultErrorCode(statusCode), message, nil)
+}
+
+// writeErrorCode writes an error response with an explicit error code and
+// optional details
+func (s *Server) writeErrorCode(w http.ResponseWriter, statusCode int, code, message string, details map[string]any) {
 	// Add WWW-Authenticate header for 401 Unauthorized responses when OAuth2 is enabled
 	if statusCode == http.StatusUnauthorized && s.oauth2Provider != nil {
 		s.addWWWAuthenticateHeader(w, "", "")
@@ -429,9 +648,11 @@ func (s *Server) writeError(w http.ResponseWriter, statusCode int, message strin
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 	if err := json.NewEncoder(w).Encode(ErrorResponse{
-		Error:   http.StatusText(statusCode),
-		Message: message,
-		Code:    statusCode,
+		Error:     http.StatusText(statusCode),
+		Message:   message,
+		Code:      statusCode,
+		ErrorCode: code,
+		Details:   details,
 	}); err != nil {
 		s.logger.Error(logging.DestinationHTTP, "Failed to encode error response", "error", err, "status_code", statusCode)
 	}
@@ -444,10 +665,12 @@ func (s *Server) writeOAuthError(w http.ResponseWriter, statusCode int, errorCod
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
+	// OAuth2 error codes (RFC 6749) are already machine-readable
 	if err := json.NewEncoder(w).Encode(ErrorResponse{
-		Error:   errorCode,
-		Message: errorDescription,
-		Code:    statusCode,
+		Error:     errorCode,
+		Message:   errorDescription,
+		Code:      statusCode,
+		ErrorCode: errorCode,
 	}); err != nil {
 		s.logger.Error(logging.DestinationHTTP, "Failed to encode error response", "error", err, "status_code", statusCode)
 	}
@@ -479,9 +702,13 @@ func (s *Server) addWWWAuthenticateHeader(w http.ResponseWriter, errorCode, erro
 
 // writeJSON writes a JSON response
 func (s *Server) writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
+	// Keep a versioned media type chosen by content negotiation
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
 	w.WriteHeader(statusCode)
 	if data != nil {
+		s.redactResponse(data)
 		if err := json.NewEncoder(w).Encode(data); err != nil {
 			s.logger.Error(logging.DestinationHTTP, "Error encoding JSON response", "error", err, "status_code", statusCode)
 		}
@@ -514,7 +741,7 @@ func (s *Server) extractOrGenerateToken(r *http.Request) (string, error) {
 	}
 
 	// If userHeader is configured and signing key is available, try to generate token
-	if s.userHeader != "" && s.signingKeyPath != "" {
+	if s.userHeader != "" && s.signingKeys != nil {
 		username := r.Header.Get(s.userHeader)
 		if username == "" {
 			return "", fmt.Errorf("no authorization token and %s header is empty", s.userHeader)
@@ -533,9 +760,8 @@ func (s *Server) extractOrGenerateToken(r *http.Request) (string, error) {
 			}
 			username = username + "@" + s.uidDomain
 		}
-		kid := filepath.Base(s.signingKeyPath)
-		s.logger.Debug(logging.DestinationSecurity, "Generating token for user", "username", username, "header", s.userHeader, "issuer", issuer, "key", kid)
-		token, err := security.GenerateJWT(filepath.Dir(s.signingKeyPath), kid, username, issuer, iat, exp, nil)
+		s.logger.Debug(logging.DestinationSecurity, "Generating token for user", "username", username, "header", s.userHeader, "issuer", issuer, "key", filepath.Base(s.signingKeyPath))
+		token, err := s.signingKeys.GenerateToken(username, issuer, iat, exp, nil)
 		if err != nil {
 			return "", fmt.Errorf("failed to generate token for user %s: %w", username, err)
 		}
@@ -547,6 +773,27 @@ func (s *Server) extractOrGenerateToken(r *http.Request) (string, error) {
 	return "", fmt.Errorf("no authorization token and user header not configured")
 }
 
+// verifyOwnToken checks the signature and expiry of a token that names the
+// server's signing key, accepting the previous key during a rotation window,
+// so a forged or expired token is rejected before its username is trusted.
+// Tokens signed with other keys are left for the schedd to verify.
+func (s *Server) verifyOwnToken(token string) error {
+	if s.signingKeys == nil {
+		return nil
+	}
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+	if err != nil {
+		return nil
+	}
+	if kid, _ := parsed.Header["kid"].(string); kid != s.signingKeys.KeyID() {
+		return nil
+	}
+	if _, err := s.signingKeys.VerifyToken(token); err != nil {
+		return fmt.Errorf("token signed with %s: %w", s.signingKeys.KeyID(), err)
+	}
+	return nil
+}
+
 // createAuthenticatedContext creates a context with both token and SecurityConfig set
 // This is a helper to avoid duplicating security setup code in every handler
 func (s *Server) createAuthenticatedContext(r *http.Request) (context.Context, error) {
@@ -555,6 +802,9 @@ func (s *Server) createAuthenticatedContext(r *http.Request) (context.Context, e
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyOwnToken(token); err != nil {
+		return nil, err
+	}
 
 	// Create context with token
 	ctx := WithToken(r.Context(), token)
@@ -675,9 +925,7 @@ func discoverSchedd(collector *htcondor.Collector, scheddName string, timeout ti
 				if err == nil {
 					// Try to find a schedd whose name matches the hostname
 					for _, ad := range ads {
-						if nameExpr, ok := ad.Lookup("Name"); ok {
-							name := nameExpr.String()
-							name = strings.Trim(name, "\"")
+						if name, ok := htcondor.AdString(ad, "Name"); ok {
 							if name == hostname {
 								selectedAd = ad
 								logger.Info(logging.DestinationSchedd, "Found schedd matching hostname", "hostname", hostname)
@@ -690,9 +938,7 @@ func discoverSchedd(collector *htcondor.Collector, scheddName string, timeout ti
 				// If no match found, use the first schedd
 				if selectedAd == nil {
 					selectedAd = ads[0]
-					if nameExpr, ok := selectedAd.Lookup("Name"); ok {
-						name := nameExpr.String()
-						name = strings.Trim(name, "\"")
+					if name, ok := htcondor.AdString(selectedAd, "Name"); ok {
 						logger.Info(logging.DestinationSchedd, "Using first schedd found", "name", name)
 					}
 				}
@@ -702,16 +948,14 @@ func discoverSchedd(collector *htcondor.Collector, scheddName string, timeout ti
 			}
 
 			// Extract MyAddress from the selected schedd ad
-			myAddressExpr, ok := selectedAd.Lookup("MyAddress")
+			myAddress, ok := htcondor.AdString(selectedAd, "MyAddress")
 			if !ok {
 				return "", fmt.Errorf("schedd ad missing MyAddress attribute")
 			}
 
-			// ClassAd String() returns a quoted string; trim surrounding
-			// quotes and whitespace. Also remove surrounding angle brackets so
-			// the cedar client receives a clean sinful-like address.
-			myAddress := strings.TrimSpace(myAddressExpr.String())
-			myAddress = strings.Trim(myAddress, "\"")
+			// Remove surrounding angle brackets so the cedar client
+			// receives a clean sinful-like address.
+			myAddress = strings.TrimSpace(myAddress)
 			myAddress = strings.TrimPrefix(myAddress, "<")
 			myAddress = strings.TrimSuffix(myAddress, ">")
 
@@ -0,0 +1,201 @@
+package httpserver
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// serveForTest serves s on a loopback listener and returns its address
+func serveForTest(t *testing.T, s *Server) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() { _ = s.httpServer.Serve(ln) }()
+	t.Cleanup(func() { _ = s.httpServer.Close() })
+	return ln.Addr().String()
+}
+
+// expectDisconnect waits for the server to close conn, failing if it takes longer than within
+func expectDisconnect(t *testing.T, conn net.Conn, within time.Duration) {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(within))
+	_, err := io.ReadAll(conn)
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		t.Fatalf("Server did not close the connection within %v", within)
+	}
+}
+
+func TestReadHeaderTimeoutDisconnectsSlowClient(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	s, err := NewServer(Config{
+		ListenAddr:        "127.0.0.1:0",
+		ScheddAddr:        "127.0.0.1:9618",
+		ReadTimeout:       time.Minute,
+		ReadHeaderTimeout: 200 * time.Millisecond,
+		Logger:            logger,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	if s.httpServer.MaxHeaderBytes != 64<<10 {
+		t.Errorf("Expected default MaxHeaderBytes of 64 KiB, got %d", s.httpServer.MaxHeaderBytes)
+	}
+	addr := serveForTest(t, s)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Send a partial request and then stall, as a slowloris client would
+	if _, err := conn.Write([]byte("GET /healthz HTTP/1.1\r\nHost: localhost\r\nX-Slow: ")); err != nil {
+		t.Fatalf("Failed to write partial request: %v", err)
+	}
+
+	// Disconnected after the header timeout rather than the (much longer) read timeout
+	expectDisconnect(t, conn, 5*time.Second)
+}
+
+func TestTransferBodyFirstByteDeadline(t *testing.T) {
+	s := &Server{transferTimeout: 200 * time.Millisecond}
+
+	readErr := make(chan error, 1)
+	s.httpServer = &http.Server{
+		ReadHeaderTimeout: time.Minute,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := io.ReadAll(s.transferBody(w, r))
+			readErr <- err
+		}),
+	}
+	addr := serveForTest(t, s)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Complete headers promise a body that never arrives
+	w := bufio.NewWriter(conn)
+	_, _ = w.WriteString("PUT /api/v1/jobs/1.0/input HTTP/1.1\r\nHost: localhost\r\nContent-Length: 1024\r\n\r\n")
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Failed to write request headers: %v", err)
+	}
+
+	select {
+	case err := <-readErr:
+		if err == nil {
+			t.Error("Expected the stalled body read to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stalled transfer body was not cut off by the first-byte deadline")
+	}
+}
+
+func TestUserHeaderSigningKey(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "POOL")
+	if err := os.WriteFile(keyPath, []byte("first signing key"), 0600); err != nil {
+		t.Fatalf("Failed to write signing key: %v", err)
+	}
+
+	s, err := NewServer(Config{
+		ListenAddr:     "127.0.0.1:0",
+		ScheddName:     "test_schedd",
+		ScheddAddr:     "127.0.0.1:9618",
+		UserHeader:     "X-Remote-User",
+		TrustDomain:    "test.domain",
+		UIDDomain:      "test.domain",
+		SigningKeyPath: keyPath,
+		Logger:         logger,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	defer func() { _ = s.Shutdown(context.Background()) }()
+
+	authenticate := func(header, value string) (string, error) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs", nil)
+		req.Header.Set(header, value)
+		ctx, err := s.createAuthenticatedContext(req)
+		if err != nil {
+			return "", err
+		}
+		token, _ := GetTokenFromContext(ctx)
+		return token, nil
+	}
+
+	// A token is minted for the user named by the header
+	token, err := authenticate("X-Remote-User", "alice")
+	if err != nil {
+		t.Fatalf("Failed to authenticate user header: %v", err)
+	}
+	claims, err := s.signingKeys.VerifyToken(token)
+	if err != nil {
+		t.Fatalf("Minted token does not verify: %v", err)
+	}
+	if claims.Subject != "alice@test.domain" || claims.Issuer != "test.domain" {
+		t.Errorf("Expected alice@test.domain issued by test.domain, got %s issued by %s", claims.Subject, claims.Issuer)
+	}
+
+	// A bearer token naming the server's key must be signed with it
+	forgedPath := filepath.Join(t.TempDir(), "POOL")
+	if err := os.WriteFile(forgedPath, []byte("some other key"), 0600); err != nil {
+		t.Fatalf("Failed to write signing key: %v", err)
+	}
+	now := time.Now().Unix()
+	forged, err := htcondor.NewSigningKeyWatcher(forgedPath, 0).GenerateToken("bob@test.domain", "test.domain", now, now+60, nil)
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+	if _, err := authenticate("Authorization", "Bearer "+forged); err == nil {
+		t.Error("Expected a token signed with another key to be rejected")
+	}
+
+	// Tokens minted before a rotation keep working during the window
+	if err := os.WriteFile(keyPath, []byte("second signing key"), 0600); err != nil {
+		t.Fatalf("Failed to rotate signing key: %v", err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(keyPath, later, later); err != nil {
+		t.Fatalf("Failed to set signing key mtime: %v", err)
+	}
+	if changed, err := s.signingKeys.Reload(); err != nil || !changed {
+		t.Fatalf("Expected the rotated key to load, got changed=%v err=%v", changed, err)
+	}
+	if _, err := authenticate("Authorization", "Bearer "+token); err != nil {
+		t.Errorf("Expected a token signed with the previous key to be accepted: %v", err)
+	}
+	rotated, err := authenticate("X-Remote-User", "alice")
+	if err != nil {
+		t.Fatalf("Failed to authenticate user header after rotation: %v", err)
+	}
+	if rotated == token {
+		t.Error("Expected a new token after rotation")
+	}
+	if _, err := authenticate("Authorization", "Bearer "+rotated); err != nil {
+		t.Errorf("Expected a token signed with the new key to be accepted: %v", err)
+	}
+}
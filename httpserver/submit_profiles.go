@@ -0,0 +1,84 @@
+package httpserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// enforcedProfilePrefix marks a submit profile command the user's submit file
+// cannot override
+const enforcedProfilePrefix = "!"
+
+// submitProfile is a named set of submit commands from Config.SubmitProfiles
+type submitProfile struct {
+	// defaults are placed ahead of the user's submit file, which may
+	// override them
+	defaults []string
+	// enforced are placed before every queue statement, overriding the
+	// user's submit file and appended commands
+	enforced []string
+}
+
+// newSubmitProfiles validates the profiles in cfg and converts them into
+// submit commands, sorted by command name
+func newSubmitProfiles(cfg map[string]map[string]string) (map[string]*submitProfile, error) {
+	if len(cfg) == 0 {
+		return nil, nil
+	}
+	profiles := make(map[string]*submitProfile, len(cfg))
+	for name, commands := range cfg {
+		if name == "" {
+			return nil, fmt.Errorf("submit profile name must not be empty")
+		}
+		keys := make([]string, 0, len(commands))
+		for key := range commands {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		profile := &submitProfile{}
+		for _, key := range keys {
+			value := commands[key]
+			command, enforced := strings.CutPrefix(key, enforcedProfilePrefix)
+			command = strings.TrimSpace(command)
+			if command == "" || strings.ContainsAny(command, "=\n \t") {
+				return nil, fmt.Errorf("submit profile %q: invalid command name %q", name, key)
+			}
+			if strings.Contains(value, "\n") {
+				return nil, fmt.Errorf("submit profile %q: value of %s must be a single line", name, command)
+			}
+			line := command + " = " + value
+			if enforced {
+				profile.enforced = append(profile.enforced, line)
+			} else {
+				profile.defaults = append(profile.defaults, line)
+			}
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
+
+// submitProfile returns the named submit profile, or nil if there is none
+func (s *Server) submitProfile(name string) *submitProfile {
+	profiles := s.submitProfiles.Load()
+	if profiles == nil {
+		return nil
+	}
+	return (*profiles)[name]
+}
+
+// apply returns submitFile with the profile's commands added: defaults at
+// the top, the caller's appended commands before the first queue statement
+// and enforced commands, after those, before every queue statement
+func (p *submitProfile) apply(submitFile string, appended []string) string {
+	var prefix string
+	if len(p.defaults) > 0 {
+		prefix = strings.Join(p.defaults, "\n") + "\n"
+	}
+	submitFile = htcondor.AppendSubmitCommands(prefix+submitFile, appended)
+	return htcondor.EnforceSubmitCommands(submitFile, p.enforced)
+}
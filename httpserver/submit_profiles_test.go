@@ -0,0 +1,142 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// newProfileTestServer returns a server with the given submit profiles and
+// an executable allowlist, whose schedd is unreachable
+func newProfileTestServer(t *testing.T, profiles map[string]map[string]string) *Server {
+	t.Helper()
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	s := &Server{
+		logger:     logger,
+		tokenCache: NewTokenCache(),
+		schedd:     htcondor.NewSchedd("unreachable", "127.0.0.1:1"),
+	}
+	if err := s.applyMutableConfig(Config{AllowedExecutables: []string{"/usr/bin/*"}, SubmitProfiles: profiles}); err != nil {
+		t.Fatalf("Failed to apply config: %v", err)
+	}
+	return s
+}
+
+// TestSubmitProfileApplied verifies a profile's commands reach the job ad:
+// defaults unless the submit file sets them, enforced commands always
+func TestSubmitProfileApplied(t *testing.T) {
+	s := newProfileTestServer(t, map[string]map[string]string{
+		"gpu-large": {
+			"request_cpus":      "8",
+			"request_memory":    "32768",
+			"+SiteProfile":      `"gpu-large"`,
+			"!accounting_group": "gpu",
+		},
+	})
+	profile := s.submitProfile("gpu-large")
+	if profile == nil {
+		t.Fatal("Expected profile gpu-large")
+	}
+
+	submit := "executable = /usr/bin/train\nrequest_memory = 65536\naccounting_group = cpu\nqueue\n"
+	submitFile := profile.apply(submit, []string{"accounting_group = physics"})
+
+	sf, err := htcondor.ParseSubmitFile(strings.NewReader(submitFile))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file:\n%s\nerror: %v", submitFile, err)
+	}
+	ad, err := sf.MakeJobAd(htcondor.JobID{Cluster: 1, Proc: 0}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	if cpus, _ := ad.EvaluateAttrInt("RequestCpus"); cpus != 8 {
+		t.Errorf("RequestCpus = %d, want 8 from the profile", cpus)
+	}
+	if memory, _ := ad.EvaluateAttrInt("RequestMemory"); memory != 65536 {
+		t.Errorf("RequestMemory = %d, want 65536 from the submit file", memory)
+	}
+	if site, _ := ad.EvaluateAttrString("SiteProfile"); site != "gpu-large" {
+		t.Errorf("SiteProfile = %q, want gpu-large", site)
+	}
+	if group, _ := ad.EvaluateAttrString("AccountingGroup"); group != "gpu" {
+		t.Errorf("AccountingGroup = %q, want enforced gpu", group)
+	}
+
+	// Enforced commands hold for every queue statement, not just the first
+	submit = "executable = /usr/bin/train\nqueue\naccounting_group = cpu\nqueue 2\n"
+	sf, err = htcondor.ParseSubmitFile(strings.NewReader(profile.apply(submit, nil)))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	for i, ad := range result.ProcAds {
+		if group, _ := ad.EvaluateAttrString("AccountingGroup"); group != "gpu" {
+			t.Errorf("Proc %d: AccountingGroup = %q, want enforced gpu", i, group)
+		}
+	}
+}
+
+// TestSubmitProfileRequests checks the handler applies the selected profile
+// before the executable policy and rejects unknown profiles
+func TestSubmitProfileRequests(t *testing.T) {
+	s := newProfileTestServer(t, map[string]map[string]string{
+		"quick-test": {"executable": "/usr/bin/true", "transfer_executable": "false"},
+		"locked":     {"!executable": "/bin/sh", "!transfer_executable": "false"},
+	})
+	token := createTestJWTToken(3600)
+
+	tests := []struct {
+		name       string
+		req        JobSubmitRequest
+		wantStatus int
+		wantCode   string
+	}{
+		// Accepted by the policy; nothing listens on the schedd port
+		{"profile default", JobSubmitRequest{SubmitFile: "queue\n", Profile: "quick-test"}, http.StatusServiceUnavailable, ErrCodeScheddUnreachable},
+		{"submit file overrides default", JobSubmitRequest{SubmitFile: "executable = /bin/sh\nqueue\n", Profile: "quick-test"}, http.StatusForbidden, ErrCodeExecutableNotAllowed},
+		{"enforced over append", JobSubmitRequest{SubmitFile: "queue\n", Append: []string{"executable = /usr/bin/true"}, Profile: "locked"}, http.StatusForbidden, ErrCodeExecutableNotAllowed},
+		{"unknown profile", JobSubmitRequest{SubmitFile: "queue\n", Profile: "missing"}, http.StatusBadRequest, ErrCodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatalf("Failed to marshal request: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/jobs", strings.NewReader(string(body)))
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+
+			s.handleSubmitJob(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			assertErrorCode(t, w, tt.wantCode)
+		})
+	}
+}
+
+// TestNewServerRejectsBadSubmitProfile verifies profiles are validated at startup
+func TestNewServerRejectsBadSubmitProfile(t *testing.T) {
+	_, err := NewServer(Config{
+		ScheddAddr:     "127.0.0.1:9618",
+		SubmitProfiles: map[string]map[string]string{"bad": {"request memory": "1024"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid command name") {
+		t.Errorf("Expected invalid command name error, got %v", err)
+	}
+}
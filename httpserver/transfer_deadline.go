@@ -0,0 +1,46 @@
+package httpserver
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// deadlineReader pushes the connection read deadline forward before each read
+// of a transfer request body. The client must send the first byte within the
+// timeout and may never stall longer than that, while a steadily progressing
+// upload is not cut off by the server-wide ReadTimeout.
+type deadlineReader struct {
+	r       io.Reader
+	rc      *http.ResponseController
+	timeout time.Duration
+}
+
+func (d *deadlineReader) Read(p []byte) (int, error) {
+	// Not all ResponseWriters support deadlines (e.g. in tests); ignore if unsupported
+	_ = d.rc.SetReadDeadline(time.Now().Add(d.timeout))
+	return d.r.Read(p)
+}
+
+// deadlineWriter is the write-side counterpart of deadlineReader for transfer
+// responses, so a client that stops reading a download is disconnected
+type deadlineWriter struct {
+	w       io.Writer
+	rc      *http.ResponseController
+	timeout time.Duration
+}
+
+func (d *deadlineWriter) Write(p []byte) (int, error) {
+	_ = d.rc.SetWriteDeadline(time.Now().Add(d.timeout))
+	return d.w.Write(p)
+}
+
+// transferBody wraps a transfer request body with the server's transfer deadline
+func (s *Server) transferBody(w http.ResponseWriter, r *http.Request) io.Reader {
+	return &deadlineReader{r: r.Body, rc: http.NewResponseController(w), timeout: s.transferTimeout}
+}
+
+// transferWriter wraps a transfer response with the server's transfer deadline
+func (s *Server) transferWriter(w http.ResponseWriter) io.Writer {
+	return &deadlineWriter{w: w, rc: http.NewResponseController(w), timeout: s.transferTimeout}
+}
@@ -0,0 +1,235 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	htcondor "github.com/bbockelm/golang-htcondor"
+)
+
+// Directions of a sandbox transfer
+const (
+	TransferDirectionInput  = "input"  // Input files uploaded to the schedd
+	TransferDirectionOutput = "output" // Output files downloaded from the schedd
+)
+
+// errTransferNotFound is returned when cancelling a transfer that is not in
+// progress, or that belongs to another user
+var errTransferNotFound = errors.New("transfer not found")
+
+// TransferInfo describes a sandbox transfer in progress
+type TransferInfo struct {
+	ID        string    `json:"id"`
+	JobID     string    `json:"job_id"`
+	User      string    `json:"user"`
+	Direction string    `json:"direction"`
+	Bytes     int64     `json:"bytes"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// TransfersResponse lists sandbox transfers in progress
+type TransfersResponse struct {
+	Transfers []TransferInfo `json:"transfers"`
+}
+
+// activeTransfer is a transfer in the registry. Its reader and writer count
+// the bytes moved and fail once the transfer is cancelled.
+type activeTransfer struct {
+	info   TransferInfo
+	bytes  atomic.Int64
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   func()
+}
+
+// reader counts the bytes read from r
+func (t *activeTransfer) reader(r io.Reader) io.Reader {
+	return &countingReader{r: r, t: t}
+}
+
+// writer counts the bytes written to w
+func (t *activeTransfer) writer(w io.Writer) io.Writer {
+	return &countingWriter{w: w, t: t}
+}
+
+// cancelled reports whether the transfer was cancelled through the registry
+func (t *activeTransfer) cancelled() bool {
+	return errors.Is(context.Cause(t.ctx), errTransferCancelled)
+}
+
+// errTransferCancelled is the cause of a transfer's context once cancelled
+var errTransferCancelled = errors.New("transfer cancelled")
+
+type countingReader struct {
+	r io.Reader
+	t *activeTransfer
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	if err := c.t.ctx.Err(); err != nil {
+		return 0, context.Cause(c.t.ctx)
+	}
+	n, err := c.r.Read(p)
+	c.t.bytes.Add(int64(n))
+	return n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	t *activeTransfer
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if err := c.t.ctx.Err(); err != nil {
+		return 0, context.Cause(c.t.ctx)
+	}
+	n, err := c.w.Write(p)
+	c.t.bytes.Add(int64(n))
+	return n, err
+}
+
+// transferRegistry tracks the server's sandbox transfers in progress, so
+// users can list and cancel them. The zero value is ready to use.
+type transferRegistry struct {
+	mu        sync.Mutex
+	transfers map[string]*activeTransfer
+}
+
+// start registers a transfer for the job and returns it; the transfer must
+// run under its ctx and be ended with its done func
+func (reg *transferRegistry) start(ctx context.Context, user, jobID, direction string) *activeTransfer {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	ctx, cancel := context.WithCancelCause(ctx)
+	t := &activeTransfer{
+		info: TransferInfo{
+			ID:        hex.EncodeToString(id[:]),
+			JobID:     jobID,
+			User:      user,
+			Direction: direction,
+			StartTime: time.Now(),
+		},
+		ctx:    ctx,
+		cancel: func() { cancel(errTransferCancelled) },
+	}
+	t.done = func() {
+		reg.mu.Lock()
+		delete(reg.transfers, t.info.ID)
+		reg.mu.Unlock()
+		cancel(nil)
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if reg.transfers == nil {
+		reg.transfers = make(map[string]*activeTransfer)
+	}
+	reg.transfers[t.info.ID] = t
+	return t
+}
+
+// list returns the transfers of user, or of every user if all is set,
+// oldest first
+func (reg *transferRegistry) list(user string, all bool) []TransferInfo {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	infos := []TransferInfo{}
+	for _, t := range reg.transfers {
+		if all || t.info.User == user {
+			info := t.info
+			info.Bytes = t.bytes.Load()
+			infos = append(infos, info)
+		}
+	}
+	slices.SortFunc(infos, func(a, b TransferInfo) int {
+		if c := a.StartTime.Compare(b.StartTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return infos
+}
+
+// cancel cancels the transfer with the given ID if it belongs to user, or
+// to anyone if all is set
+func (reg *transferRegistry) cancel(id, user string, all bool) error {
+	reg.mu.Lock()
+	t, ok := reg.transfers[id]
+	reg.mu.Unlock()
+	if !ok || (!all && t.info.User != user) {
+		return errTransferNotFound
+	}
+	t.cancel()
+	return nil
+}
+
+// isAdmin reports whether user may see and cancel every user's transfers
+func (s *Server) isAdmin(user string) bool {
+	return user != "" && s.adminUsers[user]
+}
+
+// handleTransfers handles GET /api/v1/transfers, which lists the sandbox
+// transfers in progress of the authenticated user, or of every user for an
+// admin
+func (s *Server) handleTransfers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, user, ok := s.transferUser(w, r)
+	if !ok || !s.authorize(ctx, w, r, ActionListTransfers, Resource{}) {
+		return
+	}
+	s.writeJSON(w, http.StatusOK, TransfersResponse{Transfers: s.transfers.list(user, s.isAdmin(user))})
+}
+
+// handleTransferByID handles DELETE /api/v1/transfers/{id}, which cancels a
+// transfer in progress
+func (s *Server) handleTransferByID(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/v1/transfers/")
+	if id == "" || strings.Contains(id, "/") {
+		s.writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+	if r.Method != http.MethodDelete {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, user, ok := s.transferUser(w, r)
+	if !ok || !s.authorize(ctx, w, r, ActionCancelTransfer, Resource{TransferID: id}) {
+		return
+	}
+	if err := s.transfers.cancel(id, user, s.isAdmin(user)); err != nil {
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeNotFound, "Transfer not found", nil)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// transferUser authenticates the request and returns its context and user,
+// writing the error response and returning false if there is none
+func (s *Server) transferUser(w http.ResponseWriter, r *http.Request) (context.Context, string, bool) {
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return nil, "", false
+	}
+	user := htcondor.GetAuthenticatedUserFromContext(ctx)
+	if user == "" {
+		s.writeError(w, http.StatusUnauthorized, "Authentication failed: no user")
+		return nil, "", false
+	}
+	return ctx, user, true
+}
@@ -0,0 +1,99 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListAndCancelTransfer lists an upload in progress and cancels it,
+// checking another user can neither see nor cancel it unless an admin
+func TestListAndCancelTransfer(t *testing.T) {
+	s := newErrorTestServer(t)
+	token := createTestJWTToken(3600) // alice@test.domain
+
+	// An upload of alice's, with 5 bytes read so far, and one of bob's
+	pr, pw := io.Pipe()
+	transfer := s.transfers.start(context.Background(), "alice@test.domain", "12.0", TransferDirectionInput)
+	defer transfer.done()
+	reader := transfer.reader(pr)
+	go func() { _, _ = pw.Write([]byte("hello")) }()
+	if _, err := io.ReadFull(reader, make([]byte, 5)); err != nil {
+		t.Fatalf("Failed to read: %v", err)
+	}
+	other := s.transfers.start(context.Background(), "bob@test.domain", "13.0", TransferDirectionOutput)
+	defer other.done()
+
+	list := func() []TransferInfo {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/transfers", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleTransfers(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		var resp TransfersResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp.Transfers
+	}
+	cancel := func(id string) int {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/transfers/"+id, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.handleTransferByID(w, req)
+		return w.Code
+	}
+
+	transfers := list()
+	if len(transfers) != 1 {
+		t.Fatalf("Expected only alice's transfer, got %+v", transfers)
+	}
+	got := transfers[0]
+	if got.ID != transfer.info.ID || got.JobID != "12.0" || got.User != "alice@test.domain" ||
+		got.Direction != TransferDirectionInput || got.Bytes != 5 || got.StartTime.IsZero() {
+		t.Errorf("Unexpected transfer: %+v", got)
+	}
+
+	if code := cancel(other.info.ID); code != http.StatusNotFound {
+		t.Errorf("Expected 404 cancelling another user's transfer, got %d", code)
+	}
+	if other.ctx.Err() != nil {
+		t.Error("Another user's transfer was cancelled")
+	}
+
+	if code := cancel(transfer.info.ID); code != http.StatusNoContent {
+		t.Fatalf("Expected 204 cancelling the transfer, got %d", code)
+	}
+	if !transfer.cancelled() {
+		t.Error("Expected the transfer's context to be cancelled")
+	}
+	if _, err := reader.Read(make([]byte, 5)); !errors.Is(err, errTransferCancelled) {
+		t.Errorf("Expected reads to fail once cancelled, got %v", err)
+	}
+
+	// The transfer stays listed until its handler ends it
+	transfer.done()
+	if transfers := list(); len(transfers) != 0 {
+		t.Errorf("Expected no transfers once done, got %+v", transfers)
+	}
+	if code := cancel(transfer.info.ID); code != http.StatusNotFound {
+		t.Errorf("Expected 404 cancelling a finished transfer, got %d", code)
+	}
+
+	// An admin sees and may cancel every user's transfers
+	s.adminUsers = map[string]bool{"alice@test.domain": true}
+	if transfers := list(); len(transfers) != 1 || transfers[0].User != "bob@test.domain" {
+		t.Errorf("Expected the admin to see bob's transfer, got %+v", transfers)
+	}
+	if code := cancel(other.info.ID); code != http.StatusNoContent {
+		t.Errorf("Expected 204 for an admin cancelling another user's transfer, got %d", code)
+	}
+}
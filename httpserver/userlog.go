@@ -0,0 +1,240 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// errUserLogNotAllowed is returned by openUserLog for a log the server will
+// not read for the job
+var errUserLogNotAllowed = errors.New("user log not allowed")
+
+// userLogPollInterval is how often a followed user log is checked for new
+// events
+var userLogPollInterval = htcondor.DefaultUserLogPollInterval
+
+// JobEventResponse represents one event from a job's user log
+type JobEventResponse struct {
+	Type    int      `json:"type"`
+	Name    string   `json:"name"`
+	JobID   string   `json:"job_id"`
+	Time    string   `json:"time"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
+}
+
+// newJobEventResponse converts a user log event to its response
+func newJobEventResponse(event *htcondor.JobEvent) JobEventResponse {
+	return JobEventResponse{
+		Type:    int(event.Type),
+		Name:    event.Type.String(),
+		JobID:   fmt.Sprintf("%d.%d", event.JobID.Cluster, event.JobID.Proc),
+		Time:    event.Time.Format(time.RFC3339),
+		Message: event.Message,
+		Details: event.Details,
+	}
+}
+
+// handleJobUserLog handles GET /api/v1/jobs/{id}/userlog, which streams the
+// job's events from its user log as NDJSON, or as server-sent events when
+// the client accepts text/event-stream or passes format=sse. With
+// follow=true the stream stays open, like condor_watch_q, until the job
+// terminates or is removed; otherwise it ends with the events logged so far.
+//
+// The log is read from the file named by the job's UserLog (relative to its
+// Iwd), so this needs the server to run on the schedd's host; for spooled
+// jobs that is where the log is written. The log must be a regular file
+// owned by the job's owner, and only well-formed events of the job itself
+// are sent.
+func (s *Server) handleJobUserLog(w http.ResponseWriter, r *http.Request, jobID string) {
+	if r.Method != http.MethodGet {
+		s.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	ctx, err := s.createAuthenticatedContext(r)
+	if err != nil {
+		s.writeError(w, http.StatusUnauthorized, fmt.Sprintf("Authentication failed: %v", err))
+		return
+	}
+
+	schedd, ok := s.scheddForRequest(w, r)
+	if !ok {
+		return
+	}
+
+	cluster, proc, err := parseJobID(jobID)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid job ID: %v", err))
+		return
+	}
+
+	follow := false
+	if followStr := r.URL.Query().Get("follow"); followStr != "" {
+		if follow, err = strconv.ParseBool(followStr); err != nil {
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid follow value %q", followStr))
+			return
+		}
+	}
+	sse := r.URL.Query().Get("format") == "sse" || strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", cluster, proc)
+	if !s.authorize(ctx, w, r, ActionReadUserLog, Resource{JobID: jobID, Constraint: constraint}) {
+		return
+	}
+
+	jobAds, err := s.queryJobs(ctx, schedd, constraint, []string{"UserLog", "Iwd", "Owner"})
+	if err != nil {
+		s.writeBackendError(w, err, http.StatusInternalServerError, ErrCodeInternal, "Query failed")
+		return
+	}
+	if len(jobAds) == 0 {
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeJobNotFound, "Job not found", nil)
+		return
+	}
+	path := jobUserLogPath(jobAds[0])
+	if path == "" {
+		s.writeErrorCode(w, http.StatusNotFound, ErrCodeNotFound, "Job has no user log", nil)
+		return
+	}
+
+	owner, _ := jobAds[0].EvaluateAttrString("Owner")
+	open := func(path string) (*os.File, error) { return openUserLog(path, owner) }
+
+	// Fail before the stream starts if the log cannot be read; a log that
+	// does not exist yet has no events so far
+	file, err := open(path)
+	if errors.Is(err, errUserLogNotAllowed) {
+		s.logger.Warn(logging.DestinationHTTP, "Refusing to read user log", "job_id", jobID, "error", err)
+		s.writeErrorCode(w, http.StatusForbidden, ErrCodeForbidden, "The job's user log cannot be read", nil)
+		return
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.logger.Error(logging.DestinationHTTP, "Failed to open user log", "job_id", jobID, "path", path, "error", err)
+		s.writeErrorCode(w, http.StatusInternalServerError, ErrCodeInternal, "Failed to open the job's user log", nil)
+		return
+	}
+	if file != nil && follow {
+		_ = file.Close()
+	}
+
+	if sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	stream := &jobEventStream{w: s.transferWriter(w), rc: http.NewResponseController(w), sse: sse}
+	_ = stream.rc.Flush()
+
+	job := htcondor.JobID{Cluster: cluster, Proc: proc}
+	if follow {
+		err = s.followJobUserLog(ctx, path, open, job, stream)
+	} else if file != nil {
+		defer func() { _ = file.Close() }()
+		err = readJobUserLog(file, job, stream)
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// The stream has started, so the error can only be logged
+		s.logger.Error(logging.DestinationHTTP, "Error streaming user log", "job_id", jobID, "error", err)
+	}
+}
+
+// followJobUserLog streams job's events from the log at path, opened with
+// open, until its final event or the client goes away
+func (s *Server) followJobUserLog(ctx context.Context, path string, open func(string) (*os.File, error),
+	job htcondor.JobID, stream *jobEventStream) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, errCh := htcondor.FollowUserLog(ctx, path, &htcondor.FollowUserLogOptions{
+		PollInterval: userLogPollInterval,
+		Job:          &job,
+		Open:         open,
+	})
+	for event := range events {
+		if err := stream.send(event); err != nil {
+			cancel()
+			<-errCh
+			return err
+		}
+	}
+	return <-errCh
+}
+
+// readJobUserLog streams job's events logged so far
+func readJobUserLog(r io.Reader, job htcondor.JobID, stream *jobEventStream) error {
+	reader := htcondor.NewUserLogReader(r)
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if event.JobID != job {
+			continue
+		}
+		if err := stream.send(event); err != nil {
+			return err
+		}
+	}
+}
+
+// jobEventStream writes events as NDJSON lines or server-sent events,
+// flushing each so the client sees it as soon as it is logged
+type jobEventStream struct {
+	w   io.Writer
+	rc  *http.ResponseController
+	sse bool
+}
+
+func (s *jobEventStream) send(event *htcondor.JobEvent) error {
+	data, err := json.Marshal(newJobEventResponse(event))
+	if err != nil {
+		return err
+	}
+	if s.sse {
+		_, err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event.Type, data)
+	} else {
+		_, err = fmt.Fprintf(s.w, "%s\n", data)
+	}
+	if err != nil {
+		return err
+	}
+	// Not all ResponseWriters support flushing; the event is then sent with the response
+	_ = s.rc.Flush()
+	return nil
+}
+
+// jobUserLogPath returns the path of the job's user log, or "" if it has none
+func jobUserLogPath(ad *classad.ClassAd) string {
+	userLog, ok := ad.EvaluateAttrString("UserLog")
+	if !ok || userLog == "" {
+		return ""
+	}
+	if filepath.IsAbs(userLog) {
+		return filepath.Clean(userLog)
+	}
+	iwd, ok := ad.EvaluateAttrString("Iwd")
+	if !ok || !filepath.IsAbs(iwd) {
+		return ""
+	}
+	return filepath.Join(iwd, userLog)
+}
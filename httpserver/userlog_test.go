@@ -0,0 +1,180 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newUserLogTestServer returns a server whose job 7.0 logs to the returned path
+func newUserLogTestServer(t *testing.T) (*Server, string) {
+	t.Helper()
+	logPath := filepath.Join(t.TempDir(), "job.log")
+	return newUserLogTestServerWithLog(t, logPath, currentUsername(t)), logPath
+}
+
+// newUserLogTestServerWithLog returns a server whose job 7.0 of owner logs
+// to logPath
+func newUserLogTestServerWithLog(t *testing.T, logPath, owner string) *Server {
+	t.Helper()
+	condorQ := writeFakeCondorQOutput(t, `[{"ClusterId": 7, "ProcId": 0, "Owner": "`+owner+`", "UserLog": "`+logPath+`"}]`)
+	s := newFallbackTestServer(t, errTestAuthFailed, true, condorQ)
+	s.tokenCache = NewTokenCache()
+	return s
+}
+
+// currentUsername returns the name of the user running the test, who owns
+// the logs it writes
+func currentUsername(t *testing.T) string {
+	t.Helper()
+	u, err := user.Current()
+	if err != nil {
+		t.Skipf("Cannot look up the current user: %v", err)
+	}
+	return u.Username
+}
+
+func appendUserLog(t *testing.T, path, data string) {
+	t.Helper()
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Errorf("Failed to open user log: %v", err)
+		return
+	}
+	defer func() { _ = file.Close() }()
+	if _, err := file.WriteString(data); err != nil {
+		t.Errorf("Failed to write user log: %v", err)
+	}
+}
+
+func TestJobUserLogFollow(t *testing.T) {
+	s, logPath := newUserLogTestServer(t)
+	defer func(interval time.Duration) { userLogPollInterval = interval }(userLogPollInterval)
+	userLogPollInterval = 10 * time.Millisecond
+
+	// The job runs while the request follows its log
+	go func() {
+		for _, event := range []string{
+			"000 (007.000.000) 2024-03-05 10:11:12 Job submitted from host: <127.0.0.1:9618>\n...\n",
+			"001 (007.000.000) 2024-03-05 10:11:15 Job executing on host: <127.0.0.1:9619>\n...\n",
+			"001 (008.000.000) 2024-03-05 10:11:16 Job executing on host: <127.0.0.1:9619>\n...\n",
+			"005 (007.000.000) 2024-03-05 10:11:20 Job terminated.\n\t(1) Normal termination (return value 0)\n...\n",
+		} {
+			time.Sleep(20 * time.Millisecond)
+			appendUserLog(t, logPath, event)
+		}
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/7.0/userlog?follow=true", nil)
+	req.Header.Set("Authorization", "Bearer "+createTestJWTTokenFor(currentUsername(t)+"@test.domain", 3600))
+	w := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		s.handleJobByID(w, req)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Follow did not end after the job terminated")
+	}
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	var names []string
+	for _, line := range strings.Split(strings.TrimSpace(w.Body.String()), "\n") {
+		var event JobEventResponse
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("Invalid event line %q: %v", line, err)
+		}
+		if event.JobID != "7.0" {
+			t.Errorf("Got an event of job %s", event.JobID)
+		}
+		names = append(names, event.Name)
+	}
+	if got := strings.Join(names, ","); got != "SUBMIT,EXECUTE,JOB_TERMINATED" {
+		t.Errorf("Got events %s, expected SUBMIT,EXECUTE,JOB_TERMINATED", got)
+	}
+}
+
+func TestJobUserLogSnapshot(t *testing.T) {
+	s, logPath := newUserLogTestServer(t)
+	appendUserLog(t, logPath, "000 (007.000.000) 2024-03-05 10:11:12 Job submitted from host: <127.0.0.1:9618>\n...\n")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/7.0/userlog", nil)
+	req.Header.Set("Authorization", "Bearer "+createTestJWTTokenFor(currentUsername(t)+"@test.domain", 3600))
+	req.Header.Set("Accept", "text/event-stream")
+	w := httptest.NewRecorder()
+	s.handleJobByID(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.HasPrefix(w.Body.String(), "event: SUBMIT\ndata: {") {
+		t.Errorf("Expected a SUBMIT server-sent event, got %q", w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/jobs/7.0/userlog?follow=maybe", nil)
+	req.Header.Set("Authorization", "Bearer "+createTestJWTTokenFor(currentUsername(t)+"@test.domain", 3600))
+	w = httptest.NewRecorder()
+	s.handleJobByID(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an invalid follow, got %d", w.Code)
+	}
+}
+
+func TestJobUserLogRejectsOtherFiles(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Log owners are not checked on Windows")
+	}
+	dir := t.TempDir()
+	target := filepath.Join(dir, "secret")
+	appendUserLog(t, target, "000 (007.000.000) 2024-03-05 10:11:12 Job submitted from host: <127.0.0.1:9618>\n...\n")
+	link := filepath.Join(dir, "link.log")
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+	owner := currentUsername(t)
+	other := "root"
+	if owner == other {
+		other = "nobody"
+	}
+
+	tests := []struct {
+		name    string
+		logPath string
+		owner   string
+	}{
+		{"symlink", link, owner},
+		{"directory", dir, owner},
+		{"other owner", target, other},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newUserLogTestServerWithLog(t, tt.logPath, tt.owner)
+			for _, query := range []string{"", "?follow=true"} {
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/7.0/userlog"+query, nil)
+				req.Header.Set("Authorization", "Bearer "+createTestJWTTokenFor(tt.owner+"@test.domain", 3600))
+				w := httptest.NewRecorder()
+				s.handleJobByID(w, req)
+				if w.Code != http.StatusForbidden {
+					t.Errorf("%q: expected status 403, got %d: %s", query, w.Code, w.Body.String())
+				}
+				if strings.Contains(w.Body.String(), "Job submitted") {
+					t.Errorf("%q: the file's contents were sent: %s", query, w.Body.String())
+				}
+			}
+		})
+	}
+}
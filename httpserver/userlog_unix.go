@@ -0,0 +1,53 @@
+//go:build unix
+
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// openUserLog opens the user log at path of a job of owner. The log is
+// opened without following a symlink and must be a regular file owned by
+// owner, so that a job cannot name some other file, or a FIFO or device, for
+// the server to read.
+func openUserLog(path, owner string) (*os.File, error) {
+	u, err := user.Lookup(owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown owner %q: %w", errUserLogNotAllowed, owner, err)
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid uid of %q: %w", errUserLogNotAllowed, owner, err)
+	}
+
+	// O_NONBLOCK keeps opening a FIFO from waiting for a writer; it has no
+	// effect on a regular file
+	file, err := os.OpenFile(path, os.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK, 0) //nolint:gosec // Checked below
+	if err != nil {
+		if errors.Is(err, unix.ELOOP) {
+			return nil, fmt.Errorf("%w: %s is a symlink", errUserLogNotAllowed, path)
+		}
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w: %s is not a regular file", errUserLogNotAllowed, path)
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); !ok || uint64(stat.Uid) != uid {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w: %s is not owned by %s", errUserLogNotAllowed, path, owner)
+	}
+	return file, nil
+}
@@ -0,0 +1,157 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// API response schema versions.
+//
+// The version in the URL path (/api/v1) identifies the set of endpoints; the
+// response schema version identifies the shape of the JSON they return, so
+// response formats can evolve without breaking existing clients.
+const (
+	// APIVersion1 is the original response schema
+	APIVersion1 = 1
+
+	// DefaultAPIVersion is served when the client does not request a version
+	DefaultAPIVersion = APIVersion1
+
+	// LatestAPIVersion is the newest response schema the server can produce
+	LatestAPIVersion = APIVersion1
+)
+
+// APIVersionHeader is the request header clients may use to select a response schema version
+const APIVersionHeader = "Accept-Version"
+
+// apiVersionResponseHeader reports the response schema version that was served
+const apiVersionResponseHeader = "API-Version"
+
+// vendorMediaTypePrefix and vendorMediaTypeSuffix bracket the version number in
+// media types of the form application/vnd.htcondor.v1+json
+const (
+	vendorMediaTypePrefix = "application/vnd.htcondor.v"
+	vendorMediaTypeSuffix = "+json"
+)
+
+// apiVersionContextKey is the type for the negotiated API version context key
+type apiVersionContextKey struct{}
+
+// GetAPIVersionFromContext returns the negotiated response schema version,
+// or DefaultAPIVersion if none was negotiated
+func GetAPIVersionFromContext(ctx context.Context) int {
+	if version, ok := ctx.Value(apiVersionContextKey{}).(int); ok {
+		return version
+	}
+	return DefaultAPIVersion
+}
+
+// vendorMediaType returns the vendor media type for a response schema version
+func vendorMediaType(version int) string {
+	return fmt.Sprintf("%s%d%s", vendorMediaTypePrefix, version, vendorMediaTypeSuffix)
+}
+
+// negotiateAPIVersion selects the response schema version for a request.
+// In order of precedence it honors the api_version query parameter, the
+// Accept-Version header, and a vendor media type in the Accept header.
+// vendor reports whether the version came from a vendor media type, in which
+// case the response should use that media type as its Content-Type.
+func negotiateAPIVersion(r *http.Request) (version int, vendor bool, err error) {
+	if v := r.URL.Query().Get("api_version"); v != "" {
+		version, err = parseAPIVersion(v)
+		return version, false, err
+	}
+
+	if v := r.Header.Get(APIVersionHeader); v != "" {
+		version, err = parseAPIVersion(v)
+		return version, false, err
+	}
+
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return DefaultAPIVersion, false, nil
+	}
+
+	// Take the first vendor media type listed; generic types select the default
+	var unsupported string
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, parseErr := mime.ParseMediaType(strings.TrimSpace(part))
+		if parseErr != nil {
+			continue
+		}
+		if !strings.HasPrefix(mediaType, vendorMediaTypePrefix) || !strings.HasSuffix(mediaType, vendorMediaTypeSuffix) {
+			continue
+		}
+		v := strings.TrimSuffix(strings.TrimPrefix(mediaType, vendorMediaTypePrefix), vendorMediaTypeSuffix)
+		version, parseErr := parseAPIVersion(v)
+		if parseErr != nil {
+			if unsupported == "" {
+				unsupported = mediaType
+			}
+			continue
+		}
+		return version, true, nil
+	}
+
+	// Only reject if the client asked exclusively for versions we cannot serve
+	if unsupported != "" && !acceptsGenericJSON(accept) {
+		return 0, false, fmt.Errorf("unsupported media type %q; supported versions are 1-%d", unsupported, LatestAPIVersion)
+	}
+	return DefaultAPIVersion, false, nil
+}
+
+// parseAPIVersion parses a version number such as "1" or "v1"
+func parseAPIVersion(v string) (int, error) {
+	v = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(v)), "v")
+	version, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid API version %q", v)
+	}
+	if version < APIVersion1 || version > LatestAPIVersion {
+		return 0, fmt.Errorf("unsupported API version %d; supported versions are 1-%d", version, LatestAPIVersion)
+	}
+	return version, nil
+}
+
+// acceptsGenericJSON reports whether an Accept header allows plain JSON
+func acceptsGenericJSON(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "application/json", "application/*", "*/*":
+			return true
+		}
+	}
+	return false
+}
+
+// apiVersionMiddleware negotiates the response schema version and makes it
+// available to handlers via GetAPIVersionFromContext. Handlers that change their
+// response shape in a later version branch on the negotiated version, so one
+// handler serves every version. Unsupported versions are rejected with 406.
+func (s *Server) apiVersionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, vendor, err := negotiateAPIVersion(r)
+		w.Header().Add("Vary", "Accept, "+APIVersionHeader)
+		if err != nil {
+			s.writeError(w, http.StatusNotAcceptable, err.Error())
+			return
+		}
+
+		w.Header().Set(apiVersionResponseHeader, strconv.Itoa(version))
+		if vendor {
+			// writeJSON keeps a Content-Type set here
+			w.Header().Set("Content-Type", vendorMediaType(version))
+		}
+
+		ctx := context.WithValue(r.Context(), apiVersionContextKey{}, version)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// newVersionedTestHandler returns a handler that reports the negotiated version
+func newVersionedTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	s := &Server{logger: logger}
+	return s.apiVersionMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.writeJSON(w, http.StatusOK, map[string]int{"version": GetAPIVersionFromContext(r.Context())})
+	}))
+}
+
+func TestAPIVersionDefault(t *testing.T) {
+	handler := newVersionedTestHandler(t)
+
+	for _, accept := range []string{"", "application/json", "*/*", "text/html, application/json;q=0.9"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs", nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Accept %q: expected status 200, got %d", accept, w.Code)
+			continue
+		}
+		if got := w.Header().Get("API-Version"); got != "1" {
+			t.Errorf("Accept %q: expected API-Version 1, got %q", accept, got)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("Accept %q: expected application/json, got %q", accept, got)
+		}
+	}
+}
+
+func TestAPIVersionExplicit(t *testing.T) {
+	handler := newVersionedTestHandler(t)
+
+	tests := []struct {
+		name        string
+		target      string
+		header      string
+		value       string
+		wantStatus  int
+		wantContent string
+	}{
+		{"vendor media type", "/api/v1/jobs", "Accept", "application/vnd.htcondor.v1+json", http.StatusOK, "application/vnd.htcondor.v1+json"},
+		{"query parameter", "/api/v1/jobs?api_version=1", "", "", http.StatusOK, "application/json"},
+		{"Accept-Version header", "/api/v1/jobs", "Accept-Version", "v1", http.StatusOK, "application/json"},
+		{"unsupported query version", "/api/v1/jobs?api_version=99", "", "", http.StatusNotAcceptable, "application/json"},
+		{"unsupported Accept-Version", "/api/v1/jobs", "Accept-Version", "2", http.StatusNotAcceptable, "application/json"},
+		{"invalid version", "/api/v1/jobs?api_version=latest", "", "", http.StatusNotAcceptable, "application/json"},
+		{"unsupported vendor media type", "/api/v1/jobs", "Accept", "application/vnd.htcondor.v99+json", http.StatusNotAcceptable, "application/json"},
+		{"unsupported vendor type with JSON fallback", "/api/v1/jobs", "Accept", "application/vnd.htcondor.v99+json, application/json", http.StatusOK, "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantContent {
+				t.Errorf("Expected Content-Type %q, got %q", tt.wantContent, got)
+			}
+			if tt.wantStatus == http.StatusOK && w.Header().Get("API-Version") != "1" {
+				t.Errorf("Expected API-Version 1, got %q", w.Header().Get("API-Version"))
+			}
+		})
+	}
+}
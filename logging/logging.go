@@ -56,10 +56,13 @@ type Config struct {
 	EnabledDestinations map[Destination]bool
 }
 
-// Logger wraps slog.Logger with destination and verbosity filtering
+// Logger wraps slog.Logger with destination and verbosity filtering.
+// A nil *Logger discards every message, so optional loggers need no checks.
 type Logger struct {
 	config *Config
 	logger *slog.Logger
+	// level is the handler's minimum level, adjustable with SetVerbosity
+	level *slog.LevelVar
 }
 
 // New creates a new Logger with the given configuration
@@ -87,24 +90,12 @@ func New(config *Config) (*Logger, error) {
 		writer = f
 	}
 
-	// Convert our verbosity to slog level
-	var slogLevel slog.Level
-	switch config.MinVerbosity {
-	case VerbosityError:
-		slogLevel = slog.LevelError
-	case VerbosityWarn:
-		slogLevel = slog.LevelWarn
-	case VerbosityInfo:
-		slogLevel = slog.LevelInfo
-	case VerbosityDebug:
-		slogLevel = slog.LevelDebug
-	default:
-		slogLevel = slog.LevelInfo
-	}
+	level := new(slog.LevelVar)
+	level.Set(slogLevel(config.MinVerbosity))
 
 	// Create slog handler with options
 	opts := &slog.HandlerOptions{
-		Level: slogLevel,
+		Level: level,
 	}
 
 	handler := slog.NewTextHandler(writer, opts)
@@ -113,9 +104,46 @@ func New(config *Config) (*Logger, error) {
 	return &Logger{
 		config: config,
 		logger: logger,
+		level:  level,
 	}, nil
 }
 
+// slogLevel converts our verbosity to the slog level
+func slogLevel(verbosity Verbosity) slog.Level {
+	switch verbosity {
+	case VerbosityError:
+		return slog.LevelError
+	case VerbosityWarn:
+		return slog.LevelWarn
+	case VerbosityDebug:
+		return slog.LevelDebug
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// SetVerbosity changes the minimum verbosity of a running logger; it is
+// safe to call while other goroutines log
+func (l *Logger) SetVerbosity(verbosity Verbosity) {
+	l.level.Set(slogLevel(verbosity))
+}
+
+// ParseVerbosity parses a verbosity name as used by LOG_VERBOSITY
+// (ERROR, WARN or WARNING, INFO, DEBUG; case-insensitive)
+func ParseVerbosity(name string) (Verbosity, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "ERROR":
+		return VerbosityError, nil
+	case "WARN", "WARNING":
+		return VerbosityWarn, nil
+	case "INFO":
+		return VerbosityInfo, nil
+	case "DEBUG":
+		return VerbosityDebug, nil
+	}
+	return VerbosityInfo, fmt.Errorf("unknown log verbosity %q (want ERROR, WARN, INFO or DEBUG)", name)
+}
+
 // FromConfig creates a new Logger from HTCondor configuration.
 // It reads the following configuration parameters:
 //   - LOG: Output path (stdout, stderr, or file path). Defaults to stderr.
@@ -141,15 +169,8 @@ func FromConfig(cfg *config.Config) (*Logger, error) {
 	// Parse verbosity
 	verbosity := VerbosityInfo
 	if logVerbosity, ok := cfg.Get("LOG_VERBOSITY"); ok {
-		switch strings.ToUpper(strings.TrimSpace(logVerbosity)) {
-		case "ERROR":
-			verbosity = VerbosityError
-		case "WARN", "WARNING":
-			verbosity = VerbosityWarn
-		case "INFO":
-			verbosity = VerbosityInfo
-		case "DEBUG":
-			verbosity = VerbosityDebug
+		if parsed, err := ParseVerbosity(logVerbosity); err == nil {
+			verbosity = parsed
 		}
 	}
 
@@ -186,6 +207,9 @@ func FromConfig(cfg *config.Config) (*Logger, error) {
 
 // shouldLog checks if a log should be written based on destination filtering
 func (l *Logger) shouldLog(dest Destination) bool {
+	if l == nil {
+		return false
+	}
 	// If no destinations are configured, allow all
 	if len(l.config.EnabledDestinations) == 0 {
 		return true
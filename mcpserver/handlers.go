@@ -92,6 +92,24 @@ func (s *Server) handleListTools(_ context.Context, _ json.RawMessage) interface
 				"required": []string{"job_id"},
 			},
 		},
+		{
+			Name:        "analyze_job",
+			Description: "Explain why an HTCondor job is not matching: evaluates each clause of the job's Requirements against the pool's machines",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"job_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Job ID in format 'cluster.proc' (e.g., '123.0')",
+					},
+					"token": map[string]interface{}{
+						"type":        "string",
+						"description": "Authentication token (optional)",
+					},
+				},
+				"required": []string{"job_id"},
+			},
+		},
 		{
 			Name:        "remove_job",
 			Description: "Remove (delete) a specific HTCondor job",
@@ -254,6 +272,8 @@ func (s *Server) handleCallTool(ctx context.Context, params json.RawMessage) (in
 		result, err = s.toolQueryJobs(ctx, request.Arguments)
 	case "get_job":
 		result, err = s.toolGetJob(ctx, request.Arguments)
+	case "analyze_job":
+		result, err = s.toolAnalyzeJob(ctx, request.Arguments)
 	case "remove_job":
 		result, err = s.toolRemoveJob(ctx, request.Arguments)
 	case "remove_jobs":
@@ -286,7 +306,13 @@ func (s *Server) toolSubmitJob(ctx context.Context, args map[string]interface{})
 		return nil, fmt.Errorf("submit_file is required")
 	}
 
-	clusterID, procAds, err := s.schedd.SubmitRemote(ctx, submitFile)
+	// The executable policy is checked on the job ads SubmitRemote submits
+	var opts htcondor.SubmitFileOptions
+	if s.submitOptions != nil {
+		opts = *s.submitOptions
+	}
+	opts.ExecutablePolicy = s.executablePolicy
+	clusterID, procAds, err := s.schedd.SubmitRemoteWithOptions(ctx, submitFile, &opts)
 	if err != nil {
 		return nil, fmt.Errorf("job submission failed: %w", err)
 	}
@@ -335,6 +361,7 @@ func (s *Server) toolQueryJobs(ctx context.Context, args map[string]interface{})
 	}
 
 	// Convert ClassAds to JSON
+	s.redactor.Redact(jobAds...)
 	jobsJSON, err := json.Marshal(jobAds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize jobs: %w", err)
@@ -377,6 +404,7 @@ func (s *Server) toolGetJob(ctx context.Context, args map[string]interface{}) (i
 		return nil, fmt.Errorf("job %s not found", jobID)
 	}
 
+	s.redactor.Redact(jobAds[0])
 	jobJSON, err := json.MarshalIndent(jobAds[0], "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize job: %w", err)
@@ -392,6 +420,47 @@ func (s *Server) toolGetJob(ctx context.Context, args map[string]interface{}) (i
 	}, nil
 }
 
+// toolAnalyzeJob handles explaining why a job does not match any machines
+func (s *Server) toolAnalyzeJob(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	jobID, ok := args["job_id"].(string)
+	if !ok || jobID == "" {
+		return nil, fmt.Errorf("job_id is required")
+	}
+
+	cluster, proc, err := parseJobID(jobID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job_id: %w", err)
+	}
+
+	if s.collector == nil {
+		return nil, fmt.Errorf("job analysis unavailable (no collector configured)")
+	}
+
+	analysis, err := s.schedd.AnalyzeJob(ctx, htcondor.JobID{Cluster: cluster, Proc: proc}, s.collector)
+	if err != nil {
+		return nil, fmt.Errorf("analysis failed: %w", err)
+	}
+
+	analysisJSON, err := json.MarshalIndent(analysis, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize analysis: %w", err)
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{
+			{
+				"type": "text",
+				"text": fmt.Sprintf("Job %s matches %d of %d machine(s):\n%s",
+					jobID, analysis.MatchingMachines, analysis.TotalMachines, string(analysisJSON)),
+			},
+		},
+		"metadata": map[string]interface{}{
+			"total_machines":    analysis.TotalMachines,
+			"matching_machines": analysis.MatchingMachines,
+		},
+	}, nil
+}
+
 // performJobAction is a helper function for single job actions (hold/release/remove)
 func performJobAction(ctx context.Context, args map[string]interface{}, actionFunc func(context.Context, string, string) (*htcondor.JobActionResults, error), defaultReason, actionName string) (interface{}, error) {
 	jobID, ok := args["job_id"].(string)
@@ -620,6 +689,7 @@ func (s *Server) resourceScheddStatus(ctx context.Context) (interface{}, error)
 	}
 
 	// Serialize the schedd ad (use first one)
+	s.redactor.Redact(ads[0])
 	adJSON, err := json.MarshalIndent(ads[0], "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize schedd ad: %w", err)
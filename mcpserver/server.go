@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/PelicanPlatform/classad/classad"
 	htcondor "github.com/bbockelm/golang-htcondor"
 	"github.com/bbockelm/golang-htcondor/logging"
 	"github.com/bbockelm/golang-htcondor/metricsd"
@@ -28,6 +29,9 @@ type Server struct {
 	stdout             io.Writer
 	validatedTokens    map[string]TokenInfo // Cache of validated tokens
 	tokenMutex         sync.RWMutex
+	executablePolicy   *htcondor.ExecutablePolicy
+	redactor           *htcondor.AttributeRedactor
+	submitOptions      *htcondor.SubmitFileOptions
 }
 
 // TokenInfo stores information about a validated token
@@ -50,6 +54,15 @@ type Config struct {
 	Logger          *logging.Logger     // Logger instance (optional, creates default if nil)
 	Stdin           io.Reader           // Input stream (default: os.Stdin)
 	Stdout          io.Writer           // Output stream (default: os.Stdout)
+	// ExecutablePolicy restricts which executables submit_job accepts (nil = unrestricted)
+	ExecutablePolicy *htcondor.ExecutablePolicy
+	// Redactor strips secret attributes from the ads tools return
+	// (nil = htcondor.DefaultRedactedAttributes)
+	Redactor *htcondor.AttributeRedactor
+	// SubmitOptions are the options submit_job parses submit files with,
+	// e.g. DisableIncludes for submit files from remote users (nil =
+	// defaults). Its ExecutablePolicy is replaced by ExecutablePolicy.
+	SubmitOptions *htcondor.SubmitFileOptions
 }
 
 // NewServer creates a new MCP server
@@ -104,16 +117,24 @@ func NewServer(cfg Config) (*Server, error) {
 		stdout = os.Stdout
 	}
 
+	redactor := cfg.Redactor
+	if redactor == nil {
+		redactor = htcondor.NewAttributeRedactor(htcondor.DefaultRedactedAttributes)
+	}
+
 	s := &Server{
-		schedd:          schedd,
-		collector:       cfg.Collector,
-		trustDomain:     cfg.TrustDomain,
-		uidDomain:       cfg.UIDDomain,
-		signingKeyPath:  cfg.SigningKeyPath,
-		logger:          logger,
-		stdin:           stdin,
-		stdout:          stdout,
-		validatedTokens: make(map[string]TokenInfo),
+		schedd:           schedd,
+		collector:        cfg.Collector,
+		trustDomain:      cfg.TrustDomain,
+		uidDomain:        cfg.UIDDomain,
+		signingKeyPath:   cfg.SigningKeyPath,
+		logger:           logger,
+		stdin:            stdin,
+		stdout:           stdout,
+		validatedTokens:  make(map[string]TokenInfo),
+		executablePolicy: cfg.ExecutablePolicy,
+		redactor:         redactor,
+		submitOptions:    cfg.SubmitOptions,
 	}
 
 	// Setup metrics if collector is provided
@@ -142,7 +163,7 @@ func discoverSchedd(collector *htcondor.Collector, scheddName string, timeout ti
 
 	constraint := "true"
 	if scheddName != "" {
-		constraint = fmt.Sprintf("Name == %q", scheddName)
+		constraint = "Name == " + classad.Quote(scheddName)
 	}
 
 	ads, err := collector.QueryAds(ctx, "ScheddAd", constraint)
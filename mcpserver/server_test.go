@@ -10,6 +10,7 @@ import (
 	"time"
 
 	htcondor "github.com/bbockelm/golang-htcondor"
+	"github.com/bbockelm/golang-htcondor/config"
 	"github.com/bbockelm/golang-htcondor/logging"
 )
 
@@ -196,3 +197,30 @@ func TestParseJobID(t *testing.T) {
 		})
 	}
 }
+
+// TestMCPSubmitJobDisableIncludes tests submit_job honors DisableIncludes
+func TestMCPSubmitJobDisableIncludes(t *testing.T) {
+	logger, err := logging.New(&logging.Config{OutputPath: "stderr", MinVerbosity: logging.VerbosityError})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	server := &Server{
+		schedd:        htcondor.NewSchedd("unreachable", "127.0.0.1:1"),
+		logger:        logger,
+		submitOptions: &htcondor.SubmitFileOptions{DisableIncludes: true},
+	}
+
+	params, err := json.Marshal(map[string]interface{}{
+		"name": "submit_job",
+		"arguments": map[string]interface{}{
+			"submit_file": "executable = /usr/bin/true\ninclude command : echo request_memory = 1\nqueue\n",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal params: %v", err)
+	}
+	_, err = server.handleCallTool(context.Background(), params)
+	if !errors.Is(err, config.ErrIncludeDisabled) {
+		t.Errorf("Expected ErrIncludeDisabled, got %v", err)
+	}
+}
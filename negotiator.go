@@ -0,0 +1,194 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// ErrNegotiatorNotFound is returned by Collector.LocateNegotiator when the
+// collector has no negotiator ad
+var ErrNegotiatorNotFound = errors.New("no negotiator found in the collector")
+
+// Negotiator represents an HTCondor negotiator daemon, which keeps the
+// pool's fair-share accounting
+type Negotiator struct {
+	address   string
+	transport Transport
+}
+
+// NewNegotiator creates a new Negotiator instance
+func NewNegotiator(address string) *Negotiator {
+	return &Negotiator{address: address}
+}
+
+// NewNegotiatorWithTransport creates a new Negotiator instance that opens its
+// CEDAR connections through the given transport instead of the default cedar client
+func NewNegotiatorWithTransport(address string, transport Transport) *Negotiator {
+	return &Negotiator{address: address, transport: transport}
+}
+
+// Address returns the negotiator's address
+func (n *Negotiator) Address() string {
+	return n.address
+}
+
+// connect opens a new connection to the negotiator using the configured transport
+func (n *Negotiator) connect(ctx context.Context) (Connection, error) {
+	transport := n.transport
+	if transport == nil {
+		transport = DefaultTransport()
+	}
+	return transport.Connect(ctx, n.address)
+}
+
+// UserPriority is the fair-share accounting of one submitter or accounting
+// group, as condor_userprio shows it
+type UserPriority struct {
+	// Name is the submitter (user@uid_domain) or accounting group name
+	Name string `json:"name"`
+	// IsAccountingGroup is set for the entries of accounting groups
+	IsAccountingGroup bool `json:"is_accounting_group"`
+	// AccountingGroup is the group the submitter's usage is charged to, if any
+	AccountingGroup string `json:"accounting_group,omitempty"`
+	// Priority is the effective user priority; lower is better
+	Priority float64 `json:"priority"`
+	// PriorityFactor scales the real priority into the effective one
+	PriorityFactor float64 `json:"priority_factor"`
+	// ResourcesUsed is the number of slots in use now
+	ResourcesUsed float64 `json:"resources_used"`
+	// WeightedResourcesUsed is ResourcesUsed weighted by slot size (SlotWeight)
+	WeightedResourcesUsed float64 `json:"weighted_resources_used"`
+	// AccumulatedUsage is the total weighted usage since BeginUsageTime, in seconds
+	AccumulatedUsage float64 `json:"accumulated_usage"`
+	// BeginUsageTime is when usage was first recorded, in Unix seconds (0 if never)
+	BeginUsageTime int64 `json:"begin_usage_time"`
+	// LastUsageTime is when resources were last in use, in Unix seconds (0 if never)
+	LastUsageTime int64 `json:"last_usage_time"`
+}
+
+// QueryUserPriorities returns the priority and usage of every submitter and
+// accounting group the negotiator accounts for, like condor_userprio -all,
+// using the negotiator's GET_PRIORITY command.
+func (n *Negotiator) QueryUserPriorities(ctx context.Context) ([]UserPriority, error) {
+	htcondorClient, err := n.connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to negotiator at %s: %w", n.address, err)
+	}
+	defer func() { _ = htcondorClient.Close() }()
+
+	cedarStream := htcondorClient.GetStream()
+	if err := negotiatorHandshake(ctx, cedarStream, commands.GET_PRIORITY, n.address); err != nil {
+		return nil, err
+	}
+
+	// The request has no payload
+	if err := message.NewMessageForStream(cedarStream).FinishMessage(ctx); err != nil {
+		return nil, fmt.Errorf("failed to send priority request: %w", err)
+	}
+
+	ad, err := message.NewMessageFromStream(cedarStream).GetClassAd(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read priorities: %w", err)
+	}
+	return parseUserPriorities(ad), nil
+}
+
+// SetUserPriorityFactor sets the priority factor of a submitter
+// (user@uid_domain) or accounting group, like condor_userprio -setfactor.
+// The negotiator requires ADMINISTRATOR authorization, and sends no reply:
+// a denied request fails in the security handshake.
+func (n *Negotiator) SetUserPriorityFactor(ctx context.Context, name string, factor float64) error {
+	if name == "" {
+		return fmt.Errorf("submitter name is required")
+	}
+	if factor < 1 {
+		return fmt.Errorf("priority factor must be at least 1, got %g", factor)
+	}
+
+	htcondorClient, err := n.connect(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to negotiator at %s: %w", n.address, err)
+	}
+	defer func() { _ = htcondorClient.Close() }()
+
+	cedarStream := htcondorClient.GetStream()
+	if err := negotiatorHandshake(ctx, cedarStream, commands.SET_PRIORITYFACTOR, n.address); err != nil {
+		return err
+	}
+
+	msg := message.NewMessageForStream(cedarStream)
+	if err := msg.PutString(ctx, name); err != nil {
+		return fmt.Errorf("failed to send submitter name: %w", err)
+	}
+	if err := msg.PutDouble(ctx, factor); err != nil {
+		return fmt.Errorf("failed to send priority factor: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to send priority factor: %w", err)
+	}
+	return nil
+}
+
+// negotiatorHandshake authenticates a negotiator command
+func negotiatorHandshake(ctx context.Context, cedarStream *stream.Stream, cmd commands.CommandType, address string) error {
+	secConfig, err := GetSecurityConfigOrDefault(ctx, nil, int(cmd), "CLIENT", address)
+	if err != nil {
+		return fmt.Errorf("failed to create security config: %w", err)
+	}
+	auth := security.NewAuthenticator(secConfig, cedarStream)
+	if _, err := auth.ClientHandshake(ctx); err != nil {
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
+	}
+	return nil
+}
+
+// parseUserPriorities reads the accountant's report, which numbers its
+// entries 1 to NumSubmittors (Name1, Priority1, ...)
+func parseUserPriorities(ad *classad.ClassAd) []UserPriority {
+	count, _ := ad.EvaluateAttrInt("NumSubmittors")
+	priorities := make([]UserPriority, 0, count)
+	for i := 1; i <= int(count); i++ {
+		suffix := strconv.Itoa(i)
+		name, ok := ad.EvaluateAttrString("Name" + suffix)
+		if !ok {
+			continue
+		}
+		number := func(attr string) float64 {
+			value, _ := ad.EvaluateAttrNumber(attr + suffix)
+			return value
+		}
+		timestamp := func(attr string) int64 {
+			secs, _ := ad.EvaluateAttrInt(attr + suffix)
+			return secs
+		}
+
+		entry := UserPriority{
+			Name:                  name,
+			Priority:              number("Priority"),
+			PriorityFactor:        number("PriorityFactor"),
+			ResourcesUsed:         number("ResourcesUsed"),
+			WeightedResourcesUsed: number("WeightedResourcesUsed"),
+			AccumulatedUsage:      number("WeightedAccumulatedUsage"),
+			BeginUsageTime:        timestamp("BeginUsageTime"),
+			LastUsageTime:         timestamp("LastUsageTime"),
+		}
+		if _, ok := ad.Lookup("WeightedAccumulatedUsage" + suffix); !ok {
+			entry.AccumulatedUsage = number("AccumulatedUsage")
+		}
+		entry.IsAccountingGroup, _ = ad.EvaluateAttrBool("IsAccountingGroup" + suffix)
+		if group, ok := ad.EvaluateAttrString("AccountingGroup" + suffix); ok && !strings.EqualFold(group, name) {
+			entry.AccountingGroup = group
+		}
+		priorities = append(priorities, entry)
+	}
+	return priorities
+}
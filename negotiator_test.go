@@ -0,0 +1,141 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// negotiatorHandshakeCommand performs the server side of DC_AUTHENTICATE and
+// checks the command the client asked for
+func negotiatorHandshakeCommand(ctx context.Context, s *stream.Stream, want commands.CommandType) error {
+	auth := security.NewAuthenticator(&security.SecurityConfig{
+		AuthMethods:    []security.AuthMethod{security.AuthNone},
+		Authentication: security.SecurityNever,
+		Encryption:     security.SecurityNever,
+		Integrity:      security.SecurityNever,
+	}, s)
+	negotiation, err := auth.ServerHandshake(ctx)
+	if err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	// The client's requested command is in its security ad
+	if negotiation.ClientConfig == nil || negotiation.ClientConfig.Command != int(want) {
+		return fmt.Errorf("expected command %d, got %+v", want, negotiation.ClientConfig)
+	}
+	return nil
+}
+
+func TestQueryUserPriorities(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := negotiatorHandshakeCommand(ctx, s, commands.GET_PRIORITY); err != nil {
+			return err
+		}
+		if _, err := s.ReceiveCompleteMessage(ctx); err != nil {
+			return fmt.Errorf("request: %w", err)
+		}
+
+		report := classad.New()
+		_ = report.Set("NumSubmittors", int64(2))
+		_ = report.Set("Name1", "group_physics")
+		_ = report.Set("IsAccountingGroup1", true)
+		_ = report.Set("AccountingGroup1", "group_physics")
+		_ = report.Set("Priority1", 500.0)
+		_ = report.Set("PriorityFactor1", 1000.0)
+		_ = report.Set("Name2", "alice@example.com")
+		_ = report.Set("IsAccountingGroup2", false)
+		_ = report.Set("AccountingGroup2", "group_physics")
+		_ = report.Set("Priority2", 0.5)
+		_ = report.Set("PriorityFactor2", 1000.0)
+		_ = report.Set("ResourcesUsed2", int64(4))
+		_ = report.Set("WeightedResourcesUsed2", 8.0)
+		_ = report.Set("WeightedAccumulatedUsage2", 7200.0)
+		_ = report.Set("BeginUsageTime2", int64(1700000000))
+		_ = report.Set("LastUsageTime2", int64(1700003600))
+		return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, report) })
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	priorities, err := NewNegotiatorWithTransport("mock-negotiator:9618", transport).QueryUserPriorities(ctx)
+	if err != nil {
+		t.Fatalf("QueryUserPriorities failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted negotiator failed: %v", err)
+	}
+	if len(priorities) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(priorities))
+	}
+
+	group := priorities[0]
+	if group.Name != "group_physics" || !group.IsAccountingGroup || group.AccountingGroup != "" {
+		t.Errorf("Unexpected group entry: %+v", group)
+	}
+	want := UserPriority{
+		Name:                  "alice@example.com",
+		AccountingGroup:       "group_physics",
+		Priority:              0.5,
+		PriorityFactor:        1000,
+		ResourcesUsed:         4,
+		WeightedResourcesUsed: 8,
+		AccumulatedUsage:      7200,
+		BeginUsageTime:        1700000000,
+		LastUsageTime:         1700003600,
+	}
+	if priorities[1] != want {
+		t.Errorf("Got %+v, expected %+v", priorities[1], want)
+	}
+}
+
+func TestSetUserPriorityFactor(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := negotiatorHandshakeCommand(ctx, s, commands.SET_PRIORITYFACTOR); err != nil {
+			return err
+		}
+		msg := message.NewMessageFromStream(s)
+		name, err := msg.GetString(ctx)
+		if err != nil {
+			return fmt.Errorf("name: %w", err)
+		}
+		factor, err := msg.GetDouble(ctx)
+		if err != nil {
+			return fmt.Errorf("factor: %w", err)
+		}
+		// CEDAR's double encoding keeps about 31 bits of mantissa
+		if name != "alice@example.com" || math.Abs(factor-2000) > 1e-6 {
+			return fmt.Errorf("unexpected request %q %g", name, factor)
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	negotiator := NewNegotiatorWithTransport("mock-negotiator:9618", transport)
+	if err := negotiator.SetUserPriorityFactor(ctx, "alice@example.com", 2000); err != nil {
+		t.Fatalf("SetUserPriorityFactor failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted negotiator failed: %v", err)
+	}
+
+	// Invalid requests are rejected before connecting
+	if err := negotiator.SetUserPriorityFactor(ctx, "alice@example.com", 0.5); err == nil {
+		t.Error("Expected an error for a factor below 1")
+	}
+	if err := negotiator.SetUserPriorityFactor(ctx, "", 10); err == nil {
+		t.Error("Expected an error for an empty name")
+	}
+}
@@ -50,23 +50,23 @@ func generate() error {
 	fmt.Fprintln(out, "")
 	fmt.Fprintln(out, "package config")
 	fmt.Fprintln(out, "")
-	fmt.Fprintln(out, "// ParamDefault represents a default parameter value from param_info.in")
+	fmt.Fprintln(out, "// ParamDefault represents a parameter declared in param_info.in")
 	fmt.Fprintln(out, "type ParamDefault struct {")
 	fmt.Fprintln(out, "\tName         string")
 	fmt.Fprintln(out, "\tDefault      string")
 	fmt.Fprintln(out, "\tWin32Default string")
 	fmt.Fprintln(out, "\tType         string")
+	fmt.Fprintln(out, "\tRange        string")
+	fmt.Fprintln(out, "\tDescription  string")
 	fmt.Fprintln(out, "}")
 	fmt.Fprintln(out, "")
-	fmt.Fprintln(out, "// paramDefaults contains all default values from HTCondor param_info.in")
+	fmt.Fprintln(out, "// paramDefaults contains all parameters declared in HTCondor param_info.in,")
+	fmt.Fprintln(out, "// including those with no default value")
 	fmt.Fprintln(out, "var paramDefaults = []ParamDefault{")
 
 	for _, p := range params {
-		if p.Default == "" && p.Win32Default == "" {
-			continue // Skip params with no defaults
-		}
-		fmt.Fprintf(out, "\t{Name: %q, Default: %q, Win32Default: %q, Type: %q},\n",
-			p.Name, p.Default, p.Win32Default, p.Type)
+		fmt.Fprintf(out, "\t{Name: %q, Default: %q, Win32Default: %q, Type: %q, Range: %q, Description: %q},\n",
+			p.Name, p.Default, p.Win32Default, p.Type, p.Range, p.Description)
 	}
 
 	fmt.Fprintln(out, "}")
@@ -0,0 +1,209 @@
+package htcondor
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bbockelm/cedar/addresses"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+	"golang.org/x/net/proxy"
+)
+
+// proxyDialTimeout bounds establishing a tunnel when the context has no
+// deadline, matching the cedar client's connection timeout
+const proxyDialTimeout = 30 * time.Second
+
+// proxyTransport is a Transport that tunnels CEDAR connections through an
+// HTTP CONNECT or SOCKS5 proxy
+type proxyTransport struct {
+	proxyURL *url.URL
+	dial     func(ctx context.Context, addr string) (net.Conn, error)
+}
+
+// NewProxyTransport returns a Transport that reaches daemons through the
+// proxy at proxyURL. Supported schemes are http (HTTP CONNECT), socks5 and
+// socks5h; credentials in the URL's user info are sent to the proxy.
+// Shared port addresses are supported: the tunnel is opened to the shared
+// port server and the daemon is selected over it.
+func NewProxyTransport(proxyURL string) (Transport, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", proxyURL)
+	}
+
+	t := &proxyTransport{proxyURL: u}
+	switch u.Scheme {
+	case "http":
+		t.dial = t.dialHTTPConnect
+	case "socks5", "socks5h":
+		var auth *proxy.Auth
+		if u.User != nil {
+			password, _ := u.User.Password()
+			auth = &proxy.Auth{User: u.User.Username(), Password: password}
+		}
+		dialer, err := proxy.SOCKS5("tcp", u.Host, auth, &net.Dialer{Timeout: proxyDialTimeout})
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+		}
+		contextDialer := dialer.(proxy.ContextDialer)
+		t.dial = func(ctx context.Context, addr string) (net.Conn, error) {
+			return contextDialer.DialContext(ctx, "tcp", addr)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q (want http, socks5 or socks5h)", u.Scheme)
+	}
+	return t, nil
+}
+
+// WithProxy returns a copy of the schedd that connects through the HTTP
+// CONNECT or SOCKS5 proxy at proxyURL (see NewProxyTransport). Any transport
+// previously configured on the schedd is replaced.
+func (s *Schedd) WithProxy(proxyURL string) (*Schedd, error) {
+	transport, err := NewProxyTransport(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	return NewScheddWithTransport(s.name, s.address, transport), nil
+}
+
+// Connect implements Transport by opening a tunnel to the daemon's server
+// address and, for shared port addresses, requesting the daemon over it
+func (t *proxyTransport) Connect(ctx context.Context, address string) (Connection, error) {
+	addrInfo := addresses.ParseHTCondorAddress(address)
+	conn, err := t.dial(ctx, addrInfo.ServerAddr)
+	if err != nil {
+		return nil, &ConnectError{Address: address, Err: fmt.Errorf("failed to connect to %s through proxy %s: %w", addrInfo.ServerAddr, t.proxyURL.Redacted(), err)}
+	}
+
+	if addrInfo.IsSharedPort {
+		if err := sendSharedPortConnect(ctx, stream.NewStream(conn), addrInfo.SharedPortID); err != nil {
+			_ = conn.Close()
+			return nil, &ConnectError{Address: address, Err: err}
+		}
+	}
+
+	// A fresh stream, so the shared port request is not part of the
+	// message digests the daemon sees
+	s := stream.NewStream(conn)
+	s.SetPeerAddr(address)
+	return &streamConnection{conn: conn, stream: s}, nil
+}
+
+// dialHTTPConnect opens a tunnel to addr with an HTTP CONNECT request
+func (t *proxyTransport) dialHTTPConnect(ctx context.Context, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: proxyDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.proxyURL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(proxyDialTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u := t.proxyURL.User; u != nil {
+		password, _ := u.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(u.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to send CONNECT request: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to read CONNECT response: %w", err)
+	}
+	// A successful CONNECT response has no body; the tunnel follows it
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		_ = conn.Close()
+		return nil, fmt.Errorf("proxy refused CONNECT: %s", resp.Status)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: br}, nil
+	}
+	return conn, nil
+}
+
+// sendSharedPortConnect asks the shared port server at the other end of s to
+// hand the connection to the daemon with the given shared port ID
+func sendSharedPortConnect(ctx context.Context, s *stream.Stream, sharedPortID string) error {
+	if !addresses.IsValidSharedPortID(sharedPortID) {
+		return fmt.Errorf("invalid shared port ID: %s", sharedPortID)
+	}
+
+	deadline := int64(-1)
+	if d, ok := ctx.Deadline(); ok {
+		deadline = int64(time.Until(d).Seconds())
+	}
+
+	msg := message.NewMessageForStream(s)
+	if err := msg.PutInt32(ctx, int32(commands.SHARED_PORT_CONNECT)); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	if err := msg.PutString(ctx, sharedPortID); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	if err := msg.PutString(ctx, "golang-htcondor"); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	if err := msg.PutInt64(ctx, deadline); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	// more_args, reserved
+	if err := msg.PutInt32(ctx, 0); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to send shared port request: %w", err)
+	}
+	return nil
+}
+
+// streamConnection is a Connection over a tunneled net.Conn
+type streamConnection struct {
+	conn   net.Conn
+	stream *stream.Stream
+}
+
+// GetStream implements Connection
+func (c *streamConnection) GetStream() *stream.Stream { return c.stream }
+
+// Close implements Connection
+func (c *streamConnection) Close() error { return c.conn.Close() }
+
+// bufferedConn is a net.Conn whose first reads drain bytes the proxy sent
+// after its CONNECT response
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
@@ -0,0 +1,306 @@
+package htcondor
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// pipeConns copies between two connections until either side closes
+func pipeConns(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() { _, _ = io.Copy(a, b); done <- struct{}{} }()
+	go func() { _, _ = io.Copy(b, a); done <- struct{}{} }()
+	<-done
+	_ = a.Close()
+	_ = b.Close()
+}
+
+// startConnectProxy starts an HTTP CONNECT proxy requiring the given basic
+// credentials (none if user is empty) and returns its URL
+func startConnectProxy(t *testing.T, user, password string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		if user != "" {
+			want := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+			if r.Header.Get("Proxy-Authorization") != want {
+				http.Error(w, "bad credentials", http.StatusProxyAuthRequired)
+				return
+			}
+		}
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			_ = upstream.Close()
+			return
+		}
+		if _, err := conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+			_ = conn.Close()
+			_ = upstream.Close()
+			return
+		}
+		pipeConns(conn, upstream)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// startSOCKS5Proxy starts a SOCKS5 proxy without authentication and returns its URL
+func startSOCKS5Proxy(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSOCKS5(conn)
+		}
+	}()
+	return "socks5://" + ln.Addr().String()
+}
+
+// serveSOCKS5 handles a single CONNECT request
+func serveSOCKS5(conn net.Conn) {
+	buf := make([]byte, 262)
+	// Greeting: version, method count, methods; reply "no authentication"
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		_ = conn.Close()
+		return
+	}
+	if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+		_ = conn.Close()
+		return
+	}
+	if _, err := conn.Write([]byte{5, 0}); err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	// Request: version, command, reserved, address type, address, port
+	if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+		_ = conn.Close()
+		return
+	}
+	var host string
+	switch buf[3] {
+	case 1:
+		if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+			_ = conn.Close()
+			return
+		}
+		host = net.IP(buf[:4]).String()
+	case 3:
+		if _, err := io.ReadFull(conn, buf[:1]); err != nil {
+			_ = conn.Close()
+			return
+		}
+		n := int(buf[0])
+		if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+			_ = conn.Close()
+			return
+		}
+		host = string(buf[:n])
+	default:
+		_ = conn.Close()
+		return
+	}
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		_ = conn.Close()
+		return
+	}
+	port := binary.BigEndian.Uint16(buf[:2])
+
+	upstream, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(int(port))))
+	if err != nil {
+		_, _ = conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+		_ = conn.Close()
+		return
+	}
+	if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+		_ = conn.Close()
+		_ = upstream.Close()
+		return
+	}
+	pipeConns(conn, upstream)
+}
+
+// startEchoDaemon starts a TCP server that, optionally after a shared port
+// request for sharedPortID, echoes back one CEDAR int32 message
+func startEchoDaemon(t *testing.T, sharedPortID string) (string, chan error) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	errCh := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		ctx := context.Background()
+		s := stream.NewStream(conn)
+
+		if sharedPortID != "" {
+			req := message.NewMessageFromStream(s)
+			cmd, err := req.GetInt32(ctx)
+			if err != nil || cmd != int32(commands.SHARED_PORT_CONNECT) {
+				errCh <- fmt.Errorf("expected SHARED_PORT_CONNECT, got %d (%v)", cmd, err)
+				return
+			}
+			id, err := req.GetString(ctx)
+			if err != nil || id != sharedPortID {
+				errCh <- fmt.Errorf("expected shared port ID %q, got %q (%v)", sharedPortID, id, err)
+				return
+			}
+			s = stream.NewStream(conn)
+		}
+
+		value, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			errCh <- fmt.Errorf("read: %w", err)
+			return
+		}
+		errCh <- sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, value) })
+	}()
+	return ln.Addr().String(), errCh
+}
+
+func TestProxyTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		proxyURL     func(t *testing.T) string
+		sharedPortID string
+	}{
+		{"http connect", func(t *testing.T) string { return startConnectProxy(t, "", "") }, ""},
+		{"http connect with credentials", func(t *testing.T) string {
+			return strings.Replace(startConnectProxy(t, "alice", "s3cret"), "http://", "http://alice:s3cret@", 1)
+		}, ""},
+		{"http connect shared port", func(t *testing.T) string { return startConnectProxy(t, "", "") }, "schedd"},
+		{"socks5", startSOCKS5Proxy, ""},
+		{"socks5 shared port", startSOCKS5Proxy, "schedd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			daemonAddr, errCh := startEchoDaemon(t, tt.sharedPortID)
+			address := "<" + daemonAddr + ">"
+			if tt.sharedPortID != "" {
+				address = "<" + daemonAddr + "?sock=" + tt.sharedPortID + ">"
+			}
+
+			transport, err := NewProxyTransport(tt.proxyURL(t))
+			if err != nil {
+				t.Fatalf("NewProxyTransport: %v", err)
+			}
+			conn, err := transport.Connect(ctx, address)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			defer func() { _ = conn.Close() }()
+
+			s := conn.GetStream()
+			if s.GetPeerAddr() != address {
+				t.Errorf("Expected peer address %q, got %q", address, s.GetPeerAddr())
+			}
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 42) }); err != nil {
+				t.Fatalf("send: %v", err)
+			}
+			reply, err := message.NewMessageFromStream(s).GetInt32(ctx)
+			if err != nil {
+				t.Fatalf("receive: %v", err)
+			}
+			if reply != 42 {
+				t.Errorf("Expected echoed 42, got %d", reply)
+			}
+			if err := <-errCh; err != nil {
+				t.Errorf("daemon: %v", err)
+			}
+		})
+	}
+}
+
+func TestProxyTransportErrors(t *testing.T) {
+	for _, proxyURL := range []string{"ftp://proxy:21", "http://", "://bad"} {
+		if _, err := NewProxyTransport(proxyURL); err == nil {
+			t.Errorf("Expected error for proxy URL %q", proxyURL)
+		}
+	}
+
+	// Wrong credentials surface as a connection failure naming the proxy status
+	proxyURL := strings.Replace(startConnectProxy(t, "alice", "s3cret"), "http://", "http://alice:wrong@", 1)
+	schedd, err := NewSchedd("test", "127.0.0.1:9618").WithProxy(proxyURL)
+	if err != nil {
+		t.Fatalf("WithProxy: %v", err)
+	}
+	_, err = schedd.connect(context.Background())
+	var connErr *ConnectError
+	if !errors.As(err, &connErr) {
+		t.Fatalf("Expected ConnectError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "407") || strings.Contains(err.Error(), "wrong") {
+		t.Errorf("Expected a redacted 407 error, got %v", err)
+	}
+}
+
+// TestScheddWithProxyIntegration queries the demo schedd through a local
+// HTTP CONNECT proxy
+func TestScheddWithProxyIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH - skipping integration test")
+	}
+
+	harness := setupCondorHarness(t)
+	if err := harness.waitForDaemons(); err != nil {
+		t.Fatalf("Daemons failed to start: %v", err)
+	}
+
+	schedd, err := NewSchedd("local", discoverSchedd(t, harness)).WithProxy(startConnectProxy(t, "", ""))
+	if err != nil {
+		t.Fatalf("WithProxy: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := schedd.Query(ctx, "true", []string{"ClusterId"}); err != nil {
+		t.Fatalf("Query through proxy failed: %v", err)
+	}
+}
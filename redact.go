@@ -0,0 +1,72 @@
+package htcondor
+
+import (
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// DefaultRedactedAttributes lists ad attributes known to hold secrets:
+// cloud credentials and the claim IDs and capabilities that let their holder
+// act as a daemon or claim a slot
+var DefaultRedactedAttributes = []string{
+	"EC2AccessKeyId",
+	"EC2SecretAccessKey",
+	"ClaimId",
+	"ClaimIds",
+	"ClaimIdList",
+	"ChildClaimIds",
+	"Capability",
+	"TransferKey",
+}
+
+// AttributeRedactor removes a set of attributes from ads before they are
+// handed to clients. Attribute names are matched case-insensitively, as
+// ClassAd attribute names are.
+type AttributeRedactor struct {
+	names map[string]bool
+}
+
+// NewAttributeRedactor creates a redactor for the given attributes
+func NewAttributeRedactor(attributes []string) *AttributeRedactor {
+	r := &AttributeRedactor{names: make(map[string]bool, len(attributes))}
+	for _, name := range attributes {
+		if name = strings.TrimSpace(name); name != "" {
+			r.names[strings.ToLower(name)] = true
+		}
+	}
+	return r
+}
+
+// Redact removes the redacted attributes from ads in place. A nil redactor
+// leaves the ads unchanged.
+func (r *AttributeRedactor) Redact(ads ...*classad.ClassAd) {
+	if r == nil || len(r.names) == 0 {
+		return
+	}
+	for _, ad := range ads {
+		if ad == nil {
+			continue
+		}
+		for _, name := range ad.GetAttributes() {
+			if r.names[strings.ToLower(name)] {
+				ad.Delete(name)
+			}
+		}
+	}
+}
+
+// RedactMaps removes the redacted attributes from ads converted with
+// AdToJSON, in place. A nil redactor leaves the ads unchanged.
+func (r *AttributeRedactor) RedactMaps(ads ...map[string]interface{}) {
+	if r == nil || len(r.names) == 0 {
+		return
+	}
+	for _, ad := range ads {
+		for name := range ad {
+			if r.names[strings.ToLower(name)] {
+				delete(ad, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package htcondor
+
+import (
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestAttributeRedactor(t *testing.T) {
+	ad := classad.New()
+	_ = ad.Set("ClusterId", 42)
+	_ = ad.Set("Owner", "alice")
+	_ = ad.Set("ec2secretaccesskey", "/home/alice/.ec2/secret")
+	_ = ad.Set("ClaimId", "<10.0.0.1:9618>#1700000000#1#...")
+
+	NewAttributeRedactor(DefaultRedactedAttributes).Redact(ad, nil)
+
+	for _, attr := range []string{"EC2SecretAccessKey", "ClaimId"} {
+		if _, ok := ad.Lookup(attr); ok {
+			t.Errorf("Expected %s to be redacted", attr)
+		}
+	}
+	if owner, _ := ad.EvaluateAttrString("Owner"); owner != "alice" {
+		t.Errorf("Owner = %q, expected alice", owner)
+	}
+
+	// Neither a nil redactor nor an empty one removes anything
+	var nilRedactor *AttributeRedactor
+	nilRedactor.Redact(ad)
+	NewAttributeRedactor(nil).Redact(ad)
+	if _, ok := ad.Lookup("ClusterId"); !ok {
+		t.Error("Expected ClusterId to be kept")
+	}
+}
+
+func TestAttributeRedactorMaps(t *testing.T) {
+	ad := map[string]interface{}{"Name": "slot1@host", "capability": "<10.0.0.1:9618>#1"}
+
+	NewAttributeRedactor(DefaultRedactedAttributes).RedactMaps(ad, nil)
+
+	if _, ok := ad["capability"]; ok {
+		t.Error("Expected capability to be redacted")
+	}
+	if _, ok := ad["Name"]; !ok {
+		t.Error("Expected Name to be kept")
+	}
+}
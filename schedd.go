@@ -2,15 +2,17 @@ package htcondor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/PelicanPlatform/classad/classad"
-	"github.com/bbockelm/cedar/client"
 	"github.com/bbockelm/cedar/commands"
 	"github.com/bbockelm/cedar/message"
 	"github.com/bbockelm/cedar/security"
+	"github.com/bbockelm/cedar/stream"
+	"github.com/bbockelm/golang-htcondor/logging"
 )
 
 // securityConfigContextKey is the type for the security configuration context key
@@ -52,8 +54,9 @@ func GetAuthenticatedUserFromContext(ctx context.Context) string {
 
 // Schedd represents an HTCondor schedd daemon
 type Schedd struct {
-	name    string
-	address string
+	name      string
+	address   string
+	transport Transport
 }
 
 // NewSchedd creates a new Schedd instance
@@ -65,6 +68,63 @@ func NewSchedd(name string, address string) *Schedd {
 	}
 }
 
+// NewScheddWithTransport creates a new Schedd instance that opens its CEDAR
+// connections through the given transport instead of the default cedar client.
+// This is primarily useful for tests that need to inject a mock stream.
+func NewScheddWithTransport(name string, address string, transport Transport) *Schedd {
+	return &Schedd{
+		name:      name,
+		address:   address,
+		transport: transport,
+	}
+}
+
+// getTransport returns the schedd's transport, falling back to the default cedar client
+func (s *Schedd) getTransport() Transport {
+	if s.transport == nil {
+		return DefaultTransport()
+	}
+	return s.transport
+}
+
+// connect opens a new connection to the schedd using the configured transport
+func (s *Schedd) connect(ctx context.Context) (Connection, error) {
+	return s.getTransport().Connect(ctx, s.address)
+}
+
+// newQmgmtConnection opens a queue management connection to the schedd using the configured transport
+func (s *Schedd) newQmgmtConnection(ctx context.Context) (*QmgmtConnection, error) {
+	return newQmgmtConnection(ctx, s.getTransport(), s.address)
+}
+
+// Qmgmt opens a queue management connection to the schedd for operations
+// the high-level API does not cover. The connection is authenticated, a
+// transaction is already open, and the effective owner is the authenticated
+// user, so jobs created on it belong to that user as with Submit.
+//
+// Use the connection's primitives (NewCluster, NewProc, SetAttribute,
+// GetAttribute, DestroyCluster), then CommitTransaction or AbortTransaction;
+// BeginTransaction starts another transaction on the same connection. The
+// caller must Close the connection.
+func (s *Schedd) Qmgmt(ctx context.Context) (*QmgmtConnection, error) {
+	qmgmt, err := s.newQmgmtConnection(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+	}
+
+	// GetCapabilities implicitly started the transaction; only the owner is left
+	owner := qmgmt.authenticatedUser
+	if owner == "" {
+		_ = qmgmt.Close()
+		return nil, fmt.Errorf("no authenticated user")
+	}
+	if err := qmgmt.SetEffectiveOwner(ctx, owner); err != nil {
+		_ = qmgmt.Close()
+		return nil, fmt.Errorf("failed to set effective owner: %w", err)
+	}
+	return qmgmt, nil
+}
+
 // Name returns the schedd's name
 func (s *Schedd) Name() string {
 	return s.name
@@ -75,32 +135,22 @@ func (s *Schedd) Address() string {
 	return s.address
 }
 
-// Query queries the schedd for job advertisements
-// constraint is a ClassAd constraint expression (use "true" to get all jobs)
-// projection is a list of attributes to return (use nil to get all attributes)
+// Query queries the schedd for job advertisements, as condor_q does, with
+// the QUERY_JOB_ADS command.
+// constraint is a ClassAd constraint expression ("" or "true" for all jobs);
+// a constraint that does not parse is an error.
+// projection is a list of attributes to return (use nil to get all attributes).
+// Cancelling ctx while the ads are streamed in closes the connection and
+// returns the ads received so far with the context's error.
 func (s *Schedd) Query(ctx context.Context, constraint string, projection []string) ([]*classad.ClassAd, error) {
 	return s.queryWithAuth(ctx, constraint, projection, false)
 }
 
 // queryWithAuth performs the actual query with optional authentication
-func (s *Schedd) queryWithAuth(ctx context.Context, constraint string, projection []string, useAuth bool) ([]*classad.ClassAd, error) {
-	// Apply rate limiting if configured
-	// Use a short timeout context for rate limiting to avoid blocking HTTP requests
-	// If rate limit is exceeded, we want to return 429 immediately, not block
-	username := GetAuthenticatedUserFromContext(ctx)
-	rateLimitManager := getRateLimitManager()
-	if rateLimitManager != nil {
-		rateLimitCtx, cancelRateLimit := context.WithTimeout(ctx, 1000*time.Millisecond)
-		defer cancelRateLimit()
-		if err := rateLimitManager.WaitSchedd(rateLimitCtx, username); err != nil {
-			return nil, fmt.Errorf("rate limit exceeded: %w", err)
-		}
-	}
-
-	// Establish connection using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, s.address)
+func (s *Schedd) queryWithAuth(ctx context.Context, constraint string, projection []string, useAuth bool) (ads []*classad.ClassAd, err error) {
+	htcondorClient, err := s.openJobQuery(ctx, constraint, projection, useAuth)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+		return nil, err
 	}
 	defer func() {
 		if cerr := htcondorClient.Close(); cerr != nil && err == nil {
@@ -108,9 +158,37 @@ func (s *Schedd) queryWithAuth(ctx context.Context, constraint string, projectio
 		}
 	}()
 
-	// Get CEDAR stream from client
-	cedarStream := htcondorClient.GetStream()
+	return readQueryAds(ctx, htcondorClient.GetStream())
+}
+
+// openJobQuery connects to the schedd and sends a job query, leaving the
+// connection ready for the results to be read
+func (s *Schedd) openJobQuery(ctx context.Context, constraint string, projection []string, useAuth bool) (Connection, error) {
+	requestAd, err := createJobQueryAd(constraint, projection)
+	if err != nil {
+		return nil, err
+	}
+
+	username := GetAuthenticatedUserFromContext(ctx)
+	if err := waitScheddRateLimit(ctx, username); err != nil {
+		return nil, err
+	}
+
+	// Establish connection using cedar client
+	htcondorClient, err := s.connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+	}
+	if err := sendJobQuery(ctx, htcondorClient.GetStream(), s.address, requestAd, useAuth); err != nil {
+		_ = htcondorClient.Close()
+		return nil, err
+	}
+	return htcondorClient, nil
+}
 
+// sendJobQuery authenticates a query command on cedarStream and sends the
+// request ad
+func sendJobQuery(ctx context.Context, cedarStream *stream.Stream, address string, requestAd *classad.ClassAd, useAuth bool) error {
 	// Determine command
 	cmd := commands.QUERY_JOB_ADS
 	if useAuth {
@@ -118,94 +196,108 @@ func (s *Schedd) queryWithAuth(ctx context.Context, constraint string, projectio
 	}
 
 	// Get SecurityConfig from context, HTCondor config, or defaults
-	secConfig, err := GetSecurityConfigOrDefault(ctx, nil, cmd, "CLIENT", s.address)
+	secConfig, err := GetSecurityConfigOrDefault(ctx, nil, cmd, "CLIENT", address)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create security config: %w", err)
+		return fmt.Errorf("failed to create security config: %w", err)
 	}
 
 	auth := security.NewAuthenticator(secConfig, cedarStream)
-	negotiation, err := auth.ClientHandshake(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("security handshake failed: %w", err)
+	if _, err := auth.ClientHandshake(ctx); err != nil {
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
-	// If username not already set in context, use the authenticated user from handshake
-	if username == "" && negotiation.User != "" {
-		// Update context with authenticated user for potential future use
-		// Note: This doesn't affect rate limiting for this call since we already checked it
-		ctx = WithAuthenticatedUser(ctx, negotiation.User)
-	}
-
-	// Create query request ClassAd
-	requestAd := createJobQueryAd(constraint, projection)
-
 	// Send query
 	queryMsg := message.NewMessageForStream(cedarStream)
-	err = queryMsg.PutClassAd(ctx, requestAd)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize query ClassAd: %w", err)
+	if err := queryMsg.PutClassAd(ctx, requestAd); err != nil {
+		return fmt.Errorf("failed to serialize query ClassAd: %w", err)
+	}
+	if err := queryMsg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to send query: %w", err)
 	}
+	return nil
+}
 
-	err = queryMsg.FinishMessage(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send query: %w", err)
+// waitScheddRateLimit applies the schedd query rate limit, if configured.
+// It waits at most a second so HTTP requests fail fast with 429 rather
+// than block.
+func waitScheddRateLimit(ctx context.Context, username string) error {
+	rateLimitManager := getRateLimitManager()
+	if rateLimitManager == nil {
+		return nil
+	}
+	rateLimitCtx, cancelRateLimit := context.WithTimeout(ctx, 1000*time.Millisecond)
+	defer cancelRateLimit()
+	if err := rateLimitManager.WaitSchedd(rateLimitCtx, username); err != nil {
+		return fmt.Errorf("rate limit exceeded: %w", err)
 	}
+	return nil
+}
 
-	// Receive response ads
+// readQueryAds reads the ads a schedd sends in reply to a job or history
+// query, up to the final ad (Owner == 0) that reports any error
+func readQueryAds(ctx context.Context, cedarStream *stream.Stream) ([]*classad.ClassAd, error) {
 	var jobAds []*classad.ClassAd
-
 	for {
-		// Check for context cancellation
-		select {
-		case <-ctx.Done():
-			return jobAds, ctx.Err()
-		default:
+		ad, err := readQueryAd(ctx, cedarStream)
+		if err != nil || ad == nil {
+			return jobAds, err
 		}
+		jobAds = append(jobAds, ad)
+	}
+}
+
+// readQueryAd reads the next ad of a job or history query's results. It
+// returns nil without an error once the final ad (Owner == 0) reports
+// success.
+func readQueryAd(ctx context.Context, cedarStream *stream.Stream) (*classad.ClassAd, error) {
+	// Check for context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-		// Create a new message for each response ClassAd
-		responseMsg := message.NewMessageFromStream(cedarStream)
+	// Create a new message for each response ClassAd
+	responseMsg := message.NewMessageFromStream(cedarStream)
 
-		// Read ClassAd
-		ad, err := responseMsg.GetClassAd(ctx)
-		if err != nil {
-			return jobAds, fmt.Errorf("failed to read ClassAd: %w", err)
+	// Read ClassAd
+	ad, err := responseMsg.GetClassAd(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Cancelled mid-stream; the read was interrupted by closing the connection
+			return nil, ctx.Err()
 		}
+		return nil, fmt.Errorf("failed to read ClassAd: %w", err)
+	}
 
-		// Check if this is the final ad (Owner == 0)
-		if ownerVal, ok := ad.EvaluateAttrInt("Owner"); ok && ownerVal == 0 {
-			// This is the final ad - check for errors
-			if errCode, ok := ad.EvaluateAttrInt("ErrorCode"); ok && errCode != 0 {
-				errMsg := "unknown error"
-				if errStr, ok := ad.EvaluateAttrString("ErrorString"); ok {
-					errMsg = errStr
-				}
-				return jobAds, fmt.Errorf("schedd query error %d: %s", errCode, errMsg)
+	// Check if this is the final ad (Owner == 0)
+	if ownerVal, ok := ad.EvaluateAttrInt("Owner"); ok && ownerVal == 0 {
+		// This is the final ad - check for errors
+		if errCode, ok := ad.EvaluateAttrInt("ErrorCode"); ok && errCode != 0 {
+			errMsg := "unknown error"
+			if errStr, ok := ad.EvaluateAttrString("ErrorString"); ok {
+				errMsg = errStr
 			}
-			// Success - final ad received (may contain summary information)
-			break
+			return nil, fmt.Errorf("schedd query error %d: %s", errCode, errMsg)
 		}
-
-		// This is a job ad - append to results
-		jobAds = append(jobAds, ad)
+		// Success - final ad received (may contain summary information)
+		return nil, nil
 	}
 
-	return jobAds, nil
+	// This is a job ad
+	return ad, nil
 }
 
 // createJobQueryAd creates a request ClassAd for querying jobs
-func createJobQueryAd(constraint string, projection []string) *classad.ClassAd {
-	ad := classad.New()
-
+func createJobQueryAd(constraint string, projection []string) (*classad.ClassAd, error) {
 	// Set constraint (use "true" if empty)
 	if constraint == "" {
 		constraint = "true"
 	}
-	// Parse constraint as an expression
 	constraintExpr, err := classad.ParseExpr(constraint)
 	if err != nil {
-		// If parsing fails, use a simple "true" expression
-		constraintExpr, _ = classad.ParseExpr("true")
+		return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
 	}
+
+	ad := classad.New()
 	ad.InsertExpr("Requirements", constraintExpr)
 
 	// Set projection (newline-separated list of attributes)
@@ -214,7 +306,7 @@ func createJobQueryAd(constraint string, projection []string) *classad.ClassAd {
 		_ = ad.Set("Projection", projectionStr)
 	}
 
-	return ad
+	return ad, nil
 }
 
 // Submit submits a job to the schedd using an HTCondor submit file
@@ -227,10 +319,10 @@ func (s *Schedd) Submit(ctx context.Context, submitFileContent string) (string,
 		return "", fmt.Errorf("failed to parse submit file: %w", err)
 	}
 
-	// Create QMGMT connection
-	qmgmt, err := NewQmgmtConnection(ctx, s.address)
+	// Create QMGMT connection, owned by the authenticated user
+	qmgmt, err := s.Qmgmt(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+		return "", err
 	}
 	defer func() {
 		if cerr := qmgmt.Close(); cerr != nil && err == nil {
@@ -246,19 +338,6 @@ func (s *Schedd) Submit(ctx context.Context, submitFileContent string) (string,
 		}
 	}()
 
-	// Get authenticated user from the QMGMT connection
-	owner := qmgmt.authenticatedUser
-	if owner == "" {
-		submissionErr = fmt.Errorf("no authenticated user")
-		return "", submissionErr
-	}
-
-	// Set effective owner
-	if err := qmgmt.SetEffectiveOwner(ctx, owner); err != nil {
-		submissionErr = fmt.Errorf("failed to set effective owner: %w", err)
-		return "", submissionErr
-	}
-
 	// Create new cluster
 	clusterID, err := qmgmt.NewCluster(ctx)
 	if err != nil {
@@ -297,6 +376,11 @@ func (s *Schedd) Submit(ctx context.Context, submitFileContent string) (string,
 	return fmt.Sprintf("%d", clusterID), nil
 }
 
+// ErrInvalidSubmitFile is returned (wrapped) by SubmitRemote when the submit
+// file cannot be parsed or its job ads cannot be built, including when
+// SubmitFileOptions.ExecutablePolicy rejects them
+var ErrInvalidSubmitFile = errors.New("invalid submit file")
+
 // SubmitRemote submits jobs to the schedd with remote submission semantics.
 // This method is designed for remote job submission with file spooling support.
 //
@@ -310,16 +394,30 @@ func (s *Schedd) Submit(ctx context.Context, submitFileContent string) (string,
 //
 // The caller should then use SpoolJobFilesFromFS or SpoolJobFilesFromTar to upload input files.
 func (s *Schedd) SubmitRemote(ctx context.Context, submitFileContent string) (clusterID int, procAds []*classad.ClassAd, err error) {
+	return s.SubmitRemoteWithOptions(ctx, submitFileContent, nil)
+}
+
+// SubmitRemoteWithOptions is like SubmitRemote but parses the submit file
+// with opts, e.g. to set the default job lease. opts may be nil.
+func (s *Schedd) SubmitRemoteWithOptions(ctx context.Context, submitFileContent string, opts *SubmitFileOptions) (clusterID int, procAds []*classad.ClassAd, err error) {
 	// Parse the submit file
-	submitFile, err := ParseSubmitFile(strings.NewReader(submitFileContent))
+	submitFile, err := ParseSubmitFileWithOptions(strings.NewReader(submitFileContent), opts)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to parse submit file: %w", err)
+		return 0, nil, fmt.Errorf("%w: %w", ErrInvalidSubmitFile, err)
+	}
+
+	// Build the job ads once before contacting the schedd, so that a submit
+	// file whose ads cannot be built, or that the executable policy rejects,
+	// does not open a cluster. Submit checks the ads actually sent again,
+	// which can differ from this preview (e.g. with $RANDOM_CHOICE).
+	if _, err := submitFile.Submit(0); err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", ErrInvalidSubmitFile, err)
 	}
 
-	// Connect to schedd's queue management interface
-	qmgmt, err := NewQmgmtConnection(ctx, s.address)
+	// Connect to schedd's queue management interface as the authenticated user
+	qmgmt, err := s.Qmgmt(ctx)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+		return 0, nil, err
 	}
 	defer func() {
 		if cerr := qmgmt.Close(); cerr != nil && err == nil {
@@ -335,19 +433,6 @@ func (s *Schedd) SubmitRemote(ctx context.Context, submitFileContent string) (cl
 		}
 	}()
 
-	// Get authenticated user from the QMGMT connection
-	owner := qmgmt.authenticatedUser
-	if owner == "" {
-		submissionErr = fmt.Errorf("no authenticated user")
-		return 0, nil, submissionErr
-	}
-
-	// Set effective owner
-	if err := qmgmt.SetEffectiveOwner(ctx, owner); err != nil {
-		submissionErr = fmt.Errorf("failed to set effective owner: %w", err)
-		return 0, nil, submissionErr
-	}
-
 	// Create new cluster
 	clusterIDInt, err := qmgmt.NewCluster(ctx)
 	if err != nil {
@@ -358,9 +443,12 @@ func (s *Schedd) SubmitRemote(ctx context.Context, submitFileContent string) (cl
 	// Generate job ads from the submit file
 	submitResult, err := submitFile.Submit(clusterIDInt)
 	if err != nil {
-		submissionErr = fmt.Errorf("failed to generate job ads: %w", err)
+		submissionErr = fmt.Errorf("%w: failed to generate job ads: %w", ErrInvalidSubmitFile, err)
 		return 0, nil, submissionErr
 	}
+	for _, warning := range submitResult.Warnings {
+		submitFile.opts.Logger.Warn(logging.DestinationSchedd, "Submit file warning", "cluster_id", clusterIDInt, "warning", warning)
+	}
 
 	// For remote submission, configure job attributes similar to HTCondor's behavior
 	// This mimics what condor_submit does when using the -name option (remote submission)
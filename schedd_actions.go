@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/PelicanPlatform/classad/classad"
-	"github.com/bbockelm/cedar/client"
 	"github.com/bbockelm/cedar/commands"
 	"github.com/bbockelm/cedar/message"
 	"github.com/bbockelm/cedar/security"
@@ -93,6 +92,60 @@ func (s *Schedd) RemoveJobsByID(ctx context.Context, ids []string, reason string
 	return s.actOnJobs(ctx, JA_REMOVE_JOBS, "", ids, reason, "RemoveReason", "", "", AR_TOTALS)
 }
 
+// RemoveJobOptions configures RemoveJob
+type RemoveJobOptions struct {
+	// Reason is the removal reason recorded in the job ad (optional)
+	Reason string
+	// Force removes a running job with a fast shutdown even if it asked for
+	// graceful removal (submit command want_graceful_removal)
+	Force bool
+}
+
+// RemoveJob removes a single job. A running job whose ad sets
+// WantGracefulRemoval is removed gracefully: the schedd gives it the soft
+// kill signal and its shutdown grace period, as it would for a graceful
+// vacate. With opts.Force, WantGracefulRemoval is cleared first so the job
+// is shut down fast; the removal cannot share a transaction with that edit,
+// so if the job is not removed the attribute is set back. A job not in the
+// queue yields an error wrapping ErrJobNotFound.
+func (s *Schedd) RemoveJob(ctx context.Context, jobID JobID, opts *RemoveJobOptions) (*JobActionResults, error) {
+	if opts == nil {
+		opts = &RemoveJobOptions{}
+	}
+
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	ads, err := s.Query(ctx, constraint, []string{"ClusterId", "ProcId", "WantGracefulRemoval"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(ads) == 0 {
+		return nil, fmt.Errorf("%w: %d.%d", ErrJobNotFound, jobID.Cluster, jobID.Proc)
+	}
+
+	// The schedd consults WantGracefulRemoval when it shuts the job down
+	graceful, _ := AdBool(ads[0], "WantGracefulRemoval")
+	cleared := graceful && opts.Force
+	if cleared {
+		if err := s.EditJob(ctx, jobID.Cluster, jobID.Proc, map[string]string{"WantGracefulRemoval": "false"}, nil); err != nil {
+			return nil, fmt.Errorf("failed to clear WantGracefulRemoval of job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+		}
+	}
+
+	results, err := s.actOnJobs(ctx, JA_REMOVE_JOBS, "", []string{fmt.Sprintf("%d.%d", jobID.Cluster, jobID.Proc)}, opts.Reason, "RemoveReason", "", "", AR_TOTALS)
+	if cleared && (err != nil || results.Success == 0) {
+		// The job stays in the queue: put back its graceful removal, even if
+		// ctx was canceled during the removal
+		restoreCtx := context.WithoutCancel(ctx)
+		if rerr := s.EditJob(restoreCtx, jobID.Cluster, jobID.Proc, map[string]string{"WantGracefulRemoval": "true"}, nil); rerr != nil {
+			if err == nil {
+				err = fmt.Errorf("job %d.%d was not removed", jobID.Cluster, jobID.Proc)
+			}
+			return results, fmt.Errorf("%w; failed to restore WantGracefulRemoval: %w", err, rerr)
+		}
+	}
+	return results, err
+}
+
 // HoldJobs holds jobs matching the constraint
 func (s *Schedd) HoldJobs(ctx context.Context, constraint string, reason string) (*JobActionResults, error) {
 	if constraint == "" {
@@ -135,7 +188,7 @@ func (s *Schedd) actOnJobs(
 	}
 
 	// Connect to schedd using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, s.address)
+	htcondorClient, err := s.connect(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to schedd: %w", err)
 	}
@@ -156,7 +209,7 @@ func (s *Schedd) actOnJobs(
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("security handshake failed: %w", err)
+		return nil, fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// Build command ClassAd
@@ -2,9 +2,14 @@ package htcondor
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
 )
 
 // TestJobActionConstants verifies job action constants are defined
@@ -111,3 +116,152 @@ func TestActOnJobsValidation(t *testing.T) {
 		t.Errorf("Unexpected error message: %v", err)
 	}
 }
+
+// serveRemove serves an ACT_ON_JOBS request, reporting one job acted on;
+// it calls seen with the command ad
+func serveRemove(ctx context.Context, s *stream.Stream, seen func(*classad.ClassAd)) error {
+	if err := serverHandshake(ctx, s); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	cmdAd, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+	if err != nil {
+		return fmt.Errorf("command ad: %w", err)
+	}
+	seen(cmdAd)
+	resultAd := classad.New()
+	_ = resultAd.Set("ActionResult", int64(1))
+	_ = resultAd.Set("result_total_1", int64(1))
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, resultAd) }); err != nil {
+		return err
+	}
+	if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+		return fmt.Errorf("acknowledgment: %w", err)
+	}
+	return sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) })
+}
+
+// TestRemoveJobGraceful verifies a job that wants graceful removal keeps
+// WantGracefulRemoval when removed, unless the removal is forced
+func TestRemoveJobGraceful(t *testing.T) {
+	for _, force := range []bool{false, true} {
+		t.Run(fmt.Sprintf("force=%v", force), func(t *testing.T) {
+			jobID := JobID{Cluster: 42, Proc: 0}
+			jobAd := classad.New()
+			_ = jobAd.Set("ClusterId", int64(42))
+			_ = jobAd.Set("ProcId", int64(0))
+			_ = jobAd.Set("WantGracefulRemoval", true)
+			queue := newFakeQueue()
+			queue.jobs[jobID] = map[string]string{"WantGracefulRemoval": "true"}
+
+			var gracefulAtRemoval string
+			var cmdAd *classad.ClassAd
+			var conns atomic.Int32
+			transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+				switch n := conns.Add(1); {
+				case n == 1:
+					return serveJobQuery(ctx, s, []*classad.ClassAd{jobAd})
+				case n == 2 && force:
+					return queue.serve(ctx, s)
+				default:
+					return serveRemove(ctx, s, func(ad *classad.ClassAd) {
+						cmdAd = ad
+						gracefulAtRemoval = queue.jobs[jobID]["WantGracefulRemoval"]
+					})
+				}
+			})
+			schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+			ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+			defer cancel()
+
+			results, err := schedd.RemoveJob(ctx, jobID, &RemoveJobOptions{Reason: "done", Force: force})
+			if err != nil {
+				t.Fatalf("RemoveJob failed: %v", err)
+			}
+			for range conns.Load() {
+				if err := <-transport.errCh; err != nil {
+					t.Fatalf("Scripted server failed: %v", err)
+				}
+			}
+
+			if results.Success != 1 {
+				t.Errorf("Expected 1 job removed, got %+v", results)
+			}
+			if action, _ := AdInt(cmdAd, "JobAction"); JobAction(action) != JA_REMOVE_JOBS {
+				t.Errorf("Expected JA_REMOVE_JOBS, got %d", action)
+			}
+			if ids, _ := AdString(cmdAd, "ActionIds"); ids != "42.0" {
+				t.Errorf("Expected ActionIds 42.0, got %q", ids)
+			}
+			if reason, _ := AdString(cmdAd, "RemoveReason"); reason != "done" {
+				t.Errorf("Expected RemoveReason done, got %q", reason)
+			}
+			want := "true"
+			if force {
+				want = "false"
+			}
+			if gracefulAtRemoval != want {
+				t.Errorf("Expected WantGracefulRemoval = %s when removed, got %q", want, gracefulAtRemoval)
+			}
+		})
+	}
+}
+
+// TestRemoveJobForceRestoresGraceful verifies a forced removal that fails
+// sets WantGracefulRemoval back
+func TestRemoveJobForceRestoresGraceful(t *testing.T) {
+	jobID := JobID{Cluster: 42, Proc: 0}
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", int64(42))
+	_ = jobAd.Set("ProcId", int64(0))
+	_ = jobAd.Set("WantGracefulRemoval", true)
+	queue := newFakeQueue()
+	queue.jobs[jobID] = map[string]string{"WantGracefulRemoval": "true"}
+
+	var gracefulAtRemoval string
+	var conns atomic.Int32
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		switch conns.Add(1) {
+		case 1:
+			return serveJobQuery(ctx, s, []*classad.ClassAd{jobAd})
+		case 3:
+			// The schedd refuses the removal
+			if err := serverHandshake(ctx, s); err != nil {
+				return fmt.Errorf("handshake: %w", err)
+			}
+			if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+				return fmt.Errorf("command ad: %w", err)
+			}
+			gracefulAtRemoval = queue.jobs[jobID]["WantGracefulRemoval"]
+			resultAd := classad.New()
+			_ = resultAd.Set("ActionResult", int64(0))
+			_ = resultAd.Set("result_total_5", int64(1))
+			return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, resultAd) })
+		default:
+			return queue.serve(ctx, s)
+		}
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	if _, err := schedd.RemoveJob(ctx, jobID, &RemoveJobOptions{Force: true}); err == nil {
+		t.Fatal("Expected RemoveJob to fail")
+	}
+	for range conns.Load() {
+		if err := <-transport.errCh; err != nil {
+			t.Fatalf("Scripted server failed: %v", err)
+		}
+	}
+
+	if n := conns.Load(); n != 4 {
+		t.Errorf("Expected query, edit, removal and restore connections, got %d", n)
+	}
+	if gracefulAtRemoval != "false" {
+		t.Errorf("Expected WantGracefulRemoval cleared for the removal, got %q", gracefulAtRemoval)
+	}
+	if got := queue.jobs[jobID]["WantGracefulRemoval"]; got != "true" {
+		t.Errorf("Expected WantGracefulRemoval restored, got %q", got)
+	}
+}
@@ -0,0 +1,477 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/ast"
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/PelicanPlatform/classad/parser"
+)
+
+// MatchAnalysis is a structured equivalent of `condor_q -better-analyze` for a single job.
+// It reports how many machines satisfy each clause of the job's Requirements expression.
+type MatchAnalysis struct {
+	JobID        JobID  `json:"job_id"`
+	Requirements string `json:"requirements"`
+	// TotalMachines is the number of machine ads considered
+	TotalMachines int `json:"total_machines"`
+	// MatchingMachines is the number of machines satisfying the job's full Requirements
+	MatchingMachines int `json:"matching_machines"`
+	// MutualMatches is the number of machines where the job's Requirements and the
+	// machine's own Requirements (its START policy) are both satisfied
+	MutualMatches int `json:"mutual_matches"`
+	// Conditions has one entry per top-level && clause of Requirements, in order
+	Conditions []ConditionAnalysis `json:"conditions"`
+}
+
+// ConditionAnalysis reports how one clause of the job's Requirements fares against the pool
+type ConditionAnalysis struct {
+	Expression string `json:"expression"`
+	// Matching is the number of machines for which the clause evaluates to true
+	Matching int `json:"matching"`
+	// Failing is the number of machines for which the clause is false, undefined, or an error
+	Failing int `json:"failing"`
+	// Suggestion describes how the clause could be relaxed, if it rejects every machine
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// AnalyzeJob fetches a job ad from the schedd and the machine ads from the collector,
+// and reports which parts of the job's Requirements prevent it from matching.
+func (s *Schedd) AnalyzeJob(ctx context.Context, jobID JobID, collector *Collector) (*MatchAnalysis, error) {
+	if collector == nil {
+		return nil, fmt.Errorf("collector is required for match analysis")
+	}
+
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	jobAds, err := s.Query(ctx, constraint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(jobAds) == 0 {
+		return nil, fmt.Errorf("job %d.%d not found", jobID.Cluster, jobID.Proc)
+	}
+
+	machineAds, candidates, err := queryMachineAds(ctx, collector, jobAds[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to query machine ads: %w", err)
+	}
+
+	analysis, err := analyzeMatch(jobAds[0], machineAds, candidates)
+	if err != nil {
+		return nil, err
+	}
+	analysis.JobID = jobID
+	return analysis, nil
+}
+
+// AnalyzeMatch evaluates a job ad's Requirements against a set of machine ads.
+// The job ID in the result is taken from the job ad's ClusterId and ProcId, if present.
+func AnalyzeMatch(jobAd *classad.ClassAd, machineAds []*classad.ClassAd) (*MatchAnalysis, error) {
+	return analyzeMatch(jobAd, machineAds, machineAds)
+}
+
+// queryMachineAds fetches the machine ads analyzeMatch needs for jobAd:
+// every slot, projected to the attributes the job's Requirements read, and
+// the full ads of the slots satisfying the Requirements, whose own
+// Requirements decide the mutual matches. The Requirements are pushed down
+// to the collector as the candidates' constraint; when they cannot be, every
+// full slot ad is fetched once and serves as both.
+func queryMachineAds(ctx context.Context, collector *Collector, jobAd *classad.ClassAd) (machineAds, candidates []*classad.ClassAd, err error) {
+	constraint, projection := machineQuery(jobAd)
+	candidates, err = collector.QueryAds(ctx, "StartdAd", constraint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if constraint == "" {
+		return candidates, candidates, nil
+	}
+	machineAds, err = collector.QueryAdsWithProjection(ctx, "StartdAd", "", projection)
+	if err != nil {
+		return nil, nil, err
+	}
+	return machineAds, candidates, nil
+}
+
+// machineQuery returns a collector constraint selecting the machines that
+// satisfy jobAd's Requirements, and the machine attributes the Requirements
+// read, directly or through job attributes. The constraint is empty when the
+// Requirements cannot be rewritten for the collector, e.g. when they use a
+// job attribute that does not evaluate on its own.
+func machineQuery(jobAd *classad.ClassAd) (constraint string, projection []string) {
+	reqExpr, ok := jobAd.Lookup("Requirements")
+	if !ok {
+		return "", nil
+	}
+	node, err := parseExprNode(reqExpr.String())
+	if err != nil {
+		return "", nil
+	}
+
+	attrs := map[string]bool{"Name": true}
+	machineRefs(node, jobAd, attrs, map[string]bool{})
+	projection = make([]string, 0, len(attrs))
+	for attr := range attrs {
+		projection = append(projection, attr)
+	}
+	sort.Strings(projection)
+
+	// machineRefs only reads node; rewriting needs a fresh copy
+	node, _ = parseExprNode(reqExpr.String())
+	return collectorConstraint(node, jobAd), projection
+}
+
+// machineRefs adds to attrs the machine attributes e reads: TARGET
+// references and unscoped references the job ad does not define, following
+// the job attributes e uses
+func machineRefs(e ast.Expr, jobAd *classad.ClassAd, attrs, visited map[string]bool) {
+	walkExprNode(e, func(ref *ast.AttributeReference) bool {
+		switch ref.Scope {
+		case ast.TargetScope:
+			attrs[ref.Name] = true
+		case ast.MyScope, ast.NoScope:
+			expr, defined := jobAd.Lookup(ref.Name)
+			if !defined {
+				if ref.Scope == ast.NoScope {
+					attrs[ref.Name] = true
+				}
+				break
+			}
+			key := strings.ToLower(ref.Name)
+			if visited[key] {
+				break
+			}
+			visited[key] = true
+			if node, err := parseExprNode(expr.String()); err == nil {
+				machineRefs(node, jobAd, attrs, visited)
+			}
+		}
+		return true
+	})
+}
+
+// collectorConstraint rewrites the job Requirements e as a constraint on
+// machine ads: TARGET references become the machine's own attributes and
+// job attributes are replaced with their values. It returns "" if e cannot
+// be rewritten faithfully. e is modified.
+func collectorConstraint(e ast.Expr, jobAd *classad.ClassAd) string {
+	ok := walkExprNode(e, func(ref *ast.AttributeReference) bool {
+		_, defined := jobAd.Lookup(ref.Name)
+		switch ref.Scope {
+		case ast.TargetScope:
+			// Flattening would replace it with the job's attribute
+			if defined {
+				return false
+			}
+			ref.Scope = ast.NoScope
+		case ast.MyScope:
+			if !defined {
+				return false
+			}
+			ref.Scope = ast.NoScope
+		case ast.ParentScope:
+			return false
+		}
+		if defined {
+			// A job attribute that needs the machine to evaluate cannot be
+			// replaced by its value
+			value := jobAd.EvaluateAttr(ref.Name)
+			if value.IsUndefined() || value.IsError() {
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return ""
+	}
+	expr, err := classad.ParseExpr(e.String())
+	if err != nil {
+		return ""
+	}
+	return jobAd.Flatten(expr).String()
+}
+
+// walkExprNode calls visit for every attribute reference in e, stopping
+// early and returning false once visit does
+func walkExprNode(e ast.Expr, visit func(ref *ast.AttributeReference) bool) bool {
+	walk := func(exprs ...ast.Expr) bool {
+		for _, expr := range exprs {
+			if expr != nil && !walkExprNode(expr, visit) {
+				return false
+			}
+		}
+		return true
+	}
+	switch v := e.(type) {
+	case *ast.AttributeReference:
+		return visit(v)
+	case *ast.BinaryOp:
+		return walk(v.Left, v.Right)
+	case *ast.UnaryOp:
+		return walk(v.Expr)
+	case *ast.ConditionalExpr:
+		return walk(v.Condition, v.TrueExpr, v.FalseExpr)
+	case *ast.ElvisExpr:
+		return walk(v.Left, v.Right)
+	case *ast.FunctionCall:
+		return walk(v.Args...)
+	case *ast.ListLiteral:
+		return walk(v.Elements...)
+	case *ast.SelectExpr:
+		return walk(v.Record)
+	case *ast.SubscriptExpr:
+		return walk(v.Container, v.Index)
+	case *ast.ClassAd:
+		for _, attr := range v.Attributes {
+			if !walk(attr.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// analyzeMatch is AnalyzeMatch with the mutual matches counted among
+// candidates, full machine ads that include every machine satisfying the
+// job's Requirements; machineAds need only hold the attributes the
+// Requirements read
+func analyzeMatch(jobAd *classad.ClassAd, machineAds, candidates []*classad.ClassAd) (*MatchAnalysis, error) {
+	analysis := &MatchAnalysis{
+		TotalMachines: len(machineAds),
+		Conditions:    []ConditionAnalysis{},
+	}
+	if cluster, ok := jobAd.EvaluateAttrInt("ClusterId"); ok {
+		analysis.JobID.Cluster = int(cluster)
+	}
+	if proc, ok := jobAd.EvaluateAttrInt("ProcId"); ok {
+		analysis.JobID.Proc = int(proc)
+	}
+
+	reqExpr, ok := jobAd.Lookup("Requirements")
+	if !ok {
+		// No requirements: every machine matches on the job side
+		analysis.Requirements = "true"
+		analysis.MatchingMachines = len(machineAds)
+		for _, machine := range candidates {
+			if machineAccepts(machine, jobAd) {
+				analysis.MutualMatches++
+			}
+		}
+		return analysis, nil
+	}
+	analysis.Requirements = reqExpr.String()
+
+	clauses, err := splitConjuncts(analysis.Requirements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse job Requirements: %w", err)
+	}
+
+	// Evaluate each clause and the full expression against every machine
+	type clauseState struct {
+		node ast.Expr
+		expr *classad.Expr
+	}
+	states := make([]clauseState, 0, len(clauses))
+	for _, clause := range clauses {
+		expr, err := classad.ParseExpr(clause.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse requirement clause %q: %w", clause.String(), err)
+		}
+		states = append(states, clauseState{node: clause, expr: expr})
+		analysis.Conditions = append(analysis.Conditions, ConditionAnalysis{Expression: clause.String()})
+	}
+
+	for _, machine := range machineAds {
+		for i, state := range states {
+			if evalTrue(state.expr, jobAd, machine) {
+				analysis.Conditions[i].Matching++
+			} else {
+				analysis.Conditions[i].Failing++
+			}
+		}
+		if evalTrue(reqExpr, jobAd, machine) {
+			analysis.MatchingMachines++
+		}
+	}
+	for _, machine := range candidates {
+		if evalTrue(reqExpr, jobAd, machine) && machineAccepts(machine, jobAd) {
+			analysis.MutualMatches++
+		}
+	}
+
+	// Suggest relaxations for clauses that reject the whole pool
+	if len(machineAds) > 0 {
+		for i, state := range states {
+			if analysis.Conditions[i].Matching == 0 {
+				analysis.Conditions[i].Suggestion = suggestRelaxation(state.node, jobAd, machineAds)
+			}
+		}
+	}
+
+	return analysis, nil
+}
+
+// machineAccepts reports whether the machine's own Requirements accept the job
+func machineAccepts(machine, jobAd *classad.ClassAd) bool {
+	req, ok := machine.Lookup("Requirements")
+	if !ok {
+		return true
+	}
+	return evalTrue(req, machine, jobAd)
+}
+
+// evalTrue evaluates expr with the given MY and TARGET ads and reports whether it is true
+func evalTrue(expr *classad.Expr, scope, target *classad.ClassAd) bool {
+	val := expr.EvalWithContext(scope, target)
+	if !val.IsBool() {
+		return false
+	}
+	b, err := val.BoolValue()
+	return err == nil && b
+}
+
+// parseExprNode parses an expression into its syntax tree
+func parseExprNode(expr string) (ast.Expr, error) {
+	node, err := parser.Parse(fmt.Sprintf("[__expr__ = %s]", expr))
+	if err != nil {
+		return nil, err
+	}
+	ad, ok := node.(*ast.ClassAd)
+	if !ok || len(ad.Attributes) != 1 {
+		return nil, fmt.Errorf("unable to extract expression from %q", expr)
+	}
+	return ad.Attributes[0].Value, nil
+}
+
+// splitConjuncts parses an expression and returns its top-level && clauses
+func splitConjuncts(expr string) ([]ast.Expr, error) {
+	node, err := parseExprNode(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var clauses []ast.Expr
+	var walk func(e ast.Expr)
+	walk = func(e ast.Expr) {
+		if op, ok := e.(*ast.BinaryOp); ok && op.Op == "&&" {
+			walk(op.Left)
+			walk(op.Right)
+			return
+		}
+		clauses = append(clauses, e)
+	}
+	walk(node)
+	return clauses, nil
+}
+
+// suggestRelaxation describes how a clause that rejects every machine could be relaxed.
+// For numeric comparisons between a machine attribute and a job value it reports the
+// best value available in the pool; otherwise it suggests removing the clause.
+func suggestRelaxation(clause ast.Expr, jobAd *classad.ClassAd, machineAds []*classad.ClassAd) string {
+	op, ok := clause.(*ast.BinaryOp)
+	if !ok {
+		return fmt.Sprintf("no machine satisfies %s; consider removing it", clause.String())
+	}
+
+	// Normalize to "TARGET.attr <op> jobExpr"
+	machineSide, jobSide, cmp := op.Left, op.Right, op.Op
+	if _, ok := machineAttr(machineSide, jobAd); !ok {
+		machineSide, jobSide = op.Right, op.Left
+		cmp = flipComparison(cmp)
+	}
+	attr, ok := machineAttr(machineSide, jobAd)
+	if !ok || cmp == "" {
+		return fmt.Sprintf("no machine satisfies %s; consider removing it", clause.String())
+	}
+
+	jobExpr, err := classad.ParseExpr(jobSide.String())
+	if err != nil {
+		return fmt.Sprintf("no machine satisfies %s; consider removing it", clause.String())
+	}
+	required, err := jobExpr.Eval(jobAd).NumberValue()
+	if err != nil {
+		return fmt.Sprintf("no machine satisfies %s; consider removing it", clause.String())
+	}
+
+	// Find the most permissive value of the machine attribute in the pool
+	var best float64
+	found := false
+	for _, machine := range machineAds {
+		v, ok := machine.EvaluateAttrNumber(attr)
+		if !ok {
+			continue
+		}
+		switch cmp {
+		case ">=", ">":
+			if !found || v > best {
+				best = v
+			}
+		case "<=", "<":
+			if !found || v < best {
+				best = v
+			}
+		default:
+			return fmt.Sprintf("no machine satisfies %s; consider removing it", clause.String())
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Sprintf("no machine defines %s; consider removing %s", attr, clause.String())
+	}
+
+	what := strings.TrimSpace(jobSide.String())
+	switch cmp {
+	case ">=", ">":
+		return fmt.Sprintf("the largest %s in the pool is %s but the job requires %s; lower %s to at most %s",
+			attr, formatNumber(best), formatNumber(required), what, formatNumber(best))
+	default:
+		return fmt.Sprintf("the smallest %s in the pool is %s but the job requires at most %s; raise %s to at least %s",
+			attr, formatNumber(best), formatNumber(required), what, formatNumber(best))
+	}
+}
+
+// machineAttr returns the attribute name if e refers to a machine attribute:
+// either TARGET.attr, or an unscoped attr that the job ad does not define
+func machineAttr(e ast.Expr, jobAd *classad.ClassAd) (string, bool) {
+	ref, ok := e.(*ast.AttributeReference)
+	if !ok {
+		return "", false
+	}
+	switch ref.Scope {
+	case ast.TargetScope:
+		return ref.Name, true
+	case ast.NoScope:
+		if _, defined := jobAd.Lookup(ref.Name); !defined {
+			return ref.Name, true
+		}
+	}
+	return "", false
+}
+
+// flipComparison returns the comparison operator with its operands swapped,
+// or "" if op is not an ordering comparison
+func flipComparison(op string) string {
+	switch op {
+	case ">=":
+		return "<="
+	case ">":
+		return "<"
+	case "<=":
+		return ">="
+	case "<":
+		return ">"
+	default:
+		return ""
+	}
+}
+
+// formatNumber formats a float without a trailing ".0" for whole numbers
+func formatNumber(f float64) string {
+	if f == float64(int64(f)) {
+		return fmt.Sprintf("%d", int64(f))
+	}
+	return fmt.Sprintf("%g", f)
+}
@@ -0,0 +1,165 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func makeMachineAd(t *testing.T, name string, memory, cpus int64) *classad.ClassAd {
+	t.Helper()
+	ad := makeStartdAd(name, "Unclaimed")
+	_ = ad.Set("Memory", memory)
+	_ = ad.Set("Cpus", cpus)
+	_ = ad.Set("OpSys", "LINUX")
+	return ad
+}
+
+func TestAnalyzeMatchInsufficientMemory(t *testing.T) {
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", int64(12))
+	_ = jobAd.Set("ProcId", int64(3))
+	_ = jobAd.Set("RequestMemory", int64(4096))
+	_ = jobAd.Set("RequestCpus", int64(1))
+	req, err := classad.ParseExpr(`TARGET.OpSys == "LINUX" && TARGET.Memory >= RequestMemory && TARGET.Cpus >= RequestCpus`)
+	if err != nil {
+		t.Fatalf("Failed to parse requirements: %v", err)
+	}
+	jobAd.InsertExpr("Requirements", req)
+
+	machines := []*classad.ClassAd{
+		makeMachineAd(t, "slot1@host1", 2048, 4),
+		makeMachineAd(t, "slot1@host2", 1024, 2),
+	}
+
+	analysis, err := AnalyzeMatch(jobAd, machines)
+	if err != nil {
+		t.Fatalf("AnalyzeMatch failed: %v", err)
+	}
+
+	if analysis.JobID.Cluster != 12 || analysis.JobID.Proc != 3 {
+		t.Errorf("Expected job 12.3, got %d.%d", analysis.JobID.Cluster, analysis.JobID.Proc)
+	}
+	if analysis.TotalMachines != 2 {
+		t.Errorf("Expected 2 machines, got %d", analysis.TotalMachines)
+	}
+	if analysis.MatchingMachines != 0 {
+		t.Errorf("Expected 0 matching machines, got %d", analysis.MatchingMachines)
+	}
+	if len(analysis.Conditions) != 3 {
+		t.Fatalf("Expected 3 conditions, got %d: %+v", len(analysis.Conditions), analysis.Conditions)
+	}
+
+	for i, want := range []struct {
+		matching, failing int
+	}{{2, 0}, {0, 2}, {2, 0}} {
+		cond := analysis.Conditions[i]
+		if cond.Matching != want.matching || cond.Failing != want.failing {
+			t.Errorf("Condition %d (%s): expected %d/%d matching/failing, got %d/%d",
+				i, cond.Expression, want.matching, want.failing, cond.Matching, cond.Failing)
+		}
+	}
+
+	memory := analysis.Conditions[1]
+	if !strings.Contains(memory.Expression, "Memory") {
+		t.Errorf("Expected memory condition, got %s", memory.Expression)
+	}
+	if !strings.Contains(memory.Suggestion, "RequestMemory") || !strings.Contains(memory.Suggestion, "2048") {
+		t.Errorf("Expected suggestion to lower RequestMemory to 2048, got %q", memory.Suggestion)
+	}
+	if analysis.Conditions[0].Suggestion != "" || analysis.Conditions[2].Suggestion != "" {
+		t.Errorf("Expected no suggestions for satisfiable conditions, got %+v", analysis.Conditions)
+	}
+}
+
+func TestAnalyzeMatchMachineRequirements(t *testing.T) {
+	jobAd := classad.New()
+	_ = jobAd.Set("Owner", "alice")
+	_ = jobAd.Set("RequestMemory", int64(512))
+	req, err := classad.ParseExpr(`TARGET.Memory >= RequestMemory`)
+	if err != nil {
+		t.Fatalf("Failed to parse requirements: %v", err)
+	}
+	jobAd.InsertExpr("Requirements", req)
+
+	// The second machine only runs jobs from bob
+	open := makeMachineAd(t, "slot1@host1", 2048, 1)
+	restricted := makeMachineAd(t, "slot1@host2", 2048, 1)
+	start, err := classad.ParseExpr(`TARGET.Owner == "bob"`)
+	if err != nil {
+		t.Fatalf("Failed to parse machine requirements: %v", err)
+	}
+	restricted.InsertExpr("Requirements", start)
+
+	analysis, err := AnalyzeMatch(jobAd, []*classad.ClassAd{open, restricted})
+	if err != nil {
+		t.Fatalf("AnalyzeMatch failed: %v", err)
+	}
+	if analysis.MatchingMachines != 2 {
+		t.Errorf("Expected 2 machines matching the job's requirements, got %d", analysis.MatchingMachines)
+	}
+	if analysis.MutualMatches != 1 {
+		t.Errorf("Expected 1 mutual match, got %d", analysis.MutualMatches)
+	}
+}
+
+// TestMachineQuery verifies the job's Requirements are rewritten as a
+// collector constraint on machine ads, with a projection of the machine
+// attributes they read
+func TestMachineQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		requirements   string
+		wantConstraint string
+		wantProjection []string
+	}{
+		{
+			name:           "target and job attributes",
+			requirements:   `TARGET.OpSys == "LINUX" && TARGET.Memory >= RequestMemory && MY.RequestCpus <= Cpus`,
+			wantConstraint: `(((OpSys == "LINUX") && (Memory >= 2048)) && (1 <= Cpus))`,
+			wantProjection: []string{"Cpus", "Memory", "Name", "OpSys"},
+		},
+		{
+			name:           "job attribute expression",
+			requirements:   `TARGET.Disk >= RequestDisk`,
+			wantConstraint: `(Disk >= 20)`,
+			wantProjection: []string{"Disk", "Name"},
+		},
+		{
+			name:           "job attribute read from the machine",
+			requirements:   `TARGET.Memory >= MemoryNeeded`,
+			wantConstraint: "",
+			wantProjection: []string{"Memory", "MemoryProvisioned", "Name"},
+		},
+		{
+			name:           "undefined job attribute",
+			requirements:   `TARGET.Memory >= MY.Missing`,
+			wantConstraint: "",
+			wantProjection: []string{"Memory", "Name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobAd, err := classad.Parse(`[RequestMemory = 2048; RequestCpus = 1; DiskUsage = 10; RequestDisk = DiskUsage * 2;
+				MemoryNeeded = TARGET.MemoryProvisioned]`)
+			if err != nil {
+				t.Fatalf("Failed to parse job ad: %v", err)
+			}
+			req, err := classad.ParseExpr(tt.requirements)
+			if err != nil {
+				t.Fatalf("Failed to parse requirements: %v", err)
+			}
+			jobAd.InsertExpr("Requirements", req)
+
+			constraint, projection := machineQuery(jobAd)
+			if constraint != tt.wantConstraint {
+				t.Errorf("Expected constraint %q, got %q", tt.wantConstraint, constraint)
+			}
+			if strings.Join(projection, ",") != strings.Join(tt.wantProjection, ",") {
+				t.Errorf("Expected projection %v, got %v", tt.wantProjection, projection)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// DefaultChirpAttrPrefix is the attribute name prefix used when GetJobChirpAttrs
+// is called without one. It matches the default CHIRP_DELAYED_UPDATE_PREFIX, the
+// namespace the starter allows for delayed chirp updates.
+const DefaultChirpAttrPrefix = "Chirp"
+
+// GetJobChirpAttrs returns the attributes of a job whose names start with prefix
+// (case-insensitively), for reading progress values a running job publishes with
+// chirp (condor_chirp set_job_attr or set_job_attr_delayed).
+//
+// Chirp updates are ordinary job ad attributes, so Query also returns them; this
+// is a convenience for picking them out. If prefix is empty, DefaultChirpAttrPrefix
+// is used. The returned ad is empty if the job has no matching attributes.
+func (s *Schedd) GetJobChirpAttrs(ctx context.Context, jobID JobID, prefix string) (*classad.ClassAd, error) {
+	if prefix == "" {
+		prefix = DefaultChirpAttrPrefix
+	}
+
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	jobAds, err := s.Query(ctx, constraint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(jobAds) == 0 {
+		return nil, fmt.Errorf("job %d.%d not found", jobID.Cluster, jobID.Proc)
+	}
+
+	return filterAttrsByPrefix(jobAds[0], prefix), nil
+}
+
+// filterAttrsByPrefix returns a new ad holding the attributes of ad whose
+// names start with prefix, compared case-insensitively as ClassAd names are
+func filterAttrsByPrefix(ad *classad.ClassAd, prefix string) *classad.ClassAd {
+	filtered := classad.New()
+	lowerPrefix := strings.ToLower(prefix)
+	for _, name := range ad.GetAttributes() {
+		if !strings.HasPrefix(strings.ToLower(name), lowerPrefix) {
+			continue
+		}
+		if expr, ok := ad.Lookup(name); ok {
+			filtered.InsertExpr(name, expr)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,91 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// serveJobQuery replays the server side of a schedd job query
+func serveJobQuery(ctx context.Context, s *stream.Stream, ads []*classad.ClassAd) error {
+	if err := serverHandshake(ctx, s); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+		return fmt.Errorf("query ad: %w", err)
+	}
+	for _, ad := range ads {
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, ad) }); err != nil {
+			return fmt.Errorf("job ad: %w", err)
+		}
+	}
+	final := classad.New()
+	_ = final.Set("Owner", int64(0))
+	return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, final) })
+}
+
+func TestGetJobChirpAttrs(t *testing.T) {
+	// A running job that has chirped its progress (simulated by editing the ad)
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", int64(42))
+	_ = jobAd.Set("ProcId", int64(0))
+	_ = jobAd.Set("Owner", "alice")
+	_ = jobAd.Set("JobStatus", int64(2))
+	_ = jobAd.Set("JobProgress", int64(75))
+	_ = jobAd.Set("ChirpEvents", int64(3))
+
+	ctx := testSecurityContext(context.Background())
+
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		return serveJobQuery(ctx, s, []*classad.ClassAd{jobAd})
+	})
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	// Query returns chirp-updated attributes like any other
+	ads, err := schedd.Query(ctx, "ClusterId == 42", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if progress, ok := ads[0].EvaluateAttrInt("JobProgress"); !ok || progress != 75 {
+		t.Errorf("Expected JobProgress 75 from Query, got %d (ok=%v)", progress, ok)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	attrs, err := schedd.GetJobChirpAttrs(ctx, JobID{Cluster: 42, Proc: 0}, "jobprogress")
+	if err != nil {
+		t.Fatalf("GetJobChirpAttrs failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if attrs.Size() != 1 {
+		t.Errorf("Expected 1 attribute, got %d: %s", attrs.Size(), attrs.String())
+	}
+	if progress, ok := attrs.EvaluateAttrInt("JobProgress"); !ok || progress != 75 {
+		t.Errorf("Expected JobProgress 75, got %d (ok=%v)", progress, ok)
+	}
+}
+
+func TestFilterAttrsByPrefix(t *testing.T) {
+	ad := classad.New()
+	_ = ad.Set("Owner", "alice")
+	_ = ad.Set("ChirpEvents", int64(3))
+	_ = ad.Set("chirpStage", "analysis")
+
+	filtered := filterAttrsByPrefix(ad, DefaultChirpAttrPrefix)
+	if filtered.Size() != 2 {
+		t.Fatalf("Expected 2 attributes, got %d: %s", filtered.Size(), filtered.String())
+	}
+	if _, ok := filtered.Lookup("Owner"); ok {
+		t.Error("Expected Owner to be filtered out")
+	}
+	if stage, ok := filtered.EvaluateAttrString("chirpStage"); !ok || stage != "analysis" {
+		t.Errorf("Expected chirpStage 'analysis', got %q (ok=%v)", stage, ok)
+	}
+}
@@ -0,0 +1,86 @@
+package htcondor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// DefaultCondorQPath is the condor_q binary used by QueryCLI when no path is given
+const DefaultCondorQPath = "condor_q"
+
+// QueryCLI queries the schedd for job advertisements by running `condor_q -json`.
+// This is a degraded-mode fallback for hosts where the condor_q binary is available
+// but the CEDAR query is not permitted for the caller's identity. The command runs
+// with the identity of the current process, not the identity in ctx.
+//
+// condorQPath is the condor_q binary to run (DefaultCondorQPath if empty)
+// constraint is a ClassAd constraint expression (use "true" to get all jobs)
+// projection is a list of attributes to return (use nil to get all attributes)
+func (s *Schedd) QueryCLI(ctx context.Context, condorQPath string, constraint string, projection []string) ([]*classad.ClassAd, error) {
+	if condorQPath == "" {
+		condorQPath = DefaultCondorQPath
+	}
+
+	// #nosec G204 -- binary path comes from server configuration and arguments are passed without a shell
+	cmd := exec.CommandContext(ctx, condorQPath, condorQArgs(s.name, constraint, projection)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run %s: %w (stderr: %s)", condorQPath, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return ParseCondorQJSON(&stdout)
+}
+
+// condorQArgs builds the condor_q command line for a JSON job query
+// If name is empty, condor_q queries the local schedd.
+func condorQArgs(name, constraint string, projection []string) []string {
+	args := []string{"-json", "-allusers"}
+	if name != "" {
+		args = append(args, "-name", name)
+	}
+	if constraint != "" {
+		args = append(args, "-constraint", constraint)
+	}
+	if len(projection) > 0 {
+		args = append(args, "-attributes", strings.Join(projection, ","))
+	}
+	return args
+}
+
+// ParseCondorQJSON parses the output of `condor_q -json` into ClassAds.
+// condor_q prints nothing at all when no jobs match, so empty input yields an empty slice.
+// Expression-valued attributes encoded as "\/Expr(...)\/" are parsed as expressions.
+func ParseCondorQJSON(r io.Reader) ([]*classad.ClassAd, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read condor_q output: %w", err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return []*classad.ClassAd{}, nil
+	}
+
+	var ads []*classad.ClassAd
+	if err := json.Unmarshal(data, &ads); err != nil {
+		return nil, fmt.Errorf("failed to parse condor_q JSON output: %w", err)
+	}
+
+	// Drop null entries so callers never see a nil ad
+	result := make([]*classad.ClassAd, 0, len(ads))
+	for _, ad := range ads {
+		if ad != nil {
+			result = append(result, ad)
+		}
+	}
+	return result, nil
+}
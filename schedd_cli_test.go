@@ -0,0 +1,168 @@
+package htcondor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// condorQJSONFixture is representative `condor_q -json` output for two jobs
+const condorQJSONFixture = `[
+{
+  "ClusterId": 42,
+  "ProcId": 0,
+  "Owner": "alice",
+  "JobStatus": 1,
+  "Cmd": "/bin/sleep",
+  "Args": "60",
+  "RequestMemory": "\/Expr(ifthenelse(MemoryUsage =!= undefined,MemoryUsage,(ImageSize + 1023) / 1024))\/",
+  "QDate": 1700000000,
+  "WantCheckpoint": false,
+  "JobPrio": 0,
+  "CpusProvisioned": null
+}
+,
+{
+  "ClusterId": 42,
+  "ProcId": 1,
+  "Owner": "alice",
+  "JobStatus": 2,
+  "Cmd": "/bin/sleep",
+  "Args": "60",
+  "RemoteWallClockTime": 12.5
+}
+]
+`
+
+func TestParseCondorQJSON(t *testing.T) {
+	ads, err := ParseCondorQJSON(strings.NewReader(condorQJSONFixture))
+	if err != nil {
+		t.Fatalf("ParseCondorQJSON failed: %v", err)
+	}
+	if len(ads) != 2 {
+		t.Fatalf("Expected 2 ads, got %d", len(ads))
+	}
+
+	for i, ad := range ads {
+		cluster, ok := ad.EvaluateAttrInt("ClusterId")
+		if !ok || cluster != 42 {
+			t.Errorf("Ad %d: expected ClusterId 42, got %d (ok=%v)", i, cluster, ok)
+		}
+		proc, ok := ad.EvaluateAttrInt("ProcId")
+		if !ok || proc != int64(i) {
+			t.Errorf("Ad %d: expected ProcId %d, got %d (ok=%v)", i, i, proc, ok)
+		}
+		owner, ok := ad.EvaluateAttrString("Owner")
+		if !ok || owner != "alice" {
+			t.Errorf("Ad %d: expected Owner 'alice', got '%s' (ok=%v)", i, owner, ok)
+		}
+	}
+
+	// Expressions are kept as expressions rather than strings
+	expr, ok := ads[0].Lookup("RequestMemory")
+	if !ok {
+		t.Fatal("Expected RequestMemory attribute")
+	}
+	if !strings.Contains(expr.String(), "MemoryUsage") {
+		t.Errorf("Expected RequestMemory expression, got %s", expr.String())
+	}
+	if memory, ok := ads[0].EvaluateAttrInt("RequestMemory"); ok {
+		t.Errorf("Expected RequestMemory to be unevaluable without ImageSize, got %d", memory)
+	}
+
+	if want, ok := ads[0].EvaluateAttrBool("WantCheckpoint"); !ok || want {
+		t.Errorf("Expected WantCheckpoint false, got %v (ok=%v)", want, ok)
+	}
+	if wall, ok := ads[1].EvaluateAttrReal("RemoteWallClockTime"); !ok || wall != 12.5 {
+		t.Errorf("Expected RemoteWallClockTime 12.5, got %v (ok=%v)", wall, ok)
+	}
+}
+
+func TestParseCondorQJSONEmpty(t *testing.T) {
+	for _, input := range []string{"", "\n", "[]"} {
+		ads, err := ParseCondorQJSON(strings.NewReader(input))
+		if err != nil {
+			t.Errorf("ParseCondorQJSON(%q) failed: %v", input, err)
+			continue
+		}
+		if len(ads) != 0 {
+			t.Errorf("ParseCondorQJSON(%q): expected no ads, got %d", input, len(ads))
+		}
+	}
+}
+
+func TestParseCondorQJSONInvalid(t *testing.T) {
+	if _, err := ParseCondorQJSON(strings.NewReader("-- Schedd: example.com : <127.0.0.1:9618>")); err == nil {
+		t.Error("Expected error for non-JSON output")
+	}
+}
+
+func TestCondorQArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedd     string
+		constraint string
+		projection []string
+		want       []string
+	}{
+		{
+			name:       "local schedd",
+			constraint: "true",
+			want:       []string{"-json", "-allusers", "-constraint", "true"},
+		},
+		{
+			name:       "named schedd with projection",
+			schedd:     "schedd@example.com",
+			constraint: "Owner == \"alice\"",
+			projection: []string{"ClusterId", "ProcId"},
+			want: []string{"-json", "-allusers", "-name", "schedd@example.com",
+				"-constraint", "Owner == \"alice\"", "-attributes", "ClusterId,ProcId"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := condorQArgs(tt.schedd, tt.constraint, tt.projection)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("condorQArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheddQueryCLI(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Requires a POSIX shell")
+	}
+
+	// Fake condor_q that prints the fixture
+	dir := t.TempDir()
+	fixturePath := filepath.Join(dir, "fixture.json")
+	if err := os.WriteFile(fixturePath, []byte(condorQJSONFixture), 0600); err != nil {
+		t.Fatalf("Failed to write fixture: %v", err)
+	}
+	scriptPath := filepath.Join(dir, "condor_q")
+	script := "#!/bin/sh\ncat " + fixturePath + "\n"
+	//nolint:gosec // Test script must be executable
+	if err := os.WriteFile(scriptPath, []byte(script), 0700); err != nil {
+		t.Fatalf("Failed to write fake condor_q: %v", err)
+	}
+
+	schedd := NewSchedd("test_schedd", "localhost:9618")
+	ads, err := schedd.QueryCLI(context.Background(), scriptPath, "ClusterId == 42", nil)
+	if err != nil {
+		t.Fatalf("QueryCLI failed: %v", err)
+	}
+	if len(ads) != 2 {
+		t.Errorf("Expected 2 ads, got %d", len(ads))
+	}
+
+	// A missing binary is reported as an error
+	if _, err := schedd.QueryCLI(context.Background(), filepath.Join(dir, "missing"), "true", nil); err == nil {
+		t.Error("Expected error for missing condor_q binary")
+	}
+}
@@ -2,9 +2,10 @@ package htcondor
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/PelicanPlatform/classad/classad"
 )
@@ -49,6 +50,10 @@ var defaultProtectedAttrs = map[string]bool{
 	"NumShadowStarts":       true,
 }
 
+// ErrAttributeNotEditable is returned when an edit changes an immutable
+// attribute, or a protected one without EditJobOptions.AllowProtectedAttrs
+var ErrAttributeNotEditable = errors.New("attribute cannot be edited")
+
 // EditJobOptions contains options for editing jobs
 type EditJobOptions struct {
 	// AllowProtectedAttrs allows editing of protected attributes (requires superuser privileges)
@@ -67,12 +72,12 @@ func ValidateAttributeForEdit(attrName string, opts *EditJobOptions) error {
 
 	// Check if attribute is immutable
 	if defaultImmutableAttrs[attrName] {
-		return fmt.Errorf("attribute %s is immutable and cannot be changed", attrName)
+		return fmt.Errorf("%w: %s is immutable", ErrAttributeNotEditable, attrName)
 	}
 
 	// Check if attribute is protected and we're not allowing protected changes
 	if !opts.AllowProtectedAttrs && defaultProtectedAttrs[attrName] {
-		return fmt.Errorf("attribute %s is protected and can only be changed by queue superusers", attrName)
+		return fmt.Errorf("%w: %s is protected and can only be changed by queue superusers", ErrAttributeNotEditable, attrName)
 	}
 
 	return nil
@@ -80,7 +85,7 @@ func ValidateAttributeForEdit(attrName string, opts *EditJobOptions) error {
 
 // EditJob edits attributes of an existing job
 // This opens a QMGMT connection, edits the specified attributes, and commits the changes
-func (s *Schedd) EditJob(ctx context.Context, clusterID, procID int, attributes map[string]string, opts *EditJobOptions) error {
+func (s *Schedd) EditJob(ctx context.Context, clusterID, procID int, attributes map[string]string, opts *EditJobOptions) (err error) {
 	if opts == nil {
 		opts = &EditJobOptions{}
 	}
@@ -94,28 +99,23 @@ func (s *Schedd) EditJob(ctx context.Context, clusterID, procID int, attributes
 		}
 	}
 
-	// Open QMGMT connection
-	qmgmt, err := NewQmgmtConnection(ctx, s.address)
+	// Open QMGMT connection; it starts with a transaction open
+	qmgmt, err := s.Qmgmt(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to open QMGMT connection: %w", err)
 	}
 	defer func() {
-		if err := qmgmt.Close(); err != nil {
-			log.Printf("Failed to close QMGMT connection: %v", err)
+		if cerr := qmgmt.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("failed to close QMGMT connection: %w", cerr)
 		}
 	}()
 
-	// Begin transaction
-	if err := qmgmt.BeginTransaction(ctx); err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
 	// Set attributes
 	for attrName, attrValue := range attributes {
 		if err := qmgmt.SetAttribute(ctx, clusterID, procID, attrName, attrValue, 0); err != nil {
 			// Try to abort transaction on error
 			_ = qmgmt.AbortTransaction(ctx)
-			return fmt.Errorf("failed to set attribute %s: %w", attrName, err)
+			return editAttributeError(err, clusterID, procID, attrName)
 		}
 	}
 
@@ -127,6 +127,16 @@ func (s *Schedd) EditJob(ctx context.Context, clusterID, procID int, attributes
 	return nil
 }
 
+// editAttributeError wraps an error setting attrName of a job, marking the
+// schedd's report that the job does not exist with ErrJobNotFound
+func editAttributeError(err error, clusterID, procID int, attrName string) error {
+	var qerr *QmgmtError
+	if errors.As(err, &qerr) && qerr.Errno == errnoENOENT {
+		return fmt.Errorf("failed to set attribute %s for job %d.%d: %w: %w", attrName, clusterID, procID, ErrJobNotFound, err)
+	}
+	return fmt.Errorf("failed to set attribute %s for job %d.%d: %w", attrName, clusterID, procID, err)
+}
+
 // EditJobByID is a convenience method that parses a job ID string (e.g., "123.0")
 // and calls EditJob
 func (s *Schedd) EditJobByID(ctx context.Context, jobID string, attributes map[string]string, opts *EditJobOptions) error {
@@ -148,7 +158,7 @@ func (s *Schedd) EditJobByID(ctx context.Context, jobID string, attributes map[s
 
 // EditJobs edits attributes for multiple jobs matching a constraint
 // This is more efficient than calling EditJob multiple times as it uses a single transaction
-func (s *Schedd) EditJobs(ctx context.Context, constraint string, attributes map[string]string, opts *EditJobOptions) (int, error) {
+func (s *Schedd) EditJobs(ctx context.Context, constraint string, attributes map[string]string, opts *EditJobOptions) (_ int, err error) {
 	if opts == nil {
 		opts = &EditJobOptions{}
 	}
@@ -173,22 +183,17 @@ func (s *Schedd) EditJobs(ctx context.Context, constraint string, attributes map
 		return 0, nil
 	}
 
-	// Open QMGMT connection
-	qmgmt, err := NewQmgmtConnection(ctx, s.address)
+	// Open QMGMT connection; it starts with a transaction open
+	qmgmt, err := s.Qmgmt(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to open QMGMT connection: %w", err)
 	}
 	defer func() {
-		if err := qmgmt.Close(); err != nil {
-			log.Printf("Failed to close QMGMT connection: %v", err)
+		if cerr := qmgmt.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("failed to close QMGMT connection: %w", cerr)
 		}
 	}()
 
-	// Begin transaction
-	if err := qmgmt.BeginTransaction(ctx); err != nil {
-		return 0, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
 	// Edit each job
 	jobsEdited := 0
 	for _, ad := range ads {
@@ -209,8 +214,7 @@ func (s *Schedd) EditJobs(ctx context.Context, constraint string, attributes map
 		for attrName, attrValue := range attributes {
 			if err := qmgmt.SetAttribute(ctx, int(clusterInt), int(procInt), attrName, attrValue, 0); err != nil {
 				_ = qmgmt.AbortTransaction(ctx)
-				return jobsEdited, fmt.Errorf("failed to set attribute %s for job %d.%d: %w",
-					attrName, clusterInt, procInt, err)
+				return jobsEdited, editAttributeError(err, int(clusterInt), int(procInt), attrName)
 			}
 		}
 		jobsEdited++
@@ -224,6 +228,51 @@ func (s *Schedd) EditJobs(ctx context.Context, constraint string, attributes map
 	return jobsEdited, nil
 }
 
+// EditJobsWithValues is EditJobs with typed values, like condor_qedit: each
+// value is encoded as the ClassAd literal of its Go type (integers, floats,
+// bools and strings), and a *classad.Expr is set as an expression, e.g.
+//
+//	n, err := schedd.EditJobsWithValues(ctx, "Owner == \"alice\"", map[string]interface{}{
+//	    "RequestMemory": 4096,
+//	    "MyFlag":        true,
+//	    "Site":          "east",
+//	    "Rank":          expr, // from classad.ParseExpr("Memory * 2")
+//	}, nil)
+//
+// Strings are always set as strings; to set a value written as text the way
+// a +Attr submit command is read, parse it with classad.ParseExpr. Returns
+// the number of jobs modified.
+func (s *Schedd) EditJobsWithValues(ctx context.Context, constraint string, attributes map[string]interface{}, opts *EditJobOptions) (int, error) {
+	encoded := make(map[string]string, len(attributes))
+	for attrName, value := range attributes {
+		str, err := encodeAttributeValue(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value for %s: %w", attrName, err)
+		}
+		encoded[attrName] = str
+	}
+	return s.EditJobs(ctx, constraint, encoded, opts)
+}
+
+// encodeAttributeValue returns the ClassAd text of a Go value
+func encodeAttributeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", fmt.Errorf("value is nil")
+	}
+	if str, ok := value.(string); ok && !utf8.ValidString(str) {
+		return "", fmt.Errorf("string is not valid UTF-8")
+	}
+	ad := classad.New()
+	if err := ad.Set("Value", value); err != nil {
+		return "", err
+	}
+	expr, ok := ad.Lookup("Value")
+	if !ok {
+		return "", fmt.Errorf("unsupported type %T", value)
+	}
+	return expr.String(), nil
+}
+
 // EditJobAttributes edits attributes using ClassAd values instead of strings
 // This is useful when you need to set complex ClassAd expressions or values
 func (s *Schedd) EditJobAttributes(ctx context.Context, clusterID, procID int, attributes *classad.ClassAd, opts *EditJobOptions) error {
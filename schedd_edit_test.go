@@ -1,8 +1,11 @@
 package htcondor
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidateAttributeForEdit(t *testing.T) {
@@ -153,3 +156,36 @@ func TestMutableAttributes(t *testing.T) {
 		})
 	}
 }
+
+// TestEditJobErrors verifies edit failures can be told apart with errors.Is
+func TestEditJobErrors(t *testing.T) {
+	if err := ValidateAttributeForEdit("Owner", nil); !errors.Is(err, ErrAttributeNotEditable) {
+		t.Errorf("Expected ErrAttributeNotEditable for an immutable attribute, got %v", err)
+	}
+	if err := ValidateAttributeForEdit("JobPrio", nil); !errors.Is(err, ErrAttributeNotEditable) {
+		t.Errorf("Expected ErrAttributeNotEditable for a protected attribute, got %v", err)
+	}
+
+	queue := newFakeQueue()
+	queue.rejectAttribute = "Forbidden"
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	err := schedd.EditJob(ctx, 1, 0, map[string]string{"Forbidden": "true"}, nil)
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("Expected ErrPermissionDenied when the schedd refuses the edit, got %v", err)
+	}
+	if errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Expected a refused edit not to be reported as a missing job, got %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	missing := editAttributeError(&QmgmtError{Op: "SetAttribute JobPrio", Errno: errnoENOENT}, 1, 0, "JobPrio")
+	if !errors.Is(missing, ErrJobNotFound) || errors.Is(missing, ErrPermissionDenied) {
+		t.Errorf("Expected ENOENT to be reported as ErrJobNotFound, got %v", missing)
+	}
+}
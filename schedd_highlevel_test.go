@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os/exec"
-	"strings"
 	"testing"
 	"time"
 )
@@ -35,14 +34,11 @@ func discoverSchedd(t *testing.T, harness *condorTestHarness) string {
 	scheddAd := scheddAds[0]
 
 	// Get MyAddress attribute
-	myAddressExpr, ok := scheddAd.Lookup("MyAddress")
+	myAddress, ok := AdString(scheddAd, "MyAddress")
 	if !ok {
-		t.Fatal("Schedd ad does not have MyAddress attribute")
+		t.Fatal("Schedd ad does not have a MyAddress string")
 	}
 
-	myAddress := myAddressExpr.String()
-	myAddress = strings.Trim(myAddress, "\"")
-
 	// Parse schedd sinful string
 	scheddAddr := parseCollectorSinfulString(myAddress)
 
@@ -0,0 +1,117 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/commands"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+)
+
+// HistoryOptions controls a schedd history query
+type HistoryOptions struct {
+	// Limit is the maximum number of ads to return (0 for no limit)
+	Limit int
+
+	// OwnJobsOnly restricts the results to jobs owned by the user the
+	// schedd authenticates for this query, whatever the constraint says
+	OwnJobsOnly bool
+}
+
+// History queries the schedd for the ads of jobs that have left the queue
+// (completed or removed), newest first, like condor_history.
+// constraint is a ClassAd constraint expression (use "true" for all jobs)
+// and projection the attributes to return (nil for all attributes).
+func (s *Schedd) History(ctx context.Context, constraint string, projection []string, opts *HistoryOptions) ([]*classad.ClassAd, error) {
+	if opts == nil {
+		opts = &HistoryOptions{}
+	}
+	if constraint == "" {
+		constraint = "true"
+	}
+	if _, err := classad.ParseExpr(constraint); err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+	}
+
+	username := GetAuthenticatedUserFromContext(ctx)
+	if err := waitScheddRateLimit(ctx, username); err != nil {
+		return nil, err
+	}
+
+	htcondorClient, err := s.connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
+	}
+	defer func() { _ = htcondorClient.Close() }()
+
+	cedarStream := htcondorClient.GetStream()
+
+	secConfig, err := GetSecurityConfigOrDefault(ctx, nil, commands.QUERY_SCHEDD_HISTORY, "CLIENT", s.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create security config: %w", err)
+	}
+
+	auth := security.NewAuthenticator(secConfig, cedarStream)
+	negotiation, err := auth.ClientHandshake(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
+	}
+
+	if opts.OwnJobsOnly {
+		owner := OwnerOfUser(negotiation.User)
+		if owner == "" {
+			return nil, fmt.Errorf("no authenticated user to restrict history to")
+		}
+		constraint = fmt.Sprintf("(%s) && (Owner == %s)", constraint, classad.Quote(owner))
+	}
+
+	requestAd, err := createHistoryQueryAd(constraint, projection, opts.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMsg := message.NewMessageForStream(cedarStream)
+	if err := queryMsg.PutClassAd(ctx, requestAd); err != nil {
+		return nil, fmt.Errorf("failed to serialize history query ClassAd: %w", err)
+	}
+	if err := queryMsg.FinishMessage(ctx); err != nil {
+		return nil, fmt.Errorf("failed to send history query: %w", err)
+	}
+
+	return readQueryAds(ctx, cedarStream)
+}
+
+// OwnerOfUser returns the Owner attribute value of jobs belonging to an
+// authenticated user such as "alice@example.com", or "" for no user or an
+// unmapped one
+func OwnerOfUser(user string) string {
+	if user == "" || strings.EqualFold(user, "unauthenticated@unmapped") {
+		return ""
+	}
+	owner, _, _ := strings.Cut(user, "@")
+	return owner
+}
+
+// createHistoryQueryAd creates the request ClassAd for QUERY_SCHEDD_HISTORY
+func createHistoryQueryAd(constraint string, projection []string, limit int) (*classad.ClassAd, error) {
+	constraintExpr, err := classad.ParseExpr(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+	}
+
+	ad := classad.New()
+	ad.InsertExpr("Requirements", constraintExpr)
+	if len(projection) > 0 {
+		_ = ad.Set("Projection", strings.Join(projection, ","))
+	}
+	// NumJobMatches caps the ads the schedd sends; -1 means all of them
+	if limit <= 0 {
+		limit = -1
+	}
+	_ = ad.Set("NumJobMatches", limit)
+	_ = ad.Set("StreamResults", true)
+	return ad, nil
+}
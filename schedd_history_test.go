@@ -0,0 +1,89 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+func TestScheddHistoryScriptedTransport(t *testing.T) {
+	var request *classad.ClassAd
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		req, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+		if err != nil {
+			return fmt.Errorf("request ad: %w", err)
+		}
+		request = req
+
+		for _, text := range []string{
+			`[ClusterId = 12; ProcId = 0; Owner = "alice"; JobStatus = 4; CompletionDate = 1700000200]`,
+			`[ClusterId = 11; ProcId = 0; Owner = "alice"; JobStatus = 3; CompletionDate = 0]`,
+			`[Owner = 0; ErrorCode = 0; NumJobMatches = 2]`,
+		} {
+			ad, err := classad.Parse(text)
+			if err != nil {
+				return err
+			}
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, ad) }); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	ads, err := schedd.History(ctx, `Owner == "alice"`, []string{"ClusterId", "ProcId"}, &HistoryOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	if len(ads) != 2 {
+		t.Fatalf("Expected 2 history ads, got %d", len(ads))
+	}
+	if cluster, _ := ads[0].EvaluateAttrInt("ClusterId"); cluster != 12 {
+		t.Errorf("Expected the newest job first, got cluster %d", cluster)
+	}
+
+	if n, _ := request.EvaluateAttrInt("NumJobMatches"); n != 2 {
+		t.Errorf("Expected NumJobMatches 2, got %d", n)
+	}
+	if proj, _ := request.EvaluateAttrString("Projection"); proj != "ClusterId,ProcId" {
+		t.Errorf("Expected projection ClusterId,ProcId, got %q", proj)
+	}
+	if req, ok := request.Lookup("Requirements"); !ok || req.String() != `(Owner == "alice")` {
+		t.Errorf("Expected the constraint as Requirements, got %v", req)
+	}
+
+	if _, err := schedd.History(ctx, "Owner ==", nil, nil); err == nil {
+		t.Error("Expected an invalid constraint to be rejected")
+	}
+}
+
+func TestHistoryOwner(t *testing.T) {
+	tests := map[string]string{
+		"alice@example.com":        "alice",
+		"bob":                      "bob",
+		"unauthenticated@unmapped": "",
+		"":                         "",
+	}
+	for user, want := range tests {
+		if got := OwnerOfUser(user); got != want {
+			t.Errorf("OwnerOfUser(%q) = %q, want %q", user, got, want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// Where a job's ad was found
+const (
+	// JobSourceQueue means the job is still in the queue
+	JobSourceQueue = "queue"
+	// JobSourceHistory means the job has left the queue
+	JobSourceHistory = "history"
+)
+
+// JobUsage is the resource usage the schedd records for a job, as reported
+// by the starter while it runs and totalled when it finishes. Fields of
+// attributes the job ad does not have (e.g. for a job that never ran) are 0.
+type JobUsage struct {
+	JobID JobID `json:"job_id"`
+	// JobStatus is the job's status (1 = idle ... 4 = completed)
+	JobStatus int `json:"job_status"`
+	// Source is JobSourceQueue or JobSourceHistory
+	Source string `json:"source"`
+
+	// RemoteUserCpu and RemoteSysCpu are the CPU seconds the job used in
+	// user and system mode, over all its runs
+	RemoteUserCpu float64 `json:"remote_user_cpu"`
+	RemoteSysCpu  float64 `json:"remote_sys_cpu"`
+	// RemoteWallClockTime is the wall clock seconds of all its runs
+	RemoteWallClockTime float64 `json:"remote_wall_clock_time"`
+	// CpusUsage is the average number of cores used in the last run
+	CpusUsage float64 `json:"cpus_usage,omitempty"`
+	// MemoryUsage is the peak memory, in MB
+	MemoryUsage int64 `json:"memory_usage"`
+	// ResidentSetSize is the peak resident set size, in KiB
+	ResidentSetSize int64 `json:"resident_set_size"`
+	// DiskUsage is the peak size of the sandbox, in KiB
+	DiskUsage int64 `json:"disk_usage"`
+
+	// The resources requested, for comparison: RequestMemory in MB and
+	// RequestDisk in KiB
+	RequestCpus   int64 `json:"request_cpus"`
+	RequestMemory int64 `json:"request_memory"`
+	RequestDisk   int64 `json:"request_disk"`
+
+	// NumJobStarts is how many times the job started running
+	NumJobStarts int64 `json:"num_job_starts"`
+	// CompletionDate is when the job completed, in Unix seconds (0 if it
+	// has not)
+	CompletionDate int64 `json:"completion_date,omitempty"`
+}
+
+// jobUsageAttributes are the attributes GetJobUsage reads. MemoryUsage is
+// normally an expression of ResidentSetSize, and the default RequestMemory
+// one of MemoryUsage and ImageSize, so those are fetched as well.
+var jobUsageAttributes = []string{
+	"ClusterId", "ProcId", "JobStatus",
+	"RemoteUserCpu", "RemoteSysCpu", "RemoteWallClockTime", "CpusUsage",
+	"MemoryUsage", "ResidentSetSize", "ImageSize", "DiskUsage",
+	"RequestCpus", "RequestMemory", "RequestDisk",
+	"NumJobStarts", "CompletionDate",
+}
+
+// GetJobUsage returns the resource usage of a job, read from its ad in the
+// queue or, once it has left the queue, in the history. For a running job
+// the figures are as of the starter's last update. A job that is in neither
+// yields an error wrapping ErrJobNotFound.
+func (s *Schedd) GetJobUsage(ctx context.Context, jobID JobID) (*JobUsage, error) {
+	ad, source, err := s.queueOrHistoryAd(ctx, jobID, "", jobUsageAttributes)
+	if err != nil {
+		return nil, err
+	}
+	usage := jobUsageFromAd(ad)
+	usage.JobID = jobID
+	usage.Source = source
+	return usage, nil
+}
+
+// queueOrHistoryAd returns the ad of a job, looking in the queue first and
+// then in the history, and says which it came from. If owner is set, only a
+// job of that owner is found.
+func (s *Schedd) queueOrHistoryAd(ctx context.Context, jobID JobID, owner string, projection []string) (*classad.ClassAd, string, error) {
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	if owner != "" {
+		constraint += " && Owner == " + classad.Quote(owner)
+	}
+	ads, err := s.Query(ctx, constraint, projection)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(ads) > 0 {
+		return ads[0], JobSourceQueue, nil
+	}
+	ads, err = s.History(ctx, constraint, projection, &HistoryOptions{Limit: 1})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query history of job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(ads) == 0 {
+		return nil, "", fmt.Errorf("%w: %d.%d", ErrJobNotFound, jobID.Cluster, jobID.Proc)
+	}
+	return ads[0], JobSourceHistory, nil
+}
+
+// jobUsageFromAd reads the usage attributes of a job ad
+func jobUsageFromAd(ad *classad.ClassAd) *JobUsage {
+	number := func(name string) float64 {
+		value, _ := ad.EvaluateAttrNumber(name)
+		return value
+	}
+	return &JobUsage{
+		JobStatus:           int(number("JobStatus")),
+		RemoteUserCpu:       number("RemoteUserCpu"),
+		RemoteSysCpu:        number("RemoteSysCpu"),
+		RemoteWallClockTime: number("RemoteWallClockTime"),
+		CpusUsage:           number("CpusUsage"),
+		MemoryUsage:         int64(number("MemoryUsage")),
+		ResidentSetSize:     int64(number("ResidentSetSize")),
+		DiskUsage:           int64(number("DiskUsage")),
+		RequestCpus:         int64(number("RequestCpus")),
+		RequestMemory:       int64(number("RequestMemory")),
+		RequestDisk:         int64(number("RequestDisk")),
+		NumJobStarts:        int64(number("NumJobStarts")),
+		CompletionDate:      int64(number("CompletionDate")),
+	}
+}
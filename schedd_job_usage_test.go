@@ -0,0 +1,112 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+func TestJobUsageFromAd(t *testing.T) {
+	ad, err := classad.Parse(`[JobStatus = 2; RemoteUserCpu = 12.5; RemoteSysCpu = 1.0;
+		RemoteWallClockTime = 30.0; ResidentSetSize = 204800; ImageSize = 300000;
+		MemoryUsage = ((ResidentSetSize + 1023) / 1024);
+		RequestMemory = ifThenElse(MemoryUsage =!= undefined, MemoryUsage, (ImageSize + 1023) / 1024);
+		RequestCpus = 1; NumJobStarts = 1]`)
+	if err != nil {
+		t.Fatalf("Failed to parse ad: %v", err)
+	}
+	usage := jobUsageFromAd(ad)
+	if usage.JobStatus != 2 || usage.RemoteUserCpu != 12.5 || usage.RemoteWallClockTime != 30 {
+		t.Errorf("Unexpected status or times: %+v", usage)
+	}
+	if usage.MemoryUsage != 200 || usage.RequestMemory != 200 || usage.ResidentSetSize != 204800 {
+		t.Errorf("Expected MemoryUsage and RequestMemory evaluated to 200, got %+v", usage)
+	}
+	if usage.DiskUsage != 0 || usage.CompletionDate != 0 {
+		t.Errorf("Expected missing attributes to be 0, got %+v", usage)
+	}
+}
+
+// TestGetJobUsageFromHistory verifies GetJobUsage falls back to the history
+// for a job that has left the queue
+func TestGetJobUsageFromHistory(t *testing.T) {
+	var conns atomic.Int32
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if conns.Add(1) == 1 {
+			return serveJobQuery(ctx, s, nil)
+		}
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+			return fmt.Errorf("request ad: %w", err)
+		}
+		for _, text := range []string{
+			`[ClusterId = 7; ProcId = 0; JobStatus = 4; RemoteWallClockTime = 61.0; MemoryUsage = 42; CompletionDate = 1700000200]`,
+			`[Owner = 0; ErrorCode = 0; NumJobMatches = 1]`,
+		} {
+			ad, err := classad.Parse(text)
+			if err != nil {
+				return err
+			}
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, ad) }); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	usage, err := schedd.GetJobUsage(ctx, JobID{Cluster: 7, Proc: 0})
+	if err != nil {
+		t.Fatalf("GetJobUsage failed: %v", err)
+	}
+	for range 2 {
+		if err := <-transport.errCh; err != nil {
+			t.Fatalf("Scripted schedd failed: %v", err)
+		}
+	}
+	if usage.Source != JobSourceHistory || usage.JobStatus != 4 {
+		t.Errorf("Expected a completed job from the history, got %+v", usage)
+	}
+	if usage.RemoteWallClockTime != 61 || usage.MemoryUsage != 42 || usage.CompletionDate != 1700000200 {
+		t.Errorf("Unexpected usage: %+v", usage)
+	}
+	if usage.JobID != (JobID{Cluster: 7, Proc: 0}) {
+		t.Errorf("Expected job 7.0, got %v", usage.JobID)
+	}
+
+	// A job in neither the queue nor the history
+	conns.Store(0)
+	transport2 := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if conns.Add(1) == 1 {
+			return serveJobQuery(ctx, s, nil)
+		}
+		if err := serverHandshake(ctx, s); err != nil {
+			return err
+		}
+		if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+			return err
+		}
+		final, err := classad.Parse(`[Owner = 0; ErrorCode = 0; NumJobMatches = 0]`)
+		if err != nil {
+			return err
+		}
+		return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, final) })
+	})
+	schedd = NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport2)
+	if _, err := schedd.GetJobUsage(ctx, JobID{Cluster: 8, Proc: 0}); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// Outcomes reported in MatchStatus.Status
+const (
+	// MatchStatusMatched means the job was matched to a machine
+	MatchStatusMatched = "matched"
+	// MatchStatusRejected means the job could not be matched
+	MatchStatusRejected = "rejected"
+	// MatchStatusPending means the job is idle and no outcome is known yet
+	MatchStatusPending = "pending"
+	// MatchStatusNotIdle means the job is not idle (e.g. held or
+	// completed), so it is not being negotiated for
+	MatchStatusNotIdle = "not_idle"
+)
+
+// Sources of MatchStatus.Reason
+const (
+	// MatchSourceNegotiator is the outcome the schedd recorded in the job ad
+	// from the negotiator's last cycle
+	MatchSourceNegotiator = "negotiator"
+	// MatchSourceAnalysis is an outcome predicted by AnalyzeMatch, used when
+	// the negotiator has not reported one
+	MatchSourceAnalysis = "analysis"
+)
+
+// MatchStatus is the outcome of the last negotiation for a job
+type MatchStatus struct {
+	JobID JobID `json:"job_id"`
+	// Status is one of the MatchStatus* constants
+	Status string `json:"status"`
+	// Reason explains the status, e.g. the negotiator's rejection reason
+	Reason string `json:"reason,omitempty"`
+	// Time is when the outcome was recorded, in Unix seconds (0 if unknown)
+	Time int64 `json:"time,omitempty"`
+	// Source says where the outcome comes from (MatchSourceNegotiator or
+	// MatchSourceAnalysis)
+	Source string `json:"source"`
+	// Analysis breaks down the job's Requirements against the pool, when a
+	// collector was given and the job is not matched
+	Analysis *MatchAnalysis `json:"analysis,omitempty"`
+}
+
+// jobStatusNames names the JobStatus values of jobs that do not negotiate
+var jobStatusNames = map[int64]string{
+	3: "removed",             // REMOVED
+	4: "completed",           // COMPLETED
+	5: "held",                // HELD
+	6: "transferring output", // TRANSFERRING_OUTPUT
+	7: "suspended",           // SUSPENDED
+}
+
+// MatchStatus reports the outcome of the last negotiation for a job. The
+// negotiator's verdict is taken from the attributes the schedd records in
+// the job ad (LastMatchTime, LastRejMatchTime and LastRejMatchReason). When
+// the job is idle without a recorded outcome, and collector is not nil, the
+// outcome is predicted by analyzing the job's Requirements against the
+// pool's machines, as AnalyzeJob does; the analysis is also attached to a
+// rejection. A job that is not in the queue yields an error wrapping
+// ErrJobNotFound.
+func (s *Schedd) MatchStatus(ctx context.Context, jobID JobID, collector *Collector) (*MatchStatus, error) {
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	jobAds, err := s.Query(ctx, constraint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job %d.%d: %w", jobID.Cluster, jobID.Proc, err)
+	}
+	if len(jobAds) == 0 {
+		return nil, fmt.Errorf("%w: %d.%d", ErrJobNotFound, jobID.Cluster, jobID.Proc)
+	}
+	jobAd := jobAds[0]
+
+	status := negotiatedMatchStatus(jobAd)
+	status.JobID = jobID
+	if collector == nil || (status.Status != MatchStatusPending && status.Status != MatchStatusRejected) {
+		return status, nil
+	}
+
+	machineAds, candidates, err := queryMachineAds(ctx, collector, jobAd)
+	if err != nil {
+		if status.Status == MatchStatusRejected {
+			// The negotiator's reason stands on its own
+			return status, nil
+		}
+		return nil, fmt.Errorf("failed to query machine ads: %w", err)
+	}
+	analysis, err := analyzeMatch(jobAd, machineAds, candidates)
+	if err != nil {
+		if status.Status == MatchStatusRejected {
+			return status, nil
+		}
+		return nil, err
+	}
+	analysis.JobID = jobID
+	status.Analysis = analysis
+
+	if status.Status == MatchStatusPending {
+		status.Source = MatchSourceAnalysis
+		if analysis.MutualMatches == 0 {
+			status.Status = MatchStatusRejected
+		}
+		status.Reason = analysisReason(analysis)
+	}
+	return status, nil
+}
+
+// negotiatedMatchStatus reads the match outcome recorded in a job ad
+func negotiatedMatchStatus(jobAd *classad.ClassAd) *MatchStatus {
+	status := &MatchStatus{Source: MatchSourceNegotiator}
+	jobStatus, _ := jobAd.EvaluateAttrInt("JobStatus")
+	lastMatch, _ := jobAd.EvaluateAttrInt("LastMatchTime")
+
+	switch jobStatus {
+	case 1: // IDLE
+	case 2: // RUNNING
+		status.Status = MatchStatusMatched
+		status.Reason = "job is running"
+		status.Time = lastMatch
+		return status
+	default:
+		status.Status = MatchStatusNotIdle
+		name, ok := jobStatusNames[jobStatus]
+		if !ok {
+			name = fmt.Sprintf("in status %d", jobStatus)
+		}
+		status.Reason = "job is " + name
+		if holdReason, ok := jobAd.EvaluateAttrString("HoldReason"); ok && jobStatus == 5 && holdReason != "" {
+			status.Reason += ": " + holdReason
+		}
+		return status
+	}
+
+	lastRejected, _ := jobAd.EvaluateAttrInt("LastRejMatchTime")
+	switch {
+	case lastRejected > 0 && lastRejected >= lastMatch:
+		status.Status = MatchStatusRejected
+		status.Time = lastRejected
+		status.Reason, _ = jobAd.EvaluateAttrString("LastRejMatchReason")
+		if status.Reason == "" {
+			status.Reason = "rejected by the negotiator"
+		}
+	case lastMatch > 0:
+		status.Status = MatchStatusMatched
+		status.Time = lastMatch
+		status.Reason = "matched; waiting for the claim to start the job"
+	default:
+		status.Status = MatchStatusPending
+		status.Reason = "the negotiator has not considered the job yet"
+	}
+	return status
+}
+
+// analysisReason summarizes why an analysis predicts the job will or will
+// not match
+func analysisReason(analysis *MatchAnalysis) string {
+	switch {
+	case analysis.TotalMachines == 0:
+		return "no machines in the pool"
+	case analysis.MatchingMachines == 0:
+		for _, condition := range analysis.Conditions {
+			if condition.Matching == 0 {
+				reason := "no machine satisfies " + condition.Expression
+				if condition.Suggestion != "" {
+					reason += "; " + condition.Suggestion
+				}
+				return reason
+			}
+		}
+		return "no machine satisfies the job's Requirements"
+	case analysis.MutualMatches == 0:
+		return fmt.Sprintf("%d of %d machines satisfy the job's Requirements, but none accepts the job",
+			analysis.MatchingMachines, analysis.TotalMachines)
+	default:
+		return fmt.Sprintf("%d of %d machines can run the job; waiting for the negotiator",
+			analysis.MutualMatches, analysis.TotalMachines)
+	}
+}
@@ -0,0 +1,75 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestNegotiatedMatchStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		attrs      map[string]interface{}
+		wantStatus string
+		wantReason string
+		wantTime   int64
+	}{
+		{"running", map[string]interface{}{"JobStatus": 2, "LastMatchTime": 200}, MatchStatusMatched, "job is running", 200},
+		{"held", map[string]interface{}{"JobStatus": 5, "HoldReason": "Spooling input data files"}, MatchStatusNotIdle, "job is held: Spooling input data files", 0},
+		{"completed", map[string]interface{}{"JobStatus": 4}, MatchStatusNotIdle, "job is completed", 0},
+		{"never negotiated", map[string]interface{}{"JobStatus": 1}, MatchStatusPending, "not considered", 0},
+		{"rejected", map[string]interface{}{"JobStatus": 1, "LastRejMatchTime": 300, "LastRejMatchReason": "no match found"}, MatchStatusRejected, "no match found", 300},
+		{"rejected after a match", map[string]interface{}{"JobStatus": 1, "LastMatchTime": 100, "LastRejMatchTime": 300}, MatchStatusRejected, "rejected by the negotiator", 300},
+		{"matched after a rejection", map[string]interface{}{"JobStatus": 1, "LastMatchTime": 400, "LastRejMatchTime": 300}, MatchStatusMatched, "matched", 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobAd := classad.New()
+			for name, value := range tt.attrs {
+				_ = jobAd.Set(name, value)
+			}
+			status := negotiatedMatchStatus(jobAd)
+			if status.Status != tt.wantStatus || !strings.Contains(status.Reason, tt.wantReason) || status.Time != tt.wantTime {
+				t.Errorf("Got %s (%q) at %d, expected %s containing %q at %d",
+					status.Status, status.Reason, status.Time, tt.wantStatus, tt.wantReason, tt.wantTime)
+			}
+			if status.Source != MatchSourceNegotiator {
+				t.Errorf("Expected source %s, got %s", MatchSourceNegotiator, status.Source)
+			}
+		})
+	}
+}
+
+func TestAnalysisReason(t *testing.T) {
+	jobAd := classad.New()
+	_ = jobAd.Set("RequestMemory", int64(4096))
+	req, err := classad.ParseExpr(`TARGET.OpSys == "LINUX" && TARGET.Memory >= RequestMemory`)
+	if err != nil {
+		t.Fatalf("Failed to parse requirements: %v", err)
+	}
+	jobAd.InsertExpr("Requirements", req)
+
+	tests := []struct {
+		name     string
+		machines []*classad.ClassAd
+		want     string
+	}{
+		{"empty pool", nil, "no machines in the pool"},
+		{"too little memory", []*classad.ClassAd{makeMachineAd(t, "slot1@host1", 2048, 4)}, "no machine satisfies (TARGET.Memory >= RequestMemory); the largest Memory"},
+		{"can match", []*classad.ClassAd{makeMachineAd(t, "slot1@host1", 8192, 4)}, "1 of 1 machines can run the job"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := AnalyzeMatch(jobAd, tt.machines)
+			if err != nil {
+				t.Fatalf("AnalyzeMatch failed: %v", err)
+			}
+			if reason := analysisReason(analysis); !strings.Contains(reason, tt.want) {
+				t.Errorf("Expected reason containing %q, got %q", tt.want, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// TestQmgmtManualSubmit scripts a submission with the raw QMGMT primitives
+func TestQmgmtManualSubmit(t *testing.T) {
+	queue := newFakeQueue()
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	qmgmt, err := schedd.Qmgmt(ctx)
+	if err != nil {
+		t.Fatalf("Qmgmt failed: %v", err)
+	}
+
+	clusterID, err := qmgmt.NewCluster(ctx)
+	if err != nil {
+		t.Fatalf("NewCluster failed: %v", err)
+	}
+	procID, err := qmgmt.NewProc(ctx, clusterID)
+	if err != nil {
+		t.Fatalf("NewProc failed: %v", err)
+	}
+	if err := qmgmt.SetAttribute(ctx, clusterID, procID, "Cmd", `"/bin/true"`, 0); err != nil {
+		t.Fatalf("SetAttribute failed: %v", err)
+	}
+
+	// Uncommitted changes are visible on the connection, not in the queue
+	value, err := qmgmt.GetAttribute(ctx, clusterID, procID, "Cmd")
+	if err != nil {
+		t.Fatalf("GetAttribute failed: %v", err)
+	}
+	if value != `"/bin/true"` {
+		t.Errorf("Expected Cmd \"/bin/true\", got %s", value)
+	}
+	var qerr *QmgmtError
+	if _, err := qmgmt.GetAttribute(ctx, clusterID, procID, "Missing"); !errors.As(err, &qerr) || qerr.Errno != errnoENOENT {
+		t.Errorf("Expected a QmgmtError with ENOENT getting a missing attribute, got %v", err)
+	}
+	if len(queue.jobs) != 0 {
+		t.Fatalf("Expected no jobs in the queue before commit, got %d", len(queue.jobs))
+	}
+
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		t.Fatalf("CommitTransaction failed: %v", err)
+	}
+	job := JobID{Cluster: clusterID, Proc: procID}
+	if got := queue.jobs[job]["Cmd"]; got != `"/bin/true"` {
+		t.Errorf("Expected committed Cmd \"/bin/true\", got %q", got)
+	}
+
+	// A second transaction on the same connection removes the cluster again
+	if err := qmgmt.BeginTransaction(ctx); err != nil {
+		t.Fatalf("BeginTransaction failed: %v", err)
+	}
+	if err := qmgmt.DestroyCluster(ctx, clusterID); err != nil {
+		t.Fatalf("DestroyCluster failed: %v", err)
+	}
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		t.Fatalf("CommitTransaction failed: %v", err)
+	}
+	if err := qmgmt.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if _, ok := queue.jobs[job]; ok {
+		t.Error("Expected the destroyed cluster to be gone from the queue")
+	}
+}
+
+// TestEditJobUsesOpenTransaction verifies EditJob edits within the
+// transaction the QMGMT connection starts with
+func TestEditJobUsesOpenTransaction(t *testing.T) {
+	queue := newFakeQueue()
+	job := JobID{Cluster: 7, Proc: 0}
+	queue.jobs[job] = map[string]string{"Cmd": `"/bin/true"`}
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	if err := schedd.EditJob(ctx, job.Cluster, job.Proc, map[string]string{"MyTag": `"edited"`}, nil); err != nil {
+		t.Fatalf("EditJob failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if got := queue.jobs[job]["MyTag"]; got != `"edited"` {
+		t.Errorf("Expected MyTag \"edited\", got %q", got)
+	}
+}
+
+// TestEditJobsWithValues verifies typed values are encoded as ClassAd
+// literals and set on every job matching the constraint
+func TestEditJobsWithValues(t *testing.T) {
+	queue := newFakeQueue()
+	for proc := 0; proc < 2; proc++ {
+		queue.jobs[JobID{Cluster: 7, Proc: proc}] = map[string]string{"Cmd": `"/bin/true"`}
+	}
+	// The matching jobs are queried first, then edited over QMGMT
+	var connections atomic.Int32
+	query := scriptJobQuery(func(*classad.ClassAd) error { return nil }, 2, nil)
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if connections.Add(1) == 1 {
+			return query(ctx, s)
+		}
+		return queue.serve(ctx, s)
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	rank, err := classad.ParseExpr("Memory * 2")
+	if err != nil {
+		t.Fatalf("Failed to parse expression: %v", err)
+	}
+	edited, err := schedd.EditJobsWithValues(ctx, "ClusterId == 7", map[string]interface{}{
+		"RequestMemory": 4096,
+		"Weight":        0.5,
+		"MyFlag":        true,
+		"Site":          `east "1"`,
+		"Rank":          rank,
+	}, nil)
+	if err != nil {
+		t.Fatalf("EditJobsWithValues failed: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-transport.errCh; err != nil {
+			t.Fatalf("Scripted schedd failed: %v", err)
+		}
+	}
+	if edited != 2 {
+		t.Errorf("Expected 2 jobs edited, got %d", edited)
+	}
+
+	want := map[string]string{
+		"RequestMemory": "4096",
+		"Weight":        "0.5",
+		"MyFlag":        "true",
+		"Site":          `"east \"1\""`,
+		"Rank":          "(Memory * 2)",
+	}
+	for proc := 0; proc < 2; proc++ {
+		job := queue.jobs[JobID{Cluster: 7, Proc: proc}]
+		for name, value := range want {
+			if job[name] != value {
+				t.Errorf("Job 7.%d: expected %s = %s, got %q", proc, name, value, job[name])
+			}
+		}
+	}
+
+	if _, err := schedd.EditJobsWithValues(ctx, "true", map[string]interface{}{"Site": nil}, nil); err == nil {
+		t.Error("Expected an error for a nil value")
+	}
+}
@@ -0,0 +1,93 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// JobAdIterator provides iteration over the job ads of a query as the
+// schedd streams them in
+type JobAdIterator interface {
+	// Next advances to the next ad and returns true if there is one. It
+	// returns false once the results are exhausted or reading them fails.
+	Next() bool
+	// Ad returns the current ad
+	Ad() *classad.ClassAd
+	// Err returns the error that ended the iteration, if any
+	Err() error
+	// Close closes the connection to the schedd. It is done automatically
+	// when Next returns false, and may be called more than once.
+	Close() error
+}
+
+// QueryStream queries the schedd for job advertisements like Query, but
+// returns the ads one at a time as they arrive instead of collecting them,
+// so queues of any size can be processed in constant memory. The caller
+// must Close the iterator if it stops before Next returns false.
+//
+//	jobs, err := schedd.QueryStream(ctx, "JobStatus == 5", []string{"ClusterId", "ProcId"})
+//	if err != nil {
+//	    return err
+//	}
+//	defer jobs.Close()
+//	for jobs.Next() {
+//	    process(jobs.Ad())
+//	}
+//	if err := jobs.Err(); err != nil {
+//	    return err
+//	}
+func (s *Schedd) QueryStream(ctx context.Context, constraint string, projection []string) (JobAdIterator, error) {
+	conn, err := s.openJobQuery(ctx, constraint, projection, false)
+	if err != nil {
+		return nil, err
+	}
+	return &jobAdIterator{ctx: ctx, conn: conn}, nil
+}
+
+// jobAdIterator reads the results of a job query from an open connection
+type jobAdIterator struct {
+	ctx  context.Context
+	conn Connection
+	ad   *classad.ClassAd
+	err  error
+	// closed is set once the connection is closed
+	closed bool
+}
+
+func (it *jobAdIterator) Next() bool {
+	it.ad = nil
+	if it.closed {
+		return false
+	}
+	ad, err := readQueryAd(it.ctx, it.conn.GetStream())
+	if err != nil || ad == nil {
+		it.err = err
+		if cerr := it.Close(); cerr != nil && it.err == nil {
+			it.err = cerr
+		}
+		return false
+	}
+	it.ad = ad
+	return true
+}
+
+func (it *jobAdIterator) Ad() *classad.ClassAd {
+	return it.ad
+}
+
+func (it *jobAdIterator) Err() error {
+	return it.err
+}
+
+func (it *jobAdIterator) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+	if err := it.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close connection: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package htcondor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestQueryStream(t *testing.T) {
+	transport := newScriptedTransport(scriptJobQuery(func(*classad.ClassAd) error { return nil }, 3, nil))
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobs, err := schedd.QueryStream(testSecurityContext(ctx), "true", nil)
+	if err != nil {
+		t.Fatalf("QueryStream failed: %v", err)
+	}
+	defer func() { _ = jobs.Close() }()
+
+	var procs []int64
+	for jobs.Next() {
+		proc, _ := jobs.Ad().EvaluateAttrInt("ProcId")
+		procs = append(procs, proc)
+	}
+	if err := jobs.Err(); err != nil {
+		t.Fatalf("Iteration failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if len(procs) != 3 || procs[0] != 0 || procs[2] != 2 {
+		t.Errorf("Expected procs 0-2 in order, got %v", procs)
+	}
+	if jobs.Next() || jobs.Ad() != nil {
+		t.Error("Expected an exhausted iterator to stay exhausted")
+	}
+}
+
+// TestQueryStreamEarlyClose verifies closing the iterator before the results
+// end hangs up on the schedd
+func TestQueryStreamEarlyClose(t *testing.T) {
+	sent := make(chan struct{})
+	transport := newScriptedTransport(scriptJobQuery(func(*classad.ClassAd) error { return nil }, 1, sent))
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobs, err := schedd.QueryStream(testSecurityContext(ctx), "true", nil)
+	if err != nil {
+		t.Fatalf("QueryStream failed: %v", err)
+	}
+	if !jobs.Next() {
+		t.Fatalf("Expected a first ad, got error %v", jobs.Err())
+	}
+	<-sent
+	if err := jobs.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	// The scripted schedd finishes once it sees the connection close
+	select {
+	case <-transport.errCh:
+	case <-ctx.Done():
+		t.Fatal("Expected the connection to be closed")
+	}
+	if jobs.Next() {
+		t.Error("Expected no ads after Close")
+	}
+	if err := jobs.Close(); err != nil {
+		t.Errorf("Expected a second Close to succeed, got %v", err)
+	}
+}
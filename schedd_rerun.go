@@ -0,0 +1,255 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// ErrJobNotFound is returned when a job is neither in the queue nor in the
+// schedd's history
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrJobNotFinished is returned by RerunJob for jobs that are still idle,
+// running or held
+var ErrJobNotFinished = errors.New("job has not finished")
+
+// rerunClearedAttributes are the run-state attributes of a finished job,
+// which RerunJob drops so the new job starts afresh. Attributes whose
+// names start with MachineAttr are dropped too.
+var rerunClearedAttributes = map[string]bool{
+	"clusterid":                    true,
+	"procid":                       true,
+	"globaljobid":                  true,
+	"qdate":                        true,
+	"jobstatus":                    true,
+	"lastjobstatus":                true,
+	"enteredcurrentstatus":         true,
+	"completiondate":               true,
+	"jobstartdate":                 true,
+	"jobcurrentstartdate":          true,
+	"jobcurrentstartexecutingdate": true,
+	"joblaststartdate":             true,
+	"shadowbday":                   true,
+	"lastmatchtime":                true,
+	"nummatches":                   true,
+	"numjobmatches":                true,
+	"numjobstarts":                 true,
+	"numshadowstarts":              true,
+	"numshadowexceptions":          true,
+	"numrestarts":                  true,
+	"numsystemholds":               true,
+	"numholds":                     true,
+	"numholdsbyreason":             true,
+	"jobruncount":                  true,
+	"exitcode":                     true,
+	"exitstatus":                   true,
+	"exitbysignal":                 true,
+	"exitsignal":                   true,
+	"exitreason":                   true,
+	"holdreason":                   true,
+	"holdreasoncode":               true,
+	"holdreasonsubcode":            true,
+	"lastholdreason":               true,
+	"lastholdreasoncode":           true,
+	"lastholdreasonsubcode":        true,
+	"releasereason":                true,
+	"removereason":                 true,
+	"remotehost":                   true,
+	"lastremotehost":               true,
+	"remoteslotid":                 true,
+	"startdipaddr":                 true,
+	"startdprincipal":              true,
+	"remotewallclocktime":          true,
+	"cumulativeslottime":           true,
+	"committedtime":                true,
+	"committedslottime":            true,
+	"committedsuspensiontime":      true,
+	"cumulativesuspensiontime":     true,
+	"totalsuspensions":             true,
+	"lastsuspensiontime":           true,
+	"remoteusercpu":                true,
+	"remotesyscpu":                 true,
+	"localusercpu":                 true,
+	"localsyscpu":                  true,
+	"cumulativeremoteusercpu":      true,
+	"cumulativeremotesyscpu":       true,
+	"bytessent":                    true,
+	"bytesrecvd":                   true,
+	"transferinputstats":           true,
+	"transferoutputstats":          true,
+	"spooledoutputfiles":           true,
+	"stageinstart":                 true,
+	"stageinfinish":                true,
+	"stageoutstart":                true,
+	"stageoutfinish":               true,
+	"lastjobleaserenewal":          true,
+}
+
+// spooledAttributePrefix prefixes the attributes in which the schedd keeps
+// the submit-time values of paths it rewrites when input files are spooled,
+// e.g. SUBMIT_Iwd
+const spooledAttributePrefix = "SUBMIT_"
+
+// RerunOptions controls RerunJobWithOptions
+type RerunOptions struct {
+	// Inputs, if set, holds the input files to spool to the new job, as for
+	// SpoolJobFilesFromFS. The schedd cannot hand back a spooled input
+	// sandbox, so jobs whose inputs were spooled need them supplied again.
+	Inputs fs.FS
+
+	// ExecutablePolicy, if set, is checked against the job before it is
+	// resubmitted, as for a new submission
+	ExecutablePolicy *ExecutablePolicy
+}
+
+// RerunJob resubmits a completed or removed job as a new cluster with the
+// same attributes, like submitting it again with the same submit file.
+// The job's ad is taken from the queue or, once it has left the queue, from
+// the schedd's history; run-state attributes (status, start and completion
+// times, counters, usage) are cleared. Only the authenticated user's own
+// jobs can be rerun; other users' jobs yield an error wrapping
+// ErrJobNotFound.
+//
+// If the job's input files were spooled, the new job is queued held until
+// they are spooled again, with SpoolJobFilesFromFS or SpoolJobFilesFromTar
+// (or use RerunJobWithOptions to pass them).
+func (s *Schedd) RerunJob(ctx context.Context, jobID JobID) (*SubmitResult, error) {
+	return s.RerunJobWithOptions(ctx, jobID, nil)
+}
+
+// RerunJobWithOptions is RerunJob with options
+func (s *Schedd) RerunJobWithOptions(ctx context.Context, jobID JobID, opts *RerunOptions) (result *SubmitResult, err error) {
+	if opts == nil {
+		opts = &RerunOptions{}
+	}
+
+	// The new job is owned by the user the schedd authenticates, who may
+	// only rerun their own jobs
+	qmgmt, err := s.Qmgmt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := qmgmt.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("failed to close qmgmt connection: %w", cerr)
+		}
+	}()
+	owner := OwnerOfUser(qmgmt.authenticatedUser)
+	if owner == "" {
+		return nil, fmt.Errorf("no authenticated user to rerun job %d.%d as", jobID.Cluster, jobID.Proc)
+	}
+
+	original, err := s.finishedJobAd(ctx, jobID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, spooled := rerunJobAd(original, time.Now())
+	if err := opts.ExecutablePolicy.CheckJobAd(ad); err != nil {
+		return nil, err
+	}
+	spool := spooled || opts.Inputs != nil
+	if spool {
+		// Like SubmitRemote jobs, hold the job until its inputs are spooled
+		_ = ad.Set("JobStatus", int64(5))
+		_ = ad.Set("HoldReasonCode", int64(16))
+		_ = ad.Set("HoldReason", "Spooling input data files")
+	}
+
+	clusterID, procID, err := rerunSubmit(ctx, qmgmt, ad)
+	if err != nil {
+		_ = qmgmt.AbortTransaction(ctx)
+		return nil, err
+	}
+
+	result = &SubmitResult{
+		ClusterID: clusterID,
+		NumProcs:  1,
+		ProcAds:   []*classad.ClassAd{ad},
+	}
+	if opts.Inputs != nil {
+		if err := s.SpoolJobFilesFromFS(ctx, result.ProcAds, opts.Inputs); err != nil {
+			return result, fmt.Errorf("job %d.%d was queued but spooling its input files failed: %w", clusterID, procID, err)
+		}
+	}
+	return result, nil
+}
+
+// rerunSubmit queues ad as the only proc of a new cluster
+func rerunSubmit(ctx context.Context, qmgmt *QmgmtConnection, ad *classad.ClassAd) (clusterID, procID int, err error) {
+	clusterID, err = qmgmt.NewCluster(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create cluster: %w", err)
+	}
+	procID, err = qmgmt.NewProc(ctx, clusterID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create proc: %w", err)
+	}
+
+	_ = ad.Set("ClusterId", int64(clusterID))
+	_ = ad.Set("ProcId", int64(procID))
+	if err := qmgmt.SendJobAttributes(ctx, clusterID, procID, ad); err != nil {
+		return 0, 0, fmt.Errorf("failed to set job attributes: %w", err)
+	}
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		return 0, 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return clusterID, procID, nil
+}
+
+// finishedJobAd returns the ad of a completed or removed job of owner,
+// looking in the queue first and then in the history. Other users' jobs are
+// not found.
+func (s *Schedd) finishedJobAd(ctx context.Context, jobID JobID, owner string) (*classad.ClassAd, error) {
+	ad, _, err := s.queueOrHistoryAd(ctx, jobID, owner, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// JobStatus: 3 = REMOVED, 4 = COMPLETED
+	if status, _ := ad.EvaluateAttrInt("JobStatus"); status != 3 && status != 4 {
+		return nil, fmt.Errorf("%w: job %d.%d has status %d", ErrJobNotFinished, jobID.Cluster, jobID.Proc, status)
+	}
+	return ad, nil
+}
+
+// rerunJobAd copies a finished job's ad for resubmission: run-state
+// attributes are dropped, paths the schedd rewrote when spooling the job's
+// inputs are restored from their SUBMIT_ copies (reporting whether there
+// were any), and the new job is queued idle at now.
+func rerunJobAd(original *classad.ClassAd, now time.Time) (*classad.ClassAd, bool) {
+	ad := classad.New()
+	submitted := make(map[string]*classad.Expr)
+	for _, name := range original.GetAttributes() {
+		expr, ok := original.Lookup(name)
+		if !ok || expr == nil {
+			continue
+		}
+		lower := strings.ToLower(name)
+		if rerunClearedAttributes[lower] || strings.HasPrefix(lower, "machineattr") {
+			continue
+		}
+		if len(name) > len(spooledAttributePrefix) && strings.EqualFold(name[:len(spooledAttributePrefix)], spooledAttributePrefix) {
+			submitted[name[len(spooledAttributePrefix):]] = expr
+			continue
+		}
+		_ = ad.Set(name, expr)
+	}
+	for name, expr := range submitted {
+		_ = ad.Set(name, expr)
+	}
+
+	_ = ad.Set("QDate", now.Unix())
+	_ = ad.Set("JobStatus", int64(1)) // IDLE
+	_ = ad.Set("EnteredCurrentStatus", now.Unix())
+	for _, counter := range []string{"NumJobStarts", "NumRestarts", "NumSystemHolds", "JobRunCount"} {
+		_ = ad.Set(counter, 0)
+	}
+	return ad, len(submitted) > 0
+}
@@ -0,0 +1,105 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// waitForJobStatus polls the queue until the job has the given status
+func waitForJobStatus(ctx context.Context, t *testing.T, schedd *Schedd, jobID JobID, status int64) {
+	t.Helper()
+
+	constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+	for {
+		ads, err := schedd.Query(ctx, constraint, []string{"JobStatus"})
+		if err != nil {
+			t.Fatalf("Failed to query job %d.%d: %v", jobID.Cluster, jobID.Proc, err)
+		}
+		if len(ads) > 0 {
+			if got, _ := ads[0].EvaluateAttrInt("JobStatus"); got == status {
+				return
+			}
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Job %d.%d did not reach status %d: %v", jobID.Cluster, jobID.Proc, status, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// TestScheddRerunJobIntegration reruns a completed job and checks that the
+// new cluster runs to completion too
+func TestScheddRerunJobIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Check if condor_master is available
+	if _, err := exec.LookPath("condor_master"); err != nil {
+		t.Skip("condor_master not found in PATH - skipping integration test")
+	}
+
+	// Set up mini HTCondor environment
+	harness := setupCondorHarness(t)
+	if err := harness.waitForDaemons(); err != nil {
+		t.Fatalf("Daemons failed to start: %v", err)
+	}
+	schedd := NewSchedd("local", discoverSchedd(t, harness))
+
+	// Keep the job in the queue once completed, so both lookups are covered:
+	// the original is found in the queue, the rerun is checked there too
+	submitFile := `
+universe = vanilla
+executable = /bin/echo
+arguments = rerun me
+output = test_rerun.out
+error = test_rerun.err
+log = test_rerun.log
+leave_in_queue = JobStatus == 4
+queue
+`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	clusterStr, err := schedd.Submit(ctx, submitFile)
+	if err != nil {
+		t.Fatalf("Failed to submit job: %v", err)
+	}
+	cluster, err := strconv.Atoi(clusterStr)
+	if err != nil {
+		t.Fatalf("Invalid cluster ID %q: %v", clusterStr, err)
+	}
+	original := JobID{Cluster: cluster, Proc: 0}
+	waitForJobStatus(ctx, t, schedd, original, 4)
+
+	result, err := schedd.RerunJob(ctx, original)
+	if err != nil {
+		t.Fatalf("Failed to rerun job %d.0: %v", cluster, err)
+	}
+	if result.ClusterID == cluster {
+		t.Fatalf("Expected the rerun in a new cluster, got %d again", cluster)
+	}
+	t.Logf("Reran job %d.0 as %d.0", cluster, result.ClusterID)
+
+	rerun := JobID{Cluster: result.ClusterID, Proc: 0}
+	waitForJobStatus(ctx, t, schedd, rerun, 4)
+
+	ads, err := schedd.Query(ctx, fmt.Sprintf("ClusterId == %d", result.ClusterID), []string{"NumJobStarts"})
+	if err != nil || len(ads) == 0 {
+		t.Fatalf("Failed to query rerun job: %v", err)
+	}
+	if starts, _ := ads[0].EvaluateAttrInt("NumJobStarts"); starts < 1 {
+		t.Errorf("Rerun job NumJobStarts = %d, expected it to have started", starts)
+	}
+
+	if _, err := schedd.RerunJob(ctx, JobID{Cluster: cluster + 1000, Proc: 0}); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound rerunning a job that does not exist, got %v", err)
+	}
+}
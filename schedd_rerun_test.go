@@ -0,0 +1,133 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+func TestRerunJobAd(t *testing.T) {
+	original := classad.New()
+	_ = original.Set("ClusterId", 42)
+	_ = original.Set("ProcId", 3)
+	_ = original.Set("GlobalJobId", "submit.example.com#42.3#1700000000")
+	_ = original.Set("Owner", "alice")
+	_ = original.Set("Cmd", "/home/alice/analyze")
+	_ = original.Set("Arguments", "--fast")
+	_ = original.Set("RequestMemory", 2048)
+	_ = original.Set("JobStatus", 4)
+	_ = original.Set("CompletionDate", 1700003600)
+	_ = original.Set("ExitCode", 0)
+	_ = original.Set("NumJobStarts", 2)
+	_ = original.Set("RemoteWallClockTime", 3600.0)
+	_ = original.Set("LastRemoteHost", "slot1@worker.example.com")
+	_ = original.Set("MachineAttrGLIDEIN_Site0", "UW")
+	requirements, _ := classad.ParseExpr(`TARGET.OpSys == "LINUX"`)
+	_ = original.Set("Requirements", requirements)
+
+	now := time.Unix(1700010000, 0)
+	ad, spooled := rerunJobAd(original, now)
+	if spooled {
+		t.Error("Expected a job without SUBMIT_ attributes not to be reported as spooled")
+	}
+
+	for _, attr := range []string{"ClusterId", "ProcId", "GlobalJobId", "CompletionDate", "ExitCode",
+		"RemoteWallClockTime", "LastRemoteHost", "MachineAttrGLIDEIN_Site0"} {
+		if _, ok := ad.Lookup(attr); ok {
+			t.Errorf("Expected %s to be cleared", attr)
+		}
+	}
+	if owner, _ := ad.EvaluateAttrString("Owner"); owner != "alice" {
+		t.Errorf("Owner = %q, expected alice", owner)
+	}
+	if args, _ := ad.EvaluateAttrString("Arguments"); args != "--fast" {
+		t.Errorf("Arguments = %q, expected --fast", args)
+	}
+	if mem, _ := ad.EvaluateAttrInt("RequestMemory"); mem != 2048 {
+		t.Errorf("RequestMemory = %d, expected 2048", mem)
+	}
+	if expr, ok := ad.Lookup("Requirements"); !ok || expr.String() != requirements.String() {
+		t.Errorf("Requirements = %v, expected %s", expr, requirements)
+	}
+	if status, _ := ad.EvaluateAttrInt("JobStatus"); status != 1 {
+		t.Errorf("JobStatus = %d, expected 1 (idle)", status)
+	}
+	if qdate, _ := ad.EvaluateAttrInt("QDate"); qdate != now.Unix() {
+		t.Errorf("QDate = %d, expected %d", qdate, now.Unix())
+	}
+	if starts, _ := ad.EvaluateAttrInt("NumJobStarts"); starts != 0 {
+		t.Errorf("NumJobStarts = %d, expected 0", starts)
+	}
+}
+
+func TestRerunJobAdSpooled(t *testing.T) {
+	original := classad.New()
+	_ = original.Set("Iwd", "/var/lib/condor/spool/42/3/cluster42.proc3.subproc0")
+	_ = original.Set("SUBMIT_Iwd", "/home/alice/run")
+	_ = original.Set("Cmd", "analyze")
+	_ = original.Set("SUBMIT_Cmd", "/home/alice/run/analyze")
+	_ = original.Set("JobStatus", 4)
+
+	ad, spooled := rerunJobAd(original, time.Now())
+	if !spooled {
+		t.Error("Expected a job with SUBMIT_ attributes to be reported as spooled")
+	}
+	if iwd, _ := ad.EvaluateAttrString("Iwd"); iwd != "/home/alice/run" {
+		t.Errorf("Iwd = %q, expected the submit-time /home/alice/run", iwd)
+	}
+	if cmd, _ := ad.EvaluateAttrString("Cmd"); cmd != "/home/alice/run/analyze" {
+		t.Errorf("Cmd = %q, expected the submit-time /home/alice/run/analyze", cmd)
+	}
+	if _, ok := ad.Lookup("SUBMIT_Iwd"); ok {
+		t.Error("Expected SUBMIT_Iwd to be dropped")
+	}
+}
+
+// TestFinishedJobAdOwnJobsOnly verifies the job to rerun is looked up among
+// the owner's jobs only
+func TestFinishedJobAdOwnJobsOnly(t *testing.T) {
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", int64(42))
+	_ = jobAd.Set("ProcId", int64(0))
+	_ = jobAd.Set("Owner", "alice")
+	_ = jobAd.Set("JobStatus", int64(4))
+
+	var requirements string
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		queryAd, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+		if err != nil {
+			return fmt.Errorf("query ad: %w", err)
+		}
+		if expr, ok := queryAd.Lookup("Requirements"); ok {
+			requirements = expr.String()
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, jobAd) }); err != nil {
+			return err
+		}
+		final := classad.New()
+		_ = final.Set("Owner", int64(0))
+		return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, final) })
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	if _, err := schedd.finishedJobAd(ctx, JobID{Cluster: 42}, "alice"); err != nil {
+		t.Fatalf("finishedJobAd failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if !strings.Contains(requirements, `Owner == "alice"`) {
+		t.Errorf("Expected the query to be limited to alice's jobs, got %s", requirements)
+	}
+}
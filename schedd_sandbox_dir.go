@@ -0,0 +1,137 @@
+package htcondor
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// sandboxWriter stores the entries of a job's sandbox as they are received.
+// Names are slash-separated and relative to the sandbox.
+type sandboxWriter interface {
+	// CreateFile starts a file of the given mode and size; its data is
+	// written to the returned writer, which is then closed
+	CreateFile(name string, mode, size int64) (io.WriteCloser, error)
+	// Mkdir creates a directory
+	Mkdir(name string) error
+}
+
+// tarSandbox writes a sandbox as entries of a tar archive, under prefix
+type tarSandbox struct {
+	tw     *tar.Writer
+	prefix string
+}
+
+func (t *tarSandbox) CreateFile(name string, mode, size int64) (io.WriteCloser, error) {
+	name = path.Join(t.prefix, name)
+	header := &tar.Header{
+		Name:    name,
+		Size:    size,
+		Mode:    mode,
+		ModTime: time.Now(),
+	}
+	if err := t.tw.WriteHeader(header); err != nil {
+		return nil, fmt.Errorf("failed to write tar header for %s: %w", name, err)
+	}
+	return nopWriteCloser{t.tw}, nil
+}
+
+func (t *tarSandbox) Mkdir(name string) error {
+	name = path.Join(t.prefix, name)
+	header := &tar.Header{
+		Name:     name + "/",
+		Mode:     0755,
+		Typeflag: tar.TypeDir,
+	}
+	if err := t.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write tar header for directory %s: %w", name, err)
+	}
+	return nil
+}
+
+// nopWriteCloser is a writer whose Close does nothing
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// dirSandbox writes a sandbox to files under root
+type dirSandbox struct {
+	root string
+}
+
+// newDirSandbox creates root if need be and returns a sandbox writing to it
+func newDirSandbox(root string) (*dirSandbox, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sandbox directory: %w", err)
+	}
+	return &dirSandbox{root: root}, nil
+}
+
+// localPath returns the path of a sandbox entry on disk, refusing names that
+// would leave root
+func (d *dirSandbox) localPath(name string) (string, error) {
+	local := filepath.FromSlash(name)
+	if !filepath.IsLocal(local) {
+		return "", fmt.Errorf("refusing to write %q outside the sandbox directory", name)
+	}
+	return filepath.Join(d.root, local), nil
+}
+
+func (d *dirSandbox) CreateFile(name string, mode, _ int64) (io.WriteCloser, error) {
+	local, err := d.localPath(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for %s: %w", name, err)
+	}
+	perm := fs.FileMode(mode) & fs.ModePerm
+	if mode <= 0 {
+		perm = 0644
+	}
+	file, err := os.OpenFile(local, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm) //nolint:gosec // Confined to the sandbox directory by localPath
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	// Apply the mode as received, rather than as masked by the umask
+	if err := file.Chmod(perm); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to set mode of %s: %w", name, err)
+	}
+	return file, nil
+}
+
+func (d *dirSandbox) Mkdir(name string) error {
+	local, err := d.localPath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(local, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", name, err)
+	}
+	return nil
+}
+
+// ReceiveJobSandboxToDir downloads the output sandboxes of the jobs matching
+// constraint, like ReceiveJobSandbox, but writes the files straight to disk:
+// each job's files go under destDir/cluster.proc/, which is created, with
+// the directories the schedd sends recreated and the file mode bits applied
+// as received. Existing files are overwritten. Names that would escape the
+// job's directory are skipped, as in ReceiveJobSandbox.
+//
+// The file transfer protocol does not carry modification times, so files
+// have the time they were written.
+func (s *Schedd) ReceiveJobSandboxToDir(ctx context.Context, constraint, destDir string) error {
+	if destDir == "" {
+		return fmt.Errorf("destination directory is required")
+	}
+	return s.doReceiveJobSandbox(ctx, constraint, nil, &TransferOptions{destDir: destDir})
+}
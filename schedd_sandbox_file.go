@@ -0,0 +1,84 @@
+package htcondor
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+)
+
+// ErrFileNotFound is returned by ReadSandboxFile when the job's sandbox has
+// no such file
+var ErrFileNotFound = errors.New("file not found in job sandbox")
+
+// ReadSandboxFile downloads a single output file from the sandbox of a job
+// and returns its contents and size. filename is relative to the sandbox,
+// as in the archives written by ReceiveJobSandbox; absolute names and names
+// containing ".." are rejected. If the job has no such file (or the job does
+// not exist), the error wraps ErrFileNotFound, and also an
+// *OutputDestinationError if the job sent its output to a URL.
+//
+// The schedd still sends the job's other files, which are discarded as they
+// arrive. Closing the returned reader waits for the transfer to finish and
+// reports any error from it.
+func (s *Schedd) ReadSandboxFile(ctx context.Context, jobID JobID, filename string) (io.ReadCloser, int64, error) {
+	if filename == "." || !fs.ValidPath(filename) {
+		return nil, 0, fmt.Errorf("invalid sandbox file name %q", filename)
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		constraint := fmt.Sprintf("ClusterId == %d && ProcId == %d", jobID.Cluster, jobID.Proc)
+		err := s.doReceiveJobSandbox(ctx, constraint, pw, &TransferOptions{onlyFile: filename})
+		_ = pw.CloseWithError(err)
+		done <- err
+	}()
+
+	tr := tar.NewReader(pr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			if err := <-done; err != nil {
+				var elsewhere *OutputDestinationError
+				if errors.As(err, &elsewhere) {
+					return nil, 0, fmt.Errorf("%w: %s in job %d.%d: %w", ErrFileNotFound, filename, jobID.Cluster, jobID.Proc, err)
+				}
+				return nil, 0, err
+			}
+			return nil, 0, fmt.Errorf("%w: %s in job %d.%d", ErrFileNotFound, filename, jobID.Cluster, jobID.Proc)
+		}
+		if err != nil {
+			_ = pr.CloseWithError(err)
+			if transferErr := <-done; transferErr != nil {
+				return nil, 0, transferErr
+			}
+			return nil, 0, fmt.Errorf("failed to read sandbox archive: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg && header.Name == filename {
+			return &sandboxFileReader{Reader: tr, pipe: pr, done: done}, header.Size, nil
+		}
+	}
+}
+
+// sandboxFileReader reads one file out of a sandbox download in progress
+type sandboxFileReader struct {
+	io.Reader
+	pipe   *io.PipeReader
+	done   chan error
+	closed bool
+	err    error
+}
+
+// Close drains the rest of the download and returns the transfer's error
+func (r *sandboxFileReader) Close() error {
+	if r.closed {
+		return r.err
+	}
+	r.closed = true
+	_, _ = io.Copy(io.Discard, r.pipe)
+	r.err = <-r.done
+	return r.err
+}
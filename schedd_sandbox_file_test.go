@@ -0,0 +1,103 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// sandboxFileTransport serves the sandbox of job 42.0, holding output.txt
+func sandboxFileTransport(content []byte) *scriptedTransport {
+	return newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		constraint, err := req.GetString(ctx)
+		if err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+		if constraint != "ClusterId == 42 && ProcId == 0" {
+			return fmt.Errorf("unexpected constraint %q", constraint)
+		}
+
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) }); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 0, "output.txt", content); err != nil {
+			return err
+		}
+
+		reply, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		if reply != 0 {
+			return fmt.Errorf("unexpected final reply %d", reply)
+		}
+		return nil
+	})
+}
+
+func TestReadSandboxFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	content := []byte("hello from the sandbox\n")
+	transport := sandboxFileTransport(content)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	r, size, err := schedd.ReadSandboxFile(ctx, JobID{Cluster: 42, Proc: 0}, "output.txt")
+	if err != nil {
+		t.Fatalf("ReadSandboxFile failed: %v", err)
+	}
+	if size != int64(len(content)) {
+		t.Errorf("Expected size %d, got %d", len(content), size)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(data) != string(content) {
+		t.Errorf("Expected content %q, got %q", content, data)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+}
+
+func TestReadSandboxFileNotFound(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	transport := sandboxFileTransport([]byte("not this one\n"))
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	if _, _, err := schedd.ReadSandboxFile(ctx, JobID{Cluster: 42, Proc: 0}, "results/missing.txt"); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("Expected ErrFileNotFound, got %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+
+	for _, name := range []string{"../etc/passwd", "/etc/passwd", "a/../../b", ".", ""} {
+		if _, _, err := schedd.ReadSandboxFile(ctx, JobID{Cluster: 42, Proc: 0}, name); err == nil || errors.Is(err, ErrFileNotFound) {
+			t.Errorf("Expected %q to be rejected as an invalid name, got %v", name, err)
+		}
+	}
+}
@@ -2,10 +2,10 @@ package htcondor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/PelicanPlatform/classad/classad"
-	"github.com/bbockelm/cedar/client"
 	"github.com/bbockelm/cedar/message"
 	"github.com/bbockelm/cedar/security"
 	"github.com/bbockelm/cedar/stream"
@@ -28,6 +28,7 @@ const (
 	CONDOR_NewProc                  = 10003
 	CONDOR_DestroyCluster           = 10004
 	CONDOR_SetAttribute             = 10006
+	CONDOR_GetAttributeExpr         = 10011
 	CONDOR_SetAttribute2            = 10027
 	CONDOR_BeginTransaction         = 10023
 	CONDOR_CommitTransactionNoFlags = 10007
@@ -39,6 +40,34 @@ const (
 	QMGMT_WRITE_CMD                 = 1112
 )
 
+// ErrPermissionDenied is matched by queue management errors in which the
+// schedd refused an operation the user is not permitted, such as editing
+// another user's job
+var ErrPermissionDenied = errors.New("permission denied")
+
+// errno values the schedd reports in failed QMGMT replies
+const (
+	errnoEPERM  = 1
+	errnoENOENT = 2
+	errnoEACCES = 13
+)
+
+// QmgmtError reports a QMGMT operation the schedd refused, with the errno
+// it sent. It matches ErrPermissionDenied for EPERM and EACCES.
+type QmgmtError struct {
+	Op    string // The operation and its subject, e.g. "SetAttribute JobPrio"
+	Errno int
+}
+
+func (e *QmgmtError) Error() string {
+	return fmt.Sprintf("%s failed with error code %d", e.Op, e.Errno)
+}
+
+// Is reports whether the error is a permission error
+func (e *QmgmtError) Is(target error) bool {
+	return target == ErrPermissionDenied && (e.Errno == errnoEPERM || e.Errno == errnoEACCES)
+}
+
 // QmgmtConnection represents an active connection to the schedd for queue management operations
 // Implements HTCondor's QMGMT (Queue Management) protocol for job submission
 //
@@ -55,7 +84,7 @@ const (
 //  10. CloseSocket (10028)
 type QmgmtConnection struct {
 	address            string
-	htcondorClient     *client.HTCondorClient
+	htcondorClient     Connection
 	stream             *stream.Stream
 	authenticatedUser  string // User from authentication negotiation
 	inTransaction      bool
@@ -68,8 +97,13 @@ type QmgmtConnection struct {
 // Uses FS authentication from cedar package
 // address can be a hostname:port or a sinful string like "<IP:PORT?addrs=...>"
 func NewQmgmtConnection(ctx context.Context, address string) (*QmgmtConnection, error) {
-	// Establish connection using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, address)
+	return newQmgmtConnection(ctx, DefaultTransport(), address)
+}
+
+// newQmgmtConnection establishes a queue management connection using the given transport
+func newQmgmtConnection(ctx context.Context, transport Transport, address string) (*QmgmtConnection, error) {
+	// Establish connection using the transport
+	htcondorClient, err := transport.Connect(ctx, address)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to schedd at %s: %w", address, err)
 	}
@@ -88,7 +122,7 @@ func NewQmgmtConnection(ctx context.Context, address string) (*QmgmtConnection,
 	negotiation, err := auth.ClientHandshake(ctx)
 	if err != nil {
 		_ = htcondorClient.Close()
-		return nil, fmt.Errorf("authentication handshake failed: %w", err)
+		return nil, fmt.Errorf("authentication handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// Verify authentication succeeded (accept any of the configured auth methods)
@@ -246,15 +280,39 @@ func (q *QmgmtConnection) CommitTransaction(ctx context.Context) error {
 	return nil
 }
 
-// AbortTransaction aborts a queue management transaction
-//
-//nolint:revive // ctx will be used when implementing CONDOR_AbortTransaction protocol
+// AbortTransaction aborts a queue management transaction, discarding all
+// changes made since it began
 func (q *QmgmtConnection) AbortTransaction(ctx context.Context) error {
 	if !q.inTransaction {
 		return fmt.Errorf("no transaction in progress")
 	}
-	// TODO: Send CONDOR_AbortTransaction (10024) command
 	q.inTransaction = false
+
+	// Send CONDOR_AbortTransaction (10024) command
+	msg := message.NewMessageForStream(q.stream)
+	if err := msg.PutInt(ctx, CONDOR_AbortTransaction); err != nil {
+		return fmt.Errorf("failed to send AbortTransaction command: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to finish AbortTransaction message: %w", err)
+	}
+
+	// Receive response
+	responseMsg := message.NewMessageFromStream(q.stream)
+	rval, err := responseMsg.GetInt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to receive AbortTransaction response: %w", err)
+	}
+
+	if rval < 0 {
+		// Read error code
+		errCode, err := responseMsg.GetInt(ctx)
+		if err != nil {
+			return fmt.Errorf("AbortTransaction failed but could not read error code: %w", err)
+		}
+		return fmt.Errorf("AbortTransaction failed with error code %d", errCode)
+	}
+
 	return nil
 }
 
@@ -376,7 +434,7 @@ func (q *QmgmtConnection) SetAttribute(ctx context.Context, clusterID, procID in
 		if err != nil {
 			return fmt.Errorf("SetAttribute failed but could not read error code: %w", err)
 		}
-		return fmt.Errorf("SetAttribute failed for %s with error code %d", attrName, errCode)
+		return &QmgmtError{Op: "SetAttribute " + attrName, Errno: errCode}
 	}
 
 	return nil
@@ -419,21 +477,92 @@ func (q *QmgmtConnection) SetEffectiveOwner(ctx context.Context, owner string) e
 		if err != nil {
 			return fmt.Errorf("SetEffectiveOwner failed but could not read error code: %w", err)
 		}
-		return fmt.Errorf("SetEffectiveOwner failed for %s with error code %d", owner, errCode)
+		return &QmgmtError{Op: "SetEffectiveOwner " + owner, Errno: errCode}
 	}
 
 	return nil
 }
 
 // DestroyCluster removes a cluster and all its procs
-//
-//nolint:revive // ctx will be used when implementing CONDOR_DestroyCluster protocol
 func (q *QmgmtConnection) DestroyCluster(ctx context.Context, clusterID int) error {
 	if !q.inTransaction {
 		return fmt.Errorf("must be in a transaction to destroy a cluster")
 	}
-	// TODO: Send CONDOR_DestroyCluster (10004) command
-	return fmt.Errorf("DestroyCluster not yet implemented")
+
+	// Send CONDOR_DestroyCluster (10004) command
+	msg := message.NewMessageForStream(q.stream)
+	if err := msg.PutInt(ctx, CONDOR_DestroyCluster); err != nil {
+		return fmt.Errorf("failed to send DestroyCluster command: %w", err)
+	}
+	if err := msg.PutInt(ctx, clusterID); err != nil {
+		return fmt.Errorf("failed to send cluster ID: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return fmt.Errorf("failed to finish DestroyCluster message: %w", err)
+	}
+
+	// Receive response
+	responseMsg := message.NewMessageFromStream(q.stream)
+	rval, err := responseMsg.GetInt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to receive DestroyCluster response: %w", err)
+	}
+
+	if rval < 0 {
+		// Read error code
+		errCode, err := responseMsg.GetInt(ctx)
+		if err != nil {
+			return fmt.Errorf("DestroyCluster failed but could not read error code: %w", err)
+		}
+		return &QmgmtError{Op: fmt.Sprintf("DestroyCluster %d", clusterID), Errno: errCode}
+	}
+
+	return nil
+}
+
+// GetAttribute returns the expression of an attribute of a job (cluster.proc)
+// or cluster (cluster.-1), as the schedd sees it within the current
+// transaction. Attributes the job does not have are reported as errors.
+func (q *QmgmtConnection) GetAttribute(ctx context.Context, clusterID, procID int, attrName string) (string, error) {
+	// Send CONDOR_GetAttributeExpr (10011) command
+	msg := message.NewMessageForStream(q.stream)
+	if err := msg.PutInt(ctx, CONDOR_GetAttributeExpr); err != nil {
+		return "", fmt.Errorf("failed to send GetAttribute command: %w", err)
+	}
+	if err := msg.PutInt(ctx, clusterID); err != nil {
+		return "", fmt.Errorf("failed to send cluster ID: %w", err)
+	}
+	if err := msg.PutInt(ctx, procID); err != nil {
+		return "", fmt.Errorf("failed to send proc ID: %w", err)
+	}
+	if err := msg.PutString(ctx, attrName); err != nil {
+		return "", fmt.Errorf("failed to send attribute name: %w", err)
+	}
+	if err := msg.FinishMessage(ctx); err != nil {
+		return "", fmt.Errorf("failed to finish GetAttribute message: %w", err)
+	}
+
+	// Receive response: return value, then the expression or an error code
+	responseMsg := message.NewMessageFromStream(q.stream)
+	rval, err := responseMsg.GetInt(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to receive GetAttribute response: %w", err)
+	}
+
+	if rval < 0 {
+		// Read error code
+		errCode, err := responseMsg.GetInt(ctx)
+		if err != nil {
+			return "", fmt.Errorf("GetAttribute failed but could not read error code: %w", err)
+		}
+		return "", &QmgmtError{Op: "GetAttribute " + attrName, Errno: errCode}
+	}
+
+	value, err := responseMsg.GetString(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to receive value of %s: %w", attrName, err)
+	}
+	return value, nil
 }
 
 // SendJobAttributes sends all attributes from a ClassAd to the schedd for a specific job
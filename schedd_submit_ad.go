@@ -0,0 +1,159 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// ErrIncompleteJobAd is returned (wrapped) by SubmitAd when the job ad lacks
+// an attribute every job needs
+var ErrIncompleteJobAd = errors.New("job ad is missing a required attribute")
+
+// submitAdCounters are the run counters SubmitAd starts at 0, as submit
+// files do (see setAutoAttributes)
+var submitAdCounters = []string{"NumJobStarts", "NumRestarts", "NumSystemHolds", "JobRunCount"}
+
+// SubmitAd submits count jobs built from a pre-built job ad as a new
+// cluster, for callers that already have the ad and want to skip the submit
+// file language (see SubmitFile.Submit for that route).
+//
+// The ad must set Cmd, JobUniverse and Owner. It is sent as is, except that
+// each proc gets its own ClusterId and ProcId, and QDate, JobStatus (idle),
+// EnteredCurrentStatus and the run counters are filled in where the ad does
+// not set them. The ad itself is not modified; the result's ProcAds hold the
+// ads as submitted.
+func (s *Schedd) SubmitAd(ctx context.Context, ad *classad.ClassAd, count int) (*SubmitResult, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("count must be at least 1, got %d", count)
+	}
+	if err := checkSubmitAd(ad); err != nil {
+		return nil, err
+	}
+	ads := make([]*classad.ClassAd, count)
+	for i := range ads {
+		ads[i] = ad
+	}
+	return s.submitAds(ctx, ads)
+}
+
+// SubmitJobs submits pre-built job ads as the procs of a new cluster, one
+// proc per ad in order, and returns the cluster and proc IDs the schedd
+// assigned. Each ad is treated as in SubmitAd: it must set Cmd, JobUniverse
+// and Owner, any ClusterId and ProcId it has are replaced, and the ads are
+// not modified.
+//
+// The jobs are created in a single QMGMT transaction. If any step fails the
+// transaction is aborted, so no part of the cluster is left in the queue.
+func (s *Schedd) SubmitJobs(ctx context.Context, ads []*classad.ClassAd) (*SubmitResult, error) {
+	if len(ads) == 0 {
+		return nil, fmt.Errorf("no job ads to submit")
+	}
+	for i, ad := range ads {
+		if err := checkSubmitAd(ad); err != nil {
+			return nil, fmt.Errorf("job ad %d: %w", i, err)
+		}
+	}
+	return s.submitAds(ctx, ads)
+}
+
+// submitAds queues ads, which have passed checkSubmitAd, as the procs of a
+// new cluster in one transaction
+func (s *Schedd) submitAds(ctx context.Context, ads []*classad.ClassAd) (result *SubmitResult, err error) {
+	qmgmt, err := s.Qmgmt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := qmgmt.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("failed to close qmgmt connection: %w", cerr)
+		}
+	}()
+
+	// Abort the transaction on failure; should that fail too, the schedd
+	// discards the uncommitted transaction when the connection closes
+	var submissionErr error
+	defer func() {
+		if submissionErr != nil {
+			_ = qmgmt.AbortTransaction(ctx)
+		}
+	}()
+
+	clusterID, err := qmgmt.NewCluster(ctx)
+	if err != nil {
+		submissionErr = fmt.Errorf("failed to create cluster: %w", err)
+		return nil, submissionErr
+	}
+
+	now := time.Now()
+	result = &SubmitResult{ClusterID: clusterID, NumProcs: len(ads)}
+	for i, ad := range ads {
+		procID, err := qmgmt.NewProc(ctx, clusterID)
+		if err != nil {
+			submissionErr = fmt.Errorf("failed to create proc %d: %w", i, err)
+			return nil, submissionErr
+		}
+
+		procAd := submitProcAd(ad, clusterID, procID, now)
+		if err := qmgmt.SendJobAttributes(ctx, clusterID, procID, procAd); err != nil {
+			submissionErr = fmt.Errorf("failed to set attributes for proc %d: %w", i, err)
+			return nil, submissionErr
+		}
+		result.ProcAds = append(result.ProcAds, procAd)
+	}
+
+	if err := qmgmt.CommitTransaction(ctx); err != nil {
+		submissionErr = fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, submissionErr
+	}
+	return result, nil
+}
+
+// checkSubmitAd checks that ad sets the attributes SubmitAd requires
+func checkSubmitAd(ad *classad.ClassAd) error {
+	if ad == nil {
+		return fmt.Errorf("%w: no job ad", ErrIncompleteJobAd)
+	}
+	for _, attr := range []string{"Cmd", "Owner"} {
+		if value, ok := ad.EvaluateAttrString(attr); !ok || value == "" {
+			return fmt.Errorf("%w: %s must be a non-empty string", ErrIncompleteJobAd, attr)
+		}
+	}
+	universe, ok := ad.EvaluateAttrInt("JobUniverse")
+	if !ok {
+		return fmt.Errorf("%w: JobUniverse must be an integer", ErrIncompleteJobAd)
+	}
+	if universe <= UniverseMin || universe > UniverseDocker {
+		return fmt.Errorf("%w: invalid JobUniverse %d", ErrIncompleteJobAd, universe)
+	}
+	return nil
+}
+
+// submitProcAd copies ad for proc clusterID.procID, filling in the
+// attributes a newly queued job needs that ad does not set
+func submitProcAd(ad *classad.ClassAd, clusterID, procID int, now time.Time) *classad.ClassAd {
+	procAd := classad.New()
+	for _, name := range ad.GetAttributes() {
+		if expr, ok := ad.Lookup(name); ok && expr != nil {
+			_ = procAd.Set(name, expr)
+		}
+	}
+
+	_ = procAd.Set("ClusterId", int64(clusterID))
+	_ = procAd.Set("ProcId", int64(procID))
+	setDefault := func(name string, value interface{}) {
+		if _, ok := procAd.Lookup(name); !ok {
+			_ = procAd.Set(name, value)
+		}
+	}
+	setDefault("QDate", now.Unix())
+	setDefault("JobStatus", int64(1)) // IDLE
+	setDefault("EnteredCurrentStatus", now.Unix())
+	for _, counter := range submitAdCounters {
+		setDefault(counter, int64(0))
+	}
+	return procAd
+}
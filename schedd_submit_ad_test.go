@@ -0,0 +1,188 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestSubmitAd(t *testing.T) {
+	queue := newFakeQueue()
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	ad := classad.New()
+	_ = ad.Set("Cmd", "/bin/sleep")
+	_ = ad.Set("Arguments", "60")
+	_ = ad.Set("JobUniverse", UniverseVanilla)
+	_ = ad.Set("Owner", "alice")
+	_ = ad.Set("NumJobStarts", 3)
+	requirements, _ := classad.ParseExpr(`TARGET.OpSys == "LINUX"`)
+	_ = ad.Set("Requirements", requirements)
+
+	result, err := schedd.SubmitAd(ctx, ad, 2)
+	if err != nil {
+		t.Fatalf("SubmitAd failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if result.NumProcs != 2 || len(result.ProcAds) != 2 {
+		t.Fatalf("Expected 2 procs, got NumProcs %d with %d ads", result.NumProcs, len(result.ProcAds))
+	}
+	if _, ok := ad.Lookup("ClusterId"); ok {
+		t.Error("Expected the caller's ad to be left unmodified")
+	}
+
+	for proc := 0; proc < 2; proc++ {
+		job, ok := queue.jobs[JobID{Cluster: result.ClusterID, Proc: proc}]
+		if !ok {
+			t.Fatalf("Job %d.%d not queued", result.ClusterID, proc)
+		}
+		want := map[string]string{
+			"Cmd":          `"/bin/sleep"`,
+			"Arguments":    `"60"`,
+			"Owner":        `"alice"`,
+			"JobUniverse":  "5",
+			"JobStatus":    "1",
+			"NumJobStarts": "3", // set by the caller, so kept
+			"JobRunCount":  "0",
+			"Requirements": requirements.String(),
+		}
+		for name, value := range want {
+			if job[name] != value {
+				t.Errorf("Job %d.%d: %s = %s, expected %s", result.ClusterID, proc, name, job[name], value)
+			}
+		}
+		if job["QDate"] == "" {
+			t.Errorf("Job %d.%d: expected QDate to be set", result.ClusterID, proc)
+		}
+		if procID, _ := result.ProcAds[proc].EvaluateAttrInt("ProcId"); procID != int64(proc) {
+			t.Errorf("ProcAds[%d] has ProcId %d", proc, procID)
+		}
+	}
+}
+
+func TestSubmitAdRequiredAttributes(t *testing.T) {
+	schedd := NewSchedd("test_schedd", "mock-schedd:9618")
+
+	for _, missing := range []string{"Cmd", "JobUniverse", "Owner"} {
+		ad := classad.New()
+		_ = ad.Set("Cmd", "/bin/true")
+		_ = ad.Set("JobUniverse", UniverseVanilla)
+		_ = ad.Set("Owner", "alice")
+		ad.Delete(missing)
+
+		if _, err := schedd.SubmitAd(context.Background(), ad, 1); !errors.Is(err, ErrIncompleteJobAd) {
+			t.Errorf("Without %s: expected ErrIncompleteJobAd, got %v", missing, err)
+		}
+	}
+}
+
+func TestSubmitJobs(t *testing.T) {
+	queue := newFakeQueue()
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	var ads []*classad.ClassAd
+	for _, args := range []string{"first", "second", "third"} {
+		ad := classad.New()
+		_ = ad.Set("Cmd", "/bin/echo")
+		_ = ad.Set("Arguments", args)
+		_ = ad.Set("JobUniverse", UniverseVanilla)
+		_ = ad.Set("Owner", "alice")
+		_ = ad.Set("ProcId", 42) // replaced by the ID the schedd assigns
+		ads = append(ads, ad)
+	}
+
+	result, err := schedd.SubmitJobs(ctx, ads)
+	if err != nil {
+		t.Fatalf("SubmitJobs failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if result.ClusterID != 101 || result.NumProcs != 3 || len(result.ProcAds) != 3 {
+		t.Fatalf("Expected cluster 101 with 3 procs, got cluster %d, NumProcs %d with %d ads",
+			result.ClusterID, result.NumProcs, len(result.ProcAds))
+	}
+
+	for proc, args := range []string{"first", "second", "third"} {
+		job, ok := queue.jobs[JobID{Cluster: 101, Proc: proc}]
+		if !ok {
+			t.Fatalf("Job 101.%d not queued", proc)
+		}
+		if job["Arguments"] != `"`+args+`"` || job["ProcId"] != fmt.Sprint(proc) || job["ClusterId"] != "101" {
+			t.Errorf("Job 101.%d: Arguments %s, ClusterId %s, ProcId %s", proc, job["Arguments"], job["ClusterId"], job["ProcId"])
+		}
+		if procID, _ := result.ProcAds[proc].EvaluateAttrInt("ProcId"); procID != int64(proc) {
+			t.Errorf("ProcAds[%d] has ProcId %d", proc, procID)
+		}
+	}
+	if procID, _ := ads[0].EvaluateAttrInt("ProcId"); procID != 42 {
+		t.Error("Expected the caller's ads to be left unmodified")
+	}
+}
+
+// TestSubmitJobsRollback verifies a failed submission aborts the transaction
+// instead of leaving part of the cluster queued
+func TestSubmitJobsRollback(t *testing.T) {
+	queue := newFakeQueue()
+	queue.rejectAttribute = "Forbidden"
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	var ads []*classad.ClassAd
+	for i := 0; i < 2; i++ {
+		ad := classad.New()
+		_ = ad.Set("Cmd", "/bin/true")
+		_ = ad.Set("JobUniverse", UniverseVanilla)
+		_ = ad.Set("Owner", "alice")
+		ads = append(ads, ad)
+	}
+	_ = ads[1].Set("Forbidden", true)
+
+	_, err := schedd.SubmitJobs(ctx, ads)
+	if err == nil || !strings.Contains(err.Error(), "proc 1") {
+		t.Fatalf("Expected an error setting attributes for proc 1, got %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if len(queue.jobs) != 0 {
+		t.Errorf("Expected the aborted cluster to leave no jobs, got %v", queue.jobs)
+	}
+}
+
+func TestSubmitJobsInvalidAds(t *testing.T) {
+	schedd := NewSchedd("test_schedd", "mock-schedd:9618")
+
+	if _, err := schedd.SubmitJobs(context.Background(), nil); err == nil {
+		t.Error("Expected an error submitting no ads")
+	}
+
+	ad := classad.New()
+	_ = ad.Set("Cmd", "/bin/true")
+	_ = ad.Set("JobUniverse", UniverseVanilla)
+	_, err := schedd.SubmitJobs(context.Background(), []*classad.ClassAd{ad})
+	if !errors.Is(err, ErrIncompleteJobAd) || !strings.Contains(err.Error(), "job ad 0") {
+		t.Errorf("Expected ErrIncompleteJobAd for job ad 0, got %v", err)
+	}
+}
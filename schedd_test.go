@@ -2,7 +2,14 @@ package htcondor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
 )
 
 func TestNewSchedd(t *testing.T) {
@@ -29,6 +36,115 @@ func TestScheddQuery(t *testing.T) {
 	}
 }
 
+// scriptJobQuery scripts the schedd side of QUERY_JOB_ADS: it passes the
+// request ad to check, then sends a job ad for each proc and the final ad.
+// If sent is not nil, it is closed after the job ads and the final ad is
+// never sent.
+func scriptJobQuery(check func(request *classad.ClassAd) error, procs int, sent chan struct{}) func(ctx context.Context, s *stream.Stream) error {
+	return func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		request, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+		if err != nil {
+			return fmt.Errorf("request ad: %w", err)
+		}
+		if err := check(request); err != nil {
+			return err
+		}
+		for proc := 0; proc < procs; proc++ {
+			ad := classad.New()
+			_ = ad.Set("ClusterId", 7)
+			_ = ad.Set("ProcId", proc)
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, ad) }); err != nil {
+				return err
+			}
+		}
+		if sent != nil {
+			close(sent)
+			// Wait for the client to hang up
+			_, _ = message.NewMessageFromStream(s).GetInt(ctx)
+			return nil
+		}
+		final := classad.New()
+		_ = final.Set("Owner", 0)
+		_ = final.Set("ErrorCode", 0)
+		return sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, final) })
+	}
+}
+
+func TestScheddQueryScripted(t *testing.T) {
+	checkRequest := func(request *classad.ClassAd) error {
+		requirements, ok := request.Lookup("Requirements")
+		if !ok || requirements.String() != "true" {
+			return fmt.Errorf("expected Requirements true for an empty constraint, got %v", requirements)
+		}
+		if projection, _ := request.EvaluateAttrString("Projection"); projection != "ClusterId ProcId" {
+			return fmt.Errorf("unexpected projection %q", projection)
+		}
+		return nil
+	}
+	transport := newScriptedTransport(scriptJobQuery(checkRequest, 3, nil))
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ads, err := schedd.Query(testSecurityContext(ctx), "", []string{"ClusterId", "ProcId"})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	if len(ads) != 3 {
+		t.Fatalf("Expected 3 job ads, got %d", len(ads))
+	}
+	for i, ad := range ads {
+		if proc, _ := ad.EvaluateAttrInt("ProcId"); proc != int64(i) {
+			t.Errorf("Ad %d has ProcId %d", i, proc)
+		}
+	}
+}
+
+// TestScheddQueryCancelMidStream verifies cancelling the context while ads
+// are streamed in interrupts the query
+func TestScheddQueryCancelMidStream(t *testing.T) {
+	sent := make(chan struct{})
+	transport := newScriptedTransport(scriptJobQuery(func(*classad.ClassAd) error { return nil }, 2, sent))
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		<-sent
+		cancel()
+	}()
+
+	ads, err := schedd.Query(testSecurityContext(ctx), "true", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if len(ads) > 2 {
+		t.Errorf("Expected at most the 2 ads sent before cancelling, got %d", len(ads))
+	}
+	<-transport.errCh
+}
+
+func TestScheddQueryInvalidConstraint(t *testing.T) {
+	transport := newScriptedTransport(func(context.Context, *stream.Stream) error {
+		return errors.New("unexpected connection")
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	if _, err := schedd.Query(context.Background(), "Owner ==", nil); err == nil {
+		t.Fatal("Expected an invalid constraint to be rejected")
+	}
+	if transport.address != "" {
+		t.Error("Expected no connection for an invalid constraint")
+	}
+}
+
 func TestScheddSubmit(t *testing.T) {
 	schedd := NewSchedd("test_schedd", "schedd.example.com:9618")
 	ctx := context.Background()
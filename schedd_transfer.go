@@ -9,24 +9,186 @@ import (
 	"io/fs"
 	"log"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/PelicanPlatform/classad/classad"
-	"github.com/bbockelm/cedar/client"
 	"github.com/bbockelm/cedar/commands"
 	"github.com/bbockelm/cedar/message"
 	"github.com/bbockelm/cedar/security"
 	"github.com/bbockelm/cedar/stream"
+	"github.com/bbockelm/golang-htcondor/logging"
+	"golang.org/x/time/rate"
 )
 
+// transferChunkSize is the size of the file data messages sent to the
+// schedd, matching the AES buffer size advertised for each file
+// (AES_FILE_BUF_SZ in the C++ FileTransfer)
+const transferChunkSize = 256 * 1024
+
+// fileDataReader reads the data of one file as the peer sends it: a series
+// of messages, each normally one frame of up to the advertised buffer size.
+// The frames are read straight from the stream rather than through a
+// message.Message per chunk, which would copy each chunk into freshly
+// allocated buffers. Read returns io.EOF once size bytes have been read and
+// the message carrying the last of them has ended.
+type fileDataReader struct {
+	ctx       context.Context
+	stream    message.StreamInterface
+	size      int64
+	remaining int64  // bytes not yet received
+	frame     []byte // unread data of the current frame
+	inMessage bool   // a message has started but not ended
+}
+
+func newFileDataReader(ctx context.Context, s message.StreamInterface, size int64) *fileDataReader {
+	return &fileDataReader{ctx: ctx, stream: s, size: size, remaining: size}
+}
+
+func (r *fileDataReader) Read(p []byte) (int, error) {
+	for len(r.frame) == 0 {
+		if r.remaining == 0 && !r.inMessage {
+			return 0, io.EOF
+		}
+		data, isEOM, err := r.stream.ReadFrame(r.ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read file data: %w", err)
+		}
+		if int64(len(data)) > r.remaining {
+			return 0, fmt.Errorf("received more than the %d bytes of file data expected", r.size)
+		}
+		r.frame = data
+		r.remaining -= int64(len(data))
+		r.inMessage = !isEOM
+	}
+	n := copy(p, r.frame)
+	r.frame = r.frame[n:]
+	return n, nil
+}
+
+// progressWriter passes writes on to w, reporting the file's progress after
+// each one until the last, which the caller reports once the file is done
+type progressWriter struct {
+	w          io.Writer
+	onProgress TransferProgressFunc
+	file       string
+	done       int64
+	total      int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.done += int64(n)
+	if p.done < p.total {
+		p.onProgress.report(p.file, p.done, p.total)
+	}
+	return n, err
+}
+
 // procID represents a job ID (cluster.proc)
 type procID struct {
 	cluster int32
 	proc    int32
 }
 
+// TransferOptions configures a sandbox transfer
+type TransferOptions struct {
+	// PerJobWriter, if set, is called once for each matching job before its
+	// files are received. The job's files are written as a separate tar
+	// archive to the returned writer, without the cluster.proc directory
+	// prefix used when several jobs share one archive. The archive is complete
+	// when the next job starts (or the transfer ends); the writer itself is
+	// not closed, so the caller owns its lifetime.
+	PerJobWriter func(jobID JobID) (io.Writer, error)
+
+	// RateLimitBytesPerSec, if positive, caps the rate at which file data is
+	// sent when spooling input files. The limit is shared by all the jobs of
+	// one call, so a large spool does not saturate the link to the schedd.
+	RateLimitBytesPerSec int64
+
+	// OnProgress, if set, is called as each file's data is sent or received,
+	// with the file's sandbox-relative name, the bytes transferred so far
+	// and its size. A last call with bytesDone == bytesTotal marks the file
+	// complete, so an empty file gets one call. It is called from the
+	// goroutine doing the transfer and should return quickly.
+	OnProgress TransferProgressFunc
+
+	// Logger receives warnings about files the transfer skips, such as
+	// input files listed more than once or output files whose names would
+	// escape the sandbox (optional)
+	Logger *logging.Logger
+
+	// onlyFile, if set, limits a sandbox download to the output file with
+	// this sandbox-relative name; the data of every other file is discarded
+	onlyFile string
+
+	// destDir, if set, makes a sandbox download write each job's files
+	// under destDir/cluster.proc rather than to a tar archive
+	destDir string
+}
+
+// TransferProgressFunc reports the progress of one file of a transfer
+type TransferProgressFunc func(file string, bytesDone, bytesTotal int64)
+
+// report calls f, if set
+func (f TransferProgressFunc) report(file string, bytesDone, bytesTotal int64) {
+	if f != nil {
+		f(file, bytesDone, bytesTotal)
+	}
+}
+
+// TransferQuotaError reports that a job's files exceed the cap it declares
+// in MaxTransferInputMB or MaxTransferOutputMB
+type TransferQuotaError struct {
+	JobID     JobID
+	Attribute string // MaxTransferInputMB or MaxTransferOutputMB
+	LimitMB   int64
+	Size      int64 // Bytes of the files, up to and including the first over the cap
+}
+
+func (e *TransferQuotaError) Error() string {
+	return fmt.Sprintf("job %d.%d: %d bytes of files exceed %s = %d", e.JobID.Cluster, e.JobID.Proc, e.Size, e.Attribute, e.LimitMB)
+}
+
+// OutputDestinationError reports that a sandbox download found nothing
+// because the job sends its output files to a URL (output_destination)
+// instead of back to the schedd
+type OutputDestinationError struct {
+	JobID       JobID
+	Destination string
+}
+
+func (e *OutputDestinationError) Error() string {
+	return fmt.Sprintf("job %d.%d sends its output to %s; there are no output files to download from the schedd", e.JobID.Cluster, e.JobID.Proc, e.Destination)
+}
+
+// transferQuota returns the cap the job ad declares in attr, in MB and in
+// bytes, or -1 for both if it declares none. As in HTCondor, a negative
+// value means no cap.
+func transferQuota(ad *classad.ClassAd, attr string) (limitMB, limitBytes int64) {
+	limitMB, ok := ad.EvaluateAttrInt(attr)
+	if !ok || limitMB < 0 {
+		return -1, -1
+	}
+	return limitMB, limitMB * 1024 * 1024
+}
+
+// newTransferLimiter returns a token bucket refilled at bytesPerSec, or nil
+// for no limit. The bucket holds one data message and starts empty, so even
+// the first message waits its turn and the average rate never exceeds the
+// limit. (Messages cannot be made smaller to suit the limit, since the
+// schedd expects full chunks of the advertised buffer size.)
+func newTransferLimiter(bytesPerSec int64) *rate.Limiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	limiter := rate.NewLimiter(rate.Limit(bytesPerSec), transferChunkSize)
+	limiter.AllowN(time.Now(), transferChunkSize)
+	return limiter
+}
+
 // ReceiveJobSandbox downloads job output files (sandbox) from the schedd for jobs matching the constraint.
 // The files are written to a tar archive via the provided writer.
 // This method starts the transfer in a goroutine and returns immediately.
@@ -52,12 +214,27 @@ type procID struct {
 // constraint: ClassAd constraint expression to select jobs (e.g., "ClusterId == 123")
 // w: Writer where the tar archive will be written
 // Returns: A channel that will receive the error result (nil on success)
+//
+// A job submitted with output_destination sends its output files to a URL
+// rather than back to the schedd. If no files are received and such a job
+// matched, the result is an *OutputDestinationError naming the URL; the
+// archive written to w is complete but empty.
 func (s *Schedd) ReceiveJobSandbox(ctx context.Context, constraint string, w io.Writer) <-chan error {
+	return s.ReceiveJobSandboxWithOptions(ctx, constraint, w, nil)
+}
+
+// ReceiveJobSandboxWithOptions is ReceiveJobSandbox with transfer options.
+// When opts.PerJobWriter is set, each job's files go to their own writer and w
+// is unused (it may be nil); otherwise all files go to one tar archive on w.
+func (s *Schedd) ReceiveJobSandboxWithOptions(ctx context.Context, constraint string, w io.Writer, opts *TransferOptions) <-chan error {
+	if opts == nil {
+		opts = &TransferOptions{}
+	}
 	errChan := make(chan error, 1)
 
 	go func() {
 		defer close(errChan)
-		err := s.doReceiveJobSandbox(ctx, constraint, w)
+		err := s.doReceiveJobSandbox(ctx, constraint, w, opts)
 		errChan <- err
 	}()
 
@@ -65,9 +242,9 @@ func (s *Schedd) ReceiveJobSandbox(ctx context.Context, constraint string, w io.
 }
 
 // doReceiveJobSandbox implements the actual transfer logic
-func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w io.Writer) error {
+func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w io.Writer, opts *TransferOptions) (err error) {
 	// 1. Connect to schedd using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, s.address)
+	htcondorClient, err := s.connect(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
 	}
@@ -89,7 +266,7 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return fmt.Errorf("security handshake failed: %w", err)
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// 3. Send version string
@@ -117,13 +294,21 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 
 	// 7. EOM (implicit)
 
-	// Create tar writer
-	tarWriter := tar.NewWriter(w)
-	defer func() {
-		if cerr := tarWriter.Close(); cerr != nil && err == nil {
-			err = fmt.Errorf("failed to close tar writer: %w", cerr)
-		}
-	}()
+	// Create the shared tar writer, unless each job gets its own
+	var tarWriter *tar.Writer
+	if opts.PerJobWriter == nil && opts.destDir == "" {
+		tarWriter = tar.NewWriter(w)
+		defer func() {
+			if cerr := tarWriter.Close(); cerr != nil && err == nil {
+				err = fmt.Errorf("failed to close tar writer: %w", cerr)
+			}
+		}()
+	}
+
+	// Jobs with an output_destination leave nothing in the spool; say so
+	// rather than handing back an empty archive
+	totalFiles := 0
+	var elsewhere *OutputDestinationError
 
 	// 8. For each job, receive job ad and files
 	for i := int32(0); i < jobCount; i++ {
@@ -158,10 +343,13 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 		}
 
 		dirPrefix := fmt.Sprintf("%d.%d", clusterID, procID)
-		if jobCount == 1 {
+		if jobCount == 1 || opts.PerJobWriter != nil {
 			dirPrefix = ""
 		}
 
+		// Output paths are relative to RemoteInitialDir on the execute node
+		remoteInitialDir, _ := jobAd.EvaluateAttrString("RemoteInitialDir")
+
 		// Get list of transfer output files (if specified)
 		var transferOutputFiles map[string]bool
 		if expr, ok := jobAd.Lookup("TransferOutputFiles"); ok {
@@ -170,11 +358,16 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 				fileList := parseFileList(str)
 				transferOutputFiles = make(map[string]bool)
 				for _, f := range fileList {
-					transferOutputFiles[f] = true
+					transferOutputFiles[sandboxOutputPath(f, remoteInitialDir)] = true
 				}
 			}
 		}
 
+		if opts.onlyFile != "" {
+			wanted := transferOutputFiles == nil || transferOutputFiles[opts.onlyFile]
+			transferOutputFiles = map[string]bool{opts.onlyFile: wanted}
+		}
+
 		// c-e. Receive files using FileTransfer protocol
 		// First receive the transfer protocol headers (final_transfer flag and xfer_info)
 		headerMsg := message.NewMessageFromStream(cedarStream)
@@ -195,9 +388,40 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 		// EOM after xfer_info (implicit)
 
 		// Now receive the files
-		if err := s.receiveJobFiles(ctx, cedarStream, tarWriter, dirPrefix, transferOutputFiles); err != nil {
+		var sandbox sandboxWriter = &tarSandbox{tw: tarWriter, prefix: dirPrefix}
+		var jobTarWriter *tar.Writer
+		switch {
+		case opts.destDir != "":
+			sandbox, err = newDirSandbox(filepath.Join(opts.destDir, fmt.Sprintf("%d.%d", clusterID, procID)))
+			if err != nil {
+				return err
+			}
+		case opts.PerJobWriter != nil:
+			jw, err := opts.PerJobWriter(JobID{Cluster: int(clusterID), Proc: int(procID)})
+			if err != nil {
+				return fmt.Errorf("failed to open writer for job %d.%d: %w", clusterID, procID, err)
+			}
+			jobTarWriter = tar.NewWriter(jw)
+			sandbox = &tarSandbox{tw: jobTarWriter}
+		}
+		jobID := JobID{Cluster: int(clusterID), Proc: int(procID)}
+		files, err := s.receiveJobFiles(ctx, cedarStream, sandbox, jobID, jobAd, remoteInitialDir, transferOutputFiles, opts)
+		if err != nil {
+			var quotaErr *TransferQuotaError
+			if errors.As(err, &quotaErr) {
+				return err
+			}
 			return fmt.Errorf("failed to receive files for job %d.%d: %w", clusterID, procID, err)
 		}
+		totalFiles += files
+		if destination, _ := jobAd.EvaluateAttrString("OutputDestination"); destination != "" && files == 0 && elsewhere == nil {
+			elsewhere = &OutputDestinationError{JobID: jobID, Destination: destination}
+		}
+		if jobTarWriter != nil {
+			if err := jobTarWriter.Close(); err != nil {
+				return fmt.Errorf("failed to close tar writer for job %d.%d: %w", clusterID, procID, err)
+			}
+		}
 	}
 
 	// 9. Send OK reply
@@ -211,22 +435,36 @@ func (s *Schedd) doReceiveJobSandbox(ctx context.Context, constraint string, w i
 		return fmt.Errorf("failed to finish OK reply: %w", err)
 	}
 
+	if totalFiles == 0 && elsewhere != nil {
+		return elsewhere
+	}
 	return nil
 }
 
-// receiveJobFiles receives files for a single job and writes them to the tar archive
+// receiveJobFiles receives files for a single job and writes them to sandbox,
+// relative to the job's remoteInitialDir, returning the number of entries
+// written. The transfer is aborted with a
+// TransferQuotaError once the files written exceed the job's
+// MaxTransferOutputMB. Progress is reported to opts.OnProgress.
 //
 //nolint:gocyclo // Complex function required for HTCondor file transfer protocol
-func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream, tarWriter *tar.Writer, dirPrefix string, transferOutputFiles map[string]bool) error {
+func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream, sandbox sandboxWriter, jobID JobID, jobAd *classad.ClassAd, remoteInitialDir string, transferOutputFiles map[string]bool, opts *TransferOptions) (int, error) {
 	// Track whether we've received GO_AHEAD_ALWAYS from the peer
 	goAheadAlways := false
 
+	limitMB, limit := transferQuota(jobAd, "MaxTransferOutputMB")
+	var received int64
+	files := 0
+
+	// File data is copied through one buffer, rather than one per chunk
+	buf := make([]byte, transferChunkSize)
+
 	for {
 		// Read transfer command
 		msg := message.NewMessageFromStream(cedarStream)
 		cmd, err := msg.GetInt32(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to receive transfer command: %w", err)
+			return 0, fmt.Errorf("failed to receive transfer command: %w", err)
 		}
 
 		transferCmd := TransferCommand(cmd)
@@ -236,7 +474,7 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 		switch transferCmd {
 		case CommandFinished:
 			// End of files for this job
-			return nil
+			return files, nil
 
 		case CommandXferFile:
 			// Protocol for receiving a file:
@@ -248,7 +486,7 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 			msg = message.NewMessageFromStream(cedarStream)
 			fileName, err := msg.GetString(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to receive filename: %w", err)
+				return 0, fmt.Errorf("failed to receive filename: %w", err)
 			}
 
 			// Modern HTCondor uses GoAhead protocol
@@ -263,7 +501,7 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 				serverAliveMsg := message.NewMessageFromStream(cedarStream)
 				serverAliveInterval, err := serverAliveMsg.GetInt32(ctx)
 				if err != nil {
-					return fmt.Errorf("failed to receive server alive_interval: %w", err)
+					return 0, fmt.Errorf("failed to receive server alive_interval: %w", err)
 				}
 				_ = serverAliveInterval // Acknowledge it
 				// EOM after alive_interval (implicit)
@@ -275,38 +513,38 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 
 				goAheadMsg := message.NewMessageForStream(cedarStream)
 				if err := goAheadMsg.PutClassAd(ctx, clientGoAhead); err != nil {
-					return fmt.Errorf("failed to send client GoAhead: %w", err)
+					return 0, fmt.Errorf("failed to send client GoAhead: %w", err)
 				}
 				if err := goAheadMsg.FinishMessage(ctx); err != nil {
-					return fmt.Errorf("failed to finish client GoAhead message: %w", err)
+					return 0, fmt.Errorf("failed to finish client GoAhead message: %w", err)
 				}
 
 				// 3. Send our alive_interval request
 				aliveMsg := message.NewMessageForStream(cedarStream)
 				aliveInterval := int32(300) // 5 minutes
 				if err := aliveMsg.PutInt32(ctx, aliveInterval); err != nil {
-					return fmt.Errorf("failed to send alive_interval: %w", err)
+					return 0, fmt.Errorf("failed to send alive_interval: %w", err)
 				}
 				if err := aliveMsg.FinishMessage(ctx); err != nil {
-					return fmt.Errorf("failed to finish alive_interval message: %w", err)
+					return 0, fmt.Errorf("failed to finish alive_interval message: %w", err)
 				}
 
 				// 4. Receive server's GoAhead ClassAd
 				serverGoAheadMsg := message.NewMessageFromStream(cedarStream)
 				serverGoAheadAd, err := serverGoAheadMsg.GetClassAd(ctx)
 				if err != nil {
-					return fmt.Errorf("failed to receive GoAhead from server: %w", err)
+					return 0, fmt.Errorf("failed to receive GoAhead from server: %w", err)
 				}
 
 				// Check Result in GoAhead
 				resultExpr, ok := serverGoAheadAd.Lookup("Result")
 				if !ok {
-					return fmt.Errorf("GoAhead missing Result attribute")
+					return 0, fmt.Errorf("GoAhead missing Result attribute")
 				}
 				resultVal := resultExpr.Eval(nil)
 				result, err := resultVal.IntValue()
 				if err != nil || result <= 0 {
-					return fmt.Errorf("GoAhead failed: Result=%v", result)
+					return 0, fmt.Errorf("GoAhead failed: Result=%v", result)
 				}
 
 				// Check if we got GO_AHEAD_ALWAYS - if so, no more handshakes needed
@@ -325,7 +563,7 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 			msg = message.NewMessageFromStream(cedarStream)
 			fileMode, err := msg.GetInt64(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to receive file permissions: %w", err)
+				return 0, fmt.Errorf("failed to receive file permissions: %w", err)
 			}
 			// EOM after permissions (implicit)
 
@@ -333,140 +571,90 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 			msg = message.NewMessageFromStream(cedarStream)
 			fileSize, err := msg.GetInt64(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to receive file size: %w", err)
+				return 0, fmt.Errorf("failed to receive file size: %w", err)
 			}
 
 			// Read buffer size (for AES encrypted transfers)
 			bufferSize, err := msg.GetInt32(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to receive buffer size: %w", err)
+				return 0, fmt.Errorf("failed to receive buffer size: %w", err)
 			}
-			_ = bufferSize // We know the buffer size but will read in chunks
+			_ = bufferSize // Data messages are read as they come, whatever their size
 			// EOM after size/buffer (implicit)
 
+			cleanPath := sandboxOutputPath(fileName, remoteInitialDir)
+
 			// Check if this file should be transferred (if filter is set)
-			if transferOutputFiles != nil && !transferOutputFiles[fileName] {
+			if transferOutputFiles != nil && !transferOutputFiles[cleanPath] {
 				// File not in the output files list, skip it by reading and discarding
-				discarded := int64(0)
-				const maxChunkSize = 256 * 1024
-				for discarded < fileSize {
-					remaining := fileSize - discarded
-					chunkSize := remaining
-					if chunkSize > maxChunkSize {
-						chunkSize = maxChunkSize
-					}
-
-					chunkMsg := message.NewMessageFromStream(cedarStream)
-					_, err := chunkMsg.GetBytes(ctx, int(chunkSize))
-					if err != nil {
-						return fmt.Errorf("failed to discard file data: %w", err)
-					}
-					discarded += chunkSize
+				if _, err := io.CopyBuffer(io.Discard, newFileDataReader(ctx, cedarStream, fileSize), buf); err != nil {
+					return 0, fmt.Errorf("failed to discard %s: %w", fileName, err)
 				}
 				log.Printf("Skipped file %s (not in TransferOutputFiles)", fileName)
 				continue
 			}
 
-			// Resolve relative path and ensure it stays within dirPrefix
-			cleanPath := path.Clean(fileName)
+			// Ensure the path stays within dirPrefix
 			if strings.HasPrefix(cleanPath, "..") || strings.Contains(cleanPath, "/../") {
 				// Path tries to escape, log and skip
-				log.Printf("Ignoring file with path traversal: %s", fileName)
+				opts.Logger.Warn(logging.DestinationSchedd, "Ignoring file with path traversal", "job_id", fmt.Sprintf("%d.%d", jobID.Cluster, jobID.Proc), "file", fileName)
 				// Read and discard the file data
-				discarded := int64(0)
-				const maxChunkSize = 256 * 1024
-				for discarded < fileSize {
-					remaining := fileSize - discarded
-					chunkSize := remaining
-					if chunkSize > maxChunkSize {
-						chunkSize = maxChunkSize
-					}
-
-					chunkMsg := message.NewMessageFromStream(cedarStream)
-					_, err := chunkMsg.GetBytes(ctx, int(chunkSize))
-					if err != nil {
-						return fmt.Errorf("failed to discard file data: %w", err)
-					}
-					discarded += chunkSize
+				if _, err := io.CopyBuffer(io.Discard, newFileDataReader(ctx, cedarStream, fileSize), buf); err != nil {
+					return 0, fmt.Errorf("failed to discard %s: %w", fileName, err)
 				}
 				continue
 			}
 
-			// Build full path in tar: dirPrefix/fileName
-			tarPath := path.Join(dirPrefix, cleanPath)
-
-			// Write tar header
-			header := &tar.Header{
-				Name:    tarPath,
-				Size:    fileSize,
-				Mode:    fileMode,
-				ModTime: time.Now(),
+			// Stop before writing a file that takes the job over its cap
+			received += fileSize
+			if limit >= 0 && received > limit {
+				return 0, &TransferQuotaError{JobID: jobID, Attribute: "MaxTransferOutputMB", LimitMB: limitMB, Size: received}
 			}
 
-			if err := tarWriter.WriteHeader(header); err != nil {
-				return fmt.Errorf("failed to write tar header for %s: %w", tarPath, err)
+			fileWriter, err := sandbox.CreateFile(cleanPath, fileMode, fileSize)
+			if err != nil {
+				return 0, err
 			}
 
-			// Stream file data directly to tar
-			// File data comes in chunks, each chunk is a separate CEDAR message
-			totalRead := int64(0)
-			const maxChunkSize = 256 * 1024 // Match AES buffer size from sender
-			for totalRead < fileSize {
-				// Calculate chunk size for this read
-				remaining := fileSize - totalRead
-				chunkSize := remaining
-				if chunkSize > maxChunkSize {
-					chunkSize = maxChunkSize
-				}
-
-				// Each chunk is a separate message
-				chunkMsg := message.NewMessageFromStream(cedarStream)
-				chunkData, err := chunkMsg.GetBytes(ctx, int(chunkSize))
-				if err != nil {
-					return fmt.Errorf("failed to read file data chunk for %s: %w", fileName, err)
-				}
-				// EOM after chunk (implicit)
-
-				if _, err := tarWriter.Write(chunkData); err != nil {
-					return fmt.Errorf("failed to write to tar for %s: %w", fileName, err)
-				}
-
-				totalRead += int64(len(chunkData))
+			// Stream file data directly to the sandbox through buf, reused
+			// for every file. The progress writer also keeps io.CopyBuffer
+			// from handing the copy to the file's own ReadFrom.
+			progress := &progressWriter{w: fileWriter, onProgress: opts.OnProgress, file: cleanPath, total: fileSize}
+			totalRead, err := io.CopyBuffer(progress, newFileDataReader(ctx, cedarStream, fileSize), buf)
+			if err != nil {
+				_ = fileWriter.Close()
+				return 0, fmt.Errorf("failed to receive %s: %w", fileName, err)
 			}
 
+			if err := fileWriter.Close(); err != nil {
+				return 0, fmt.Errorf("failed to write %s: %w", fileName, err)
+			}
 			if totalRead != fileSize {
-				return fmt.Errorf("file size mismatch for %s: expected %d, got %d", fileName, fileSize, totalRead)
+				return 0, fmt.Errorf("file size mismatch for %s: expected %d, got %d", fileName, fileSize, totalRead)
 			}
+			opts.OnProgress.report(cleanPath, fileSize, fileSize)
+			files++
 
 		case CommandMkdir:
 			// Read directory name
 			msg = message.NewMessageFromStream(cedarStream)
 			dirName, err := msg.GetString(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to receive directory name: %w", err)
+				return 0, fmt.Errorf("failed to receive directory name: %w", err)
 			}
 			// EOM (implicit)
 
 			// Resolve path
 			cleanPath := path.Clean(dirName)
 			if strings.HasPrefix(cleanPath, "..") || strings.Contains(cleanPath, "/../") {
-				log.Printf("Ignoring directory with path traversal: %s", dirName)
+				opts.Logger.Warn(logging.DestinationSchedd, "Ignoring directory with path traversal", "job_id", fmt.Sprintf("%d.%d", jobID.Cluster, jobID.Proc), "directory", dirName)
 				continue
 			}
 
-			// Build full path in tar: dirPrefix/dirName
-			tarPath := path.Join(dirPrefix, cleanPath)
-
-			// Write directory entry to tar
-			header := &tar.Header{
-				Name:     tarPath + "/",
-				Mode:     0755,
-				Typeflag: tar.TypeDir,
-			}
-
-			if err := tarWriter.WriteHeader(header); err != nil {
-				return fmt.Errorf("failed to write tar header for directory %s: %w", tarPath, err)
+			if err := sandbox.Mkdir(cleanPath); err != nil {
+				return 0, err
 			}
+			files++
 
 		default:
 			// Unknown command, skip it
@@ -501,6 +689,16 @@ func (s *Schedd) receiveJobFiles(ctx context.Context, cedarStream *stream.Stream
 // fsys: Filesystem containing the files to upload
 // Returns: error if the upload fails
 func (s *Schedd) SpoolJobFilesFromFS(ctx context.Context, jobAds []*classad.ClassAd, fsys fs.FS) error {
+	return s.SpoolJobFilesFromFSWithOptions(ctx, jobAds, fsys, nil)
+}
+
+// SpoolJobFilesFromFSWithOptions is SpoolJobFilesFromFS with transfer
+// options; opts.RateLimitBytesPerSec throttles the upload and
+// opts.OnProgress reports it.
+func (s *Schedd) SpoolJobFilesFromFSWithOptions(ctx context.Context, jobAds []*classad.ClassAd, fsys fs.FS, opts *TransferOptions) error {
+	if opts == nil {
+		opts = &TransferOptions{}
+	}
 	if len(jobAds) == 0 {
 		return fmt.Errorf("no job ads provided")
 	}
@@ -536,28 +734,54 @@ func (s *Schedd) SpoolJobFilesFromFS(ctx context.Context, jobAds []*classad.Clas
 		jobIDs[i] = procID{cluster: int32(clusterInt), proc: int32(procInt)}
 
 		// Get TransferInputFiles - this contains the comma-separated list of input files
-		transferInputFilesExpr, ok := ad.Lookup("TransferInputFiles")
-		if !ok {
+		if _, ok := ad.Lookup("TransferInputFiles"); !ok {
 			return fmt.Errorf("job ad %d (job %d.%d) missing TransferInputFiles attribute", i, clusterInt, procInt)
 		}
-
-		// Get the string representation
-		transferInputStr := transferInputFilesExpr.String()
-		transferInputStr = strings.Trim(transferInputStr, "\"") // Remove quotes if present
-
-		if transferInputStr == "" || transferInputStr == "UNDEFINED" {
+		transferInputStr, ok := AdString(ad, "TransferInputFiles")
+		if !ok || transferInputStr == "" {
 			return fmt.Errorf("job ad %d (job %d.%d): TransferInputFiles is empty or undefined", i, clusterInt, procInt)
 		}
 
-		// Parse the file list
-		fileLists[i] = parseFileList(transferInputStr)
-		if len(fileLists[i]) == 0 {
+		// Parse the file list, uploading each file once
+		files, duplicates := dedupeFileList(parseFileList(transferInputStr))
+		if len(files) == 0 {
 			return fmt.Errorf("job ad %d (job %d.%d): parsed file list is empty", i, clusterInt, procInt)
 		}
+		for _, f := range duplicates {
+			opts.Logger.Warn(logging.DestinationSchedd, "Input file listed more than once; spooling it once",
+				"job_id", fmt.Sprintf("%d.%d", clusterInt, procInt), "file", f)
+		}
+
+		// URLs are fetched on the execute node, not spooled
+		for _, f := range files {
+			if !isURL(f) {
+				fileLists[i] = append(fileLists[i], f)
+			}
+		}
+
+		// Refuse to spool more than the job allows itself
+		if limitMB, limit := transferQuota(ad, "MaxTransferInputMB"); limit >= 0 {
+			var total int64
+			for _, f := range fileLists[i] {
+				info, err := fs.Stat(fsys, f)
+				if err != nil {
+					return fmt.Errorf("job %d.%d: failed to stat file %s: %w", clusterInt, procInt, f, err)
+				}
+				total += info.Size()
+			}
+			if total > limit {
+				return &TransferQuotaError{
+					JobID:     JobID{Cluster: int(clusterInt), Proc: int(procInt)},
+					Attribute: "MaxTransferInputMB",
+					LimitMB:   limitMB,
+					Size:      total,
+				}
+			}
+		}
 	}
 
 	// 1. Connect to schedd using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, s.address)
+	htcondorClient, err := s.connect(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
 	}
@@ -583,7 +807,7 @@ func (s *Schedd) SpoolJobFilesFromFS(ctx context.Context, jobAds []*classad.Clas
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return fmt.Errorf("security handshake failed: %w", err)
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// 3. Send version string
@@ -621,8 +845,9 @@ func (s *Schedd) SpoolJobFilesFromFS(ctx context.Context, jobAds []*classad.Clas
 	}
 
 	// 8. For each job, send files using file transfer protocol
+	limiter := newTransferLimiter(opts.RateLimitBytesPerSec)
 	for i, ad := range jobAds {
-		if err := s.sendJobFiles(ctx, cedarStream, ad, fsys, fileLists[i], jobIDs[i]); err != nil {
+		if err := s.sendJobFiles(ctx, cedarStream, ad, fsys, fileLists[i], jobIDs[i], limiter, opts.OnProgress); err != nil {
 			return fmt.Errorf("failed to send files for job %d.%d: %w", jobIDs[i].cluster, jobIDs[i].proc, err)
 		}
 	}
@@ -631,8 +856,10 @@ func (s *Schedd) SpoolJobFilesFromFS(ctx context.Context, jobAds []*classad.Clas
 }
 
 // sendSingleFile sends a single file using the HTCondor file transfer protocol
-// This implements the per-file protocol from FileTransfer and ReliSock
-func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream, fileName string, fileSize int64, fileMode int64, fileReader io.Reader, peerGoesAheadAlways *bool, fileIndex int) error {
+// This implements the per-file protocol from FileTransfer and ReliSock.
+// If limiter is non-nil, the file data is sent no faster than it allows;
+// progress is reported to onProgress.
+func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream, fileName string, fileSize int64, fileMode int64, fileReader io.Reader, peerGoesAheadAlways *bool, fileIndex int, limiter *rate.Limiter, onProgress TransferProgressFunc) error {
 	log.Printf("Sending file: %s", fileName)
 
 	// Send CommandXferFile
@@ -737,8 +964,7 @@ func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream,
 	}
 
 	// Send buffer size for AESGCM encrypted transfers (256KB)
-	const aesBufferSize = int32(256 * 1024) // AES_FILE_BUF_SZ from C++
-	if err := msg.PutInt32(ctx, aesBufferSize); err != nil {
+	if err := msg.PutInt32(ctx, int32(transferChunkSize)); err != nil {
 		return fmt.Errorf("failed to send buffer size: %w", err)
 	}
 
@@ -746,23 +972,29 @@ func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream,
 		return fmt.Errorf("failed to finish file size message: %w", err)
 	}
 
-	// Stream file data in chunks matching the buffer size we advertised (256KB for AES)
-	const aesChunkSize = 256 * 1024
-	buffer := make([]byte, aesChunkSize)
+	// Stream file data in chunks matching the buffer size we advertised
+	// (256KB for AES), reading each one into the same buffer
+	buffer := make([]byte, transferChunkSize)
 	var totalRead int64
 
 	for {
 		n, err := fileReader.Read(buffer)
 		if n > 0 {
-			// In buffered mode, each chunk is a separate CEDAR message
-			chunkMsg := message.NewMessageForStream(cedarStream)
-			if err := chunkMsg.PutBytes(ctx, buffer[:n]); err != nil {
-				return fmt.Errorf("failed to send file data chunk for %s: %w", fileName, err)
+			if limiter != nil {
+				if err := limiter.WaitN(ctx, n); err != nil {
+					return fmt.Errorf("failed to wait for transfer rate limit for %s: %w", fileName, err)
+				}
 			}
-			if err := chunkMsg.FinishMessage(ctx); err != nil {
-				return fmt.Errorf("failed to finish file data chunk message for %s: %w", fileName, err)
+			// In buffered mode, each chunk is a separate CEDAR message. It
+			// fits in a single frame, so it is written straight from buffer
+			// rather than copied into a message.Message first.
+			if err := cedarStream.WriteFrame(ctx, buffer[:n], true); err != nil {
+				return fmt.Errorf("failed to send file data chunk for %s: %w", fileName, err)
 			}
 			totalRead += int64(n)
+			if totalRead < fileSize {
+				onProgress.report(fileName, totalRead, fileSize)
+			}
 		}
 
 		if errors.Is(err, io.EOF) {
@@ -777,6 +1009,7 @@ func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream,
 		return fmt.Errorf("file size mismatch for %s: expected %d, read %d", fileName, fileSize, totalRead)
 	}
 
+	onProgress.report(fileName, fileSize, fileSize)
 	log.Printf("  Successfully sent %d bytes for %s", totalRead, fileName)
 	return nil
 }
@@ -790,7 +1023,7 @@ func (s *Schedd) sendSingleFile(ctx context.Context, cedarStream *stream.Stream,
 // 3. EOM
 // 4. For each file: send CommandXferFile, filename, file data
 // 5. Send CommandFinished
-func (s *Schedd) sendJobFiles(ctx context.Context, cedarStream *stream.Stream, _ *classad.ClassAd, fsys fs.FS, fileList []string, _ procID) error {
+func (s *Schedd) sendJobFiles(ctx context.Context, cedarStream *stream.Stream, _ *classad.ClassAd, fsys fs.FS, fileList []string, _ procID, limiter *rate.Limiter, onProgress TransferProgressFunc) error {
 	// Use provided file list
 	inputFiles := fileList
 
@@ -849,7 +1082,7 @@ func (s *Schedd) sendJobFiles(ctx context.Context, cedarStream *stream.Stream, _
 			log.Printf("  File size: %d bytes, mode: %o", fileSize, fileMode)
 
 			// Send the file using the common protocol
-			if err := s.sendSingleFile(ctx, cedarStream, filePath, fileSize, int64(fileMode), file, &peerGoesAheadAlways, i); err != nil {
+			if err := s.sendSingleFile(ctx, cedarStream, filePath, fileSize, int64(fileMode), file, &peerGoesAheadAlways, i, limiter, onProgress); err != nil {
 				_ = file.Close()
 				return err
 			}
@@ -943,6 +1176,16 @@ func (s *Schedd) sendJobFiles(ctx context.Context, cedarStream *stream.Stream, _
 // r: Reader providing the tar archive
 // Returns: error if the upload fails
 func (s *Schedd) SpoolJobFilesFromTar(ctx context.Context, jobAds []*classad.ClassAd, r io.Reader) error {
+	return s.SpoolJobFilesFromTarWithOptions(ctx, jobAds, r, nil)
+}
+
+// SpoolJobFilesFromTarWithOptions is SpoolJobFilesFromTar with transfer
+// options; opts.RateLimitBytesPerSec throttles the upload and
+// opts.OnProgress reports it.
+func (s *Schedd) SpoolJobFilesFromTarWithOptions(ctx context.Context, jobAds []*classad.ClassAd, r io.Reader, opts *TransferOptions) error {
+	if opts == nil {
+		opts = &TransferOptions{}
+	}
 	if len(jobAds) == 0 {
 		return fmt.Errorf("no job ads provided")
 	}
@@ -1010,7 +1253,7 @@ func (s *Schedd) SpoolJobFilesFromTar(ctx context.Context, jobAds []*classad.Cla
 	}
 
 	// 1. Connect to schedd using cedar client
-	htcondorClient, err := client.ConnectToAddress(ctx, s.address)
+	htcondorClient, err := s.connect(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to schedd at %s: %w", s.address, err)
 	}
@@ -1036,7 +1279,7 @@ func (s *Schedd) SpoolJobFilesFromTar(ctx context.Context, jobAds []*classad.Cla
 	auth := security.NewAuthenticator(secConfig, cedarStream)
 	_, err = auth.ClientHandshake(ctx)
 	if err != nil {
-		return fmt.Errorf("security handshake failed: %w", err)
+		return fmt.Errorf("security handshake failed: %w", classifyHandshakeError(err, secConfig.Command))
 	}
 
 	// 3. Send version string
@@ -1074,7 +1317,8 @@ func (s *Schedd) SpoolJobFilesFromTar(ctx context.Context, jobAds []*classad.Cla
 
 	// 8. Process tar archive and send files for each job
 	singleJobMode := len(jobAds) == 1
-	if err := s.sendJobFilesFromTar(ctx, cedarStream, r, jobInfoMap, jobIDs, singleJobMode); err != nil {
+	limiter := newTransferLimiter(opts.RateLimitBytesPerSec)
+	if err := s.sendJobFilesFromTar(ctx, cedarStream, r, jobInfoMap, jobIDs, singleJobMode, limiter, opts.OnProgress); err != nil {
 		return fmt.Errorf("failed to send files from tar: %w", err)
 	}
 
@@ -1087,12 +1331,13 @@ type jobInfo struct {
 	inputFiles map[string]bool // Set of files that should be transferred
 	index      int             // Index in the original jobAds array
 	jobID      procID
+	spooled    int64 // Bytes of input files sent so far
 }
 
 // sendJobFilesFromTar processes the tar archive and sends files to schedd
 //
 //nolint:gocyclo // Complex function handling tar streaming, job switching, and file transfer protocol
-func (s *Schedd) sendJobFilesFromTar(ctx context.Context, cedarStream *stream.Stream, r io.Reader, jobInfoMap map[procID]*jobInfo, jobIDs []procID, singleJobMode bool) error {
+func (s *Schedd) sendJobFilesFromTar(ctx context.Context, cedarStream *stream.Stream, r io.Reader, jobInfoMap map[procID]*jobInfo, jobIDs []procID, singleJobMode bool, limiter *rate.Limiter, onProgress TransferProgressFunc) error {
 	tarReader := tar.NewReader(r)
 
 	var currentJobID *procID
@@ -1294,8 +1539,19 @@ func (s *Schedd) sendJobFilesFromTar(ctx context.Context, cedarStream *stream.St
 		fileSize := header.Size
 		fileMode := header.FileInfo().Mode().Perm()
 
+		// Stop before sending a file that takes the job over its cap
+		currentJobInfo.spooled += fileSize
+		if limitMB, limit := transferQuota(currentJobInfo.ad, "MaxTransferInputMB"); limit >= 0 && currentJobInfo.spooled > limit {
+			return &TransferQuotaError{
+				JobID:     JobID{Cluster: int(currentJobID.cluster), Proc: int(currentJobID.proc)},
+				Attribute: "MaxTransferInputMB",
+				LimitMB:   limitMB,
+				Size:      currentJobInfo.spooled,
+			}
+		}
+
 		// Use the shared sendSingleFile function with tarReader as the file reader
-		if err := s.sendSingleFile(ctx, cedarStream, fileName, fileSize, int64(fileMode), tarReader, &peerGoesAheadAlways, fileIndex); err != nil {
+		if err := s.sendSingleFile(ctx, cedarStream, fileName, fileSize, int64(fileMode), tarReader, &peerGoesAheadAlways, fileIndex, limiter, onProgress); err != nil {
 			return err
 		}
 
@@ -1419,3 +1675,21 @@ func (s *Schedd) sendTransferProtocolHeaders(ctx context.Context, cedarStream *s
 
 	return nil
 }
+
+// sandboxOutputPath returns the path of an output file within the job's
+// sandbox. Output paths on the execute node are relative to the job's
+// RemoteInitialDir, so a name that still carries that directory (absolute or
+// relative) has it stripped instead of being nested inside the sandbox.
+func sandboxOutputPath(fileName, remoteInitialDir string) string {
+	cleanPath := path.Clean(fileName)
+	if remoteInitialDir == "" {
+		return cleanPath
+	}
+	dir := path.Clean(remoteInitialDir)
+	for _, prefix := range []string{dir, strings.TrimPrefix(dir, "/")} {
+		if prefix != "" && prefix != "." && prefix != "/" && strings.HasPrefix(cleanPath, prefix+"/") {
+			return cleanPath[len(prefix)+1:]
+		}
+	}
+	return cleanPath
+}
@@ -0,0 +1,952 @@
+package htcondor
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+	"github.com/bbockelm/golang-htcondor/logging"
+)
+
+// sendSandboxJob replays the server side of one job in a sandbox transfer:
+// the job ad, transfer headers, a single file and the finished command
+func sendSandboxJob(ctx context.Context, s *stream.Stream, cluster, proc int64, fileName string, content []byte) error {
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", cluster)
+	_ = jobAd.Set("ProcId", proc)
+	return sendSandboxJobAd(ctx, s, jobAd, fileName, content)
+}
+
+// sendSandboxJobAd is sendSandboxJob with a caller-supplied job ad
+func sendSandboxJobAd(ctx context.Context, s *stream.Stream, jobAd *classad.ClassAd, fileName string, content []byte) error {
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, jobAd) }); err != nil {
+		return err
+	}
+
+	xferInfo := classad.New()
+	_ = xferInfo.Set("SandboxSize", int64(len(content)))
+	if err := sendMessage(ctx, s, func(m *message.Message) error {
+		if err := m.PutInt32(ctx, 1); err != nil {
+			return err
+		}
+		return m.PutClassAd(ctx, xferInfo)
+	}); err != nil {
+		return err
+	}
+
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandXferFile)) }); err != nil {
+		return err
+	}
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutString(ctx, fileName) }); err != nil {
+		return err
+	}
+
+	// GoAhead exchange (once per job)
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 300) }); err != nil {
+		return err
+	}
+	if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+		return fmt.Errorf("client GoAhead: %w", err)
+	}
+	if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+		return fmt.Errorf("client alive_interval: %w", err)
+	}
+	goAhead := classad.New()
+	_ = goAhead.Set("Result", int64(2))
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, goAhead) }); err != nil {
+		return err
+	}
+
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt64(ctx, 0644) }); err != nil {
+		return err
+	}
+	if err := sendMessage(ctx, s, func(m *message.Message) error {
+		if err := m.PutInt64(ctx, int64(len(content))); err != nil {
+			return err
+		}
+		return m.PutInt32(ctx, 256*1024)
+	}); err != nil {
+		return err
+	}
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutBytes(ctx, content) }); err != nil {
+		return err
+	}
+
+	return sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandFinished)) })
+}
+
+func TestReceiveJobSandboxPerJobWriter(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+
+		// Two matching jobs
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 2) }); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 0, "output.txt", []byte("job zero\n")); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 1, "output.txt", []byte("job one\n")); err != nil {
+			return err
+		}
+
+		reply, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		if reply != 0 {
+			return fmt.Errorf("unexpected final reply %d", reply)
+		}
+		return nil
+	})
+
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	buffers := make(map[JobID]*bytes.Buffer)
+	opts := &TransferOptions{
+		PerJobWriter: func(jobID JobID) (io.Writer, error) {
+			buf := &bytes.Buffer{}
+			buffers[jobID] = buf
+			return buf, nil
+		},
+	}
+	if err := <-schedd.ReceiveJobSandboxWithOptions(ctx, "ClusterId == 42", nil, opts); err != nil {
+		t.Fatalf("ReceiveJobSandboxWithOptions failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+
+	expected := map[JobID]string{
+		{Cluster: 42, Proc: 0}: "job zero\n",
+		{Cluster: 42, Proc: 1}: "job one\n",
+	}
+	if len(buffers) != len(expected) {
+		t.Fatalf("Expected %d writers, got %d", len(expected), len(buffers))
+	}
+	for jobID, want := range expected {
+		buf, ok := buffers[jobID]
+		if !ok {
+			t.Errorf("No writer requested for job %d.%d", jobID.Cluster, jobID.Proc)
+			continue
+		}
+		tr := tar.NewReader(buf)
+		header, err := tr.Next()
+		if err != nil {
+			t.Fatalf("Failed to read tar entry for job %d.%d: %v", jobID.Cluster, jobID.Proc, err)
+		}
+		// Per-job archives are not prefixed with cluster.proc
+		if header.Name != "output.txt" {
+			t.Errorf("Expected tar entry 'output.txt' for job %d.%d, got '%s'", jobID.Cluster, jobID.Proc, header.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Failed to read tar data: %v", err)
+		}
+		if string(data) != want {
+			t.Errorf("Job %d.%d: expected content %q, got %q", jobID.Cluster, jobID.Proc, want, data)
+		}
+		if _, err := tr.Next(); !errors.Is(err, io.EOF) {
+			t.Errorf("Job %d.%d: expected a single tar entry, got err=%v", jobID.Cluster, jobID.Proc, err)
+		}
+	}
+}
+
+func TestReceiveJobSandboxToDir(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+
+		// Three jobs: a file in a subdirectory, a plain file, and a file
+		// whose name tries to escape the job's directory
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 3) }); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 0, "logs/run.log", []byte("job zero\n")); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 1, "output.txt", []byte("job one\n")); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 2, "../42.0/output.txt", []byte("escaped\n")); err != nil {
+			return err
+		}
+		if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	destDir := t.TempDir()
+	if err := schedd.ReceiveJobSandboxToDir(ctx, "ClusterId == 42", destDir); err != nil {
+		t.Fatalf("ReceiveJobSandboxToDir failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+
+	for name, want := range map[string]string{
+		"42.0/logs/run.log": "job zero\n",
+		"42.1/output.txt":   "job one\n",
+	} {
+		file := filepath.Join(destDir, filepath.FromSlash(name))
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", name, err)
+			continue
+		}
+		if string(data) != want {
+			t.Errorf("%s: expected %q, got %q", name, want, data)
+		}
+		if info, err := os.Stat(file); err != nil || info.Mode().Perm() != 0644 {
+			t.Errorf("%s: expected mode 0644, got %v (%v)", name, info.Mode(), err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(destDir, "42.0", "output.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected the escaping file to be skipped, got %v", err)
+	}
+	if entries, err := os.ReadDir(filepath.Join(destDir, "42.2")); err != nil || len(entries) != 0 {
+		t.Errorf("Expected an empty directory for job 42.2, got %v (%v)", entries, err)
+	}
+}
+
+func TestReceiveJobSandboxRemoteInitialDir(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+
+		// Two jobs, so files are placed under cluster.proc directories
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 2) }); err != nil {
+			return err
+		}
+		jobs := []struct {
+			proc      int64
+			remoteDir string
+			fileName  string
+		}{
+			{0, "/scratch/work", "/scratch/work/results/out.txt"},
+			{1, "work", "work/results/out.txt"},
+		}
+		for _, job := range jobs {
+			jobAd := classad.New()
+			_ = jobAd.Set("ClusterId", int64(42))
+			_ = jobAd.Set("ProcId", job.proc)
+			_ = jobAd.Set("RemoteInitialDir", job.remoteDir)
+			_ = jobAd.Set("TransferOutputFiles", "results/out.txt")
+			if err := sendSandboxJobAd(ctx, s, jobAd, job.fileName, []byte("output\n")); err != nil {
+				return err
+			}
+		}
+
+		reply, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		if reply != 0 {
+			return fmt.Errorf("unexpected final reply %d", reply)
+		}
+		return nil
+	})
+
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	var buf bytes.Buffer
+	if err := <-schedd.ReceiveJobSandbox(ctx, "ClusterId == 42", &buf); err != nil {
+		t.Fatalf("ReceiveJobSandbox failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+
+	var names []string
+	tr := tar.NewReader(&buf)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read tar entry: %v", err)
+		}
+		names = append(names, header.Name)
+	}
+	expected := []string{"42.0/results/out.txt", "42.1/results/out.txt"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected tar entries %v, got %v", expected, names)
+	}
+}
+
+func TestSandboxOutputPath(t *testing.T) {
+	tests := []struct {
+		fileName, remoteInitialDir, want string
+	}{
+		{"out.txt", "", "out.txt"},
+		{"results/../out.txt", "", "out.txt"},
+		{"/scratch/work/out.txt", "/scratch/work", "out.txt"},
+		{"scratch/work/results/out.txt", "/scratch/work/", "results/out.txt"},
+		{"work/out.txt", "work", "out.txt"},
+		{"workdir/out.txt", "work", "workdir/out.txt"},
+		{"out.txt", "/", "out.txt"},
+	}
+	for _, tt := range tests {
+		if got := sandboxOutputPath(tt.fileName, tt.remoteInitialDir); got != tt.want {
+			t.Errorf("sandboxOutputPath(%q, %q) = %q, want %q", tt.fileName, tt.remoteInitialDir, got, tt.want)
+		}
+	}
+}
+
+// receiveSpooledJob replays the schedd side of one job in a spool upload
+// and returns the received files' contents by name
+func receiveSpooledJob(ctx context.Context, s *stream.Stream) (map[string][]byte, error) {
+	header := message.NewMessageFromStream(s)
+	if _, err := header.GetInt32(ctx); err != nil {
+		return nil, fmt.Errorf("final_transfer flag: %w", err)
+	}
+	if _, err := header.GetClassAd(ctx); err != nil {
+		return nil, fmt.Errorf("xfer_info: %w", err)
+	}
+
+	files := make(map[string][]byte)
+	for fileIndex := 0; ; fileIndex++ {
+		cmd, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("transfer command: %w", err)
+		}
+		if cmd == int32(CommandFinished) {
+			break
+		}
+		name, err := message.NewMessageFromStream(s).GetString(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("file name: %w", err)
+		}
+		if _, ok := files[name]; ok {
+			return nil, fmt.Errorf("file %s sent twice", name)
+		}
+
+		// GoAhead exchange (first file only, since we answer GO_AHEAD_ALWAYS)
+		if fileIndex == 0 {
+			if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+				return nil, fmt.Errorf("client alive_interval: %w", err)
+			}
+			goAhead := classad.New()
+			_ = goAhead.Set("Result", int64(2))
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, goAhead) }); err != nil {
+				return nil, err
+			}
+			if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 300) }); err != nil {
+				return nil, err
+			}
+			if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+				return nil, fmt.Errorf("client GoAhead: %w", err)
+			}
+		}
+
+		if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+			return nil, fmt.Errorf("file mode: %w", err)
+		}
+		sizeMsg := message.NewMessageFromStream(s)
+		size, err := sizeMsg.GetInt64(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("file size: %w", err)
+		}
+		if _, err := sizeMsg.GetInt32(ctx); err != nil {
+			return nil, fmt.Errorf("buffer size: %w", err)
+		}
+
+		var data []byte
+		for int64(len(data)) < size {
+			chunk, err := message.NewMessageFromStream(s).GetBytes(ctx, int(min(size-int64(len(data)), transferChunkSize)))
+			if err != nil {
+				return nil, fmt.Errorf("file data: %w", err)
+			}
+			data = append(data, chunk...)
+		}
+		files[name] = data
+	}
+
+	if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+		return nil, fmt.Errorf("upload TransferAck: %w", err)
+	}
+	ack := classad.New()
+	_ = ack.Set("Result", int64(0))
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, ack) }); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func TestSpoolJobFilesRateLimit(t *testing.T) {
+	const (
+		rateLimit = 256 * 1024
+		fileSize  = 128 * 1024
+	)
+	input1 := bytes.Repeat([]byte("a"), fileSize)
+	input2 := bytes.Repeat([]byte("b"), fileSize)
+
+	received := make(map[string][]byte)
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		header := message.NewMessageFromStream(s)
+		if _, err := header.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		numJobs, err := header.GetInt32(ctx)
+		if err != nil {
+			return fmt.Errorf("job count: %w", err)
+		}
+		ids := message.NewMessageFromStream(s)
+		for i := int32(0); i < 2*numJobs; i++ {
+			if _, err := ids.GetInt32(ctx); err != nil {
+				return fmt.Errorf("job IDs: %w", err)
+			}
+		}
+		for i := int32(0); i < numJobs; i++ {
+			files, err := receiveSpooledJob(ctx, s)
+			if err != nil {
+				return fmt.Errorf("job %d: %w", i, err)
+			}
+			for name, data := range files {
+				received[name] = data
+			}
+		}
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	fsys := fstest.MapFS{
+		"input1.dat": &fstest.MapFile{Data: input1, Mode: 0644},
+		"input2.dat": &fstest.MapFile{Data: input2, Mode: 0644},
+	}
+	var jobAds []*classad.ClassAd
+	for proc, file := range []string{"input1.dat", "input2.dat"} {
+		ad := classad.New()
+		_ = ad.Set("ClusterId", int64(42))
+		_ = ad.Set("ProcId", int64(proc))
+		_ = ad.Set("TransferInputFiles", file)
+		jobAds = append(jobAds, ad)
+	}
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := schedd.SpoolJobFilesFromFSWithOptions(ctx, jobAds, fsys, &TransferOptions{RateLimitBytesPerSec: rateLimit})
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("SpoolJobFilesFromFSWithOptions failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	if !bytes.Equal(received["input1.dat"], input1) || !bytes.Equal(received["input2.dat"], input2) {
+		t.Fatalf("Received files do not match the input (got %d and %d bytes)",
+			len(received["input1.dat"]), len(received["input2.dat"]))
+	}
+
+	// Both jobs share the limit, so their files take a second at 256 KiB/s
+	total := float64(2 * fileSize)
+	observed := total / elapsed.Seconds()
+	if observed > 1.1*rateLimit {
+		t.Errorf("Observed send rate %.0f bytes/s exceeds the %d bytes/s limit", observed, rateLimit)
+	}
+	if observed < 0.2*rateLimit {
+		t.Errorf("Observed send rate %.0f bytes/s, far below the %d bytes/s limit", observed, rateLimit)
+	}
+}
+
+func TestSpoolJobFilesDuplicateInput(t *testing.T) {
+	input := []byte("input data\n")
+
+	var received map[string][]byte
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		header := message.NewMessageFromStream(s)
+		if _, err := header.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := header.GetInt32(ctx); err != nil {
+			return fmt.Errorf("job count: %w", err)
+		}
+		ids := message.NewMessageFromStream(s)
+		for i := 0; i < 2; i++ {
+			if _, err := ids.GetInt32(ctx); err != nil {
+				return fmt.Errorf("job IDs: %w", err)
+			}
+		}
+		var err error
+		received, err = receiveSpooledJob(ctx, s)
+		return err
+	})
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	fsys := fstest.MapFS{
+		"input.dat": &fstest.MapFile{Data: input, Mode: 0644},
+		"other.dat": &fstest.MapFile{Data: input, Mode: 0644},
+	}
+	ad := classad.New()
+	_ = ad.Set("ClusterId", int64(42))
+	_ = ad.Set("ProcId", int64(0))
+	_ = ad.Set("TransferInputFiles", "input.dat,other.dat,input.dat")
+
+	logPath := filepath.Join(t.TempDir(), "spool.log")
+	logger, err := logging.New(&logging.Config{OutputPath: logPath, MinVerbosity: logging.VerbosityWarn})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+	if err := schedd.SpoolJobFilesFromFSWithOptions(ctx, []*classad.ClassAd{ad}, fsys, &TransferOptions{Logger: logger}); err != nil {
+		t.Fatalf("SpoolJobFilesFromFS failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	if len(received) != 2 || !bytes.Equal(received["input.dat"], input) {
+		t.Errorf("Expected input.dat and other.dat once each, got %d files", len(received))
+	}
+	logs, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	if !strings.Contains(string(logs), "Input file listed more than once") || !strings.Contains(string(logs), "input.dat") {
+		t.Errorf("Expected a duplicate input warning, got logs:\n%s", logs)
+	}
+}
+
+func TestSpoolJobFilesInputQuota(t *testing.T) {
+	transport := newScriptedTransport(func(context.Context, *stream.Stream) error {
+		return errors.New("the schedd should not be contacted")
+	})
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	fsys := fstest.MapFS{
+		"big.dat":   &fstest.MapFile{Data: make([]byte, 1024*1024), Mode: 0644},
+		"small.dat": &fstest.MapFile{Data: []byte("x"), Mode: 0644},
+	}
+	ad := classad.New()
+	_ = ad.Set("ClusterId", int64(42))
+	_ = ad.Set("ProcId", int64(3))
+	_ = ad.Set("TransferInputFiles", "big.dat,small.dat")
+	_ = ad.Set("MaxTransferInputMB", int64(1))
+
+	err := schedd.SpoolJobFilesFromFS(context.Background(), []*classad.ClassAd{ad}, fsys)
+	var quotaErr *TransferQuotaError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("Expected a TransferQuotaError, got %v", err)
+	}
+	if quotaErr.JobID != (JobID{Cluster: 42, Proc: 3}) || quotaErr.Attribute != "MaxTransferInputMB" || quotaErr.Size != 1024*1024+1 {
+		t.Errorf("Unexpected quota error: %+v", quotaErr)
+	}
+	if transport.address != "" {
+		t.Error("Expected the spool to be refused before connecting")
+	}
+}
+
+func TestReceiveJobSandboxOutputQuota(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) }); err != nil {
+			return err
+		}
+		jobAd := classad.New()
+		_ = jobAd.Set("ClusterId", int64(42))
+		_ = jobAd.Set("ProcId", int64(0))
+		_ = jobAd.Set("MaxTransferOutputMB", int64(0))
+		// The client hangs up once it sees the file is over the cap
+		_ = sendSandboxJobAd(ctx, s, jobAd, "output.txt", []byte("too much\n"))
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	var buf bytes.Buffer
+	err := <-schedd.ReceiveJobSandbox(ctx, "ClusterId == 42", &buf)
+	var quotaErr *TransferQuotaError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("Expected a TransferQuotaError, got %v", err)
+	}
+	if quotaErr.Attribute != "MaxTransferOutputMB" || quotaErr.LimitMB != 0 || quotaErr.Size != int64(len("too much\n")) {
+		t.Errorf("Unexpected quota error: %+v", quotaErr)
+	}
+	<-transport.errCh
+}
+
+// TestReceiveJobSandboxOutputDestination verifies a job whose output went to
+// a URL yields an OutputDestinationError and an empty archive
+func TestReceiveJobSandboxOutputDestination(t *testing.T) {
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) }); err != nil {
+			return err
+		}
+		jobAd := classad.New()
+		_ = jobAd.Set("ClusterId", int64(42))
+		_ = jobAd.Set("ProcId", int64(0))
+		_ = jobAd.Set("OutputDestination", "osdf:///ospool/results/42")
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, jobAd) }); err != nil {
+			return err
+		}
+
+		// No files, just the transfer headers and the end of the job
+		xferInfo := classad.New()
+		_ = xferInfo.Set("SandboxSize", int64(0))
+		if err := sendMessage(ctx, s, func(m *message.Message) error {
+			if err := m.PutInt32(ctx, 1); err != nil {
+				return err
+			}
+			return m.PutClassAd(ctx, xferInfo)
+		}); err != nil {
+			return err
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandFinished)) }); err != nil {
+			return err
+		}
+		if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		return nil
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	var buf bytes.Buffer
+	err := <-schedd.ReceiveJobSandbox(ctx, "ClusterId == 42", &buf)
+	var elsewhere *OutputDestinationError
+	if !errors.As(err, &elsewhere) {
+		t.Fatalf("Expected an OutputDestinationError, got %v", err)
+	}
+	if elsewhere.JobID != (JobID{Cluster: 42, Proc: 0}) || elsewhere.Destination != "osdf:///ospool/results/42" {
+		t.Errorf("Unexpected error: %+v", elsewhere)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+	if _, err := tar.NewReader(&buf).Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected an empty archive, got %v", err)
+	}
+}
+
+// progressCall is one call of a TransferOptions.OnProgress callback
+type progressCall struct {
+	file        string
+	done, total int64
+}
+
+func TestSpoolJobFilesFromTarProgress(t *testing.T) {
+	big := bytes.Repeat([]byte("x"), transferChunkSize+1000)
+
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	for name, data := range map[string][]byte{"big.dat": big, "empty.txt": nil} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data))}); err != nil {
+			t.Fatalf("Failed to write tar header: %v", err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatalf("Failed to write tar data: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Failed to close tar writer: %v", err)
+	}
+
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		header := message.NewMessageFromStream(s)
+		if _, err := header.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := header.GetInt32(ctx); err != nil {
+			return fmt.Errorf("job count: %w", err)
+		}
+		ids := message.NewMessageFromStream(s)
+		for range 2 {
+			if _, err := ids.GetInt32(ctx); err != nil {
+				return fmt.Errorf("job IDs: %w", err)
+			}
+		}
+		_, err := receiveSpooledJob(ctx, s)
+		return err
+	})
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	jobAd := classad.New()
+	_ = jobAd.Set("ClusterId", int64(42))
+	_ = jobAd.Set("ProcId", int64(0))
+	_ = jobAd.Set("TransferInputFiles", "big.dat, empty.txt")
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	var calls []progressCall
+	opts := &TransferOptions{OnProgress: func(file string, done, total int64) {
+		calls = append(calls, progressCall{file, done, total})
+	}}
+	if err := schedd.SpoolJobFilesFromTarWithOptions(ctx, []*classad.ClassAd{jobAd}, &archive, opts); err != nil {
+		t.Fatalf("SpoolJobFilesFromTarWithOptions failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	// Files are sent in archive order, which the map above leaves open
+	size := int64(len(big))
+	want := map[string][]progressCall{
+		"big.dat":   {{"big.dat", transferChunkSize, size}, {"big.dat", size, size}},
+		"empty.txt": {{"empty.txt", 0, 0}},
+	}
+	got := make(map[string][]progressCall)
+	for _, call := range calls {
+		got[call.file] = append(got[call.file], call)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected progress calls %v, got %v", want, calls)
+	}
+}
+
+func TestReceiveJobSandboxProgress(t *testing.T) {
+	content := []byte("job output\n")
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) }); err != nil {
+			return err
+		}
+		if err := sendSandboxJob(ctx, s, 42, 0, "output.txt", content); err != nil {
+			return err
+		}
+		_, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		return err
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(testSecurityContext(context.Background()), 10*time.Second)
+	defer cancel()
+
+	var calls []progressCall
+	opts := &TransferOptions{OnProgress: func(file string, done, total int64) {
+		calls = append(calls, progressCall{file, done, total})
+	}}
+	var buf bytes.Buffer
+	if err := <-schedd.ReceiveJobSandboxWithOptions(ctx, "ClusterId == 42", &buf, opts); err != nil {
+		t.Fatalf("ReceiveJobSandboxWithOptions failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+
+	size := int64(len(content))
+	want := []progressCall{{"output.txt", size, size}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected progress calls %v, got %v", want, calls)
+	}
+}
+
+// discardSandbox is a sandbox that throws away what is written to it
+type discardSandbox struct{}
+
+func (discardSandbox) CreateFile(string, int64, int64) (io.WriteCloser, error) {
+	return nopWriteCloser{io.Discard}, nil
+}
+
+func (discardSandbox) Mkdir(string) error { return nil }
+
+// zeroReader reads zero bytes forever
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// BenchmarkTransferFile sends a 1GB file with sendSingleFile and receives it
+// with receiveJobFiles, over an in-memory connection. File data is copied
+// through reused buffers, so the bytes allocated per op are about those of
+// the stream's own frames, one send and one receive buffer per chunk.
+func BenchmarkTransferFile(b *testing.B) {
+	const size = 1 << 30
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	ctx := context.Background()
+	schedd := NewSchedd("test_schedd", "mock-schedd:9618")
+	b.SetBytes(size)
+	b.ReportAllocs()
+	for b.Loop() {
+		clientConn, serverConn := net.Pipe()
+		sender, receiver := stream.NewStream(clientConn), stream.NewStream(serverConn)
+
+		errCh := make(chan error, 1)
+		go func() {
+			goAheadAlways := false
+			err := schedd.sendSingleFile(ctx, sender, "output.bin", size, 0644, io.LimitReader(zeroReader{}, size), &goAheadAlways, 0, nil, nil)
+			if err == nil {
+				err = sendMessage(ctx, sender, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandFinished)) })
+			}
+			errCh <- err
+		}()
+
+		files, err := schedd.receiveJobFiles(ctx, receiver, discardSandbox{}, JobID{Cluster: 1}, classad.New(), "", nil, &TransferOptions{})
+		if err != nil {
+			b.Fatalf("receiveJobFiles failed: %v", err)
+		}
+		if err := <-errCh; err != nil {
+			b.Fatalf("sendSingleFile failed: %v", err)
+		}
+		if files != 1 {
+			b.Fatalf("Expected 1 file, got %d", files)
+		}
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	}
+}
+
+// frameScript is a message.StreamInterface that reads frames from a list
+type frameScript struct {
+	frames []frameData
+}
+
+type frameData struct {
+	data []byte
+	eom  bool
+}
+
+func (f *frameScript) ReadFrame(context.Context) ([]byte, bool, error) {
+	if len(f.frames) == 0 {
+		return nil, false, io.ErrUnexpectedEOF
+	}
+	frame := f.frames[0]
+	f.frames = f.frames[1:]
+	return frame.data, frame.eom, nil
+}
+
+func (f *frameScript) WriteFrame(context.Context, []byte, bool) error { return nil }
+func (f *frameScript) IsEncrypted() bool                              { return false }
+
+func TestFileDataReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int64
+		frames  []frameData
+		want    string
+		wantErr bool
+		left    int // frames left unread
+	}{
+		{"empty file", 0, []frameData{{[]byte("next"), true}}, "", false, 1},
+		{"one message per chunk", 6, []frameData{{[]byte("abc"), true}, {[]byte("def"), true}}, "abcdef", false, 0},
+		{"message across frames", 6, []frameData{{nil, false}, {[]byte("abcdef"), true}}, "abcdef", false, 0},
+		{"trailing end of message", 3, []frameData{{[]byte("abc"), false}, {nil, true}, {[]byte("next"), true}}, "abc", false, 1},
+		{"too much data", 3, []frameData{{[]byte("abcd"), true}}, "", true, 0},
+		{"data after the file", 3, []frameData{{[]byte("abc"), false}, {[]byte("d"), true}}, "abc", true, 0},
+		{"short", 6, []frameData{{[]byte("abc"), true}}, "abc", true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &frameScript{frames: tt.frames}
+			var got bytes.Buffer
+			_, err := io.CopyBuffer(&got, newFileDataReader(context.Background(), stream, tt.size), make([]byte, 2))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got.String())
+			}
+			if len(stream.frames) != tt.left {
+				t.Errorf("Expected %d frames left unread, got %d", tt.left, len(stream.frames))
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Txn is a queue management transaction that batches several operations
+// (submissions, edits and job actions) over one authenticated QMGMT
+// connection. Nothing is visible in the queue until Commit; Abort (or any
+// failed operation followed by Abort) discards everything done in the
+// transaction.
+//
+// A Txn is finished by Commit or Abort, which also close the connection.
+// It is not safe for concurrent use.
+type Txn struct {
+	schedd  *Schedd
+	qmgmt   *QmgmtConnection
+	actions []txnAction
+	done    bool
+}
+
+// txnAction is a job action queued by Txn.Act, performed after Commit
+type txnAction struct {
+	action JobAction
+	ids    []string
+	reason string
+}
+
+// txnActionReasonAttrs maps the job actions a Txn supports to the
+// attribute carrying their reason
+var txnActionReasonAttrs = map[JobAction]string{
+	JA_HOLD_JOBS:    "HoldReason",
+	JA_RELEASE_JOBS: "ReleaseReason",
+	JA_REMOVE_JOBS:  "RemoveReason",
+}
+
+// BeginTransaction opens a QMGMT connection to the schedd and starts a
+// transaction, with the authenticated user as the effective owner.
+// The caller must call Commit or Abort.
+func (s *Schedd) BeginTransaction(ctx context.Context) (*Txn, error) {
+	qmgmt, err := s.Qmgmt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Txn{schedd: s, qmgmt: qmgmt}, nil
+}
+
+// Submit queues the jobs of an HTCondor submit file in the transaction and
+// returns their cluster ID and proc ads. The jobs can be edited or acted on
+// in the same transaction using the returned IDs.
+func (t *Txn) Submit(ctx context.Context, submitFileContent string) (*SubmitResult, error) {
+	if t.done {
+		return nil, fmt.Errorf("transaction already finished")
+	}
+
+	submitFile, err := ParseSubmitFile(strings.NewReader(submitFileContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse submit file: %w", err)
+	}
+
+	clusterID, err := t.qmgmt.NewCluster(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	submitResult, err := submitFile.Submit(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate job ads: %w", err)
+	}
+
+	for i, procAd := range submitResult.ProcAds {
+		procID, err := t.qmgmt.NewProc(ctx, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create proc %d: %w", i, err)
+		}
+		if err := t.qmgmt.SendJobAttributes(ctx, clusterID, procID, procAd); err != nil {
+			return nil, fmt.Errorf("failed to set attributes for proc %d: %w", i, err)
+		}
+	}
+
+	return submitResult, nil
+}
+
+// EditJobs sets attributes on the given jobs in the transaction.
+// Attribute values are ClassAd expressions, as with Schedd.EditJob, and are
+// validated the same way unless opts.Force is set.
+func (t *Txn) EditJobs(ctx context.Context, ids []JobID, attributes map[string]string, opts *EditJobOptions) error {
+	if t.done {
+		return fmt.Errorf("transaction already finished")
+	}
+	if opts == nil {
+		opts = &EditJobOptions{}
+	}
+
+	if !opts.Force {
+		for attrName := range attributes {
+			if err := ValidateAttributeForEdit(attrName, opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, id := range ids {
+		for attrName, attrValue := range attributes {
+			if err := t.qmgmt.SetAttribute(ctx, id.Cluster, id.Proc, attrName, attrValue, 0); err != nil {
+				return fmt.Errorf("failed to set attribute %s for job %d.%d: %w", attrName, id.Cluster, id.Proc, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Act holds, releases or removes the given jobs when the transaction
+// commits; only JA_HOLD_JOBS, JA_RELEASE_JOBS and JA_REMOVE_JOBS are
+// supported.
+//
+// The actions are sent with the schedd's ACT_ON_JOBS command, as HoldJobs
+// and friends do, so the schedd checks the jobs' status and stops running
+// jobs as usual. ACT_ON_JOBS cannot be part of a QMGMT transaction: the
+// actions run in order after the transaction is committed, so jobs
+// submitted in it can be acted on, and Abort discards them.
+func (t *Txn) Act(_ context.Context, action JobAction, ids []JobID, reason string) error {
+	if t.done {
+		return fmt.Errorf("transaction already finished")
+	}
+	if _, ok := txnActionReasonAttrs[action]; !ok {
+		return fmt.Errorf("job action %d is not supported in a transaction", action)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("ids cannot be empty")
+	}
+
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		idStrings[i] = fmt.Sprintf("%d.%d", id.Cluster, id.Proc)
+	}
+	t.actions = append(t.actions, txnAction{action: action, ids: idStrings, reason: reason})
+	return nil
+}
+
+// Commit commits the transaction and closes the connection
+func (t *Txn) Commit(ctx context.Context) error {
+	if t.done {
+		return fmt.Errorf("transaction already finished")
+	}
+	t.done = true
+
+	err := t.qmgmt.CommitTransaction(ctx)
+	if cerr := t.qmgmt.Close(); cerr != nil && err == nil {
+		return fmt.Errorf("failed to close connection: %w", cerr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, a := range t.actions {
+		if _, err := t.schedd.actOnJobs(ctx, a.action, "", a.ids, a.reason, txnActionReasonAttrs[a.action], "", "", AR_TOTALS); err != nil {
+			return fmt.Errorf("transaction committed, but job action %d on %s failed: %w", a.action, strings.Join(a.ids, ","), err)
+		}
+	}
+	return nil
+}
+
+// Abort discards everything done in the transaction and closes the
+// connection. Calling Abort after Commit or Abort is a no-op, so it can be
+// deferred.
+func (t *Txn) Abort(ctx context.Context) error {
+	if t.done {
+		return nil
+	}
+	t.done = true
+
+	err := t.qmgmt.AbortTransaction(ctx)
+	if cerr := t.qmgmt.Close(); cerr != nil && err == nil {
+		return fmt.Errorf("failed to close connection: %w", cerr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to abort transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package htcondor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// fakeQueue is a scripted schedd that keeps a job queue across QMGMT
+// connections, applying attribute changes only when a transaction commits
+type fakeQueue struct {
+	jobs        map[JobID]map[string]string
+	nextCluster int
+	// rejectAttribute, if set, is an attribute SetAttribute refuses
+	rejectAttribute string
+}
+
+func newFakeQueue() *fakeQueue {
+	return &fakeQueue{jobs: make(map[JobID]map[string]string), nextCluster: 100}
+}
+
+// sendQmgmtReply sends a QMGMT return value
+func sendQmgmtReply(ctx context.Context, s *stream.Stream, rval int) error {
+	return sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt(ctx, rval) })
+}
+
+//nolint:gocyclo // Scripted server dispatches every QMGMT command the client sends
+func (q *fakeQueue) serve(ctx context.Context, s *stream.Stream) error {
+	if err := serverHandshake(ctx, s); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+
+	req := message.NewMessageFromStream(s)
+	if cmd, err := req.GetInt(ctx); err != nil || cmd != CONDOR_GetCapabilities {
+		return fmt.Errorf("expected GetCapabilities, got %d (%v)", cmd, err)
+	}
+	if _, err := req.GetInt(ctx); err != nil {
+		return fmt.Errorf("capabilities flags: %w", err)
+	}
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, classad.New()) }); err != nil {
+		return err
+	}
+
+	pending := make(map[JobID]map[string]string)
+	procs := make(map[int]int)
+	for {
+		req := message.NewMessageFromStream(s)
+		cmd, err := req.GetInt(ctx)
+		if err != nil {
+			return fmt.Errorf("command: %w", err)
+		}
+		switch cmd {
+		case CONDOR_SetEffectiveOwner:
+			if _, err := req.GetString(ctx); err != nil {
+				return fmt.Errorf("owner: %w", err)
+			}
+			err = sendQmgmtReply(ctx, s, 0)
+		case CONDOR_NewCluster:
+			q.nextCluster++
+			err = sendQmgmtReply(ctx, s, q.nextCluster)
+		case CONDOR_NewProc:
+			cluster, err := req.GetInt(ctx)
+			if err != nil {
+				return fmt.Errorf("cluster: %w", err)
+			}
+			proc := procs[cluster]
+			procs[cluster]++
+			pending[JobID{Cluster: cluster, Proc: proc}] = make(map[string]string)
+			err = sendQmgmtReply(ctx, s, proc)
+			if err != nil {
+				return err
+			}
+		case CONDOR_SetAttribute:
+			cluster, _ := req.GetInt(ctx)
+			proc, _ := req.GetInt(ctx)
+			value, _ := req.GetString(ctx)
+			name, err := req.GetString(ctx)
+			if err != nil {
+				return fmt.Errorf("SetAttribute: %w", err)
+			}
+			if name == q.rejectAttribute {
+				err = sendMessage(ctx, s, func(m *message.Message) error {
+					if err := m.PutInt(ctx, -1); err != nil {
+						return err
+					}
+					return m.PutInt(ctx, 13) // EACCES
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			id := JobID{Cluster: cluster, Proc: proc}
+			if pending[id] == nil {
+				pending[id] = make(map[string]string)
+				for k, v := range q.jobs[id] {
+					pending[id][k] = v
+				}
+			}
+			pending[id][name] = value
+			err = sendQmgmtReply(ctx, s, 0)
+			if err != nil {
+				return err
+			}
+		case CONDOR_GetAttributeExpr:
+			cluster, _ := req.GetInt(ctx)
+			proc, _ := req.GetInt(ctx)
+			name, err := req.GetString(ctx)
+			if err != nil {
+				return fmt.Errorf("GetAttributeExpr: %w", err)
+			}
+			id := JobID{Cluster: cluster, Proc: proc}
+			attrs, ok := pending[id]
+			if !ok {
+				attrs = q.jobs[id]
+			}
+			value, ok := attrs[name]
+			if !ok {
+				err = sendMessage(ctx, s, func(m *message.Message) error {
+					if err := m.PutInt(ctx, -1); err != nil {
+						return err
+					}
+					return m.PutInt(ctx, 2) // ENOENT
+				})
+			} else {
+				err = sendMessage(ctx, s, func(m *message.Message) error {
+					if err := m.PutInt(ctx, 0); err != nil {
+						return err
+					}
+					return m.PutString(ctx, value)
+				})
+			}
+			if err != nil {
+				return err
+			}
+		case CONDOR_DestroyCluster:
+			cluster, err := req.GetInt(ctx)
+			if err != nil {
+				return fmt.Errorf("DestroyCluster: %w", err)
+			}
+			for id := range q.jobs {
+				if id.Cluster == cluster {
+					pending[id] = nil
+				}
+			}
+			err = sendQmgmtReply(ctx, s, 0)
+			if err != nil {
+				return err
+			}
+		case CONDOR_BeginTransaction:
+			err = sendQmgmtReply(ctx, s, 0)
+		case CONDOR_CommitTransactionNoFlags:
+			for id, attrs := range pending {
+				if attrs == nil {
+					delete(q.jobs, id)
+					continue
+				}
+				q.jobs[id] = attrs
+			}
+			pending = make(map[JobID]map[string]string)
+			err = sendQmgmtReply(ctx, s, 0)
+		case CONDOR_AbortTransaction:
+			pending = make(map[JobID]map[string]string)
+			err = sendQmgmtReply(ctx, s, 0)
+		case CONDOR_CloseSocket:
+			return nil
+		default:
+			return fmt.Errorf("unexpected command %d", cmd)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// serveAction serves an ACT_ON_JOBS request for jobs by ID, applying hold,
+// release and remove to the committed queue
+func (q *fakeQueue) serveAction(ctx context.Context, s *stream.Stream) error {
+	if err := serverHandshake(ctx, s); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	cmdAd, err := message.NewMessageFromStream(s).GetClassAd(ctx)
+	if err != nil {
+		return fmt.Errorf("command ad: %w", err)
+	}
+	action, _ := AdInt(cmdAd, "JobAction")
+	ids, _ := AdString(cmdAd, "ActionIds")
+	status, ok := map[JobAction]string{JA_HOLD_JOBS: "5", JA_RELEASE_JOBS: "1", JA_REMOVE_JOBS: "3"}[JobAction(action)]
+	if !ok {
+		return fmt.Errorf("unexpected job action %d", action)
+	}
+	reasonAttr := txnActionReasonAttrs[JobAction(action)]
+	reason, _ := AdString(cmdAd, reasonAttr)
+
+	var acted int64
+	for _, idStr := range strings.Split(ids, ",") {
+		var id JobID
+		if _, err := fmt.Sscanf(idStr, "%d.%d", &id.Cluster, &id.Proc); err != nil {
+			return fmt.Errorf("job ID %q: %w", idStr, err)
+		}
+		if job, ok := q.jobs[id]; ok {
+			job["JobStatus"] = status
+			job[reasonAttr] = strconv.Quote(reason)
+			acted++
+		}
+	}
+
+	resultAd := classad.New()
+	_ = resultAd.Set("ActionResult", int64(1))
+	_ = resultAd.Set("result_total_1", acted)
+	if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, resultAd) }); err != nil {
+		return err
+	}
+	if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+		return fmt.Errorf("acknowledgment: %w", err)
+	}
+	return sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) })
+}
+
+func TestTxnSubmitEditCommit(t *testing.T) {
+	queue := newFakeQueue()
+	var conns atomic.Int32
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if conns.Add(1) == 1 {
+			return queue.serve(ctx, s)
+		}
+		return queue.serveAction(ctx, s)
+	})
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	txn, err := schedd.BeginTransaction(ctx)
+	if err != nil {
+		t.Fatalf("BeginTransaction failed: %v", err)
+	}
+	result, err := txn.Submit(ctx, "executable = /bin/sleep\narguments = 60\nqueue 2\n")
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	first := JobID{Cluster: result.ClusterID, Proc: 0}
+	second := JobID{Cluster: result.ClusterID, Proc: 1}
+
+	// Edit and hold the just-submitted jobs before anything is committed
+	if err := txn.EditJobs(ctx, []JobID{first, second}, map[string]string{"MyTag": `"batch"`}, nil); err != nil {
+		t.Fatalf("EditJobs failed: %v", err)
+	}
+	if err := txn.Act(ctx, JA_HOLD_JOBS, []JobID{second}, "waiting for input"); err != nil {
+		t.Fatalf("Act failed: %v", err)
+	}
+	if len(queue.jobs) != 0 {
+		t.Fatalf("Expected no jobs in the queue before commit, got %d", len(queue.jobs))
+	}
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	for range 2 {
+		if err := <-transport.errCh; err != nil {
+			t.Fatalf("Scripted schedd failed: %v", err)
+		}
+	}
+	if n := conns.Load(); n != 2 {
+		t.Errorf("Expected a QMGMT and an ACT_ON_JOBS connection, got %d connections", n)
+	}
+
+	if len(queue.jobs) != 2 {
+		t.Fatalf("Expected 2 jobs after commit, got %d", len(queue.jobs))
+	}
+	for _, id := range []JobID{first, second} {
+		if got := queue.jobs[id]["MyTag"]; got != `"batch"` {
+			t.Errorf("Job %d.%d: expected MyTag \"batch\", got %s", id.Cluster, id.Proc, got)
+		}
+		if got := queue.jobs[id]["Cmd"]; got != `"/bin/sleep"` {
+			t.Errorf("Job %d.%d: expected Cmd \"/bin/sleep\", got %s", id.Cluster, id.Proc, got)
+		}
+	}
+	if got := queue.jobs[second]["JobStatus"]; got != "5" {
+		t.Errorf("Expected held job to have JobStatus 5, got %s", got)
+	}
+	if got := queue.jobs[second]["HoldReason"]; got != `"waiting for input"` {
+		t.Errorf("Expected hold reason to be set, got %s", got)
+	}
+	if got := queue.jobs[first]["JobStatus"]; got == "5" {
+		t.Error("Expected only the second job to be held")
+	}
+
+	if err := txn.EditJobs(ctx, []JobID{first}, map[string]string{"MyTag": "1"}, nil); err == nil {
+		t.Error("Expected an error using a committed transaction")
+	}
+}
+
+func TestTxnAbortRollsBack(t *testing.T) {
+	queue := newFakeQueue()
+	transport := newScriptedTransport(queue.serve)
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	// Commit one job to edit later
+	txn, err := schedd.BeginTransaction(ctx)
+	if err != nil {
+		t.Fatalf("BeginTransaction failed: %v", err)
+	}
+	committed, err := txn.Submit(ctx, "executable = /bin/true\nqueue\n")
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if err := txn.Commit(ctx); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+	existing := JobID{Cluster: committed.ClusterID, Proc: 0}
+
+	// Submit, edit and remove in a transaction that is then aborted
+	txn, err = schedd.BeginTransaction(ctx)
+	if err != nil {
+		t.Fatalf("BeginTransaction failed: %v", err)
+	}
+	result, err := txn.Submit(ctx, "executable = /bin/sleep\nqueue\n")
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if err := txn.EditJobs(ctx, []JobID{existing, {Cluster: result.ClusterID}}, map[string]string{"MyTag": `"gone"`}, nil); err != nil {
+		t.Fatalf("EditJobs failed: %v", err)
+	}
+	if err := txn.Act(ctx, JA_REMOVE_JOBS, []JobID{existing}, ""); err != nil {
+		t.Fatalf("Act failed: %v", err)
+	}
+	if err := txn.Abort(ctx); err != nil {
+		t.Fatalf("Abort failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted schedd failed: %v", err)
+	}
+
+	if len(queue.jobs) != 1 {
+		t.Fatalf("Expected only the committed job after abort, got %d jobs", len(queue.jobs))
+	}
+	if _, ok := queue.jobs[JobID{Cluster: result.ClusterID}]; ok {
+		t.Error("Expected the aborted submission to be discarded")
+	}
+	if _, ok := queue.jobs[existing]["MyTag"]; ok {
+		t.Error("Expected the aborted edit to be discarded")
+	}
+	if queue.jobs[existing]["JobStatus"] == "3" {
+		t.Error("Expected the aborted removal to be discarded")
+	}
+
+	// Abort is safe to call again, e.g. from a defer
+	if err := txn.Abort(ctx); err != nil {
+		t.Errorf("Expected repeated Abort to be a no-op, got %v", err)
+	}
+}
+
+func TestTxnActUnsupported(t *testing.T) {
+	txn := &Txn{}
+	if err := txn.Act(context.Background(), JA_VACATE_JOBS, []JobID{{Cluster: 1}}, ""); err == nil {
+		t.Error("Expected vacate to be rejected in a transaction")
+	}
+	if err := txn.Act(context.Background(), JA_RELEASE_JOBS, nil, ""); err == nil {
+		t.Error("Expected an action without jobs to be rejected")
+	}
+	if err := txn.Act(context.Background(), JA_RELEASE_JOBS, []JobID{{Cluster: 1}, {Cluster: 1, Proc: 1}}, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(txn.actions) != 1 || strings.Join(txn.actions[0].ids, ",") != "1.0,1.1" {
+		t.Errorf("Expected release of 1.0,1.1 to be queued, got %+v", txn.actions)
+	}
+}
@@ -61,6 +61,15 @@ func ReloadDefaultConfig() {
 	}
 }
 
+// SetRateLimitManager replaces the global rate limiter manager used for
+// schedd and collector queries, for programs that configure rate limits
+// themselves rather than through the HTCondor configuration. A nil manager
+// reverts to the limits in the default configuration. Requests already
+// waiting on the previous manager are not affected.
+func SetRateLimitManager(manager *ratelimit.Manager) {
+	globalRateLimitManager.Store(manager)
+}
+
 // getRateLimitManager returns the global rate limiter manager, loading it lazily if needed.
 // Returns nil if no configuration is available (which means unlimited).
 func getRateLimitManager() *ratelimit.Manager {
@@ -0,0 +1,256 @@
+package htcondor
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/hkdf"
+)
+
+// DefaultKeyRotationWindow is how long tokens signed with a replaced signing
+// key keep validating. It covers the lifetime of the tokens the servers mint.
+const DefaultKeyRotationWindow = time.Hour
+
+// signingKeyCheckInterval bounds how often the key file is stat'ed for changes
+const signingKeyCheckInterval = 5 * time.Second
+
+// SigningKeyWatcher mints and verifies IDTOKENs with an HTCondor signing key
+// file (e.g. SEC_TOKEN_POOL_SIGNING_KEY_FILE), reloading the key when the
+// file's modification time changes. After a rotation, tokens signed with the
+// previous key still verify until the rotation window ends, so replacing the
+// key does not invalidate tokens that are already in use.
+//
+// The key file does not need to exist when the watcher is created; it is
+// loaded on first use.
+type SigningKeyWatcher struct {
+	path   string
+	keyID  string
+	window time.Duration
+	now    func() time.Time
+
+	mu            sync.Mutex
+	current       []byte
+	modTime       time.Time
+	lastCheck     time.Time
+	previous      []byte
+	previousUntil time.Time
+}
+
+// NewSigningKeyWatcher creates a watcher for the signing key at path. The key
+// ID (the JWT kid) is the file's base name, as with condor_token_create.
+// A rotationWindow of 0 uses DefaultKeyRotationWindow.
+func NewSigningKeyWatcher(path string, rotationWindow time.Duration) *SigningKeyWatcher {
+	if rotationWindow <= 0 {
+		rotationWindow = DefaultKeyRotationWindow
+	}
+	return &SigningKeyWatcher{
+		path:   path,
+		keyID:  filepath.Base(path),
+		window: rotationWindow,
+		now:    time.Now,
+	}
+}
+
+// Path returns the signing key file path
+func (w *SigningKeyWatcher) Path() string {
+	return w.path
+}
+
+// KeyID returns the key ID (JWT kid) of the tokens the watcher mints
+func (w *SigningKeyWatcher) KeyID() string {
+	return w.keyID
+}
+
+// Watch reloads the key file every interval until ctx is done, so that a
+// rotation is noticed (and its window starts) when the file changes rather
+// than at the next use of the key. onReload, if not nil, is called with the
+// result of each reload.
+func (w *SigningKeyWatcher) Watch(ctx context.Context, interval time.Duration, onReload func(changed bool, err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		changed, err := w.Reload()
+		if onReload != nil {
+			onReload(changed, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reload re-reads the key file if its modification time changed since it was
+// last loaded, and reports whether the key changed
+func (w *SigningKeyWatcher) Reload() (bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.reloadLocked()
+}
+
+// reloadLocked implements Reload; w.mu must be held
+func (w *SigningKeyWatcher) reloadLocked() (bool, error) {
+	now := w.now()
+	w.lastCheck = now
+
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat signing key %s: %w", w.path, err)
+	}
+	if w.current != nil && info.ModTime().Equal(w.modTime) {
+		return false, nil
+	}
+
+	scrambled, err := os.ReadFile(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read signing key %s: %w", w.path, err)
+	}
+	if len(scrambled) == 0 {
+		return false, fmt.Errorf("signing key %s is empty", w.path)
+	}
+	key := unscrambleKey(scrambled)
+	w.modTime = info.ModTime()
+
+	if bytes.Equal(key, w.current) {
+		return false, nil
+	}
+	if w.current != nil {
+		w.previous = w.current
+		w.previousUntil = now.Add(w.window)
+	}
+	w.current = key
+	return true, nil
+}
+
+// keys returns the current key, and the previous key while the rotation
+// window is open, reloading the key file if it is due for a check
+func (w *SigningKeyWatcher) keys() (current, previous []byte, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.current == nil || w.now().Sub(w.lastCheck) >= signingKeyCheckInterval {
+		if _, err := w.reloadLocked(); err != nil && w.current == nil {
+			return nil, nil, err
+		}
+	}
+	if w.previous != nil && w.now().Before(w.previousUntil) {
+		previous = w.previous
+	}
+	return w.current, previous, nil
+}
+
+// GenerateToken mints an IDTOKEN signed with the current key.
+// authzLimits are encoded as condor:/ scopes, as with security.GenerateJWT.
+func (w *SigningKeyWatcher) GenerateToken(subject, issuer string, issuedAt, expiration int64, authzLimits []string) (string, error) {
+	key, _, err := w.keys()
+	if err != nil {
+		return "", err
+	}
+
+	jtiBytes := make([]byte, 16)
+	if _, err := rand.Read(jtiBytes); err != nil {
+		return "", fmt.Errorf("failed to generate jti: %w", err)
+	}
+	claims := jwt.MapClaims{
+		"sub": subject,
+		"jti": hex.EncodeToString(jtiBytes),
+		"iat": issuedAt,
+		"exp": expiration,
+	}
+	if issuer != "" {
+		claims["iss"] = issuer
+	}
+	if len(authzLimits) > 0 {
+		scopes := make([]string, len(authzLimits))
+		for i, limit := range authzLimits {
+			scopes[i] = "condor:/" + limit
+		}
+		claims["scope"] = strings.Join(scopes, " ")
+	}
+
+	jwtKey, err := w.deriveJWTKey(key)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = w.keyID
+	signed, err := token.SignedString(jwtKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// VerifyToken checks an IDTOKEN's signature and expiry against the current
+// key and, during a rotation window, the previous key
+func (w *SigningKeyWatcher) VerifyToken(token string) (*jwt.RegisteredClaims, error) {
+	current, previous, err := w.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithTimeFunc(w.now))
+	var lastErr error
+	for _, key := range [][]byte{current, previous} {
+		if key == nil {
+			continue
+		}
+		jwtKey, err := w.deriveJWTKey(key)
+		if err != nil {
+			return nil, err
+		}
+		claims := &jwt.RegisteredClaims{}
+		_, err = parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+			if kid, _ := t.Header["kid"].(string); kid != w.keyID {
+				return nil, fmt.Errorf("token key ID %q does not match signing key %q", kid, w.keyID)
+			}
+			return jwtKey, nil
+		})
+		if err == nil {
+			return claims, nil
+		}
+		lastErr = err
+		// Only a bad signature can be fixed by trying the previous key
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			break
+		}
+	}
+	return nil, fmt.Errorf("invalid token: %w", lastErr)
+}
+
+// deriveJWTKey derives the HMAC key for JWT signatures from a signing key,
+// matching HTCondor's derivation (POOL keys are doubled before HKDF)
+func (w *SigningKeyWatcher) deriveJWTKey(key []byte) ([]byte, error) {
+	input := key
+	if w.keyID == "POOL" {
+		input = append(append([]byte{}, key...), key...)
+	}
+	jwtKey := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, input, []byte("htcondor"), []byte("master jwt")), jwtKey); err != nil {
+		return nil, fmt.Errorf("failed to derive JWT key: %w", err)
+	}
+	return jwtKey, nil
+}
+
+// unscrambleKey reverses HTCondor's simple_scramble of key files
+func unscrambleKey(scrambled []byte) []byte {
+	deadbeef := []byte{0xde, 0xad, 0xbe, 0xef}
+	key := make([]byte, len(scrambled))
+	for i := range scrambled {
+		key[i] = scrambled[i] ^ deadbeef[i%len(deadbeef)]
+	}
+	return key
+}
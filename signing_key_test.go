@@ -0,0 +1,91 @@
+package htcondor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bbockelm/cedar/security"
+)
+
+func TestSigningKeyWatcherRotation(t *testing.T) {
+	keyDir := t.TempDir()
+	keyPath := filepath.Join(keyDir, "POOL")
+	if err := security.GeneratePoolSigningKey(keyPath); err != nil {
+		t.Fatalf("Failed to generate signing key: %v", err)
+	}
+
+	now := time.Now()
+	watcher := NewSigningKeyWatcher(keyPath, 10*time.Minute)
+	watcher.now = func() time.Time { return now }
+
+	iat := now.Unix()
+	exp := now.Add(time.Hour).Unix()
+	before, err := watcher.GenerateToken("alice@example.com", "example.com", iat, exp, []string{"READ"})
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+
+	// Tokens from the cedar helper use the same format and key derivation
+	cedarToken, err := security.GenerateJWT(keyDir, "POOL", "bob@example.com", "example.com", iat, exp, nil)
+	if err != nil {
+		t.Fatalf("GenerateJWT failed: %v", err)
+	}
+	claims, err := watcher.VerifyToken(cedarToken)
+	if err != nil {
+		t.Fatalf("VerifyToken rejected a cedar-minted token: %v", err)
+	}
+	if claims.Subject != "bob@example.com" {
+		t.Errorf("Expected subject bob@example.com, got %q", claims.Subject)
+	}
+
+	// Rotate the key; bump the mtime so the change is visible on coarse clocks
+	if err := security.GeneratePoolSigningKey(keyPath); err != nil {
+		t.Fatalf("Failed to rotate signing key: %v", err)
+	}
+	future := now.Add(time.Minute)
+	if err := os.Chtimes(keyPath, future, future); err != nil {
+		t.Fatalf("Failed to update key mtime: %v", err)
+	}
+	changed, err := watcher.Reload()
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if !changed {
+		t.Fatal("Expected Reload to pick up the rotated key")
+	}
+
+	after, err := watcher.GenerateToken("alice@example.com", "example.com", iat, exp, []string{"READ"})
+	if err != nil {
+		t.Fatalf("GenerateToken after rotation failed: %v", err)
+	}
+
+	// Both tokens validate during the rotation window
+	for name, token := range map[string]string{"before": before, "after": after} {
+		claims, err := watcher.VerifyToken(token)
+		if err != nil {
+			t.Errorf("Token signed %s rotation rejected: %v", name, err)
+			continue
+		}
+		if claims.Subject != "alice@example.com" {
+			t.Errorf("Expected subject alice@example.com, got %q", claims.Subject)
+		}
+	}
+
+	// Once the window closes only the new key is accepted
+	now = now.Add(11 * time.Minute)
+	if _, err := watcher.VerifyToken(before); err == nil {
+		t.Error("Expected token signed with the old key to be rejected after the rotation window")
+	}
+	if _, err := watcher.VerifyToken(after); err != nil {
+		t.Errorf("Token signed with the current key rejected: %v", err)
+	}
+}
+
+func TestSigningKeyWatcherMissingKey(t *testing.T) {
+	watcher := NewSigningKeyWatcher(filepath.Join(t.TempDir(), "POOL"), 0)
+	if _, err := watcher.GenerateToken("alice@example.com", "example.com", 0, 0, nil); err == nil {
+		t.Error("Expected an error when the key file does not exist")
+	}
+}
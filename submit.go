@@ -4,13 +4,23 @@
 package htcondor
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/PelicanPlatform/classad/classad"
 	"github.com/bbockelm/golang-htcondor/config"
+	"github.com/bbockelm/golang-htcondor/logging"
 )
 
 // SubmitFile represents a parsed HTCondor submit file
@@ -19,9 +29,32 @@ type SubmitFile struct {
 	universe int
 
 	// Queue statement information
-	queueCount    int
 	queueVars     []string
 	queueIterator SubmitIterator
+
+	// queueBlocks holds one entry per queue statement, in file order.
+	// cfg, universe and the queue fields above always describe the first block.
+	queueBlocks []*queueBlock
+
+	opts SubmitFileOptions
+
+	// batchNames caches the derived JobBatchName per cluster so every proc
+	// in a cluster gets the same name even if its executable differs
+	batchNames map[int]string
+
+	// duplicateInputs records transfer_input_files entries MakeJobAd found
+	// listed more than once, for Submit to warn about
+	duplicateInputs map[string]bool
+}
+
+// queueBlock is the state for a single queue statement: the submit
+// description as it stood when the queue statement was reached, and the
+// iterator producing that block's procs.
+type queueBlock struct {
+	cfg       *config.Config
+	universe  int
+	queueVars []string
+	iterator  SubmitIterator
 }
 
 // SubmitIterator provides iteration over queue items
@@ -46,6 +79,9 @@ type SubmitResult struct {
 	NumProcs  int
 	ClusterAd *classad.ClassAd
 	ProcAds   []*classad.ClassAd
+	// Warnings lists likely mistakes that did not prevent submission,
+	// such as several procs writing to the same output file
+	Warnings []string
 }
 
 // Universe constants matching HTCondor
@@ -62,67 +98,303 @@ const (
 	UniverseDocker    = 14 // Deprecated, use Vanilla + container
 )
 
-// ParseSubmitFile parses a submit file from a reader
+// SubmitFileOptions controls how a submit file is parsed
+type SubmitFileOptions struct {
+	// EnvLookup resolves $ENV(name) references against the submit-side environment.
+	// Returning false expands the reference to the empty string, so servers parsing
+	// submit files on behalf of remote users can restrict or hide the host environment.
+	// nil reads the process environment.
+	EnvLookup func(name string) (string, bool)
+
+	// OutputCollisions selects whether Submit warns (the default), fails, or
+	// does nothing when several procs would write the same output or error file
+	OutputCollisions OutputCollisionPolicy
+
+	// WarnUnknownInfoAttrs makes Submit warn about job_ad_information_attrs
+	// entries that are not known job attributes, which are usually typos
+	WarnUnknownInfoAttrs bool
+
+	// IncludeDir is the directory relative include paths are resolved against
+	// (empty for the current working directory)
+	IncludeDir string
+
+	// DisableIncludes rejects include directives, for submit files from
+	// untrusted users: "include : file" reads files on the submitting host
+	// and "include command : cmd" runs a shell command there
+	DisableIncludes bool
+
+	// Macros are defined before the submit file is read, like condor_submit's
+	// -append/"name=value" arguments. DAGMan passes DAGManJobId this way, so
+	// one submit file can use "if defined DAGManJobId" to behave differently
+	// under DAGMan than when submitted on its own.
+	Macros map[string]string
+
+	// MaxProcs caps the number of procs Submit and SubmitLate will create,
+	// checked as queue items are enumerated. 0 uses DefaultMaxProcs; a
+	// negative value removes the cap.
+	MaxProcs int
+
+	// ExecutablePolicy, if set, is enforced on every proc ad Submit and
+	// SubmitLate create, so the executables checked are exactly those
+	// submitted. Rejections wrap ErrExecutableNotAllowed.
+	ExecutablePolicy *ExecutablePolicy
+
+	// OAuthServices are the OAuth services the pool's credmon can issue
+	// tokens for (those configured with <SERVICE>_CLIENT_ID, and the local
+	// issuer). Services in use_oauth_services, docker_credentials or
+	// container_registry_credentials that are not listed are rejected. nil accepts any well-formed service name; an empty list
+	// rejects every service.
+	OAuthServices []string
+
+	// JobLeaseDuration is the JobLeaseDuration given to jobs in universes
+	// that can reconnect (vanilla, java, vm and docker) that do not set
+	// job_lease_duration, so they keep running through a schedd restart or
+	// network outage shorter than the lease. 0 uses DefaultJobLeaseDuration;
+	// it must not be negative.
+	JobLeaseDuration time.Duration
+
+	// Logger receives the SubmitResult.Warnings of submissions made by
+	// Schedd.SubmitRemoteWithOptions, which does not return them (optional)
+	Logger *logging.Logger
+}
+
+// DefaultMaxProcs is the default SubmitFileOptions.MaxProcs, matching
+// HTCondor's MAX_JOBS_PER_SUBMISSION default
+const DefaultMaxProcs = 20000
+
+// SubmitParseError is a syntax error in a submit file
+type SubmitParseError struct {
+	Line    int    `json:"line"` // 0 if unknown
+	Message string `json:"message"`
+}
+
+func (e SubmitParseError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// SubmitParseErrors lists every syntax error found in a submit file, in order
+type SubmitParseErrors []SubmitParseError
+
+func (e SubmitParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "failed to parse submit file: " + strings.Join(msgs, "; ")
+}
+
+// ParseSubmitFile parses a submit file from a reader.
+//
+// A submit file may contain several queue statements. Each one produces its
+// own block of procs using the submit commands that precede it, so attributes
+// may be changed between queue statements. Procs are numbered consecutively
+// across all blocks. Commands after the last queue statement are executed
+// (so errors are still reported) but do not affect any job.
+//
+// $(NAME) always refers to a submit macro, while $ENV(NAME) reads the
+// submit-side environment, so "environment = EXTRA=$ENV(HOME)/bin" uses the
+// caller's HOME even if the submit file also defines a HOME macro.
+//
+// Parsing does not stop at the first syntax error. If there are any, the
+// error is a SubmitParseErrors listing all of them, and the SubmitFile built
+// from the rest of the file is returned alongside it when that succeeds
+// (nil otherwise); the caller decides whether to go on with it.
 func ParseSubmitFile(r io.Reader) (*SubmitFile, error) {
-	// We need to parse the submit file in two passes:
-	// 1. Parse to get the queue statement
-	// 2. Execute assignments to build the config
+	return ParseSubmitFileWithOptions(r, nil)
+}
 
-	// First pass: parse to get statements including queue
+// ParseSubmitFileWithOptions is like ParseSubmitFile but allows configuring
+// how $ENV() references are resolved. opts may be nil.
+func ParseSubmitFileWithOptions(r io.Reader, opts *SubmitFileOptions) (*SubmitFile, error) {
+	// Parse to get statements including queue statements, collecting the
+	// syntax errors
 	lexer := config.NewLexer(r)
 	stmts, err := config.Parse(lexer)
+	var parseErrs SubmitParseErrors
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse submit file: %w", err)
+		var errs config.ParseErrors
+		if !errors.As(err, &errs) {
+			return nil, fmt.Errorf("failed to parse submit file: %w", err)
+		}
+		for _, e := range errs {
+			parseErrs = append(parseErrs, SubmitParseError{Line: e.Line, Message: e.Message})
+		}
 	}
 
-	// Find the queue statement (should be last, but we'll take the first we find)
-	var queueStmt *config.QueueStatement
-	var configStmts []config.Statement
+	sf, err := newSubmitFile(stmts, opts)
+	if parseErrs != nil {
+		// The rest of the file may well fail to execute because of a syntax
+		// error, so the syntax errors come first
+		if err != nil {
+			return nil, parseErrs
+		}
+		return sf, parseErrs
+	}
+	return sf, err
+}
+
+// newSubmitFile executes parsed submit file statements
+func newSubmitFile(stmts []config.Statement, opts *SubmitFileOptions) (*SubmitFile, error) {
+	if opts != nil && opts.JobLeaseDuration < 0 {
+		return nil, fmt.Errorf("JobLeaseDuration must not be negative, got %v", opts.JobLeaseDuration)
+	}
+
+	// Execute statements in order, snapshotting the config at each queue statement
+	cfg := config.NewEmpty()
+	if opts != nil && opts.EnvLookup != nil {
+		cfg.SetEnvLookup(opts.EnvLookup)
+	}
+	if opts != nil && opts.IncludeDir != "" {
+		cfg.SetIncludeDir(opts.IncludeDir)
+	}
+	if opts != nil && opts.DisableIncludes {
+		cfg.DisableIncludes()
+	}
+	if opts != nil && len(opts.Macros) > 0 {
+		names := make([]string, 0, len(opts.Macros))
+		for name := range opts.Macros {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			cfg.Set(name, opts.Macros[name])
+		}
+	}
+	var pending []config.Statement
+	var blocks []*queueBlock
 
 	for _, stmt := range stmts {
-		if qs, ok := stmt.(*config.QueueStatement); ok {
-			if queueStmt == nil {
-				queueStmt = qs
-			}
-			// Don't include queue statements in config execution
+		qs, ok := stmt.(*config.QueueStatement)
+		if !ok {
+			pending = append(pending, stmt)
 			continue
 		}
-		configStmts = append(configStmts, stmt)
+
+		if err := cfg.ExecuteStatements(pending); err != nil {
+			return nil, fmt.Errorf("failed to execute submit file: %w", err)
+		}
+		pending = nil
+
+		block, err := newQueueBlock(cfg.Clone(), qs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create queue iterator for queue statement %d: %w", len(blocks)+1, err)
+		}
+		blocks = append(blocks, block)
 	}
 
-	// Create config and execute non-queue statements
-	cfg := config.NewEmpty()
-	if err := cfg.ExecuteStatements(configStmts); err != nil {
+	// Execute any trailing statements
+	if err := cfg.ExecuteStatements(pending); err != nil {
 		return nil, fmt.Errorf("failed to execute submit file: %w", err)
 	}
 
+	// No queue statement means queue 1
+	if len(blocks) == 0 {
+		block, err := newQueueBlock(cfg, nil)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	first := blocks[0]
 	sf := &SubmitFile{
-		cfg:        cfg,
-		universe:   UniverseVanilla, // Default
-		queueCount: 1,               // Default if no queue statement
+		cfg:           first.cfg,
+		universe:      first.universe,
+		queueVars:     first.queueVars,
+		queueIterator: first.iterator,
+		queueBlocks:   blocks,
+	}
+	if opts != nil {
+		sf.opts = *opts
+	}
+
+	// Queue items are not enumerated here: "queue from" files and "matching"
+	// patterns are only read as procs are created
+
+	return sf, nil
+}
+
+// queueCount returns the number of procs the submit file queues. This
+// enumerates every queue item, so it is not used on the submit path.
+func (sf *SubmitFile) queueCount() int {
+	count := 0
+	for _, block := range sf.blocks() {
+		count += block.iterator.Count()
+	}
+	return count
+}
+
+// maxProcs returns the proc cap, or 0 for no cap
+func (sf *SubmitFile) maxProcs() int {
+	switch {
+	case sf.opts.MaxProcs < 0:
+		return 0
+	case sf.opts.MaxProcs == 0:
+		return DefaultMaxProcs
+	default:
+		return sf.opts.MaxProcs
+	}
+}
+
+// nextQueueItem advances block's iterator for the given proc number,
+// enforcing the proc cap. It returns false with a nil error at the end of
+// the block.
+func (sf *SubmitFile) nextQueueItem(block *queueBlock, proc int) (bool, error) {
+	if !block.iterator.Next() {
+		return false, iteratorErr(block.iterator)
+	}
+	if limit := sf.maxProcs(); limit > 0 && proc >= limit {
+		return false, fmt.Errorf("submit file queues more than %d procs", limit)
+	}
+	return true, nil
+}
+
+// newQueueBlock creates a queue block from a config snapshot and a queue statement.
+// A nil queue statement means queue 1.
+func newQueueBlock(cfg *config.Config, queueStmt *config.QueueStatement) (*queueBlock, error) {
+	block := &queueBlock{
+		cfg:      cfg,
+		universe: UniverseVanilla, // Default
 	}
 
 	// Set universe if specified
 	if univ, ok := cfg.Get("universe"); ok {
-		sf.universe = parseUniverse(univ)
+		block.universe = parseUniverse(univ)
 	}
 
 	// Create iterator from queue statement
-	if queueStmt != nil {
-		iterator, err := createIteratorFromQueue(queueStmt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create queue iterator: %w", err)
-		}
-		sf.queueIterator = iterator
-		sf.queueCount = iterator.Count()
-		sf.queueVars = queueStmt.VarNames
-	} else {
-		// No queue statement means queue 1
-		sf.queueIterator = newSimpleIterator(1)
-		sf.queueCount = 1
+	if queueStmt == nil {
+		block.iterator = newSimpleIterator(1)
+		return block, nil
 	}
 
-	return sf, nil
+	iterator, err := createIteratorFromQueue(queueStmt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue iterator: %w", err)
+	}
+	block.iterator = iterator
+	block.queueVars = queueStmt.VarNames
+	return block, nil
+}
+
+// useQueueBlock makes block the current source of submit commands for MakeJobAd
+func (sf *SubmitFile) useQueueBlock(block *queueBlock) {
+	sf.cfg = block.cfg
+	sf.universe = block.universe
+	sf.queueVars = block.queueVars
+	sf.queueIterator = block.iterator
+}
+
+// blocks returns the queue blocks to submit, in order
+func (sf *SubmitFile) blocks() []*queueBlock {
+	if len(sf.queueBlocks) > 0 {
+		return sf.queueBlocks
+	}
+	// SubmitFile built without ParseSubmitFile: treat current state as the only block
+	return []*queueBlock{{cfg: sf.cfg, universe: sf.universe, queueVars: sf.queueVars, iterator: sf.queueIterator}}
 }
 
 // parseUniverse converts universe string to integer constant
@@ -196,6 +468,11 @@ func (sf *SubmitFile) MakeJobAd(jobID JobID, queueVars map[string]string) (*clas
 		return nil, err
 	}
 
+	// Set OAuth credentials requested with use_oauth_services
+	if err := sf.setOAuthServices(ad); err != nil {
+		return nil, err
+	}
+
 	// Set container/docker settings
 	if err := sf.setContainerSettings(ad); err != nil {
 		return nil, err
@@ -231,11 +508,26 @@ func (sf *SubmitFile) MakeJobAd(jobID JobID, queueVars map[string]string) (*clas
 		return nil, err
 	}
 
+	// Set the job lease, which lets the job survive disconnections
+	if err := sf.setJobLease(ad); err != nil {
+		return nil, err
+	}
+
 	// Set any custom attributes (+ or MY.)
 	if err := sf.setCustomAttributes(ad); err != nil {
 		return nil, err
 	}
 
+	// Set flocking attributes
+	if err := sf.setFlocking(ad); err != nil {
+		return nil, err
+	}
+
+	// Set X.509 proxy and delegation (all universes)
+	if err := sf.setProxyParams(ad); err != nil {
+		return nil, err
+	}
+
 	// Set universe-specific parameters
 	switch sf.universe {
 	case UniverseGrid:
@@ -246,16 +538,18 @@ func (sf *SubmitFile) MakeJobAd(jobID JobID, queueVars map[string]string) (*clas
 		if err := sf.setVMParams(ad); err != nil {
 			return nil, err
 		}
-	case UniverseParallel:
-		if err := sf.setParallelParams(ad); err != nil {
-			return nil, err
-		}
 	case UniverseJava:
 		if err := sf.setJavaParams(ad); err != nil {
 			return nil, err
 		}
 	}
 
+	// Set dedicated scheduling parameters (parallel universe, or any
+	// universe with want_parallel_scheduling)
+	if err := sf.setParallelParams(ad); err != nil {
+		return nil, err
+	}
+
 	// Set periodic expressions (all universes)
 	if err := sf.setPeriodicExpressions(ad); err != nil {
 		return nil, err
@@ -276,6 +570,11 @@ func (sf *SubmitFile) MakeJobAd(jobID JobID, queueVars map[string]string) (*clas
 		return nil, err
 	}
 
+	// Set DAG workflow correlation attributes
+	if err := sf.setDAGAttributes(ad); err != nil {
+		return nil, err
+	}
+
 	// Set auto-generated attributes (should be last)
 	if err := sf.setAutoAttributes(ad); err != nil {
 		return nil, err
@@ -284,7 +583,9 @@ func (sf *SubmitFile) MakeJobAd(jobID JobID, queueVars map[string]string) (*clas
 	return ad, nil
 }
 
-// setExecutable sets the executable attribute
+// setExecutable sets the executable attribute. The executable may be a URL
+// (e.g. https:// or osdf://), which a file transfer plugin fetches on the
+// execute node; Cmd is then the URL itself.
 func (sf *SubmitFile) setExecutable(ad *classad.ClassAd) error {
 	exec, ok := sf.cfg.Get("executable")
 	if !ok {
@@ -351,6 +652,28 @@ func (sf *SubmitFile) setStandardFiles(ad *classad.ClassAd) error {
 	return nil
 }
 
+// checkWhenToTransferOutput validates an uppercased when_to_transfer_output.
+// ON_EXIT_OR_EVICT saves the job's output in the spool when it is evicted,
+// so the job restarts from it; that needs file transfer on every run and
+// conflicts with checkpoint_exit_code, where the job decides itself when
+// its files are saved.
+func (sf *SubmitFile) checkWhenToTransferOutput(when string) error {
+	switch when {
+	case "ON_EXIT", "ON_SUCCESS":
+		return nil
+	case "ON_EXIT_OR_EVICT":
+		if stf, ok := sf.cfg.Get("should_transfer_files"); ok && !strings.EqualFold(strings.TrimSpace(stf), "YES") {
+			return fmt.Errorf("when_to_transfer_output = ON_EXIT_OR_EVICT requires should_transfer_files = YES, not %s", stf)
+		}
+		if _, ok := sf.cfg.Get("checkpoint_exit_code"); ok {
+			return fmt.Errorf("when_to_transfer_output = ON_EXIT_OR_EVICT cannot be combined with checkpoint_exit_code; use ON_EXIT for self-checkpointing jobs")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid when_to_transfer_output %q: must be ON_EXIT, ON_EXIT_OR_EVICT or ON_SUCCESS", when)
+	}
+}
+
 // setFileTransfer sets file transfer related attributes
 func (sf *SubmitFile) setFileTransfer(ad *classad.ClassAd) error {
 	// should_transfer_files
@@ -363,14 +686,25 @@ func (sf *SubmitFile) setFileTransfer(ad *classad.ClassAd) error {
 
 	// when_to_transfer_output
 	if wto, ok := sf.cfg.Get("when_to_transfer_output"); ok {
-		_ = ad.Set("WhenToTransferOutput", strings.ToUpper(wto))
+		when := strings.ToUpper(strings.TrimSpace(wto))
+		if err := sf.checkWhenToTransferOutput(when); err != nil {
+			return err
+		}
+		_ = ad.Set("WhenToTransferOutput", when)
 	} else {
 		_ = ad.Set("WhenToTransferOutput", "ON_EXIT")
 	}
 
-	// transfer_input_files - parse comma-separated list
+	// transfer_input_files - parse comma-separated list, transferring each
+	// file once
 	if tif, ok := sf.cfg.Get("transfer_input_files"); ok {
-		files := parseFileList(tif)
+		files, duplicates := dedupeFileList(parseFileList(tif))
+		for _, file := range duplicates {
+			if sf.duplicateInputs == nil {
+				sf.duplicateInputs = make(map[string]bool)
+			}
+			sf.duplicateInputs[file] = true
+		}
 		if len(files) > 0 {
 			// Join with commas for ClassAd string list format
 			_ = ad.Set("TransferInputFiles", strings.Join(files, ","))
@@ -385,6 +719,32 @@ func (sf *SubmitFile) setFileTransfer(ad *classad.ClassAd) error {
 		}
 	}
 
+	// transfer_checkpoint_files - files saved when the job checkpoints
+	if tcf, ok := sf.cfg.Get("transfer_checkpoint_files"); ok {
+		files := parseFileList(tcf)
+		if len(files) > 0 {
+			_ = ad.Set("TransferCheckpoint", strings.Join(files, ","))
+		}
+	}
+
+	// checkpoint_destination - URL checkpoints are stored at instead of the spool
+	if dest, ok := sf.cfg.Get("checkpoint_destination"); ok && strings.TrimSpace(dest) != "" {
+		_ = ad.Set("CheckpointDestination", strings.TrimSpace(dest))
+	}
+
+	// output_destination - a URL that receives all the output files
+	// (including stdout and stderr) in place of the submit machine
+	if od, ok := sf.cfg.Get("output_destination"); ok {
+		destination := strings.TrimSpace(od)
+		if !isURL(destination) {
+			return fmt.Errorf("output_destination %q is not a URL", od)
+		}
+		if stf, _ := ad.EvaluateAttrString("ShouldTransferFiles"); stf == "NO" {
+			return fmt.Errorf("output_destination %s requires should_transfer_files = YES or IF_NEEDED", destination)
+		}
+		_ = ad.Set("OutputDestination", destination)
+	}
+
 	// transfer_output_remaps - format: "name1=path1;name2=path2"
 	if tor, ok := sf.cfg.Get("transfer_output_remaps"); ok {
 		remaps := parseRemaps(tor)
@@ -405,6 +765,16 @@ func (sf *SubmitFile) setFileTransfer(ad *classad.ClassAd) error {
 	}
 	_ = ad.Set("TransferExecutable", transferExec)
 
+	// A URL executable only reaches the execute node through file transfer
+	if cmd, _ := ad.EvaluateAttrString("Cmd"); isURL(cmd) {
+		if !transferExec {
+			return fmt.Errorf("executable %s is a URL, which requires transfer_executable = true", cmd)
+		}
+		if stf, _ := ad.EvaluateAttrString("ShouldTransferFiles"); stf == "NO" {
+			return fmt.Errorf("executable %s is a URL, which requires should_transfer_files = YES or IF_NEEDED", cmd)
+		}
+	}
+
 	// encrypt_input_files - comma-separated list of files to encrypt
 	if eif, ok := sf.cfg.Get("encrypt_input_files"); ok {
 		files := parseFileList(eif)
@@ -437,18 +807,28 @@ func (sf *SubmitFile) setFileTransfer(ad *classad.ClassAd) error {
 		}
 	}
 
-	// transfer_plugins - semicolon-separated list
+	// transfer_plugins - "schemes=path" entries separated by semicolons
 	if tp, ok := sf.cfg.Get("transfer_plugins"); ok {
-		plugins := strings.Split(tp, ";")
-		var cleanPlugins []string
-		for _, p := range plugins {
-			p = strings.TrimSpace(p)
-			if p != "" {
-				cleanPlugins = append(cleanPlugins, p)
-			}
+		plugins, err := parseTransferPlugins(tp)
+		if err != nil {
+			return err
 		}
-		if len(cleanPlugins) > 0 {
-			_ = ad.Set("TransferPlugins", strings.Join(cleanPlugins, ";"))
+		if len(plugins) > 0 {
+			entries := make([]string, len(plugins))
+			for i, p := range plugins {
+				entries[i] = strings.Join(p.schemes, ",") + "=" + p.path
+			}
+			_ = ad.Set("TransferPlugins", strings.Join(entries, ";"))
+
+			// Local plugins are shipped with the job, so add them to the input files
+			inputs, _ := ad.EvaluateAttrString("TransferInputFiles")
+			files := parseFileList(inputs)
+			for _, p := range plugins {
+				if !isURL(p.path) && !slices.Contains(files, p.path) {
+					files = append(files, p.path)
+				}
+			}
+			_ = ad.Set("TransferInputFiles", strings.Join(files, ","))
 		}
 	}
 
@@ -475,6 +855,28 @@ func (sf *SubmitFile) setContainerSettings(ad *classad.ClassAd) error {
 		containerImage = img
 	}
 
+	// container_image_sha256 pins the image to a digest; an image that already
+	// names a digest must name the same one
+	pinned, err := imageDigest(containerImage)
+	if err != nil {
+		return err
+	}
+	if sha, ok := sf.cfg.Get("container_image_sha256"); ok {
+		if containerImage == "" {
+			return fmt.Errorf("container_image_sha256 is set but no container image is given")
+		}
+		digest := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(sha), "sha256:"))
+		if !isSHA256Hex(digest) {
+			return fmt.Errorf("invalid container_image_sha256 %q: expected 64 hex digits", sha)
+		}
+		if pinned != "" && pinned != digest {
+			return fmt.Errorf("container image %s does not match container_image_sha256 %s", containerImage, digest)
+		}
+		if pinned == "" {
+			containerImage += "@sha256:" + digest
+		}
+	}
+
 	if containerImage != "" {
 		_ = ad.Set("DockerImage", containerImage)
 		// Also set container_image for newer HTCondor versions
@@ -541,16 +943,193 @@ func (sf *SubmitFile) setContainerSettings(ad *classad.ClassAd) error {
 		_ = ad.Set("ContainerImageSHA256", sha)
 	}
 
+	// docker_credentials or container_registry_credentials - a reference to a
+	// registry credential stored in the credd, fetched like an OAuth token
+	service, ok, err := sf.registryCredentials()
+	if err != nil {
+		return err
+	}
+	if ok {
+		_ = ad.Set("ContainerRegistryCredentials", service)
+		addOAuthService(ad, service)
+	}
+
+	return nil
+}
+
+// registryCredentials returns the credd service name given by docker_credentials
+// or container_registry_credentials. Inline credentials are rejected: secrets
+// must not be placed in the job ad, where any user with read access can see them.
+func (sf *SubmitFile) registryCredentials() (string, bool, error) {
+	key := "docker_credentials"
+	value, ok := sf.cfg.Get(key)
+	if !ok {
+		key = "container_registry_credentials"
+		value, ok = sf.cfg.Get(key)
+	}
+	if !ok {
+		return "", false, nil
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", false, nil
+	}
+	if !isCredentialServiceName(value) {
+		return "", false, fmt.Errorf("%s must name a credential stored in the credd (for example a service name "+
+			"stored with condor_store_cred or listed in use_oauth_services); inline registry credentials are not allowed", key)
+	}
+	if err := sf.checkOAuthServiceAvailable(key, value); err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+// isCredentialServiceName reports whether s is a valid credd service name
+func isCredentialServiceName(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+		case (r == '-' || r == '.') && i > 0:
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// setOAuthServices sets OAuthServicesNeeded from use_oauth_services. A
+// service requested under several handles, by submit commands such as
+// <service>_oauth_permissions_<handle>, is listed once per handle as
+// service*handle, as condor_submit does.
+func (sf *SubmitFile) setOAuthServices(ad *classad.ClassAd) error {
+	value, _ := sf.cfg.Get("use_oauth_services")
+	services := parseFileList(value)
+	if len(services) == 0 {
+		return nil
+	}
+
+	keys := sf.cfg.Keys()
+	for _, service := range services {
+		if !isCredentialServiceName(service) {
+			return fmt.Errorf("use_oauth_services: invalid service name %q", service)
+		}
+		if err := sf.checkOAuthServiceAvailable("use_oauth_services", service); err != nil {
+			return err
+		}
+
+		handles, unnamed, err := oauthHandles(keys, service)
+		if err != nil {
+			return err
+		}
+		if len(handles) > 0 && unnamed {
+			return fmt.Errorf("use_oauth_services: service %q is requested both with and without a handle", service)
+		}
+		if len(handles) == 0 {
+			addOAuthService(ad, service)
+		}
+		for _, handle := range handles {
+			addOAuthService(ad, service+"*"+handle)
+		}
+	}
 	return nil
 }
 
-// setRequirements sets the Requirements expression
+// checkOAuthServiceAvailable rejects a service the submit command key
+// requests from the credd unless it is one of SubmitFileOptions.OAuthServices
+// (when set)
+func (sf *SubmitFile) checkOAuthServiceAvailable(key, service string) error {
+	if sf.opts.OAuthServices == nil || slices.Contains(sf.opts.OAuthServices, service) {
+		return nil
+	}
+	return fmt.Errorf("%s: unknown OAuth service %q (available: %s)",
+		key, service, strings.Join(sf.opts.OAuthServices, ", "))
+}
+
+// oauthSuffixes are the per-service submit commands, each of which may be
+// followed by _<handle>
+var oauthSuffixes = []string{"_oauth_permissions", "_oauth_resource"}
+
+// oauthHandles returns the handles named by a service's submit commands,
+// sorted, and whether any of the commands has no handle
+func oauthHandles(keys []string, service string) ([]string, bool, error) {
+	seen := make(map[string]bool)
+	var handles []string
+	unnamed := false
+	for _, key := range keys {
+		lower := strings.ToLower(key)
+		for _, suffix := range oauthSuffixes {
+			prefix := strings.ToLower(service) + suffix
+			if !strings.HasPrefix(lower, prefix) {
+				continue
+			}
+			rest := key[len(prefix):]
+			switch {
+			case rest == "":
+				unnamed = true
+			case strings.HasPrefix(rest, "_"):
+				// Submit commands are case-insensitive, so handles are too
+				handle := strings.ToLower(rest[1:])
+				if !isCredentialServiceName(handle) {
+					return nil, false, fmt.Errorf("%s: invalid handle %q for OAuth service %q", key, handle, service)
+				}
+				if !seen[handle] {
+					seen[handle] = true
+					handles = append(handles, handle)
+				}
+			}
+		}
+	}
+	sort.Strings(handles)
+	return handles, unnamed, nil
+}
+
+// addOAuthService adds a service to the job's OAuthServicesNeeded list if not already present
+func addOAuthService(ad *classad.ClassAd, service string) {
+	var services []string
+	if existing, ok := ad.EvaluateAttrString("OAuthServicesNeeded"); ok {
+		services = parseFileList(existing)
+	}
+	for _, s := range services {
+		if s == service {
+			return
+		}
+	}
+	services = append(services, service)
+	_ = ad.Set("OAuthServicesNeeded", strings.Join(services, ","))
+}
+
+// setRequirements sets the Requirements expression.
+//
+// By default the user's requirements are ANDed with automatic clauses derived
+// from other submit commands (resource requests, containers, file transfer).
+// Setting "requirements_replace = true" makes the user's requirements
+// authoritative: they are used exactly as written, with no automatic clauses.
 func (sf *SubmitFile) setRequirements(ad *classad.ClassAd) error {
 	var reqParts []string
 
 	// Start with user-specified requirements
-	if req, ok := sf.cfg.Get("requirements"); ok {
-		reqParts = append(reqParts, "("+req+")")
+	req, hasReq := sf.cfg.Get("requirements")
+	if hasReq {
+		normalized, err := NormalizeExpression(req)
+		if err != nil {
+			return fmt.Errorf("failed to parse requirements expression: %w", err)
+		}
+		req = normalized
+		reqParts = append(reqParts, req)
+	}
+
+	// The user's requirements are authoritative; skip the automatic clauses
+	if replace, ok := sf.cfg.Get("requirements_replace"); ok && parseBool(replace, false) {
+		if !hasReq {
+			return fmt.Errorf("requirements_replace is set but no requirements were given")
+		}
+		reqExpr, err := classad.ParseExpr(req)
+		if err != nil {
+			return fmt.Errorf("failed to parse requirements expression: %w", err)
+		}
+		_ = ad.Set("Requirements", reqExpr)
+		return nil
 	}
 
 	// Add TARGET.OpSys check for non-grid jobs
@@ -581,17 +1160,20 @@ func (sf *SubmitFile) setRequirements(ad *classad.ClassAd) error {
 	if reqGpus, ok := sf.cfg.Get("request_gpus"); ok {
 		if intGpus, err := parseInt(reqGpus); err == nil && intGpus > 0 {
 			reqParts = append(reqParts, "(TARGET.Gpus >= RequestGpus)")
+			if clauses, err := sf.gpuPropertyClauses(); err == nil && len(clauses) > 0 {
+				reqParts = append(reqParts, gpuRequirement)
+			}
 		}
 	}
 
 	// Add specific OpSys requirement if specified
 	if reqOpsys, ok := sf.cfg.Get("request_opsys"); ok {
-		reqParts = append(reqParts, fmt.Sprintf("(TARGET.OpSys == %q)", reqOpsys))
+		reqParts = append(reqParts, fmt.Sprintf("(TARGET.OpSys == %s)", classad.Quote(reqOpsys)))
 	}
 
 	// Add specific Arch requirement if specified
 	if reqArch, ok := sf.cfg.Get("request_arch"); ok {
-		reqParts = append(reqParts, fmt.Sprintf("(TARGET.Arch == %q)", reqArch))
+		reqParts = append(reqParts, fmt.Sprintf("(TARGET.Arch == %s)", classad.Quote(reqArch)))
 	}
 
 	// Add container requirement if container image is specified
@@ -608,9 +1190,14 @@ func (sf *SubmitFile) setRequirements(ad *classad.ClassAd) error {
 		}
 	}
 
+	// Chirp needs a starter that runs the I/O proxy
+	if sf.universe != UniverseGrid && sf.wantIOProxy() {
+		reqParts = append(reqParts, "(TARGET.HasIOProxy =?= true)")
+	}
+
 	// Add file system domain requirements
 	if fsDomain, ok := sf.cfg.Get("file_system_domain"); ok {
-		reqParts = append(reqParts, fmt.Sprintf("(TARGET.FileSystemDomain == %q)", fsDomain))
+		reqParts = append(reqParts, fmt.Sprintf("(TARGET.FileSystemDomain == %s)", classad.Quote(fsDomain)))
 	}
 
 	// Add HasFileTransfer requirement if needed
@@ -633,55 +1220,50 @@ func (sf *SubmitFile) setRequirements(ad *classad.ClassAd) error {
 	return nil
 }
 
+// wantIOProxy reports whether the job asks for chirp (want_io_proxy)
+func (sf *SubmitFile) wantIOProxy() bool {
+	value, ok := sf.cfg.Get("want_io_proxy")
+	return ok && parseBool(value, false)
+}
+
 // setResourceRequests sets resource request attributes
 func (sf *SubmitFile) setResourceRequests(ad *classad.ClassAd) error {
-	// Request CPUs (default: 1)
-	cpus := 1
-	if reqCpus, ok := sf.cfg.Get("request_cpus"); ok {
-		if n, err := parseInt(reqCpus); err == nil {
-			cpus = n
-		}
-	}
-	_ = ad.Set("RequestCpus", cpus)
-
-	// Request Memory in MB (default: 128)
-	memory := 128
-	if reqMem, ok := sf.cfg.Get("request_memory"); ok {
-		if n, err := parseInt(reqMem); err == nil {
-			memory = n
-		}
-	}
-	_ = ad.Set("RequestMemory", memory)
-
-	// Request Disk in KB (default: 1024)
-	disk := 1024
-	if reqDisk, ok := sf.cfg.Get("request_disk"); ok {
-		if n, err := parseInt(reqDisk); err == nil {
-			disk = n
+	// Requests are integers or expressions; cpus default to 1, memory to
+	// 128 MB and disk to 1024 KB, and GPU requests (def -1) are only set
+	// when given. Sizes (unit > 0) may carry a K, M, G or T suffix, with or
+	// without a trailing B, like condor_submit; a bare number is in unit
+	requests := []struct {
+		key, attr string
+		def       int
+		unit      int64
+	}{
+		{"request_cpus", "RequestCpus", 1, 0},
+		{"request_memory", "RequestMemory", 128, 1 << 20},
+		{"request_disk", "RequestDisk", 1024, 1 << 10},
+		{"request_gpus", "RequestGpus", -1, 0},
+		{"request_gpu_memory", "RequestGpuMemory", -1, 1 << 20}, // MB per device
+	}
+	for _, req := range requests {
+		value, ok := sf.cfg.Get(req.key)
+		if !ok || strings.TrimSpace(value) == "" {
+			if req.def >= 0 {
+				_ = ad.Set(req.attr, req.def)
+			}
+			continue
 		}
-	}
-	_ = ad.Set("RequestDisk", disk)
-
-	// Request GPUs (default: 0)
-	if reqGpus, ok := sf.cfg.Get("request_gpus"); ok {
-		if n, err := parseInt(reqGpus); err == nil {
-			_ = ad.Set("RequestGpus", n)
+		if req.unit > 0 {
+			if n, ok := parseSize(value, req.unit); ok {
+				_ = ad.Set(req.attr, n)
+				continue
+			}
 		}
-	}
-
-	// GPU memory per device (MB)
-	if gpuMem, ok := sf.cfg.Get("request_gpu_memory"); ok {
-		if n, err := parseInt(gpuMem); err == nil {
-			_ = ad.Set("RequestGpuMemory", n)
+		if err := setRequestAttr(ad, req.attr, req.key, value); err != nil {
+			return err
 		}
 	}
 
 	// Specific GPU properties
-	if gpuProps, ok := sf.cfg.Get("require_gpus"); ok {
-		_ = ad.Set("RequireGpus", gpuProps)
-	}
-
-	return nil
+	return sf.setGPUProperties(ad)
 }
 
 // setNotification sets notification preference
@@ -700,10 +1282,15 @@ func (sf *SubmitFile) setNotification(ad *classad.ClassAd) error {
 	return nil
 }
 
-// setRank sets the rank expression
+// setRank sets the rank expression, which may prefer machines by their
+// properties, e.g. TARGET.GPUs_DeviceName
 func (sf *SubmitFile) setRank(ad *classad.ClassAd) error {
-	if rank, ok := sf.cfg.Get("rank"); ok {
-		_ = ad.Set("Rank", rank)
+	if rank, ok := sf.cfg.Get("rank"); ok && strings.TrimSpace(rank) != "" {
+		rankExpr, err := classad.ParseExpr(rank)
+		if err != nil {
+			return fmt.Errorf("failed to parse rank expression: %w", err)
+		}
+		_ = ad.Set("Rank", rankExpr)
 	} else {
 		// Default rank
 		_ = ad.Set("Rank", 0.0)
@@ -780,10 +1367,11 @@ func (sf *SubmitFile) setJobStatusControl(ad *classad.ClassAd) error {
 		}
 	}
 
-	// job_max_vacate_time - time for job to vacate before killing
+	// job_max_vacate_time - time for job to vacate before killing, in
+	// seconds or as an expression such as 60 * 5
 	if maxVacate, ok := sf.cfg.Get("job_max_vacate_time"); ok {
-		if intVacate, err := parseInt(maxVacate); err == nil {
-			_ = ad.Set("JobMaxVacateTime", intVacate)
+		if err := setRequestAttr(ad, "JobMaxVacateTime", "job_max_vacate_time", maxVacate); err != nil {
+			return err
 		}
 	}
 
@@ -816,13 +1404,6 @@ func (sf *SubmitFile) setJobStatusControl(ad *classad.ClassAd) error {
 		}
 	}
 
-	// job_lease_duration - how long schedd can keep job leased
-	if leaseDuration, ok := sf.cfg.Get("job_lease_duration"); ok {
-		if intLease, err := parseInt(leaseDuration); err == nil {
-			_ = ad.Set("JobLeaseDuration", intLease)
-		}
-	}
-
 	// concurrency_limits - resources this job needs
 	if concLimits, ok := sf.cfg.Get("concurrency_limits"); ok {
 		_ = ad.Set("ConcurrencyLimits", concLimits)
@@ -863,9 +1444,24 @@ func (sf *SubmitFile) setCustomAttributes(ad *classad.ClassAd) error {
 			continue
 		}
 
+		// A ClassAd string can hold any UTF-8 text, escaped when the ad is
+		// sent; other bytes cannot be represented
+		value = strings.TrimSpace(value)
+		if !utf8.ValidString(value) {
+			return fmt.Errorf("value of %s is not valid UTF-8", key)
+		}
+
+		// A quoted value is a ClassAd string literal; set the string it
+		// denotes, so it is not quoted twice
+		if str, ok, err := parseStringLiteral(value); err != nil {
+			return fmt.Errorf("invalid string value for %s: %w", key, err)
+		} else if ok {
+			_ = ad.Set(attrName, str)
+			continue
+		}
+
 		// Try to parse as different types
 		// First, check if it's a boolean
-		value = strings.TrimSpace(value)
 		if strings.ToLower(value) == "true" || strings.ToLower(value) == "false" {
 			_ = ad.Set(attrName, parseBool(value, false))
 			continue
@@ -901,6 +1497,23 @@ func (sf *SubmitFile) setCustomAttributes(ad *classad.ClassAd) error {
 
 // Helper functions
 
+// parseStringLiteral unescapes a double-quoted ClassAd string literal such
+// as "say \"hi\"\n" per ClassAd rules. ok is false for values that are not
+// a single string literal; err is set for quoted values that do not parse.
+func parseStringLiteral(value string) (str string, ok bool, err error) {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", false, nil
+	}
+	expr, err := classad.ParseExpr(value)
+	if err != nil {
+		return "", false, err
+	}
+	ad := classad.New()
+	ad.InsertExpr("Value", expr)
+	str, ok = ad.EvaluateAttrString("Value")
+	return str, ok, nil
+}
+
 func parseBool(s string, def bool) bool {
 	s = strings.ToLower(strings.TrimSpace(s))
 	switch s {
@@ -920,6 +1533,55 @@ func parseInt(s string) (int, error) {
 	return n, err
 }
 
+// setRequestAttr sets a request_* command, or another integer command that
+// may be an expression, on the ad: an integer as a number, anything else as
+// a ClassAd expression such as "MY.OriginalMemory * 2"
+func setRequestAttr(ad *classad.ClassAd, attr, key, value string) error {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.Atoi(value); err == nil {
+		_ = ad.Set(attr, n)
+		return nil
+	}
+	expr, err := classad.ParseExpr(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	_ = ad.Set(attr, expr)
+	return nil
+}
+
+// sizeSuffixes maps the size suffixes condor_submit accepts to bytes
+var sizeSuffixes = map[string]int64{
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// parseSize parses a size such as "4096", "4GB", "1.5 G" or "512k" into a
+// whole number of unit bytes, rounding up. A number without a suffix is
+// already in unit. ok is false for values that are not sizes, such as
+// expressions.
+func parseSize(value string, unit int64) (n int64, ok bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	multiplier := unit
+	if trimmed := strings.TrimSuffix(value, "B"); trimmed != "" && trimmed[len(trimmed)-1] >= 'A' && trimmed[len(trimmed)-1] <= 'Z' {
+		if multiplier, ok = sizeSuffixes[trimmed[len(trimmed)-1:]]; !ok {
+			return 0, false
+		}
+		value = strings.TrimSpace(trimmed[:len(trimmed)-1])
+	}
+	size, err := strconv.ParseFloat(value, 64)
+	if err != nil || size < 0 || math.IsInf(size, 0) || math.IsNaN(size) {
+		return 0, false
+	}
+	units := math.Ceil(size * float64(multiplier) / float64(unit))
+	if units > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(units), true
+}
+
 // parseFileList parses a comma-separated list of files
 // Handles whitespace and empty entries
 func parseFileList(list string) []string {
@@ -934,6 +1596,21 @@ func parseFileList(list string) []string {
 	return files
 }
 
+// dedupeFileList removes repeated entries from a file list, keeping the
+// first occurrence of each, and returns the entries that were repeated
+func dedupeFileList(files []string) (unique, duplicates []string) {
+	seen := make(map[string]bool, len(files))
+	for _, file := range files {
+		if seen[file] {
+			duplicates = append(duplicates, file)
+			continue
+		}
+		seen[file] = true
+		unique = append(unique, file)
+	}
+	return unique, duplicates
+}
+
 // parseRemaps parses transfer_output_remaps format: "name1=path1;name2=path2"
 // Returns a map of source -> destination paths
 func parseRemaps(remaps string) map[string]string {
@@ -956,6 +1633,98 @@ func parseRemaps(remaps string) map[string]string {
 	return result
 }
 
+// transferPlugin is one transfer_plugins entry: the URL schemes a plugin handles
+type transferPlugin struct {
+	schemes []string
+	path    string
+}
+
+// parseTransferPlugins parses transfer_plugins: semicolon-separated
+// "scheme=path" entries, where scheme may be a comma-separated list
+// (e.g. "http,https=/usr/libexec/condor/curl_plugin"). Every entry needs a
+// scheme and a path, and a scheme may only be claimed by one plugin.
+func parseTransferPlugins(value string) ([]transferPlugin, error) {
+	var plugins []transferPlugin
+	claimed := make(map[string]string)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		schemeList, pluginPath, found := strings.Cut(entry, "=")
+		pluginPath = strings.TrimSpace(pluginPath)
+		if !found || pluginPath == "" {
+			return nil, fmt.Errorf("invalid transfer_plugins entry %q: expected scheme=path", entry)
+		}
+		schemes := parseFileList(schemeList)
+		if len(schemes) == 0 {
+			return nil, fmt.Errorf("invalid transfer_plugins entry %q: missing scheme", entry)
+		}
+		for _, scheme := range schemes {
+			if !isURLScheme(scheme) {
+				return nil, fmt.Errorf("invalid transfer_plugins entry %q: invalid scheme %q", entry, scheme)
+			}
+			key := strings.ToLower(scheme)
+			if other, ok := claimed[key]; ok {
+				return nil, fmt.Errorf("transfer_plugins scheme %q is claimed by both %s and %s", scheme, other, pluginPath)
+			}
+			claimed[key] = pluginPath
+		}
+		plugins = append(plugins, transferPlugin{schemes: schemes, path: pluginPath})
+	}
+	return plugins, nil
+}
+
+// imageDigest returns the sha256 digest a container image reference is pinned
+// to ("repo:tag@sha256:<hex>"), or "" if it is not pinned. A malformed digest
+// is an error.
+func imageDigest(image string) (string, error) {
+	i := strings.LastIndex(image, "@sha256:")
+	if i < 0 {
+		return "", nil
+	}
+	digest := strings.ToLower(image[i+len("@sha256:"):])
+	if !isSHA256Hex(digest) {
+		return "", fmt.Errorf("invalid digest in container image %s: expected 64 hex digits", image)
+	}
+	return digest, nil
+}
+
+// isSHA256Hex reports whether s is a lowercase hex-encoded SHA-256 hash
+func isSHA256Hex(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// isURL reports whether s is a URL ("scheme://...") rather than a local path
+func isURL(s string) bool {
+	scheme, _, found := strings.Cut(s, "://")
+	return found && isURLScheme(scheme)
+}
+
+// isURLScheme reports whether s is a valid URL scheme (RFC 3986)
+func isURLScheme(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '+' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // setGridParams sets grid universe specific parameters
 //
 //nolint:gocyclo // Complex function required for grid universe parameter handling
@@ -1064,16 +1833,57 @@ func (sf *SubmitFile) setGridParams(ad *classad.ClassAd) error {
 		_ = ad.Set("ArcResources", arcResources)
 	}
 
-	// Delegate credentials
-	if delegateJobGSI, ok := sf.cfg.Get("delegate_job_gsi_credentials_lifetime"); ok {
-		if intLifetime, err := parseInt(delegateJobGSI); err == nil {
-			_ = ad.Set("DelegateJobGSICredentialsLifetime", intLifetime)
+	return nil
+}
+
+// setProxyParams sets the job's X.509 proxy and how long delegated copies
+// of it last. Proxies are delegated to vanilla and other jobs as well as
+// grid jobs, so this applies to every universe.
+func (sf *SubmitFile) setProxyParams(ad *classad.ClassAd) error {
+	proxy, _ := sf.cfg.Get("x509userproxy")
+	proxy = strings.TrimSpace(proxy)
+	if proxy == "" {
+		if use, ok := sf.cfg.Get("use_x509userproxy"); ok && parseBool(use, false) {
+			var err error
+			if proxy, err = sf.defaultProxyPath(); err != nil {
+				return err
+			}
+		}
+	}
+	if proxy != "" {
+		_ = ad.Set("X509UserProxy", proxy)
+	}
+
+	// 0 delegates a proxy lasting as long as the original
+	if lifetime, ok := sf.cfg.Get("delegate_job_gsi_credentials_lifetime"); ok && strings.TrimSpace(lifetime) != "" {
+		seconds, err := strconv.Atoi(strings.TrimSpace(lifetime))
+		if err != nil || seconds < 0 {
+			return fmt.Errorf("delegate_job_gsi_credentials_lifetime must be a non-negative number of seconds, got %q", lifetime)
 		}
+		_ = ad.Set("DelegateJobGSICredentialsLifetime", seconds)
 	}
 
 	return nil
 }
 
+// defaultProxyPath returns the proxy use_x509userproxy refers to:
+// $X509_USER_PROXY, or /tmp/x509up_u<uid> when submitting locally.
+// When EnvLookup is set the submitter's uid is unknown, so the proxy must
+// be named in the environment or with x509userproxy.
+func (sf *SubmitFile) defaultProxyPath() (string, error) {
+	lookup := os.LookupEnv
+	if sf.opts.EnvLookup != nil {
+		lookup = sf.opts.EnvLookup
+	}
+	if path, ok := lookup("X509_USER_PROXY"); ok && path != "" {
+		return path, nil
+	}
+	if sf.opts.EnvLookup != nil {
+		return "", fmt.Errorf("use_x509userproxy is set but X509_USER_PROXY is not; set x509userproxy to the proxy path")
+	}
+	return fmt.Sprintf("/tmp/x509up_u%d", os.Getuid()), nil
+}
+
 // setVMParams sets VM universe specific parameters
 func (sf *SubmitFile) setVMParams(ad *classad.ClassAd) error {
 	// vm_type - Required: type of VM (kvm, xen, vmware)
@@ -1158,17 +1968,50 @@ func (sf *SubmitFile) setVMParams(ad *classad.ClassAd) error {
 	return nil
 }
 
-// setParallelParams sets parallel/MPI universe specific parameters
+// setParallelParams sets the dedicated scheduling parameters of parallel/MPI
+// universe jobs, and of jobs of other universes that set
+// want_parallel_scheduling, like SubmitHash::SetParallelParams.
+//
+// Each queue statement of a parallel submit file is one node type of the
+// cluster, so nodes needing different resources are described by separate
+// blocks, each with its own machine_count and request_* commands; the
+// requests apply to every node of the block. Further dedicated scheduler
+// policy, such as +WantParallelSchedulingGroups or +ParallelShutdownPolicy,
+// is set as custom attributes.
 func (sf *SubmitFile) setParallelParams(ad *classad.ClassAd) error {
-	// machine_count - Required: number of machines/nodes needed
-	if machineCount, ok := sf.cfg.Get("machine_count"); ok {
-		if intCount, err := parseInt(machineCount); err == nil {
-			_ = ad.Set("MachineCount", intCount)
+	wantParallel := false
+	if value, ok := sf.cfg.Get("want_parallel_scheduling"); ok && parseBool(value, false) {
+		wantParallel = true
+		_ = ad.Set("WantParallelScheduling", true)
+	}
+	if sf.universe != UniverseParallel && !wantParallel {
+		return nil
+	}
+
+	// machine_count - Required: number of machines/nodes needed; node_count
+	// and NodeCount are accepted as alternate names
+	var machineCount string
+	found := false
+	for _, key := range []string{"machine_count", "node_count", "NodeCount"} {
+		if machineCount, found = sf.cfg.Get(key); found {
+			break
 		}
 	}
+	if !found {
+		return fmt.Errorf("machine_count is required for parallel jobs")
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(machineCount))
+	if err != nil || count <= 0 {
+		return fmt.Errorf("machine_count must be a positive integer, got %q", machineCount)
+	}
+	_ = ad.Set("MachineCount", count)
+	_ = ad.Set("MinHosts", count)
+	_ = ad.Set("MaxHosts", count)
 
-	// request_cpus for parallel jobs means CPUs per node
-	// This is already handled by setResourceRequests, but we may need special handling
+	// The nodes of a parallel job talk to each other and the shadow through
+	// the starter's chirp proxy, and always run in a sandbox
+	_ = ad.Set("WantIOProxy", true)
+	_ = ad.Set("JobRequiresSandbox", true)
 
 	return nil
 }
@@ -1192,6 +2035,19 @@ func (sf *SubmitFile) setJavaParams(ad *classad.ClassAd) error {
 	return nil
 }
 
+// keepOnFailureExpr is the on_exit_remove clause keep_on_failure adds, so
+// that only jobs exiting with code 0 leave the queue. A job that is not
+// removed when it exits goes back to idle and runs again, so failed jobs are
+// rerun until they succeed. With max_retries, keepOnFailureRetriesExpr is
+// used instead, which, like condor_submit, also removes a job once it has
+// completed max_retries + 1 times, whatever its exit code. To keep a failed
+// job for inspection without rerunning it, also set on_exit_hold =
+// ExitCode != 0.
+const (
+	keepOnFailureExpr        = "(ExitCode == 0)"
+	keepOnFailureRetriesExpr = "((ExitCode == 0) || (NumJobCompletions > MaxRetries))"
+)
+
 // setPeriodicExpressions sets periodic hold/remove/release expressions
 func (sf *SubmitFile) setPeriodicExpressions(ad *classad.ClassAd) error {
 	// periodic_hold - Expression to periodically hold job
@@ -1239,25 +2095,29 @@ func (sf *SubmitFile) setPeriodicExpressions(ad *classad.ClassAd) error {
 	}
 
 	// on_exit_remove - Remove job based on exit condition
-	if onExitRemove, ok := sf.cfg.Get("on_exit_remove"); ok {
+	onExitRemove, hasOnExitRemove := sf.cfg.Get("on_exit_remove")
+	if keep, ok := sf.cfg.Get("keep_on_failure"); ok && parseBool(keep, false) {
+		expr := keepOnFailureExpr
+		if maxRetries, ok := sf.cfg.Get("max_retries"); ok {
+			if _, err := parseInt(maxRetries); err == nil {
+				expr = keepOnFailureRetriesExpr
+			}
+		}
+		if hasOnExitRemove && strings.TrimSpace(onExitRemove) != "" {
+			expr = fmt.Sprintf("(%s) && %s", onExitRemove, expr)
+		}
+		parsed, err := classad.ParseExpr(expr)
+		if err != nil {
+			return fmt.Errorf("invalid on_exit_remove %q: %w", onExitRemove, err)
+		}
+		_ = ad.Set("OnExitRemove", parsed)
+	} else if hasOnExitRemove {
 		_ = ad.Set("OnExitRemove", onExitRemove)
 	}
 
 	// cron_* parameters for job deferral
-	if cronMinute, ok := sf.cfg.Get("cron_minute"); ok {
-		_ = ad.Set("CronMinute", cronMinute)
-	}
-	if cronHour, ok := sf.cfg.Get("cron_hour"); ok {
-		_ = ad.Set("CronHour", cronHour)
-	}
-	if cronDayOfMonth, ok := sf.cfg.Get("cron_day_of_month"); ok {
-		_ = ad.Set("CronDayOfMonth", cronDayOfMonth)
-	}
-	if cronMonth, ok := sf.cfg.Get("cron_month"); ok {
-		_ = ad.Set("CronMonth", cronMonth)
-	}
-	if cronDayOfWeek, ok := sf.cfg.Get("cron_day_of_week"); ok {
-		_ = ad.Set("CronDayOfWeek", cronDayOfWeek)
+	if err := sf.setCronFields(ad); err != nil {
+		return err
 	}
 	if cronPrepTime, ok := sf.cfg.Get("cron_prep_time"); ok {
 		if intPrepTime, err := parseInt(cronPrepTime); err == nil {
@@ -1361,31 +2221,68 @@ func (sf *SubmitFile) setAutoAttributes(ad *classad.ClassAd) error {
 func (sf *SubmitFile) Submit(clusterID int) (*SubmitResult, error) {
 	result := &SubmitResult{
 		ClusterID: clusterID,
-		NumProcs:  sf.queueCount,
-		ProcAds:   make([]*classad.ClassAd, 0, sf.queueCount),
 	}
 
-	// Iterate through queue items to create job ads
+	blocks := sf.blocks()
+	defer sf.useQueueBlock(blocks[0])
+
+	// Iterate through each queue block, then its queue items, to create job ads
 	proc := 0
-	for sf.queueIterator.Next() {
-		queueVars := sf.queueIterator.Values()
+	for _, block := range blocks {
+		sf.useQueueBlock(block)
+		resetIterator(block.iterator)
+		for {
+			ok, err := sf.nextQueueItem(block, proc)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			queueVars := block.iterator.Values()
 
-		jobID := JobID{Cluster: clusterID, Proc: proc}
-		procAd, err := sf.MakeJobAd(jobID, queueVars)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create proc %d ad: %w", proc, err)
-		}
+			jobID := JobID{Cluster: clusterID, Proc: proc}
+			procAd, err := sf.MakeJobAd(jobID, queueVars)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create proc %d ad: %w", proc, err)
+			}
+			if err := sf.opts.ExecutablePolicy.CheckJobAd(procAd); err != nil {
+				return nil, fmt.Errorf("proc %d: %w", proc, err)
+			}
 
-		if proc == 0 {
-			result.ClusterAd = procAd
+			if proc == 0 {
+				result.ClusterAd = procAd
+			}
+			result.ProcAds = append(result.ProcAds, procAd)
+			proc++
 		}
-		result.ProcAds = append(result.ProcAds, procAd)
-		proc++
 	}
+	result.NumProcs = proc
+
+	if err := sf.checkOutputCollisions(result); err != nil {
+		return nil, err
+	}
+	sf.checkInformationAttrs(result)
+	sf.checkDuplicateInputs(result)
 
 	return result, nil
 }
 
+// checkDuplicateInputs warns about input files listed more than once in
+// transfer_input_files; each is transferred only once
+func (sf *SubmitFile) checkDuplicateInputs(result *SubmitResult) {
+	files := make([]string, 0, len(sf.duplicateInputs))
+	for file := range sf.duplicateInputs {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("transfer_input_files lists %s more than once; it is transferred once", file))
+	}
+	sf.duplicateInputs = nil
+}
+
 // pushMacroContext sets up live macros for job ad creation
 // These are HTCondor-specific macros that change with each job/proc
 func (sf *SubmitFile) pushMacroContext(jobID JobID, queueVars map[string]string) {
@@ -1395,7 +2292,14 @@ func (sf *SubmitFile) pushMacroContext(jobID JobID, queueVars map[string]string)
 	sf.cfg.Set("Process", strconv.Itoa(jobID.Proc))
 	sf.cfg.Set("ProcId", strconv.Itoa(jobID.Proc))       // Alias for Process
 	sf.cfg.Set("ClusterId", strconv.Itoa(jobID.Cluster)) // Alias for Cluster
-	sf.cfg.Set("Node", strconv.Itoa(jobID.Proc))         // Alias for Process (parallel universe)
+	if sf.universe == UniverseParallel {
+		// The node number is only known once the job runs: the parallel
+		// shadow replaces this placeholder in each node's ad, as it does
+		// for condor_submit
+		sf.cfg.Set("Node", "#pArAlLeLnOdE#")
+	} else {
+		sf.cfg.Set("Node", strconv.Itoa(jobID.Proc)) // Alias for Process
+	}
 
 	// Set queue-specific macros
 	for varName, varValue := range queueVars {
@@ -1459,36 +2363,45 @@ func (sf *SubmitFile) MakeProcAd(clusterID int, procID int, queueVars map[string
 func (sf *SubmitFile) SubmitLate(clusterID int) (*SubmitResult, error) {
 	result := &SubmitResult{
 		ClusterID: clusterID,
-		NumProcs:  sf.queueCount,
-		ProcAds:   make([]*classad.ClassAd, 0, sf.queueCount),
 	}
 
-	// Create cluster ad (template for all procs)
-	// For now, we use proc 0 as the cluster ad template
-	proc := 0
-	sf.queueIterator.Next()
-	queueVars := sf.queueIterator.Values()
-
-	clusterAd, err := sf.MakeJobAd(JobID{Cluster: clusterID, Proc: 0}, queueVars)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create cluster ad: %w", err)
-	}
-	result.ClusterAd = clusterAd
-	result.ProcAds = append(result.ProcAds, clusterAd)
-	proc++
+	blocks := sf.blocks()
+	defer sf.useQueueBlock(blocks[0])
 
-	// Create remaining proc ads
-	for sf.queueIterator.Next() {
-		queueVars := sf.queueIterator.Values()
+	// The first proc ad serves as the cluster ad (template for all procs)
+	proc := 0
+	for _, block := range blocks {
+		sf.useQueueBlock(block)
+		resetIterator(block.iterator)
+		for {
+			ok, err := sf.nextQueueItem(block, proc)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			queueVars := block.iterator.Values()
+
+			procAd, err := sf.MakeProcAd(clusterID, proc, queueVars)
+			if err != nil {
+				if proc == 0 {
+					return nil, fmt.Errorf("failed to create cluster ad: %w", err)
+				}
+				return nil, fmt.Errorf("failed to create proc %d ad: %w", proc, err)
+			}
+			if err := sf.opts.ExecutablePolicy.CheckJobAd(procAd); err != nil {
+				return nil, fmt.Errorf("proc %d: %w", proc, err)
+			}
 
-		procAd, err := sf.MakeProcAd(clusterID, proc, queueVars)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create proc %d ad: %w", proc, err)
+			if proc == 0 {
+				result.ClusterAd = procAd
+			}
+			result.ProcAds = append(result.ProcAds, procAd)
+			proc++
 		}
-
-		result.ProcAds = append(result.ProcAds, procAd)
-		proc++
 	}
+	result.NumProcs = proc
 
 	return result, nil
 }
@@ -1633,8 +2546,10 @@ func (sf *SubmitFile) setSimpleJobExprs(ad *classad.ClassAd) error {
 	}
 
 	// copy_to_spool - copy files to spool directory
+	// A URL executable has no local copy to spool, so the option is ignored for it
 	if copyToSpool, ok := sf.cfg.Get("copy_to_spool"); ok {
-		_ = ad.Set("CopyToSpool", parseBool(copyToSpool, false))
+		cmd, _ := ad.EvaluateAttrString("Cmd")
+		_ = ad.Set("CopyToSpool", parseBool(copyToSpool, false) && !isURL(cmd))
 	}
 
 	// buffer_size - I/O buffer size
@@ -1654,6 +2569,8 @@ func (sf *SubmitFile) setSimpleJobExprs(ad *classad.ClassAd) error {
 	// JobBatchName - batch name for grouping jobs
 	if batchName, ok := sf.cfg.Get("batch_name"); ok {
 		_ = ad.Set("JobBatchName", batchName)
+	} else {
+		sf.setDefaultBatchName(ad)
 	}
 
 	// StackSize - stack size in KB
@@ -1666,6 +2583,71 @@ func (sf *SubmitFile) setSimpleJobExprs(ad *classad.ClassAd) error {
 	return nil
 }
 
+// setDAGAttributes sets the attributes that tie a job to a DAG node, as
+// DAGMan does, so workflows managed outside DAGMan can tag their jobs too and
+// find them later with a DAGManJobId constraint
+func (sf *SubmitFile) setDAGAttributes(ad *classad.ClassAd) error {
+	// dagman_job_id - cluster of the DAGMan (or workflow manager) job
+	if jobID, ok := sf.cfg.Get("dagman_job_id"); ok {
+		intID, err := strconv.Atoi(strings.TrimSpace(jobID))
+		if err != nil || intID < 0 {
+			return fmt.Errorf("invalid dagman_job_id %q: must be a non-negative integer", jobID)
+		}
+		_ = ad.Set("DAGManJobId", intID)
+	}
+
+	// dag_node_name - name of the node the job runs
+	if nodeName, ok := sf.cfg.Get("dag_node_name"); ok {
+		_ = ad.Set("DAGNodeName", nodeName)
+	}
+
+	// dag_parent_node_names - comma-separated names of the node's parents
+	if parents, ok := sf.cfg.Get("dag_parent_node_names"); ok {
+		_ = ad.Set("DAGParentNodeNames", strings.Join(parseFileList(parents), ","))
+	}
+
+	// dagman_log - log of all the workflow's node jobs
+	if nodesLog, ok := sf.cfg.Get("dagman_log"); ok {
+		_ = ad.Set("DAGManNodesLog", nodesLog)
+	}
+
+	return nil
+}
+
+// setDefaultBatchName derives a JobBatchName from the executable basename and
+// cluster id (e.g. "analyze.sh.123") when batch_name is not set, so condor_q
+// -batch groups the procs of a cluster together. Including the cluster id keeps
+// separate submissions of the same executable from merging into one batch.
+// The name is fixed by the first proc of a cluster and reused for the rest.
+func (sf *SubmitFile) setDefaultBatchName(ad *classad.ClassAd) {
+	clusterID, ok := ad.EvaluateAttrInt("ClusterId")
+	if !ok {
+		return
+	}
+	cluster := int(clusterID)
+
+	name, ok := sf.batchNames[cluster]
+	if !ok {
+		cmd, _ := ad.EvaluateAttrString("Cmd")
+		if isURL(cmd) {
+			// Name URL executables by their path, without query parameters
+			if u, err := url.Parse(cmd); err == nil {
+				cmd = u.Path
+			}
+		}
+		base := filepath.Base(cmd)
+		if cmd == "" || base == "." || base == "/" {
+			return
+		}
+		name = fmt.Sprintf("%s.%d", base, cluster)
+		if sf.batchNames == nil {
+			sf.batchNames = make(map[int]string)
+		}
+		sf.batchNames[cluster] = name
+	}
+	_ = ad.Set("JobBatchName", name)
+}
+
 // setExtendedJobExprs sets extended job expression attributes
 // These are less common attributes that provide additional job control
 //
@@ -1701,13 +2683,6 @@ func (sf *SubmitFile) setExtendedJobExprs(ad *classad.ClassAd) error {
 		_ = ad.Set("WantGracefulRemoval", parseBool(wantGraceful, false))
 	}
 
-	// job_max_vacate_time - max time for vacating (already in setJobStatusControl, but keep for completeness)
-	if maxVacate, ok := sf.cfg.Get("job_max_vacate_time"); ok {
-		if intVacate, err := parseInt(maxVacate); err == nil {
-			_ = ad.Set("JobMaxVacateTime", intVacate)
-		}
-	}
-
 	// run_as_owner - run as the submitting user
 	if runAsOwner, ok := sf.cfg.Get("run_as_owner"); ok {
 		_ = ad.Set("RunAsOwner", parseBool(runAsOwner, false))
@@ -1720,13 +2695,25 @@ func (sf *SubmitFile) setExtendedJobExprs(ad *classad.ClassAd) error {
 
 	// JobAdInformationAttrs - attributes to include in job ad information
 	if infoAttrs, ok := sf.cfg.Get("job_ad_information_attrs"); ok {
+		if _, err := parseInformationAttrs(infoAttrs); err != nil {
+			return err
+		}
 		_ = ad.Set("JobAdInformationAttrs", infoAttrs)
 	}
 
-	// want_io_proxy - request I/O proxy
+	// want_io_proxy - request the starter's chirp I/O proxy
 	if wantProxy, ok := sf.cfg.Get("want_io_proxy"); ok {
 		_ = ad.Set("WantIOProxy", parseBool(wantProxy, false))
 	}
+	if sf.wantIOProxy() {
+		// condor_chirp's file access and job ad updates are relayed to the
+		// shadow; enable them unless the submit file already decided
+		for _, attr := range []string{"WantRemoteIO", "WantRemoteUpdates", "WantDelayedUpdates"} {
+			if _, ok := ad.Lookup(attr); !ok {
+				_ = ad.Set(attr, true)
+			}
+		}
+	}
 
 	// job_machine_attrs - machine attributes to record in job ad
 	if machAttrs, ok := sf.cfg.Get("job_machine_attrs"); ok {
@@ -1759,11 +2746,17 @@ func (sf *SubmitFile) setExtendedJobExprs(ad *classad.ClassAd) error {
 		}
 	}
 
-	// checkpoint_exit_code - exit code that indicates checkpoint
+	// checkpoint_exit_code - exit code that indicates checkpoint. The
+	// schedd's checkpoint transforms key off SuccessCheckpointExitCode, and
+	// the starter only transfers the checkpoint when WantFTOnCheckpoint is set.
 	if checkpointCode, ok := sf.cfg.Get("checkpoint_exit_code"); ok {
-		if intCode, err := parseInt(checkpointCode); err == nil {
-			_ = ad.Set("CheckpointExitCode", intCode)
+		intCode, err := parseInt(checkpointCode)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint_exit_code %q: %w", checkpointCode, err)
 		}
+		_ = ad.Set("CheckpointExitCode", intCode)
+		_ = ad.Set("SuccessCheckpointExitCode", intCode)
+		_ = ad.Set("WantFTOnCheckpoint", true)
 	}
 
 	// want_checkpoint - request checkpoint capability
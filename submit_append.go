@@ -0,0 +1,93 @@
+package htcondor
+
+import (
+	"regexp"
+	"strings"
+)
+
+// heredocStart matches the first line of a "name @= tag" multi-line value
+var heredocStart = regexp.MustCompile(`^\s*[A-Za-z_][A-Za-z0-9_.+]*\s*@=\s*(\S*)\s*$`)
+
+// AppendSubmitCommands adds commands to a submit file the way condor_submit's
+// -append (-a) option does: they are inserted immediately before the first
+// queue statement, so they override earlier values of the same commands and
+// apply to every proc. Without a queue statement they are added at the end,
+// which also lets a command such as "queue 5" be appended.
+//
+// This allows a stored submit file to be used as a template and
+// parameterized per submission.
+func AppendSubmitCommands(submitFile string, commands []string) string {
+	return insertSubmitCommands(submitFile, commands, false)
+}
+
+// EnforceSubmitCommands inserts commands immediately before every queue
+// statement of a submit file, or adds them at the end if it has none, so
+// that they override the values the submit file sets for each queue
+// statement, including those set between queue statements.
+func EnforceSubmitCommands(submitFile string, commands []string) string {
+	return insertSubmitCommands(submitFile, commands, true)
+}
+
+// insertSubmitCommands inserts commands before the first queue statement,
+// or before each of them if every is set, or at the end if there is none
+func insertSubmitCommands(submitFile string, commands []string, every bool) string {
+	if len(commands) == 0 {
+		return submitFile
+	}
+	extra := strings.Join(commands, "\n") + "\n"
+
+	var result strings.Builder
+	lines := strings.SplitAfter(submitFile, "\n")
+	offset := 0
+	written := 0 // submitFile[:written] is in result
+	continued := false
+	heredocEnd := ""
+	for _, line := range lines {
+		start := offset
+		offset += len(line)
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case heredocEnd != "":
+			if trimmed == heredocEnd {
+				heredocEnd = ""
+			}
+			continue
+		case continued:
+			continued = strings.HasSuffix(trimmed, `\`)
+			continue
+		}
+		continued = strings.HasSuffix(trimmed, `\`)
+
+		if m := heredocStart.FindStringSubmatch(line); m != nil {
+			heredocEnd = "@" + m[1]
+			continue
+		}
+		if isQueueLine(trimmed) {
+			if !every {
+				return submitFile[:start] + extra + submitFile[start:]
+			}
+			result.WriteString(submitFile[written:start])
+			result.WriteString(extra)
+			written = start
+		}
+	}
+	if result.Len() > 0 {
+		result.WriteString(submitFile[written:])
+		return result.String()
+	}
+
+	if submitFile != "" && !strings.HasSuffix(submitFile, "\n") {
+		submitFile += "\n"
+	}
+	return submitFile + extra
+}
+
+// isQueueLine reports whether a trimmed submit file line is a queue statement
+func isQueueLine(line string) bool {
+	if len(line) < len("queue") || !strings.EqualFold(line[:len("queue")], "queue") {
+		return false
+	}
+	rest := line[len("queue"):]
+	return rest == "" || rest[0] == ' ' || rest[0] == '\t' || rest[0] == '('
+}
@@ -0,0 +1,92 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppendSubmitCommands(t *testing.T) {
+	template := `executable = /bin/analyze
+request_memory = 1024
+description @=end
+queue is not a statement here
+@end
+arguments = a \
+queue
+queue 2
+`
+	submitFile := AppendSubmitCommands(template, []string{"request_memory = 4096", "priority = 7"})
+
+	// The commands go just before the queue statement, not inside the
+	// multi-line value or the continued arguments line
+	want := "arguments = a \\\nqueue\nrequest_memory = 4096\npriority = 7\nqueue 2\n"
+	if !strings.HasSuffix(submitFile, want) {
+		t.Fatalf("Unexpected submit file:\n%s", submitFile)
+	}
+
+	sf, err := ParseSubmitFile(strings.NewReader(submitFile))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if len(result.ProcAds) != 2 {
+		t.Fatalf("Expected 2 procs, got %d", len(result.ProcAds))
+	}
+	for i, ad := range result.ProcAds {
+		if mem, ok := ad.EvaluateAttrInt("RequestMemory"); !ok || mem != 4096 {
+			t.Errorf("Proc %d: expected appended RequestMemory 4096 to override the template, got %d", i, mem)
+		}
+		if prio, ok := ad.EvaluateAttrInt("JobPrio"); !ok || prio != 7 {
+			t.Errorf("Proc %d: expected JobPrio 7, got %d", i, prio)
+		}
+	}
+
+	// Without a queue statement the commands go at the end, so one can be appended
+	submitFile = AppendSubmitCommands("executable = /bin/true", []string{"queue 3"})
+	if submitFile != "executable = /bin/true\nqueue 3\n" {
+		t.Errorf("Unexpected submit file without a queue statement: %q", submitFile)
+	}
+
+	if got := AppendSubmitCommands(template, nil); got != template {
+		t.Error("Expected no commands to leave the submit file unchanged")
+	}
+}
+
+func TestEnforceSubmitCommands(t *testing.T) {
+	submitFile := EnforceSubmitCommands(`executable = /bin/analyze
+accounting_group = physics
+queue
+accounting_group = chemistry
+queue 2
+`, []string{"accounting_group = gpu"})
+
+	want := "executable = /bin/analyze\naccounting_group = physics\naccounting_group = gpu\nqueue\n" +
+		"accounting_group = chemistry\naccounting_group = gpu\nqueue 2\n"
+	if submitFile != want {
+		t.Fatalf("Unexpected submit file:\n%s", submitFile)
+	}
+
+	sf, err := ParseSubmitFile(strings.NewReader(submitFile))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if len(result.ProcAds) != 3 {
+		t.Fatalf("Expected 3 procs, got %d", len(result.ProcAds))
+	}
+	for i, ad := range result.ProcAds {
+		if group, _ := ad.EvaluateAttrString("AccountingGroup"); group != "gpu" {
+			t.Errorf("Proc %d: expected enforced AccountingGroup gpu, got %q", i, group)
+		}
+	}
+
+	if got := EnforceSubmitCommands("executable = /bin/true", []string{"queue 3"}); got != "executable = /bin/true\nqueue 3\n" {
+		t.Errorf("Unexpected submit file without a queue statement: %q", got)
+	}
+}
@@ -0,0 +1,91 @@
+package htcondor
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// OutputCollisionPolicy controls what Submit does when several procs would
+// write their output or error to the same file
+type OutputCollisionPolicy int
+
+const (
+	// OutputCollisionWarn reports the collision in SubmitResult.Warnings (default)
+	OutputCollisionWarn OutputCollisionPolicy = iota
+	// OutputCollisionError fails the submission
+	OutputCollisionError
+	// OutputCollisionIgnore skips the check
+	OutputCollisionIgnore
+)
+
+// outputCollisionAttrs are the per-proc output files that are clobbered when shared.
+// The job event log (UserLog) is deliberately not checked: it is designed to be
+// shared by every proc in a cluster.
+var outputCollisionAttrs = []struct {
+	attr    string
+	command string
+}{
+	{"Out", "output"},
+	{"Err", "error"},
+}
+
+// findOutputCollisions returns a message for each output or error path that is
+// used by more than one proc, e.g. because it does not contain $(Process) or $(Item)
+func findOutputCollisions(procAds []*classad.ClassAd) []string {
+	if len(procAds) < 2 {
+		return nil
+	}
+
+	var messages []string
+	for _, check := range outputCollisionAttrs {
+		firstProc := make(map[string]int64)
+		sharedBy := make(map[string]int)
+		var order []string
+
+		for _, ad := range procAds {
+			file, ok := ad.EvaluateAttrString(check.attr)
+			if !ok || file == "" || file == "/dev/null" {
+				continue
+			}
+			// Relative paths are resolved against each proc's initialdir
+			if iwd, ok := ad.EvaluateAttrString("Iwd"); ok && iwd != "" && !path.IsAbs(file) {
+				file = path.Join(iwd, file)
+			}
+
+			if _, seen := firstProc[file]; !seen {
+				proc, _ := ad.EvaluateAttrInt("ProcId")
+				firstProc[file] = proc
+				order = append(order, file)
+			}
+			sharedBy[file]++
+		}
+
+		for _, file := range order {
+			if sharedBy[file] > 1 {
+				messages = append(messages, fmt.Sprintf(
+					"%s file %q is shared by %d procs and will be overwritten; include $(Process) or $(Item) in the %s path",
+					check.command, file, sharedBy[file], check.command))
+			}
+		}
+	}
+	return messages
+}
+
+// checkOutputCollisions applies the submit file's collision policy to the procs being submitted
+func (sf *SubmitFile) checkOutputCollisions(result *SubmitResult) error {
+	if sf.opts.OutputCollisions == OutputCollisionIgnore {
+		return nil
+	}
+	messages := findOutputCollisions(result.ProcAds)
+	if len(messages) == 0 {
+		return nil
+	}
+	if sf.opts.OutputCollisions == OutputCollisionError {
+		return fmt.Errorf("output files collide: %s", strings.Join(messages, "; "))
+	}
+	result.Warnings = append(result.Warnings, messages...)
+	return nil
+}
@@ -0,0 +1,206 @@
+package htcondor
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// ErrInvalidCron is returned (wrapped) for a cron_* field HTCondor would
+// reject, and by NextCronRun for a schedule that never matches
+var ErrInvalidCron = errors.New("invalid cron schedule")
+
+// maxCronDays bounds the search for a cron job's next run: within eight
+// years every valid date, including February 29, comes around
+const maxCronDays = 8 * 366
+
+// cronField is one cron_* submit command, with its job attribute and the
+// range of its values
+type cronField struct {
+	key         string
+	attr        string
+	first, last int
+}
+
+// cronFields are the fields of a cron schedule; in day_of_week both 0 and 7
+// are Sunday
+var cronFields = []cronField{
+	{"cron_minute", "CronMinute", 0, 59},
+	{"cron_hour", "CronHour", 0, 23},
+	{"cron_day_of_month", "CronDayOfMonth", 1, 31},
+	{"cron_month", "CronMonth", 1, 12},
+	{"cron_day_of_week", "CronDayOfWeek", 0, 7},
+}
+
+// setCronFields validates the cron_* commands and sets them on the ad as
+// strings, as condor_submit does
+func (sf *SubmitFile) setCronFields(ad *classad.ClassAd) error {
+	for _, field := range cronFields {
+		value, ok := sf.cfg.Get(field.key)
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, err := parseCronField(value, field.first, field.last); err != nil {
+			return fmt.Errorf("invalid %s %q: %w", field.key, value, err)
+		}
+		_ = ad.Set(field.attr, value)
+	}
+	return nil
+}
+
+// parseCronField parses a cron field (a comma-separated list of *, values,
+// ranges such as 1-5, and steps such as */15 or 0-30/10) into the set of
+// values it matches, as a bit mask
+func parseCronField(value string, first, last int) (uint64, error) {
+	var mask uint64
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%w: invalid step %q", ErrInvalidCron, stepPart)
+			}
+			step = n
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*":
+			lo, hi = first, last
+		case strings.Contains(rangePart, "-"):
+			loStr, hiStr, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseCronValue(loStr, first, last); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(hiStr, first, last); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%w: range %q is backwards", ErrInvalidCron, rangePart)
+			}
+		default:
+			var err error
+			if lo, err = parseCronValue(rangePart, first, last); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if hasStep {
+				// A start with a step runs to the end of the range
+				hi = last
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+// parseCronValue parses a single value of a cron field
+func parseCronValue(s string, first, last int) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidCron, s)
+	}
+	if n < first || n > last {
+		return 0, fmt.Errorf("%w: %d is out of range %d-%d", ErrInvalidCron, n, first, last)
+	}
+	return n, nil
+}
+
+// NextCronRun returns the next time after after at which the cron schedule
+// of a job ad (CronMinute, CronHour, CronDayOfMonth, CronMonth and
+// CronDayOfWeek; unset fields match anything) has the job run, in after's
+// time zone. As in cron, when both the day of the month and the day of the
+// week are restricted, a day matching either runs the job. The schedd
+// computes the job's DeferralTime the same way.
+func NextCronRun(ad *classad.ClassAd, after time.Time) (time.Time, error) {
+	var masks [5]uint64
+	restricted := false
+	for i, field := range cronFields {
+		value, ok := ad.EvaluateAttrString(field.attr)
+		if !ok {
+			if n, isInt := ad.EvaluateAttrInt(field.attr); isInt {
+				value, ok = strconv.FormatInt(n, 10), true
+			}
+		}
+		if !ok {
+			value = "*"
+		} else {
+			restricted = true
+		}
+		mask, err := parseCronField(value, field.first, field.last)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s %q: %w", field.attr, value, err)
+		}
+		masks[i] = mask
+	}
+	if !restricted {
+		return time.Time{}, errors.New("job ad has no cron schedule")
+	}
+	minutes, hours, daysOfMonth, months, daysOfWeek := masks[0], masks[1], masks[2], masks[3], masks[4]
+	if daysOfWeek&(1<<7) != 0 {
+		daysOfWeek |= 1 // 7 is Sunday too
+	}
+	allDaysOfMonth, _ := parseCronField("*", 1, 31)
+	allDaysOfWeek, _ := parseCronField("*", 0, 6)
+	dayOfMonthAny := daysOfMonth == allDaysOfMonth
+	dayOfWeekAny := daysOfWeek&allDaysOfWeek == allDaysOfWeek
+
+	start := after.Truncate(time.Minute).Add(time.Minute)
+	loc := after.Location()
+	for day := 0; day < maxCronDays; day++ {
+		date := time.Date(start.Year(), start.Month(), start.Day()+day, 0, 0, 0, 0, loc)
+		if months&(1<<uint(date.Month())) == 0 {
+			continue
+		}
+		domMatch := daysOfMonth&(1<<uint(date.Day())) != 0
+		dowMatch := daysOfWeek&(1<<uint(date.Weekday())) != 0
+		switch {
+		case dayOfMonthAny && dayOfWeekAny:
+		case dayOfMonthAny:
+			if !dowMatch {
+				continue
+			}
+		case dayOfWeekAny:
+			if !domMatch {
+				continue
+			}
+		default:
+			if !domMatch && !dowMatch {
+				continue
+			}
+		}
+
+		firstHour, firstMinute := 0, 0
+		if day == 0 {
+			firstHour, firstMinute = start.Hour(), start.Minute()
+		}
+		for hour := firstHour; hour < 24; hour++ {
+			if hours&(1<<uint(hour)) == 0 {
+				continue
+			}
+			minute := 0
+			if hour == firstHour {
+				minute = firstMinute
+			}
+			for ; minute < 60; minute++ {
+				if minutes&(1<<uint(minute)) != 0 {
+					return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, loc), nil
+				}
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: the schedule never matches", ErrInvalidCron)
+}
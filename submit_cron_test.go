@@ -0,0 +1,111 @@
+package htcondor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestCronFieldValidation(t *testing.T) {
+	submit := `
+executable = /bin/daily_job
+cron_minute = 0,30
+cron_hour = 1-5/2
+cron_day_of_month = *
+cron_month = */3
+cron_day_of_week = 1-5, 7
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+	if err != nil {
+		t.Fatalf("Failed to make job ad: %v", err)
+	}
+	want := map[string]string{
+		"CronMinute":     "0,30",
+		"CronHour":       "1-5/2",
+		"CronDayOfMonth": "*",
+		"CronMonth":      "*/3",
+		"CronDayOfWeek":  "1-5, 7",
+	}
+	for attr, value := range want {
+		if got, _ := ad.EvaluateAttrString(attr); got != value {
+			t.Errorf("%s = %q, expected %q", attr, got, value)
+		}
+	}
+
+	for _, invalid := range []string{
+		"cron_minute = 60",
+		"cron_hour = 5-1",
+		"cron_day_of_month = 0",
+		"cron_month = */0",
+		"cron_day_of_week = mon",
+		"cron_minute = 1,,2",
+	} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\n" + invalid + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		key, _, _ := strings.Cut(invalid, " ")
+		_, err = sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+		if !errors.Is(err, ErrInvalidCron) || !strings.Contains(err.Error(), key) {
+			t.Errorf("%s: expected an invalid %s error, got %v", invalid, key, err)
+		}
+	}
+}
+
+func TestNextCronRun(t *testing.T) {
+	// Tuesday, 5 March 2024
+	after := time.Date(2024, 3, 5, 10, 11, 12, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		fields map[string]string
+		want   time.Time
+	}{
+		{"every 15 minutes", map[string]string{"CronMinute": "*/15"}, time.Date(2024, 3, 5, 10, 15, 0, 0, time.UTC)},
+		{"daily at 02:00", map[string]string{"CronMinute": "0", "CronHour": "2"}, time.Date(2024, 3, 6, 2, 0, 0, 0, time.UTC)},
+		{"later this hour", map[string]string{"CronMinute": "5,45", "CronHour": "10"}, time.Date(2024, 3, 5, 10, 45, 0, 0, time.UTC)},
+		{"sundays as 7", map[string]string{"CronMinute": "0", "CronHour": "0", "CronDayOfWeek": "7"}, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"day of month or week", map[string]string{"CronMinute": "0", "CronHour": "0", "CronDayOfMonth": "20", "CronDayOfWeek": "5"}, time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"leap day", map[string]string{"CronMinute": "0", "CronHour": "0", "CronDayOfMonth": "29", "CronMonth": "2"}, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ad := classad.New()
+			for attr, value := range tt.fields {
+				_ = ad.Set(attr, value)
+			}
+			got, err := NextCronRun(ad, after)
+			if err != nil {
+				t.Fatalf("NextCronRun failed: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextCronRun = %v, expected %v", got, tt.want)
+			}
+		})
+	}
+
+	// A run exactly at after is not the next one
+	ad := classad.New()
+	_ = ad.Set("CronMinute", 11)
+	if got, _ := NextCronRun(ad, after.Truncate(time.Minute)); !got.Equal(time.Date(2024, 3, 5, 11, 11, 0, 0, time.UTC)) {
+		t.Errorf("NextCronRun = %v, expected 11:11", got)
+	}
+
+	ad = classad.New()
+	_ = ad.Set("CronDayOfMonth", "31")
+	_ = ad.Set("CronMonth", "2")
+	if _, err := NextCronRun(ad, after); !errors.Is(err, ErrInvalidCron) {
+		t.Errorf("Expected ErrInvalidCron for February 31, got %v", err)
+	}
+	if _, err := NextCronRun(classad.New(), after); err == nil {
+		t.Error("Expected an error for a job without a cron schedule")
+	}
+}
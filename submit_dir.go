@@ -0,0 +1,53 @@
+package htcondor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SubmitDirExt is the file extension ParseSubmitDir looks for
+const SubmitDirExt = ".sub"
+
+// ParseSubmitDir parses every *.sub file in dir, such as the node submit files
+// of a workflow, and returns them keyed by file name (e.g. "stage1.sub").
+// Relative include paths in the files are resolved against dir.
+//
+// A file that fails to parse does not stop the others from being read: the
+// returned map holds every file that parsed, and the error joins one error per
+// failed file, each naming the file.
+func ParseSubmitDir(dir string) (map[string]*SubmitFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read submit directory: %w", err)
+	}
+
+	files := make(map[string]*SubmitFile)
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), SubmitDirExt) {
+			continue
+		}
+		sf, err := parseSubmitPath(filepath.Join(dir, entry.Name()), &SubmitFileOptions{IncludeDir: dir})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+		files[entry.Name()] = sf
+	}
+
+	return files, errors.Join(errs...)
+}
+
+// parseSubmitPath parses the submit file at path
+func parseSubmitPath(path string, opts *SubmitFileOptions) (*SubmitFile, error) {
+	//nolint:gosec // G304: path comes from the caller-chosen submit directory
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return ParseSubmitFileWithOptions(f, opts)
+}
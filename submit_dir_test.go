@@ -0,0 +1,52 @@
+package htcondor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseSubmitDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"common.inc":  "request_memory = 2048\n",
+		"stage1.sub":  "executable = /bin/prepare\ninclude : common.inc\nqueue\n",
+		"stage2.sub":  "executable = /bin/analyze\nqueue 4\n",
+		"broken.sub":  "executable = /bin/broken\nif true\nqueue\n",
+		"notes.txt":   "not a submit file\n",
+		"stage3.sub~": "editor backup\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	parsed, err := ParseSubmitDir(dir)
+	if err == nil {
+		t.Fatal("Expected an error for the malformed submit file")
+	}
+	if !strings.Contains(err.Error(), "broken.sub") {
+		t.Errorf("Expected error to name broken.sub, got %v", err)
+	}
+
+	// The good files are still returned
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 parsed submit files, got %d: %v", len(parsed), parsed)
+	}
+	stage1, ok := parsed["stage1.sub"]
+	if !ok {
+		t.Fatal("Expected stage1.sub to be parsed")
+	}
+	result, err := stage1.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit of stage1.sub failed: %v", err)
+	}
+	if mem, _ := result.ProcAds[0].EvaluateAttrInt("RequestMemory"); mem != 2048 {
+		t.Errorf("Expected RequestMemory 2048 from the included file, got %d", mem)
+	}
+	if stage2, ok := parsed["stage2.sub"]; !ok || stage2.queueCount() != 4 {
+		t.Errorf("Expected stage2.sub with 4 procs, got %v", stage2)
+	}
+}
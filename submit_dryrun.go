@@ -0,0 +1,51 @@
+package htcondor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// DryRun builds the job ads Submit would create for clusterID and returns
+// them as text, like condor_submit -dry-run, without submitting anything.
+// Each ad is written in the long ClassAd format, one attribute per line,
+// and followed by a blank line. When the submit file asks for late
+// materialization (max_materialize or max_idle), the cluster ad the schedd
+// materializes procs from comes first.
+func (sf *SubmitFile) DryRun(clusterID int) (string, error) {
+	var b strings.Builder
+	if sf.wantsLateMaterialization() {
+		clusterAd, err := sf.MakeClusterAd(clusterID)
+		if err != nil {
+			return "", fmt.Errorf("failed to create cluster ad: %w", err)
+		}
+		writeLongAd(&b, clusterAd)
+	}
+
+	result, err := sf.Submit(clusterID)
+	if err != nil {
+		return "", err
+	}
+	for _, ad := range result.ProcAds {
+		writeLongAd(&b, ad)
+	}
+	return b.String(), nil
+}
+
+// wantsLateMaterialization reports whether the submit file sets any of the
+// commands that make condor_submit submit a job factory
+func (sf *SubmitFile) wantsLateMaterialization() bool {
+	_, ok := sf.submitValue("max_materialize", "max_idle", "materialize_max_idle")
+	return ok
+}
+
+// writeLongAd writes ad in the long format of condor_q -long, ending it
+// with a blank line
+func writeLongAd(b *strings.Builder, ad *classad.ClassAd) {
+	if text := ad.MarshalOld(); text != "" {
+		b.WriteString(text)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,109 @@
+package htcondor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestSubmitDryRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		extra     string
+		wantProcs []int64
+	}{
+		{"proc ads", "", []int64{0, 1}},
+		{"late materialization", "max_materialize = 1\n", []int64{-1, 0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader(`
+universe = vanilla
+executable = /bin/echo
+arguments = $(Process)
+` + tt.extra + `queue 2
+`))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+
+			text, err := sf.DryRun(42)
+			if err != nil {
+				t.Fatalf("DryRun failed: %v", err)
+			}
+			if !strings.HasSuffix(text, "\n\n") {
+				t.Errorf("Expected the last ad to end with a blank line, got %q", text)
+			}
+
+			blocks := strings.Split(strings.TrimSuffix(text, "\n\n"), "\n\n")
+			if len(blocks) != len(tt.wantProcs) {
+				t.Fatalf("Expected %d ads, got %d:\n%s", len(tt.wantProcs), len(blocks), text)
+			}
+			for i, block := range blocks {
+				ad, err := classad.ParseOld(block)
+				if err != nil {
+					t.Fatalf("Ad %d is not in long format: %v\n%s", i, err, block)
+				}
+				if cluster, _ := ad.EvaluateAttrInt("ClusterId"); cluster != 42 {
+					t.Errorf("Ad %d: expected ClusterId 42, got %d", i, cluster)
+				}
+				if proc, _ := ad.EvaluateAttrInt("ProcId"); proc != tt.wantProcs[i] {
+					t.Errorf("Ad %d: expected ProcId %d, got %d", i, tt.wantProcs[i], proc)
+				}
+				if cmd, _ := ad.EvaluateAttrString("Cmd"); cmd != "/bin/echo" {
+					t.Errorf("Ad %d: expected Cmd /bin/echo, got %q", i, cmd)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitDryRunThenSubmit(t *testing.T) {
+	tmpDir := t.TempDir()
+	itemFile := filepath.Join(tmpDir, "items.txt")
+	if err := os.WriteFile(itemFile, []byte("a\nb\nc\n"), 0600); err != nil {
+		t.Fatalf("Failed to write item file: %v", err)
+	}
+	for _, name := range []string{"in1.txt", "in2.txt"} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), nil, 0600); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		queue     string
+		wantProcs int
+	}{
+		{"simple", "queue 2", 2},
+		{"list", "queue name in (x, y, z)", 3},
+		{"file", `queue item from "` + itemFile + `"`, 3},
+		{"matching", `queue 2 matching "` + filepath.Join(tmpDir, "in*.txt") + `"`, 4},
+		{"several statements", "queue 2\nqueue name in (x, y)", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\n" + tt.queue + "\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			if _, err := sf.DryRun(1); err != nil {
+				t.Fatalf("DryRun failed: %v", err)
+			}
+
+			// The preview does not use up the queue items
+			result, err := sf.Submit(1)
+			if err != nil {
+				t.Fatalf("Submit failed: %v", err)
+			}
+			if result.NumProcs != tt.wantProcs || len(result.ProcAds) != tt.wantProcs {
+				t.Errorf("Expected %d procs after DryRun, got %d", tt.wantProcs, result.NumProcs)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package htcondor
 import (
 	"strings"
 	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
 )
 
 func TestSignalHandling(t *testing.T) {
@@ -189,6 +191,53 @@ queue
 	// Extended job expression attributes should be set
 }
 
+func TestWantIOProxy(t *testing.T) {
+	makeAd := func(t *testing.T, extra string) *classad.ClassAd {
+		t.Helper()
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/chirper\n" + extra + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, nil)
+		if err != nil {
+			t.Fatalf("Failed to create job ad: %v", err)
+		}
+		return ad
+	}
+
+	ad := makeAd(t, "want_io_proxy = true")
+	for _, attr := range []string{"WantIOProxy", "WantRemoteIO", "WantRemoteUpdates", "WantDelayedUpdates"} {
+		if value, ok := ad.EvaluateAttrBool(attr); !ok || !value {
+			t.Errorf("Expected %s = true", attr)
+		}
+	}
+	if reqExpr, ok := ad.Lookup("Requirements"); !ok || !strings.Contains(reqExpr.String(), "TARGET.HasIOProxy") {
+		t.Errorf("Expected Requirements to check TARGET.HasIOProxy, got %v", reqExpr)
+	}
+
+	// The submit file's own choices are kept
+	ad = makeAd(t, "want_io_proxy = true\nwant_remote_io = false\n+WantDelayedUpdates = false")
+	if value, _ := ad.EvaluateAttrBool("WantRemoteIO"); value {
+		t.Error("Expected want_remote_io = false to be kept")
+	}
+	if value, _ := ad.EvaluateAttrBool("WantDelayedUpdates"); value {
+		t.Error("Expected +WantDelayedUpdates = false to be kept")
+	}
+
+	ad = makeAd(t, "want_io_proxy = false")
+	if value, ok := ad.EvaluateAttrBool("WantIOProxy"); !ok || value {
+		t.Error("Expected WantIOProxy = false")
+	}
+	for _, attr := range []string{"WantRemoteUpdates", "WantDelayedUpdates"} {
+		if _, ok := ad.Lookup(attr); ok {
+			t.Errorf("Expected no %s without chirp", attr)
+		}
+	}
+	if reqExpr, ok := ad.Lookup("Requirements"); ok && strings.Contains(reqExpr.String(), "HasIOProxy") {
+		t.Errorf("Expected no HasIOProxy requirement without chirp, got %s", reqExpr.String())
+	}
+}
+
 func TestJavaKeystoreParameters(t *testing.T) {
 	submit := `
 universe = java
@@ -429,8 +478,8 @@ queue 3
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(3000)
@@ -492,3 +541,62 @@ queue
 
 	// All features should work together
 }
+
+func TestFlockingAttributes(t *testing.T) {
+	submit := `
+executable = /bin/job
+want_flocking = true
+flock_to = cm.pool-a.example.org, cm.pool-b.example.org:9618, <192.168.1.10:9618?sock=collector>
+queue
+`
+
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+
+	ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	if want, ok := ad.EvaluateAttrBool("WantFlocking"); !ok || !want {
+		t.Errorf("Expected WantFlocking = true, got %v (ok=%v)", want, ok)
+	}
+	expected := "cm.pool-a.example.org,cm.pool-b.example.org:9618,<192.168.1.10:9618?sock=collector>"
+	if flockTo, _ := ad.EvaluateAttrString("FlockTo"); flockTo != expected {
+		t.Errorf("Expected FlockTo %q, got %q", expected, flockTo)
+	}
+}
+
+func TestFlockToValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   string
+		wantErr bool
+	}{
+		{"host names", "flock_to = cm1.example.org, cm2", false},
+		{"ipv6 with port", "flock_to = [2001:db8::1]:9618", false},
+		{"bad characters", "flock_to = cm1.example.org, pool/a", true},
+		{"bad port", "flock_to = cm1.example.org:99999", true},
+		{"unterminated sinful", "flock_to = <cm1.example.org:9618", true},
+		{"sinful without port", "flock_to = <cm1.example.org>", true},
+		{"empty", "flock_to = ,", true},
+		{"flocking disabled", "want_flocking = false\nflock_to = cm1.example.org", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/job\n" + tt.lines + "\nqueue\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			_, err = sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+			if tt.wantErr && err == nil {
+				t.Error("Expected an error")
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
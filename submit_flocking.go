@@ -0,0 +1,115 @@
+package htcondor
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// submitValue returns the first of keys set in the submit file. Values of
+// custom attribute keys (+Attr, MY.Attr) are ClassAd literals, so string
+// quotes are removed.
+func (sf *SubmitFile) submitValue(keys ...string) (string, bool) {
+	for _, key := range keys {
+		value, ok := sf.cfg.Get(key)
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(key, "+") || strings.HasPrefix(key, "MY.") {
+			if unquoted, ok, err := parseStringLiteral(value); err == nil && ok {
+				value = unquoted
+			}
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// setFlocking sets the flocking attributes: want_flocking (WantFlocking) and
+// flock_to (FlockTo, a comma-separated list of pools the job may flock to).
+// The +WantFlocking/+FlockTo custom attribute forms are accepted as well.
+func (sf *SubmitFile) setFlocking(ad *classad.ClassAd) error {
+	wantFlocking, haveWant := sf.submitValue("want_flocking", "+WantFlocking", "MY.WantFlocking")
+	if haveWant {
+		_ = ad.Set("WantFlocking", parseBool(wantFlocking, false))
+	}
+
+	flockTo, ok := sf.submitValue("flock_to", "+FlockTo", "MY.FlockTo")
+	if !ok {
+		return nil
+	}
+	pools, err := parseFlockTo(flockTo)
+	if err != nil {
+		return err
+	}
+	if haveWant && !parseBool(wantFlocking, false) {
+		return fmt.Errorf("flock_to is set but want_flocking is false")
+	}
+	_ = ad.Set("FlockTo", strings.Join(pools, ","))
+	return nil
+}
+
+// parseFlockTo splits a flock_to list and checks that each entry is a pool
+// address: a collector host name, host:port, or sinful string
+func parseFlockTo(value string) ([]string, error) {
+	pools := parseFileList(value)
+	if len(pools) == 0 {
+		return nil, fmt.Errorf("flock_to is empty")
+	}
+	for _, pool := range pools {
+		if !isPoolAddress(pool) {
+			return nil, fmt.Errorf("invalid pool address %q in flock_to", pool)
+		}
+	}
+	return pools, nil
+}
+
+// isPoolAddress reports whether s looks like a collector address
+func isPoolAddress(s string) bool {
+	if strings.HasPrefix(s, "<") {
+		if !strings.HasSuffix(s, ">") {
+			return false
+		}
+		// Sinful strings may carry ?params after host:port
+		hostPort, _, _ := strings.Cut(s[1:len(s)-1], "?")
+		return isHostPort(hostPort, true)
+	}
+	return isHostPort(s, false)
+}
+
+// isHostPort reports whether s is a host name or IP address with an optional
+// (or, if portRequired, mandatory) port
+func isHostPort(s string, portRequired bool) bool {
+	host := s
+	if h, port, err := net.SplitHostPort(s); err == nil {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 0 || n > 65535 {
+			return false
+		}
+		host = h
+	} else if portRequired {
+		return false
+	}
+
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	if host == "" || len(host) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if r != '-' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package htcondor
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// gpuIDPattern matches the device IDs the startd reports in each GPU's
+// property ad (Id) and in AssignedGPUs, e.g. "GPU-c4a646d7" or a full
+// "GPU-c4a646d7-aa14-1dd1-f1b0-57288cda864d" UUID
+var gpuIDPattern = regexp.MustCompile(`^GPU-[0-9A-Fa-f]+(-[0-9A-Fa-f]+)*$`)
+
+// gpuRequirement is the requirements clause HTCondor adds when a job
+// constrains its GPUs: the machine must have enough available devices whose
+// property ads satisfy RequireGpus
+const gpuRequirement = "(countMatches(MY.RequireGpus, TARGET.AvailableGpus) >= RequestGpus)"
+
+// setGPUProperties sets RequireGpus, the constraint every GPU assigned to
+// the job must satisfy, from require_gpus and the GPU hints
+func (sf *SubmitFile) setGPUProperties(ad *classad.ClassAd) error {
+	clauses, err := sf.gpuPropertyClauses()
+	if err != nil || len(clauses) == 0 {
+		return err
+	}
+	expr, err := classad.ParseExpr(strings.Join(clauses, " && "))
+	if err != nil {
+		return fmt.Errorf("failed to parse GPU requirements: %w", err)
+	}
+	_ = ad.Set("RequireGpus", expr)
+	return nil
+}
+
+// gpuPropertyClauses returns the constraints on the job's GPUs, evaluated
+// against each GPU's property ad:
+//   - require_gpus: an arbitrary expression, e.g. DeviceName == "NVIDIA A100"
+//   - gpus_minimum_capability, gpus_maximum_capability: CUDA compute capability
+//   - gpus_minimum_memory: device memory in MB
+//   - assigned_gpus (or +AssignedGPUs): a comma-separated list of device IDs
+//     the job should run on. The startd sets the job's AssignedGPUs itself
+//     when it is matched, so the hint becomes a constraint on the device Id.
+func (sf *SubmitFile) gpuPropertyClauses() ([]string, error) {
+	var clauses []string
+
+	if require, ok := sf.cfg.Get("require_gpus"); ok && strings.TrimSpace(require) != "" {
+		normalized, err := NormalizeExpression(require)
+		if err != nil {
+			return nil, fmt.Errorf("invalid require_gpus expression: %w", err)
+		}
+		clauses = append(clauses, normalized)
+	}
+
+	minCap, haveMin, err := sf.gpuCapability("gpus_minimum_capability")
+	if err != nil {
+		return nil, err
+	}
+	maxCap, haveMax, err := sf.gpuCapability("gpus_maximum_capability")
+	if err != nil {
+		return nil, err
+	}
+	if haveMin && haveMax && minCap > maxCap {
+		return nil, fmt.Errorf("gpus_minimum_capability %g is greater than gpus_maximum_capability %g", minCap, maxCap)
+	}
+	if haveMin {
+		clauses = append(clauses, "(Capability >= "+strconv.FormatFloat(minCap, 'f', -1, 64)+")")
+	}
+	if haveMax {
+		clauses = append(clauses, "(Capability <= "+strconv.FormatFloat(maxCap, 'f', -1, 64)+")")
+	}
+
+	if memory, ok := sf.cfg.Get("gpus_minimum_memory"); ok {
+		mb, err := strconv.Atoi(strings.TrimSpace(memory))
+		if err != nil || mb <= 0 {
+			return nil, fmt.Errorf("invalid gpus_minimum_memory %q: must be a positive number of MB", memory)
+		}
+		clauses = append(clauses, fmt.Sprintf("(GlobalMemoryMb >= %d)", mb))
+	}
+
+	if assigned, ok := sf.submitValue("assigned_gpus", "+AssignedGPUs", "MY.AssignedGPUs"); ok {
+		ids, err := sf.parseAssignedGPUs(assigned)
+		if err != nil {
+			return nil, err
+		}
+		matches := make([]string, len(ids))
+		for i, id := range ids {
+			matches[i] = "Id == " + classad.Quote(id)
+		}
+		clauses = append(clauses, "("+strings.Join(matches, " || ")+")")
+	}
+
+	return clauses, nil
+}
+
+// gpuCapability parses a compute capability key such as "7.5"
+func (sf *SubmitFile) gpuCapability(key string) (float64, bool, error) {
+	value, ok := sf.cfg.Get(key)
+	if !ok {
+		return 0, false, nil
+	}
+	capability, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || capability <= 0 {
+		return 0, false, fmt.Errorf("invalid %s %q: must be a compute capability such as 7.5", key, value)
+	}
+	return capability, true, nil
+}
+
+// parseAssignedGPUs splits an assigned_gpus hint and checks that each entry
+// is a device ID and that there are enough of them for request_gpus
+func (sf *SubmitFile) parseAssignedGPUs(value string) ([]string, error) {
+	ids := parseFileList(value)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("assigned_gpus is empty")
+	}
+	for _, id := range ids {
+		if !gpuIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid GPU device ID %q in assigned_gpus: expected GPU-<hex>", id)
+		}
+	}
+	if reqGpus, ok := sf.cfg.Get("request_gpus"); ok {
+		if n, err := strconv.Atoi(strings.TrimSpace(reqGpus)); err == nil && n > len(ids) {
+			return nil, fmt.Errorf("request_gpus is %d but assigned_gpus lists only %d devices", n, len(ids))
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,139 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+func TestSubmitGPUProperties(t *testing.T) {
+	submit := `
+executable = /bin/train
+request_gpus = 2
+require_gpus = DeviceName =!= "Tesla K80"
+gpus_minimum_capability = 7.5
+gpus_maximum_capability = 9.0
+gpus_minimum_memory = 40000
+assigned_gpus = GPU-c4a646d7, GPU-8d1a2f00-aa14-1dd1-f1b0-57288cda864d
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	requireGpus, ok := ad.Lookup("RequireGpus")
+	if !ok {
+		t.Fatal("Expected RequireGpus to be set")
+	}
+	for _, want := range []string{
+		`"Tesla K80"`,
+		"Capability >= 7.5",
+		"Capability <= 9",
+		"GlobalMemoryMb >= 40000",
+		`Id == "GPU-c4a646d7"`,
+		`Id == "GPU-8d1a2f00-aa14-1dd1-f1b0-57288cda864d"`,
+	} {
+		if !strings.Contains(requireGpus.String(), want) {
+			t.Errorf("Expected RequireGpus to contain %q, got %s", want, requireGpus.String())
+		}
+	}
+
+	// RequireGpus selects among a machine's GPU property ads
+	gpu := func(id, name string, capability float64, memory int) *classad.ClassAd {
+		props := classad.New()
+		_ = props.Set("Id", id)
+		_ = props.Set("DeviceName", name)
+		_ = props.Set("Capability", capability)
+		_ = props.Set("GlobalMemoryMb", memory)
+		return props
+	}
+	for _, tc := range []struct {
+		props *classad.ClassAd
+		want  bool
+	}{
+		{gpu("GPU-c4a646d7", "NVIDIA A100-SXM4-80GB", 8.0, 81920), true},
+		{gpu("GPU-c4a646d7", "NVIDIA A100-SXM4-80GB", 8.0, 16384), false},
+		{gpu("GPU-c4a646d7", "Tesla K80", 8.0, 81920), false},
+		{gpu("GPU-0000ffff", "NVIDIA A100-SXM4-80GB", 8.0, 81920), false},
+		{gpu("GPU-c4a646d7", "NVIDIA H200", 9.5, 81920), false},
+	} {
+		matched, _ := tc.props.EvaluateExprWithTarget(requireGpus, nil).BoolValue()
+		if matched != tc.want {
+			name, _ := tc.props.EvaluateAttrString("DeviceName")
+			t.Errorf("RequireGpus matched %s %v, expected %v", name, matched, tc.want)
+		}
+	}
+
+	requirements, _ := ad.Lookup("Requirements")
+	if !strings.Contains(requirements.String(), "countMatches(MY.RequireGpus, TARGET.AvailableGpus) >= RequestGpus") {
+		t.Errorf("Expected Requirements to count matching GPUs, got %s", requirements.String())
+	}
+}
+
+func TestSubmitGPUPropertiesInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines string
+	}{
+		{"bad require_gpus", "request_gpus = 1\nrequire_gpus = Capability >= \n"},
+		{"bad capability", "request_gpus = 1\ngpus_minimum_capability = sm_80\n"},
+		{"inverted capability range", "request_gpus = 1\ngpus_minimum_capability = 8.0\ngpus_maximum_capability = 7.0\n"},
+		{"bad memory", "request_gpus = 1\ngpus_minimum_memory = 16GB\n"},
+		{"bad device ID", "request_gpus = 1\nassigned_gpus = CUDA0\n"},
+		{"too few devices", "request_gpus = 2\nassigned_gpus = GPU-c4a646d7\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/train\n" + tt.lines + "queue\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestSubmitRankPrefersGPUModel(t *testing.T) {
+	submit := `
+executable = /bin/train
+request_gpus = 1
+rank = ifThenElse(TARGET.GPUs_DeviceName == "NVIDIA H100 80GB HBM3", 100, ifThenElse(TARGET.GPUs_DeviceName == "NVIDIA A100-SXM4-80GB", 10, 0))
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	rank, ok := ad.Lookup("Rank")
+	if !ok {
+		t.Fatal("Expected Rank to be set")
+	}
+	machine := func(deviceName string) *classad.ClassAd {
+		m := classad.New()
+		_ = m.Set("GPUs_DeviceName", deviceName)
+		return m
+	}
+	for deviceName, want := range map[string]float64{
+		"NVIDIA H100 80GB HBM3": 100,
+		"NVIDIA A100-SXM4-80GB": 10,
+		"Tesla V100-SXM2-16GB":  0,
+	} {
+		got, err := ad.EvaluateExprWithTarget(rank, machine(deviceName)).NumberValue()
+		if err != nil || got != want {
+			t.Errorf("Rank for %s = %v (err %v), expected %v", deviceName, got, err, want)
+		}
+	}
+}
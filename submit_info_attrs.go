@@ -0,0 +1,111 @@
+package htcondor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// runtimeJobAttrs are job attributes that are commonly listed in
+// job_ad_information_attrs but are set by the schedd, shadow or starter after
+// submission, so they are not yet in the submitted job ad. Keys are lowercase.
+var runtimeJobAttrs = map[string]bool{
+	"cpusprovisioned":          true,
+	"cumulativeslottime":       true,
+	"cumulativesuspensiontime": true,
+	"cumulativetransfertime":   true,
+	"diskprovisioned":          true,
+	"diskusage":                true,
+	"enteredcurrentstatus":     true,
+	"exitbysignal":             true,
+	"exitcode":                 true,
+	"exitsignal":               true,
+	"exitstatus":               true,
+	"holdreason":               true,
+	"holdreasoncode":           true,
+	"holdreasonsubcode":        true,
+	"imagesize":                true,
+	"jobcurrentstartdate":      true,
+	"jobstartdate":             true,
+	"lastholdreason":           true,
+	"lastjobleaserenewal":      true,
+	"lastmatchtime":            true,
+	"lastremotehost":           true,
+	"lastsuspensiontime":       true,
+	"lastvacatetime":           true,
+	"machineattrcpus0":         true,
+	"machineattrname0":         true,
+	"memoryprovisioned":        true,
+	"memoryusage":              true,
+	"numshadowstarts":          true,
+	"qdate":                    true,
+	"releasereason":            true,
+	"remotehost":               true,
+	"remotesyscpu":             true,
+	"remoteusercpu":            true,
+	"remotewallclocktime":      true,
+	"residentsetsize":          true,
+	"startdipaddr":             true,
+	"startdprincipal":          true,
+	"totalsuspensions":         true,
+	"transferinputsizemb":      true,
+	"transferoutputsizemb":     true,
+}
+
+// parseInformationAttrs splits a comma-separated job_ad_information_attrs value
+// and checks that each entry is a valid ClassAd attribute name
+func parseInformationAttrs(value string) ([]string, error) {
+	names := parseFileList(value)
+	for _, name := range names {
+		if !isClassAdIdentifier(name) {
+			return nil, fmt.Errorf("invalid attribute name %q in job_ad_information_attrs", name)
+		}
+	}
+	return names, nil
+}
+
+// isClassAdIdentifier reports whether name is a valid unquoted ClassAd attribute name
+func isClassAdIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// checkInformationAttrs warns about job_ad_information_attrs entries that are
+// neither in the job ad nor set on the job later, which are usually typos
+func (sf *SubmitFile) checkInformationAttrs(result *SubmitResult) {
+	if !sf.opts.WarnUnknownInfoAttrs {
+		return
+	}
+
+	reported := make(map[string]bool)
+	for _, ad := range result.ProcAds {
+		value, ok := ad.EvaluateAttrString("JobAdInformationAttrs")
+		if !ok {
+			continue
+		}
+		// Already validated by MakeJobAd
+		names, _ := parseInformationAttrs(value)
+		present := make(map[string]bool)
+		for _, attr := range ad.GetAttributes() {
+			present[strings.ToLower(attr)] = true
+		}
+		for _, name := range names {
+			key := strings.ToLower(name)
+			if reported[key] || runtimeJobAttrs[key] || present[key] {
+				continue
+			}
+			reported[key] = true
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("job_ad_information_attrs lists %s, which is not a known job attribute", name))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package htcondor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+)
+
+// DefaultJobLeaseDuration is the default SubmitFileOptions.JobLeaseDuration,
+// matching condor_submit
+const DefaultJobLeaseDuration = 40 * time.Minute
+
+// minJobLeaseSeconds is the shortest job lease condor_submit allows;
+// shorter leases are raised to it
+const minJobLeaseSeconds = 20
+
+// universeCanReconnect reports whether jobs of the universe can be
+// reconnected to after their shadow or schedd loses contact with the
+// execute machine
+func universeCanReconnect(universe int) bool {
+	switch universe {
+	case UniverseVanilla, UniverseJava, UniverseVM, UniverseDocker:
+		return true
+	default:
+		return false
+	}
+}
+
+// jobLeaseDuration returns the lease given to jobs that do not set
+// job_lease_duration
+func (sf *SubmitFile) jobLeaseDuration() time.Duration {
+	if sf.opts.JobLeaseDuration == 0 {
+		return DefaultJobLeaseDuration
+	}
+	return sf.opts.JobLeaseDuration
+}
+
+// setJobLease sets JobLeaseDuration from job_lease_duration, as
+// condor_submit does: the number of seconds a job keeps running on its
+// execute machine while out of contact with its shadow, waiting for the
+// schedd to reconnect to it. Jobs in universes that can reconnect get the
+// default lease if they do not set one; 0 asks for no lease, and an
+// expression is evaluated by the schedd.
+func (sf *SubmitFile) setJobLease(ad *classad.ClassAd) error {
+	value, ok := sf.submitValue("job_lease_duration", "JobLeaseDuration")
+	if !ok {
+		if universeCanReconnect(sf.universe) {
+			_ = ad.Set("JobLeaseDuration", max(int64(sf.jobLeaseDuration()/time.Second), minJobLeaseSeconds))
+		}
+		return nil
+	}
+
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		expr, err := classad.ParseExpr(value)
+		if err != nil {
+			return fmt.Errorf("invalid job_lease_duration %q: %w", value, err)
+		}
+		ad.InsertExpr("JobLeaseDuration", expr)
+		return nil
+	}
+	switch {
+	case seconds < 0:
+		return fmt.Errorf("invalid job_lease_duration %q: must not be negative", value)
+	case seconds == 0:
+		return nil
+	case seconds < minJobLeaseSeconds:
+		seconds = minJobLeaseSeconds
+	}
+	_ = ad.Set("JobLeaseDuration", seconds)
+	return nil
+}
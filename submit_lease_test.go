@@ -0,0 +1,63 @@
+package htcondor
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSubmitJobLease(t *testing.T) {
+	tests := []struct {
+		name     string
+		submit   string
+		lease    time.Duration
+		want     string // JobLeaseDuration as written; empty if unset
+		wantFail bool
+	}{
+		{"default", "", 0, "2400", false},
+		{"configured default", "", 2 * time.Hour, "7200", false},
+		{"user value overrides", "job_lease_duration = 600\n", 2 * time.Hour, "600", false},
+		{"zero disables", "job_lease_duration = 0\n", 0, "", false},
+		{"raised to minimum", "job_lease_duration = 5\n", 0, "20", false},
+		{"expression", "job_lease_duration = 2 * 3600\n", 0, "(2 * 3600)", false},
+		{"negative", "job_lease_duration = -1\n", 0, "", true},
+		{"docker universe", "universe = docker\ndocker_image = busybox\n", 0, "2400", false},
+		{"universe cannot reconnect", "universe = scheduler\n", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFileWithOptions(strings.NewReader(tt.submit+"executable = /bin/true\nqueue\n"),
+				&SubmitFileOptions{JobLeaseDuration: tt.lease})
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+			if tt.wantFail {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MakeJobAd failed: %v", err)
+			}
+
+			expr, ok := ad.Lookup("JobLeaseDuration")
+			switch {
+			case tt.want == "" && ok:
+				t.Errorf("Expected no JobLeaseDuration, got %s", expr)
+			case tt.want != "" && !ok:
+				t.Errorf("Expected JobLeaseDuration %s, got none", tt.want)
+			case ok && expr.String() != tt.want:
+				t.Errorf("Expected JobLeaseDuration %s, got %s", tt.want, expr)
+			}
+		})
+	}
+
+	_, err := ParseSubmitFileWithOptions(strings.NewReader("executable = /bin/true\nqueue\n"),
+		&SubmitFileOptions{JobLeaseDuration: -time.Minute})
+	if err == nil {
+		t.Error("Expected a negative JobLeaseDuration option to be rejected")
+	}
+}
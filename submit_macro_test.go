@@ -403,3 +403,109 @@ queue param in (alpha, beta, gamma, delta, epsilon, zeta, eta, theta, iota, kapp
 		t.Errorf("Expected 10 proc ads, got %d", len(result.ProcAds))
 	}
 }
+
+func TestEnvironmentEnvMacro(t *testing.T) {
+	t.Setenv("GOLANG_HTCONDOR_TEST_HOME", "/home/alice")
+
+	submit := `
+executable = /bin/true
+environment = "EXTRA=$ENV(GOLANG_HTCONDOR_TEST_HOME)/bin"
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(100)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	env, ok := result.ProcAds[0].EvaluateAttrString("Environment")
+	if !ok || !strings.Contains(env, "EXTRA=/home/alice/bin") {
+		t.Errorf("Expected Environment to contain EXTRA=/home/alice/bin, got %q", env)
+	}
+}
+
+func TestEnvironmentMacroVersusEnvPrecedence(t *testing.T) {
+	t.Setenv("GOLANG_HTCONDOR_TEST_HOME", "/home/alice")
+
+	// The same name is both a submit macro and an environment variable:
+	// $(NAME) uses the macro and $ENV(NAME) uses the environment
+	submit := `
+executable = /bin/true
+GOLANG_HTCONDOR_TEST_HOME = /scratch/macro
+environment = "FROM_ENV=$ENV(GOLANG_HTCONDOR_TEST_HOME) FROM_MACRO=$(GOLANG_HTCONDOR_TEST_HOME)"
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(100)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	env, _ := result.ProcAds[0].EvaluateAttrString("Environment")
+	if !strings.Contains(env, "FROM_ENV=/home/alice") {
+		t.Errorf("Expected $ENV() to read the environment, got %q", env)
+	}
+	if !strings.Contains(env, "FROM_MACRO=/scratch/macro") {
+		t.Errorf("Expected $() to read the submit macro, got %q", env)
+	}
+
+	// A macro that is not defined does not fall back to the environment
+	t.Setenv("GOLANG_HTCONDOR_TEST_ONLY_ENV", "/env/only")
+	submit = `
+executable = /bin/true
+environment = "ONLY=$(GOLANG_HTCONDOR_TEST_ONLY_ENV)"
+queue
+`
+	sf, err = ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err = sf.Submit(100)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	env, _ = result.ProcAds[0].EvaluateAttrString("Environment")
+	if strings.Contains(env, "/env/only") {
+		t.Errorf("Expected undefined macro not to read the environment, got %q", env)
+	}
+}
+
+func TestEnvironmentEnvLookupPolicy(t *testing.T) {
+	t.Setenv("GOLANG_HTCONDOR_TEST_SECRET", "hunter2")
+
+	submit := `
+executable = /bin/true
+environment = "ALLOWED=$ENV(ALLOWED_VAR) SECRET=$ENV(GOLANG_HTCONDOR_TEST_SECRET)"
+queue
+`
+	opts := &SubmitFileOptions{
+		EnvLookup: func(name string) (string, bool) {
+			if name == "ALLOWED_VAR" {
+				return "ok", true
+			}
+			return "", false
+		},
+	}
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), opts)
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(100)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	env, _ := result.ProcAds[0].EvaluateAttrString("Environment")
+	if !strings.Contains(env, "ALLOWED=ok") {
+		t.Errorf("Expected allowed variable to expand, got %q", env)
+	}
+	if strings.Contains(env, "hunter2") {
+		t.Errorf("Expected disallowed variable to be hidden, got %q", env)
+	}
+}
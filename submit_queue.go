@@ -41,6 +41,10 @@ func (s *simpleIterator) Count() int {
 	return s.count
 }
 
+func (s *simpleIterator) reset() {
+	s.current = 0
+}
+
 // listIterator implements SubmitIterator for "queue in (list)" statements
 type listIterator struct {
 	varNames []string
@@ -118,14 +122,25 @@ func (l *listIterator) Count() int {
 	return len(l.items) * l.count
 }
 
-// fileIterator implements SubmitIterator for "queue from file" statements
+func (l *listIterator) reset() {
+	l.current, l.itemIdx, l.started = -1, -1, false
+}
+
+// fileIterator implements SubmitIterator for "queue from file" statements.
+// The file is read one line at a time during submission, not at parse time,
+// so a large item file costs nothing until its procs are materialized.
 type fileIterator struct {
 	varNames []string
-	lines    []string
+	filename string
 	count    int
 	current  int
 	lineIdx  int
+	line     string
+	file     *os.File
+	scanner  *bufio.Scanner
 	started  bool
+	done     bool
+	err      error
 }
 
 func newFileIterator(varNames []string, filename string, count int) (*fileIterator, error) {
@@ -136,31 +151,18 @@ func newFileIterator(varNames []string, filename string, count int) (*fileIterat
 		varNames = []string{"ITEM"}
 	}
 
-	// Read lines from file
-	//nolint:gosec // G304: Queue file path comes from user submit description
-	f, err := os.Open(filename)
-	if err != nil {
+	// Check the file now so a bad path is reported at parse time, and pin
+	// relative paths to the current directory since reading is deferred
+	if _, err := os.Stat(filename); err != nil {
 		return nil, fmt.Errorf("failed to open queue file %q: %w", filename, err)
 	}
-	defer func() { _ = f.Close() }()
-
-	var lines []string
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		// Skip empty lines and comments
-		if line != "" && !strings.HasPrefix(line, "#") {
-			lines = append(lines, line)
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading queue file %q: %w", filename, err)
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
 	}
 
 	return &fileIterator{
 		varNames: varNames,
-		lines:    lines,
+		filename: filename,
 		count:    count,
 		lineIdx:  -1, // Start at -1 so first Next() moves to 0
 		current:  -1,
@@ -169,24 +171,76 @@ func newFileIterator(varNames []string, filename string, count int) (*fileIterat
 
 func (f *fileIterator) Next() bool {
 	// For "queue N var from file", we queue N jobs per line
+	if f.done {
+		return false
+	}
 	if !f.started {
 		f.started = true
+		//nolint:gosec // G304: Queue file path comes from user submit description
+		file, err := os.Open(f.filename)
+		if err != nil {
+			f.finish(fmt.Errorf("failed to open queue file %q: %w", f.filename, err))
+			return false
+		}
+		f.file = file
+		f.scanner = bufio.NewScanner(file)
 		f.current = 0
-		f.lineIdx = 0
-		return f.lineIdx < len(f.lines)
+		return f.nextLine()
 	}
 
 	f.current++
 	if f.current >= f.count {
 		f.current = 0
-		f.lineIdx++
+		return f.nextLine()
 	}
+	return true
+}
+
+// nextLine advances to the next item line, skipping blank lines and comments
+func (f *fileIterator) nextLine() bool {
+	for f.scanner.Scan() {
+		line := strings.TrimSpace(f.scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			f.lineIdx++
+			f.line = line
+			return true
+		}
+	}
+	var err error
+	if scanErr := f.scanner.Err(); scanErr != nil {
+		err = fmt.Errorf("error reading queue file %q: %w", f.filename, scanErr)
+	}
+	f.finish(err)
+	return false
+}
+
+// finish ends the iteration, recording err (if any) for Err
+func (f *fileIterator) finish(err error) {
+	f.done = true
+	f.err = err
+	if f.file != nil {
+		_ = f.file.Close()
+		f.file = nil
+	}
+}
 
-	return f.lineIdx < len(f.lines)
+// Err returns the error that ended the iteration early, if any
+func (f *fileIterator) Err() error {
+	return f.err
+}
+
+// reset closes the file, so the next Next reads it again from the start
+func (f *fileIterator) reset() {
+	if f.file != nil {
+		_ = f.file.Close()
+	}
+	f.file, f.scanner, f.line = nil, nil, ""
+	f.current, f.lineIdx = -1, -1
+	f.started, f.done, f.err = false, false, nil
 }
 
 func (f *fileIterator) Values() map[string]string {
-	if f.lineIdx >= len(f.lines) {
+	if !f.started || f.done {
 		return map[string]string{}
 	}
 
@@ -197,7 +251,7 @@ func (f *fileIterator) Values() map[string]string {
 	}
 
 	// Parse line into variable values
-	line := f.lines[f.lineIdx]
+	line := f.line
 
 	if len(f.varNames) == 1 {
 		values[f.varNames[0]] = line
@@ -228,14 +282,47 @@ func (f *fileIterator) Values() map[string]string {
 	return values
 }
 
+// Count reads through the file to count its items. It does not disturb an
+// iteration in progress; errors are reported by Next and Err instead.
 func (f *fileIterator) Count() int {
-	return len(f.lines) * f.count
+	_, n := f.lines(0)
+	return n * f.count
 }
 
-// matchingIterator implements SubmitIterator for "queue matching pattern" statements
+// lines reads through the file, returning the number of item lines and,
+// if there are at most limit of them, the lines themselves
+func (f *fileIterator) lines(limit int) (items []string, n int) {
+	//nolint:gosec // G304: Queue file path comes from user submit description
+	file, err := os.Open(f.filename)
+	if err != nil {
+		return nil, 0
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			n++
+			if n <= limit {
+				items = append(items, line)
+			}
+		}
+	}
+	if n > limit {
+		items = nil
+	}
+	return items, n
+}
+
+// matchingIterator implements SubmitIterator for "queue matching pattern"
+// statements. The pattern is expanded on first use rather than at parse time.
 type matchingIterator struct {
 	varNames []string
+	pattern  string
+	dir      string // Directory relative patterns are expanded in
 	files    []string
+	globbed  bool
 	count    int
 	current  int
 	fileIdx  int
@@ -250,24 +337,51 @@ func newMatchingIterator(varNames []string, pattern string, count int) (*matchin
 		varNames = []string{"ITEM"}
 	}
 
-	// Use glob to match files
-	matches, err := filepath.Glob(pattern)
-	if err != nil {
+	// Validate the pattern now; matching is deferred
+	if _, err := filepath.Match(pattern, ""); err != nil {
 		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
 	}
+	var dir string
+	if !filepath.IsAbs(pattern) {
+		if wd, err := os.Getwd(); err == nil {
+			dir = wd
+		}
+	}
 
 	// If no matches, that's ok - we just won't queue any jobs
 	return &matchingIterator{
 		varNames: varNames,
-		files:    matches,
+		pattern:  pattern,
+		dir:      dir,
 		count:    count,
 		fileIdx:  -1, // Start at -1 so first Next() moves to 0
 		current:  -1,
 	}, nil
 }
 
+// glob expands the pattern once. Relative patterns are expanded in the
+// directory that was current at parse time and yield relative names.
+func (m *matchingIterator) glob() {
+	if m.globbed {
+		return
+	}
+	m.globbed = true
+	if m.dir == "" {
+		m.files, _ = filepath.Glob(m.pattern)
+		return
+	}
+	matches, _ := filepath.Glob(filepath.Join(m.dir, m.pattern))
+	for _, match := range matches {
+		if rel, err := filepath.Rel(m.dir, match); err == nil {
+			match = rel
+		}
+		m.files = append(m.files, match)
+	}
+}
+
 func (m *matchingIterator) Next() bool {
 	// For "queue N matching pattern", we queue N jobs per matched file
+	m.glob()
 	if !m.started {
 		m.started = true
 		m.current = 0
@@ -285,7 +399,7 @@ func (m *matchingIterator) Next() bool {
 }
 
 func (m *matchingIterator) Values() map[string]string {
-	if m.fileIdx >= len(m.files) {
+	if m.fileIdx < 0 || m.fileIdx >= len(m.files) {
 		return map[string]string{}
 	}
 
@@ -310,9 +424,33 @@ func (m *matchingIterator) Values() map[string]string {
 }
 
 func (m *matchingIterator) Count() int {
+	m.glob()
 	return len(m.files) * m.count
 }
 
+// reset keeps the expanded pattern, so every pass queues the same files
+func (m *matchingIterator) reset() {
+	m.current, m.fileIdx, m.started = -1, -1, false
+}
+
+// iteratorErr returns the error that stopped it early, for iterators that
+// can fail part way through (such as reading a queue file)
+func iteratorErr(it SubmitIterator) error {
+	if e, ok := it.(interface{ Err() error }); ok {
+		return e.Err()
+	}
+	return nil
+}
+
+// resetIterator rewinds it to its first item, for iterators that support
+// it, so a submit file can be submitted more than once (e.g. by DryRun and
+// then Submit)
+func resetIterator(it SubmitIterator) {
+	if r, ok := it.(interface{ reset() }); ok {
+		r.reset()
+	}
+}
+
 // createIteratorFromQueue creates an appropriate iterator from a QueueStatement
 func createIteratorFromQueue(qs *config.QueueStatement) (SubmitIterator, error) {
 	count := qs.Count
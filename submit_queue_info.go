@@ -0,0 +1,83 @@
+package htcondor
+
+import "slices"
+
+// QueueKind is the form of a queue statement
+type QueueKind string
+
+// Forms of queue statement
+const (
+	QueueSimple   QueueKind = "simple"   // queue [N]
+	QueueList     QueueKind = "list"     // queue [N] var in (item, ...)
+	QueueTable    QueueKind = "table"    // queue [N] var1, var2 in (row, ...), rows split on whitespace
+	QueueFile     QueueKind = "file"     // queue [N] var1, var2 from file
+	QueueMatching QueueKind = "matching" // queue [N] [var] matching pattern
+)
+
+// maxQueueInfoItems is the most items QueueInfo lists; larger queue
+// statements only report how many items they have
+const maxQueueInfoItems = 1000
+
+// QueueInfo describes a queue statement, e.g. for showing what a submit file
+// will submit before submitting it
+type QueueInfo struct {
+	Kind     QueueKind
+	Count    int      // Procs queued per item: the N of "queue N ..."
+	VarNames []string // Variables set from each item (ITEM if the statement names none)
+	Source   string   // Queue file (as an absolute path) or matching pattern
+	NumItems int      // Items iterated over; 1 for a simple queue statement
+	NumProcs int      // Procs the statement queues: NumItems * Count
+	// Items lists the items (list entries, table rows, file lines or
+	// matched file names) if there are at most maxQueueInfoItems of them;
+	// it is nil for a simple queue statement or more items
+	Items []string
+}
+
+// QueueInfo describes the submit file's first queue statement, or the
+// implied "queue 1" if there is none. Queue files are read and matching
+// patterns expanded now, as they would be by Submit.
+func (sf *SubmitFile) QueueInfo() QueueInfo {
+	return sf.blocks()[0].info()
+}
+
+// QueueInfos describes each of the submit file's queue statements, in order
+func (sf *SubmitFile) QueueInfos() []QueueInfo {
+	blocks := sf.blocks()
+	infos := make([]QueueInfo, len(blocks))
+	for i, block := range blocks {
+		infos[i] = block.info()
+	}
+	return infos
+}
+
+// info describes the block's queue statement
+func (block *queueBlock) info() QueueInfo {
+	var info QueueInfo
+	var items []string
+	switch it := block.iterator.(type) {
+	case *simpleIterator:
+		info = QueueInfo{Kind: QueueSimple, Count: it.count, NumItems: 1}
+	case *listIterator:
+		info = QueueInfo{Kind: QueueList, Count: it.count, VarNames: slices.Clone(it.varNames), NumItems: len(it.items)}
+		if len(it.varNames) > 1 {
+			info.Kind = QueueTable
+		}
+		items = it.items
+	case *fileIterator:
+		info = QueueInfo{Kind: QueueFile, Count: it.count, VarNames: slices.Clone(it.varNames), Source: it.filename}
+		items, info.NumItems = it.lines(maxQueueInfoItems)
+	case *matchingIterator:
+		it.glob()
+		info = QueueInfo{Kind: QueueMatching, Count: it.count, VarNames: slices.Clone(it.varNames), Source: it.pattern, NumItems: len(it.files)}
+		items = it.files
+	default:
+		// Not a queue statement of a parsed submit file
+		return QueueInfo{VarNames: block.queueVars, NumProcs: block.iterator.Count()}
+	}
+
+	info.NumProcs = info.NumItems * info.Count
+	if info.Kind != QueueSimple && info.NumItems <= maxQueueInfoItems {
+		info.Items = append([]string{}, items...)
+	}
+	return info
+}
@@ -0,0 +1,114 @@
+package htcondor
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQueueInfo(t *testing.T) {
+	tmpDir := t.TempDir()
+	itemFile := filepath.Join(tmpDir, "items.txt")
+	if err := os.WriteFile(itemFile, []byte("# inputs\na.dat 1\n\nb.dat 2\n"), 0600); err != nil {
+		t.Fatalf("Failed to write item file: %v", err)
+	}
+	for _, name := range []string{"in1.txt", "in2.txt", "other.dat"} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), nil, 0600); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+	pattern := filepath.Join(tmpDir, "in*.txt")
+
+	tests := []struct {
+		name  string
+		queue string
+		want  QueueInfo
+	}{
+		{"implied", "", QueueInfo{Kind: QueueSimple, Count: 1, NumItems: 1, NumProcs: 1}},
+		{"simple", "queue 5", QueueInfo{Kind: QueueSimple, Count: 5, NumItems: 1, NumProcs: 5}},
+		{"list", "queue 2 color in (red, green, blue)", QueueInfo{
+			Kind: QueueList, Count: 2, VarNames: []string{"color"},
+			NumItems: 3, NumProcs: 6, Items: []string{"red", "green", "blue"},
+		}},
+		{"table", `queue x, y in ("1 2", "3 4")`, QueueInfo{
+			Kind: QueueTable, Count: 1, VarNames: []string{"x", "y"},
+			NumItems: 2, NumProcs: 2, Items: []string{"1 2", "3 4"},
+		}},
+		{"file", `queue input, seed from "` + itemFile + `"`, QueueInfo{
+			Kind: QueueFile, Count: 1, VarNames: []string{"input", "seed"}, Source: itemFile,
+			NumItems: 2, NumProcs: 2, Items: []string{"a.dat 1", "b.dat 2"},
+		}},
+		{"matching", `queue 3 matching "` + pattern + `"`, QueueInfo{
+			Kind: QueueMatching, Count: 3, VarNames: []string{"ITEM"}, Source: pattern,
+			NumItems: 2, NumProcs: 6, Items: []string{filepath.Join(tmpDir, "in1.txt"), filepath.Join(tmpDir, "in2.txt")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\n" + tt.queue + "\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			if got := sf.QueueInfo(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+
+			// Introspection leaves the jobs to submit unchanged
+			result, err := sf.Submit(1)
+			if err != nil {
+				t.Fatalf("Submit failed: %v", err)
+			}
+			if result.NumProcs != tt.want.NumProcs {
+				t.Errorf("Expected %d procs, got %d", tt.want.NumProcs, result.NumProcs)
+			}
+		})
+	}
+}
+
+func TestQueueInfoLargeFile(t *testing.T) {
+	itemFile := filepath.Join(t.TempDir(), "items.txt")
+	content := strings.Repeat("item\n", maxQueueInfoItems+1)
+	if err := os.WriteFile(itemFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write item file: %v", err)
+	}
+
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\nqueue 2 item from \"" + itemFile + "\"\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	info := sf.QueueInfo()
+	if info.NumItems != maxQueueInfoItems+1 || info.NumProcs != 2*(maxQueueInfoItems+1) {
+		t.Errorf("Expected %d items, got NumItems=%d, NumProcs=%d", maxQueueInfoItems+1, info.NumItems, info.NumProcs)
+	}
+	if info.Items != nil {
+		t.Errorf("Expected no item list for a large file, got %d items", len(info.Items))
+	}
+}
+
+func TestQueueInfos(t *testing.T) {
+	sf, err := ParseSubmitFile(strings.NewReader(`
+executable = /bin/echo
+queue 2
+queue name in (a, b, c)
+`))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+
+	infos := sf.QueueInfos()
+	if len(infos) != 2 {
+		t.Fatalf("Expected 2 queue statements, got %d", len(infos))
+	}
+	if infos[0].Kind != QueueSimple || infos[0].NumProcs != 2 {
+		t.Errorf("Unexpected first statement: %+v", infos[0])
+	}
+	if infos[1].Kind != QueueList || infos[1].NumProcs != 3 {
+		t.Errorf("Unexpected second statement: %+v", infos[1])
+	}
+	if !reflect.DeepEqual(sf.QueueInfo(), infos[0]) {
+		t.Errorf("Expected QueueInfo to describe the first statement, got %+v", sf.QueueInfo())
+	}
+}
@@ -3,6 +3,7 @@ package htcondor
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -20,8 +21,8 @@ queue
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 1 {
-		t.Errorf("Expected queue count 1, got %d", sf.queueCount)
+	if sf.queueCount() != 1 {
+		t.Errorf("Expected queue count 1, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1000)
@@ -51,8 +52,8 @@ queue 5
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 5 {
-		t.Errorf("Expected queue count 5, got %d", sf.queueCount)
+	if sf.queueCount() != 5 {
+		t.Errorf("Expected queue count 5, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1001)
@@ -91,8 +92,8 @@ queue item in (apple, banana, cherry)
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1002)
@@ -123,8 +124,8 @@ queue 2 fruit in (apple, banana)
 	}
 
 	// 2 jobs per item * 2 items = 4 total
-	if sf.queueCount != 4 {
-		t.Errorf("Expected queue count 4, got %d", sf.queueCount)
+	if sf.queueCount() != 4 {
+		t.Errorf("Expected queue count 4, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1003)
@@ -162,8 +163,8 @@ queue color from "` + dataFile + `"
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1004)
@@ -201,8 +202,8 @@ queue 3 name from "` + dataFile + `"
 	}
 
 	// 3 jobs per line * 2 lines = 6 total
-	if sf.queueCount != 6 {
-		t.Errorf("Expected queue count 6, got %d", sf.queueCount)
+	if sf.queueCount() != 6 {
+		t.Errorf("Expected queue count 6, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1005)
@@ -250,8 +251,8 @@ queue matching "test*.txt"
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1006)
@@ -300,8 +301,8 @@ queue 2 matching "data*.dat"
 	}
 
 	// 2 jobs per file * 2 files = 4 total
-	if sf.queueCount != 4 {
-		t.Errorf("Expected queue count 4, got %d", sf.queueCount)
+	if sf.queueCount() != 4 {
+		t.Errorf("Expected queue count 4, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1007)
@@ -371,8 +372,8 @@ queue color, shape from "` + dataFile + `"
 		t.Fatalf("Failed to parse submit file: %v", err)
 	}
 
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1009)
@@ -412,8 +413,8 @@ queue matching "nonexistent*.txt"
 	}
 
 	// No matches means no jobs
-	if sf.queueCount != 0 {
-		t.Errorf("Expected queue count 0, got %d", sf.queueCount)
+	if sf.queueCount() != 0 {
+		t.Errorf("Expected queue count 0, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(1010)
@@ -425,3 +426,174 @@ queue matching "nonexistent*.txt"
 		t.Errorf("Expected 0 procs, got %d", result.NumProcs)
 	}
 }
+
+func TestQueueMultipleStatements(t *testing.T) {
+	submit := `
+universe = vanilla
+executable = /bin/echo
+arguments = small $(Process)
+request_memory = 100
+queue 2
+
+arguments = large $(Process)
+request_memory = 4096
+queue 3
+`
+
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+
+	if sf.queueCount() != 5 {
+		t.Errorf("Expected queue count 5, got %d", sf.queueCount())
+	}
+
+	result, err := sf.Submit(1011)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	if result.NumProcs != 5 || len(result.ProcAds) != 5 {
+		t.Fatalf("Expected 5 procs, got NumProcs=%d, len(ProcAds)=%d", result.NumProcs, len(result.ProcAds))
+	}
+
+	for i, ad := range result.ProcAds {
+		if procID, ok := ad.EvaluateAttrInt("ProcId"); !ok || procID != int64(i) {
+			t.Errorf("Proc ad %d: expected ProcId %d, got %d", i, i, procID)
+		}
+
+		wantMemory, wantArgs := int64(100), "small "
+		if i >= 2 {
+			wantMemory, wantArgs = 4096, "large "
+		}
+		wantArgs += strconv.Itoa(i)
+
+		if mem, ok := ad.EvaluateAttrInt("RequestMemory"); !ok || mem != wantMemory {
+			t.Errorf("Proc ad %d: expected RequestMemory %d, got %d", i, wantMemory, mem)
+		}
+		if args, ok := ad.EvaluateAttrString("Args"); !ok || args != wantArgs {
+			t.Errorf("Proc ad %d: expected Args %q, got %q", i, wantArgs, args)
+		}
+	}
+
+	// The submit file's state is unchanged by submission
+	if mem, ok := sf.cfg.Get("request_memory"); !ok || mem != "100" {
+		t.Errorf("Expected first queue block to be current after Submit, got request_memory=%q", mem)
+	}
+}
+
+func TestQueueMultipleStatementsWithItems(t *testing.T) {
+	submit := `
+executable = /bin/echo
+arguments = $(color)
+queue color in (red, green)
+
+executable = /bin/cat
+queue 1
+`
+
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+
+	result, err := sf.SubmitLate(1012)
+	if err != nil {
+		t.Fatalf("SubmitLate failed: %v", err)
+	}
+
+	if len(result.ProcAds) != 3 {
+		t.Fatalf("Expected 3 proc ads, got %d", len(result.ProcAds))
+	}
+
+	wantCmds := []string{"/bin/echo", "/bin/echo", "/bin/cat"}
+	for i, ad := range result.ProcAds {
+		if cmd, ok := ad.EvaluateAttrString("Cmd"); !ok || cmd != wantCmds[i] {
+			t.Errorf("Proc ad %d: expected Cmd %q, got %q", i, wantCmds[i], cmd)
+		}
+	}
+	if args, ok := result.ProcAds[1].EvaluateAttrString("Args"); !ok || args != "green" {
+		t.Errorf("Expected Args 'green' for proc 1, got %q", args)
+	}
+}
+
+func TestQueueFromLargeFileIsNotEnumeratedAtParse(t *testing.T) {
+	tmpDir := t.TempDir()
+	dataFile := filepath.Join(tmpDir, "items.txt")
+
+	var data strings.Builder
+	for i := 0; i < 200000; i++ {
+		data.WriteString("item" + strconv.Itoa(i) + "\n")
+	}
+	if err := os.WriteFile(dataFile, []byte(data.String()), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	submit := `
+executable = /bin/echo
+arguments = $(item)
+queue item from "` + dataFile + `"
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	iter, ok := sf.queueIterator.(*fileIterator)
+	if !ok {
+		t.Fatalf("Expected a file iterator, got %T", sf.queueIterator)
+	}
+	if iter.started {
+		t.Fatal("Queue file was read at parse time")
+	}
+
+	// Items are read at submission, so replacing the file after parsing
+	// changes what gets queued
+	if err := os.WriteFile(dataFile, []byte("a\nb\nc\n"), 0600); err != nil {
+		t.Fatalf("Failed to rewrite test file: %v", err)
+	}
+	result, err := sf.Submit(1020)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if result.NumProcs != 3 || len(result.ProcAds) != 3 {
+		t.Fatalf("Expected 3 procs, got NumProcs=%d, len(ProcAds)=%d", result.NumProcs, len(result.ProcAds))
+	}
+	if args, _ := result.ProcAds[2].EvaluateAttrString("Args"); args != "c" {
+		t.Errorf("Expected Args c for proc 2, got %q", args)
+	}
+}
+
+func TestQueueMaxProcs(t *testing.T) {
+	tmpDir := t.TempDir()
+	dataFile := filepath.Join(tmpDir, "items.txt")
+	var data strings.Builder
+	for i := 0; i < 50; i++ {
+		data.WriteString("item" + strconv.Itoa(i) + "\n")
+	}
+	if err := os.WriteFile(dataFile, []byte(data.String()), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	for _, submit := range []string{
+		"executable = /bin/echo\nqueue item from \"" + dataFile + "\"\n",
+		"executable = /bin/echo\nqueue 11\n",
+	} {
+		sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{MaxProcs: 10})
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		if _, err := sf.Submit(1021); err == nil || !strings.Contains(err.Error(), "more than 10 procs") {
+			t.Errorf("Expected proc cap error, got %v", err)
+		}
+	}
+
+	// Exactly at the cap is fine
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader("executable = /bin/echo\nqueue 10\n"), &SubmitFileOptions{MaxProcs: 10})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if result, err := sf.Submit(1022); err != nil || result.NumProcs != 10 {
+		t.Errorf("Expected 10 procs, got %v (err=%v)", result, err)
+	}
+}
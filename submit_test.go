@@ -1,8 +1,13 @@
 package htcondor
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/PelicanPlatform/classad/classad"
 )
 
 func TestParseSimpleSubmitFile(t *testing.T) {
@@ -25,8 +30,8 @@ log = job.log
 	}
 
 	// Default queue count when no queue statement is present
-	if sf.queueCount != 1 {
-		t.Errorf("Expected queue count 1, got %d", sf.queueCount)
+	if sf.queueCount() != 1 {
+		t.Errorf("Expected queue count 1, got %d", sf.queueCount())
 	}
 }
 
@@ -175,6 +180,81 @@ MY.ProjectCode = 12345
 	// but we verify that the job ad was created successfully with custom attributes
 }
 
+func TestCustomAttributeStringValues(t *testing.T) {
+	submit := `
+executable = /bin/echo
++Quoted = "say \"hi\""
++Escaped = "line1\nline2"
+MY.Heredoc @=end
+first line
+second "line"
+@end
++Bare = he said "hi"
+queue
+`
+
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	want := map[string]string{
+		"Quoted":     `say "hi"`,
+		"Escaped":    "line1\nline2",
+		"MY.Heredoc": "first line\nsecond \"line\"",
+		"Bare":       `he said "hi"`,
+	}
+	for attr, value := range want {
+		got, ok := ad.EvaluateAttrString(attr)
+		if !ok || got != value {
+			t.Errorf("%s = %q, expected %q", attr, got, value)
+			continue
+		}
+
+		// The attribute is sent to the schedd as a ClassAd expression, which
+		// must read back as the same string
+		expr, _ := ad.Lookup(attr)
+		parsed, err := classad.ParseExpr(expr.String())
+		if err != nil {
+			t.Errorf("%s serializes to %s, which does not parse: %v", attr, expr, err)
+			continue
+		}
+		roundTrip := classad.New()
+		roundTrip.InsertExpr(attr, parsed)
+		if got, _ := roundTrip.EvaluateAttrString(attr); got != value {
+			t.Errorf("%s reads back as %q, expected %q", attr, got, value)
+		}
+	}
+}
+
+func TestCustomAttributeInvalidValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		macros map[string]string
+	}{
+		{"malformed string literal", `+Broken = "a"b"`, nil},
+		// Submit files are read as UTF-8, but appended commands are not
+		{"invalid UTF-8", "", map[string]string{"+Binary": "\"\xff\xfe\""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submit := "executable = /bin/echo\n" + tt.line + "\nqueue\n"
+			sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{Macros: tt.macros})
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			if _, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{}); err == nil {
+				t.Error("Expected the value to be rejected")
+			}
+		})
+	}
+}
+
 func TestFileTransferDetails(t *testing.T) {
 	submit := `
 universe = vanilla
@@ -185,7 +265,7 @@ transfer_output_files = output1.txt, output2.dat
 transfer_output_remaps = "output1.txt=renamed1.txt; output2.dat=renamed2.dat"
 encrypt_input_files = input1.txt, input2.dat
 preserve_relative_paths = true
-transfer_plugins = http, https
+transfer_plugins = http,https=/usr/libexec/condor/curl_plugin
 `
 
 	sf, err := ParseSubmitFile(strings.NewReader(submit))
@@ -206,6 +286,77 @@ transfer_plugins = http, https
 	// Verify the job ad was created successfully with file transfer settings
 }
 
+func TestWhenToTransferOutput(t *testing.T) {
+	for _, when := range []string{"ON_EXIT", "on_exit_or_evict", "ON_SUCCESS"} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\nwhen_to_transfer_output = " + when + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+		if err != nil {
+			t.Fatalf("when_to_transfer_output = %s: %v", when, err)
+		}
+		if got, _ := ad.EvaluateAttrString("WhenToTransferOutput"); got != strings.ToUpper(when) {
+			t.Errorf("Expected WhenToTransferOutput %s, got %q", strings.ToUpper(when), got)
+		}
+	}
+
+	for _, extra := range []string{
+		"when_to_transfer_output = ON_EVICT",
+		"when_to_transfer_output = ON_EXIT_OR_EVICT\nshould_transfer_files = IF_NEEDED",
+		"when_to_transfer_output = ON_EXIT_OR_EVICT\ncheckpoint_exit_code = 85",
+	} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\n" + extra + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil || !strings.Contains(err.Error(), "when_to_transfer_output") {
+			t.Errorf("Expected a when_to_transfer_output error for %q, got %v", extra, err)
+		}
+	}
+}
+
+func TestCheckpointSettings(t *testing.T) {
+	submit := `
+executable = /bin/sim
+checkpoint_exit_code = 85
+transfer_checkpoint_files = state.dat, logs/
+checkpoint_destination = osdf:///ospool/ap40/data/user/ckpt/
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	for _, attr := range []string{"CheckpointExitCode", "SuccessCheckpointExitCode"} {
+		if got, ok := ad.EvaluateAttrInt(attr); !ok || got != 85 {
+			t.Errorf("Expected %s 85, got %d (ok=%v)", attr, got, ok)
+		}
+	}
+	if got, ok := ad.EvaluateAttrBool("WantFTOnCheckpoint"); !ok || !got {
+		t.Errorf("Expected WantFTOnCheckpoint true, got %v (ok=%v)", got, ok)
+	}
+	if got, _ := ad.EvaluateAttrString("TransferCheckpoint"); got != "state.dat,logs/" {
+		t.Errorf("Expected TransferCheckpoint %q, got %q", "state.dat,logs/", got)
+	}
+	if got, _ := ad.EvaluateAttrString("CheckpointDestination"); got != "osdf:///ospool/ap40/data/user/ckpt/" {
+		t.Errorf("Unexpected CheckpointDestination %q", got)
+	}
+
+	sf, err = ParseSubmitFile(strings.NewReader("executable = /bin/sim\ncheckpoint_exit_code = often\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil || !strings.Contains(err.Error(), "checkpoint_exit_code") {
+		t.Errorf("Expected a checkpoint_exit_code error, got %v", err)
+	}
+}
+
 func TestContainerSettings(t *testing.T) {
 	submit := `
 universe = vanilla
@@ -237,6 +388,187 @@ require_container = true
 	// Verify the job ad was created successfully with container settings
 }
 
+func TestContainerRegistryCredentials(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"docker_credentials", "docker_credentials"},
+		{"container_registry_credentials", "container_registry_credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submit := `
+universe = vanilla
+executable = /bin/echo
+container_image = docker://registry.example.com/private/image:1.0
+` + tt.key + ` = example_registry
+`
+
+			sf, err := ParseSubmitFile(strings.NewReader(submit))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+
+			ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+			if err != nil {
+				t.Fatalf("Failed to create job ad: %v", err)
+			}
+
+			if creds, ok := ad.EvaluateAttrString("ContainerRegistryCredentials"); !ok || creds != "example_registry" {
+				t.Errorf("Expected ContainerRegistryCredentials 'example_registry', got %q", creds)
+			}
+			if services, ok := ad.EvaluateAttrString("OAuthServicesNeeded"); !ok || services != "example_registry" {
+				t.Errorf("Expected OAuthServicesNeeded 'example_registry', got %q", services)
+			}
+
+			reqExpr, ok := ad.Lookup("Requirements")
+			if !ok {
+				t.Fatal("Expected Requirements attribute")
+			}
+			// The credd delivers the credential like an OAuth token; no
+			// machine attribute advertises support for it
+			if strings.Contains(reqExpr.String(), "RegistryCredentials") {
+				t.Errorf("Expected no registry credential clause in Requirements, got %s", reqExpr.String())
+			}
+		})
+	}
+
+	// Like use_oauth_services, the service must be one the pool offers
+	opts := &SubmitFileOptions{OAuthServices: []string{"scitokens", "registry"}}
+	for _, tt := range []struct {
+		value   string
+		wantErr bool
+	}{
+		{"registry", false},
+		{"other_registry", true},
+	} {
+		sf, err := ParseSubmitFileWithOptions(strings.NewReader("executable = /bin/echo\ncontainer_image = docker://registry.example.com/private/image:1.0\ndocker_credentials = "+tt.value+"\nqueue\n"), opts)
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		_, err = sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "unknown OAuth service") {
+				t.Errorf("docker_credentials = %s: expected an unknown OAuth service error, got %v", tt.value, err)
+			}
+		} else if err != nil {
+			t.Errorf("docker_credentials = %s: %v", tt.value, err)
+		}
+	}
+}
+
+func TestUseOAuthServices(t *testing.T) {
+	opts := &SubmitFileOptions{OAuthServices: []string{"scitokens", "box", "registry"}}
+	tests := []struct {
+		name    string
+		submit  string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "services without handles",
+			submit: "use_oauth_services = scitokens, box\n",
+			want:   "scitokens,box",
+		},
+		{
+			name: "service with handles",
+			submit: "use_oauth_services = scitokens, box\n" +
+				"scitokens_oauth_permissions_write = storage.modify:/data\n" +
+				"scitokens_oauth_resource_read = https://storage.example.com\n" +
+				"SCITOKENS_OAUTH_PERMISSIONS_READ = storage.read:/data\n",
+			want: "scitokens*read,scitokens*write,box",
+		},
+		{
+			name:   "merged with registry credentials",
+			submit: "use_oauth_services = box\ncontainer_image = docker://registry.example.com/image\ndocker_credentials = registry\n",
+			want:   "box,registry",
+		},
+		{
+			name:    "unknown service",
+			submit:  "use_oauth_services = scitokens, dropbox\n",
+			wantErr: `unknown OAuth service "dropbox"`,
+		},
+		{
+			name:    "invalid service name",
+			submit:  "use_oauth_services = scitokens*read\n",
+			wantErr: "invalid service name",
+		},
+		{
+			name:    "with and without a handle",
+			submit:  "use_oauth_services = box\nbox_oauth_permissions = read\nbox_oauth_permissions_h = write\n",
+			wantErr: "both with and without a handle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submit := "universe = vanilla\nexecutable = /bin/echo\n" + tt.submit + "queue\n"
+			sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), opts)
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to create job ad: %v", err)
+			}
+			if services, ok := ad.EvaluateAttrString("OAuthServicesNeeded"); !ok || services != tt.want {
+				t.Errorf("Expected OAuthServicesNeeded %q, got %q", tt.want, services)
+			}
+		})
+	}
+
+	// Without a list of available services, any well-formed name is accepted
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\nuse_oauth_services = dropbox\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+	if services, _ := ad.EvaluateAttrString("OAuthServicesNeeded"); services != "dropbox" {
+		t.Errorf("Expected OAuthServicesNeeded \"dropbox\", got %q", services)
+	}
+}
+
+func TestContainerRegistryCredentialsRejectsInline(t *testing.T) {
+	inline := []string{
+		"alice:s3cret",
+		`{"auths": {"registry.example.com": {"auth": "YWxpY2U6czNjcmV0"}}}`,
+		"YWxpY2U6czNjcmV0==",
+		"user@registry.example.com",
+	}
+
+	for _, value := range inline {
+		submit := `
+executable = /bin/echo
+container_image = docker://registry.example.com/private/image:1.0
+docker_credentials = ` + value + `
+`
+		sf, err := ParseSubmitFile(strings.NewReader(submit))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+
+		_, err = sf.MakeJobAd(JobID{Cluster: 100, Proc: 0}, map[string]string{})
+		if err == nil {
+			t.Errorf("Expected error for inline credentials %q", value)
+			continue
+		}
+		if !strings.Contains(err.Error(), "credd") {
+			t.Errorf("Expected error to point to the credd, got: %v", err)
+		}
+	}
+}
+
 func TestJobStatusControl(t *testing.T) {
 	submit := `
 universe = vanilla
@@ -270,6 +602,124 @@ concurrency_limits = DATABASE:2
 	// Verify the job ad was created successfully with job status/control settings
 }
 
+func TestJobMaxVacateTime(t *testing.T) {
+	makeAd := func(value string) (*classad.ClassAd, error) {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\njob_max_vacate_time = " + value + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		return sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+	}
+
+	ad, err := makeAd("120")
+	if err != nil {
+		t.Fatalf("Failed to make job ad: %v", err)
+	}
+	if vacate, ok := ad.EvaluateAttrInt("JobMaxVacateTime"); !ok || vacate != 120 {
+		t.Errorf("Expected JobMaxVacateTime 120, got %d", vacate)
+	}
+
+	// An expression is kept as an expression; a second pass parsing it as
+	// an integer would have truncated it to 60
+	ad, err = makeAd("60 * 5")
+	if err != nil {
+		t.Fatalf("Failed to make job ad: %v", err)
+	}
+	vacateExpr, ok := ad.Lookup("JobMaxVacateTime")
+	if !ok || !strings.Contains(vacateExpr.String(), "*") {
+		t.Errorf("Expected JobMaxVacateTime to be an expression, got %v", vacateExpr)
+	}
+	if vacate, ok := ad.EvaluateAttrInt("JobMaxVacateTime"); !ok || vacate != 300 {
+		t.Errorf("Expected JobMaxVacateTime to evaluate to 300, got %d", vacate)
+	}
+
+	// setJobStatusControl is the only place that sets it
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\njob_max_vacate_time = 120\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	extended := classad.New()
+	if err := sf.setExtendedJobExprs(extended); err != nil {
+		t.Fatalf("setExtendedJobExprs failed: %v", err)
+	}
+	if _, ok := extended.Lookup("JobMaxVacateTime"); ok {
+		t.Error("Expected setExtendedJobExprs not to set JobMaxVacateTime")
+	}
+
+	if _, err := makeAd("60 *"); err == nil || !strings.Contains(err.Error(), "job_max_vacate_time") {
+		t.Errorf("Expected an invalid job_max_vacate_time error, got %v", err)
+	}
+}
+
+func TestKeepOnFailure(t *testing.T) {
+	makeAd := func(lines string) (*classad.ClassAd, error) {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\n" + lines + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		return sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+	}
+
+	const withRetries = "((ExitCode == 0) || (NumJobCompletions > MaxRetries))"
+	tests := []struct {
+		name        string
+		lines       string
+		want        string
+		exitCode    int64
+		completions int64
+		removed     bool
+	}{
+		{"success is removed", "keep_on_failure = true", "(ExitCode == 0)", 0, 1, true},
+		{"failure is kept", "keep_on_failure = true", "(ExitCode == 0)", 1, 1, false},
+		{"composes with on_exit_remove", "keep_on_failure = true\non_exit_remove = NumJobStarts >= 2",
+			"(NumJobStarts >= 2) && (ExitCode == 0)", 0, 1, true},
+		{"failure is retried", "keep_on_failure = true\nmax_retries = 2", withRetries, 1, 2, false},
+		{"retries are bounded", "keep_on_failure = true\nmax_retries = 2", withRetries, 1, 3, true},
+		{"success within retries is removed", "keep_on_failure = true\nmax_retries = 2", withRetries, 0, 1, true},
+		{"retries compose with on_exit_remove", "keep_on_failure = true\nmax_retries = 2\non_exit_remove = NumJobStarts >= 2",
+			"(NumJobStarts >= 2) && " + withRetries, 1, 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ad, err := makeAd(tt.lines)
+			if err != nil {
+				t.Fatalf("Failed to make job ad: %v", err)
+			}
+			expr, ok := ad.Lookup("OnExitRemove")
+			if !ok {
+				t.Fatal("Expected OnExitRemove")
+			}
+			want, err := classad.ParseExpr(tt.want)
+			if err != nil {
+				t.Fatalf("Failed to parse %s: %v", tt.want, err)
+			}
+			if expr.String() != want.String() {
+				t.Errorf("OnExitRemove = %s, expected %s", expr.String(), want.String())
+			}
+
+			_ = ad.Set("ExitCode", tt.exitCode)
+			_ = ad.Set("NumJobStarts", 2)
+			_ = ad.Set("NumJobCompletions", tt.completions)
+			if removed, ok := ad.EvaluateAttrBool("OnExitRemove"); !ok || removed != tt.removed {
+				t.Errorf("OnExitRemove with exit code %d after %d completions = %v, expected %v", tt.exitCode, tt.completions, removed, tt.removed)
+			}
+		})
+	}
+
+	// Without keep_on_failure on_exit_remove is unchanged
+	ad, err := makeAd("keep_on_failure = false")
+	if err != nil {
+		t.Fatalf("Failed to make job ad: %v", err)
+	}
+	if _, ok := ad.Lookup("OnExitRemove"); ok {
+		t.Error("Expected no OnExitRemove without keep_on_failure")
+	}
+
+	if _, err := makeAd("keep_on_failure = true\non_exit_remove = ExitCode =="); err == nil || !strings.Contains(err.Error(), "on_exit_remove") {
+		t.Errorf("Expected an invalid on_exit_remove error, got %v", err)
+	}
+}
+
 func TestImprovedRequirements(t *testing.T) {
 	submit := `
 universe = vanilla
@@ -302,3 +752,792 @@ request_arch = "X86_64"
 
 	// Verify the job ad was created successfully with enhanced requirements
 }
+
+func TestRequirementsAppendAndReplace(t *testing.T) {
+	base := `
+executable = /bin/echo
+request_memory = 2048
+requirements = (TARGET.OpSys == "LINUX")
+`
+
+	tests := []struct {
+		name     string
+		extra    string
+		wantAuto bool
+	}{
+		{"default appends automatic clauses", "", true},
+		{"requirements_replace uses user expression only", "requirements_replace = true\n", false},
+		{"requirements_replace false appends", "requirements_replace = false\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader(base + tt.extra + "queue\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+			if err != nil {
+				t.Fatalf("Failed to create job ad: %v", err)
+			}
+
+			reqExpr, ok := ad.Lookup("Requirements")
+			if !ok {
+				t.Fatal("Expected Requirements attribute")
+			}
+			req := reqExpr.String()
+			if !strings.Contains(req, "LINUX") {
+				t.Errorf("Expected Requirements to contain the user's expression, got %s", req)
+			}
+			if got := strings.Contains(req, "RequestMemory"); got != tt.wantAuto {
+				t.Errorf("Expected automatic clauses present=%v, got %s", tt.wantAuto, req)
+			}
+		})
+	}
+}
+
+func TestRequirementsReplaceWithoutRequirements(t *testing.T) {
+	submit := `
+executable = /bin/echo
+requirements_replace = true
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+		t.Error("Expected error when requirements_replace is set without requirements")
+	}
+}
+
+func TestOutputCollisionWarning(t *testing.T) {
+	submit := `
+executable = /bin/echo
+output = job.out
+error = job_$(Process).err
+log = job.log
+queue 3
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	// Only the static output path collides; per-proc errors and the shared log are fine
+	if len(result.Warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %d: %v", len(result.Warnings), result.Warnings)
+	}
+	if !strings.Contains(result.Warnings[0], "job.out") || !strings.Contains(result.Warnings[0], "3 procs") {
+		t.Errorf("Expected warning about job.out shared by 3 procs, got %q", result.Warnings[0])
+	}
+}
+
+func TestOutputCollisionPolicy(t *testing.T) {
+	submit := `
+executable = /bin/echo
+output = job.out
+queue 3
+`
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{OutputCollisions: OutputCollisionError})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.Submit(1); err == nil || !strings.Contains(err.Error(), "job.out") {
+		t.Errorf("Expected collision error mentioning job.out, got %v", err)
+	}
+
+	sf, err = ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{OutputCollisions: OutputCollisionIgnore})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected no warnings when ignoring collisions, got %v", result.Warnings)
+	}
+}
+
+func TestTransferInputFilesDuplicates(t *testing.T) {
+	submit := `
+executable = /bin/echo
+transfer_input_files = data.csv, config.ini, data.csv, input_$(Process).txt, config.ini
+queue 2
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	// First occurrences are kept, in order
+	for proc, ad := range result.ProcAds {
+		want := fmt.Sprintf("data.csv,config.ini,input_%d.txt", proc)
+		if got, _ := ad.EvaluateAttrString("TransferInputFiles"); got != want {
+			t.Errorf("Proc %d: TransferInputFiles = %q, want %q", proc, got, want)
+		}
+	}
+
+	// One warning per duplicated file, however many procs list it
+	want := []string{
+		"transfer_input_files lists config.ini more than once; it is transferred once",
+		"transfer_input_files lists data.csv more than once; it is transferred once",
+	}
+	if !reflect.DeepEqual(result.Warnings, want) {
+		t.Errorf("Warnings = %q, want %q", result.Warnings, want)
+	}
+}
+
+func TestOutputCollisionVaryingPaths(t *testing.T) {
+	submit := `
+executable = /bin/echo
+output = $(Cluster).$(Process).out
+error = /dev/null
+queue 3
+`
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{OutputCollisions: OutputCollisionError})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.Submit(1); err != nil {
+		t.Errorf("Expected no collision for per-proc output paths, got %v", err)
+	}
+}
+
+func TestDefaultBatchName(t *testing.T) {
+	submit := `
+executable = /usr/bin/analyze_$(Process).sh
+queue 3
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(42)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	// The first proc fixes the name for the whole cluster
+	for i, ad := range result.ProcAds {
+		if name, ok := ad.EvaluateAttrString("JobBatchName"); !ok || name != "analyze_0.sh.42" {
+			t.Errorf("Proc %d: expected JobBatchName analyze_0.sh.42, got %q", i, name)
+		}
+	}
+
+	// An explicit batch_name is used as-is
+	sf, err = ParseSubmitFile(strings.NewReader("executable = /bin/echo\nbatch_name = nightly\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err = sf.Submit(43)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if name, _ := result.ProcAds[0].EvaluateAttrString("JobBatchName"); name != "nightly" {
+		t.Errorf("Expected JobBatchName nightly, got %q", name)
+	}
+}
+
+func TestJobAdInformationAttrs(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   string
+		wantErr bool
+	}{
+		{"single attribute", "RemoteHost", false},
+		{"comma separated", "RemoteHost, ExitCode,MemoryUsage", false},
+		{"underscores and digits", "My_Attr2, _private", false},
+		{"embedded space", "Remote Host", true},
+		{"operator", "ExitCode+1", true},
+		{"comparison", "Memory>=1024", true},
+		{"leading digit", "2ndAttr", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submit := "executable = /bin/echo\njob_ad_information_attrs = " + tt.attrs + "\nqueue\n"
+			sf, err := ParseSubmitFile(strings.NewReader(submit))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			_, err = sf.Submit(1)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "job_ad_information_attrs") {
+					t.Errorf("Expected job_ad_information_attrs error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Submit failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestJobAdInformationAttrsUnknownWarning(t *testing.T) {
+	submit := `
+executable = /bin/echo
+request_memory = 512
+job_ad_information_attrs = RemoteHost, RequestMemory, ExitCdoe
+queue 2
+`
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{WarnUnknownInfoAttrs: true})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	// Only the typo is reported, once for the whole cluster
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "ExitCdoe") {
+		t.Errorf("Expected a single warning about ExitCdoe, got %v", result.Warnings)
+	}
+
+	// Without the option nothing is reported
+	sf, err = ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err = sf.Submit(1)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected no warnings by default, got %v", result.Warnings)
+	}
+}
+
+func TestTransferPlugins(t *testing.T) {
+	submit := `
+executable = /bin/echo
+transfer_input_files = input.txt
+transfer_plugins = https=/usr/libexec/condor/curl_plugin; stash, osdf = https://example.org/plugins/stash_plugin
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	expected := "https=/usr/libexec/condor/curl_plugin;stash,osdf=https://example.org/plugins/stash_plugin"
+	if plugins, _ := ad.EvaluateAttrString("TransferPlugins"); plugins != expected {
+		t.Errorf("Expected TransferPlugins %q, got %q", expected, plugins)
+	}
+	// The local plugin is shipped with the job; the remote one is not
+	if inputs, _ := ad.EvaluateAttrString("TransferInputFiles"); inputs != "input.txt,/usr/libexec/condor/curl_plugin" {
+		t.Errorf("Expected local plugin in TransferInputFiles, got %q", inputs)
+	}
+
+	for _, value := range []string{
+		"http, https",
+		"=/usr/libexec/condor/curl_plugin",
+		"https=",
+		"ht tp=/usr/libexec/condor/curl_plugin",
+		"https=/usr/libexec/condor/curl_plugin; HTTPS=/opt/other_plugin",
+	} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/echo\ntransfer_plugins = " + value + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+			t.Errorf("Expected an error for transfer_plugins = %s", value)
+		}
+	}
+}
+
+func TestContainerImageDigest(t *testing.T) {
+	digest := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	other := "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+
+	tests := []struct {
+		name      string
+		image     string
+		sha256    string
+		wantImage string
+		wantErr   bool
+	}{
+		{"tag only", "python:3.12", "", "python:3.12", false},
+		{"digest in image", "python:3.12@sha256:" + digest, "", "python:3.12@sha256:" + digest, false},
+		{"tag plus sha256 pins the image", "python:3.12", digest, "python:3.12@sha256:" + digest, false},
+		{"sha256 with prefix", "python:3.12", "sha256:" + strings.ToUpper(digest), "python:3.12@sha256:" + digest, false},
+		{"matching digest and sha256", "python:3.12@sha256:" + digest, digest, "python:3.12@sha256:" + digest, false},
+		{"mismatched digest and sha256", "python:3.12@sha256:" + digest, other, "", true},
+		{"malformed digest", "python:3.12@sha256:abc", "", "", true},
+		{"malformed sha256", "python:3.12", "abc", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submit := "universe = container\ncontainer_image = " + tt.image + "\nexecutable = /bin/true\n"
+			if tt.sha256 != "" {
+				submit += "container_image_sha256 = " + tt.sha256 + "\n"
+			}
+			sf, err := ParseSubmitFile(strings.NewReader(submit + "queue\n"))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to create job ad: %v", err)
+			}
+			if image, _ := ad.EvaluateAttrString("ContainerImage"); image != tt.wantImage {
+				t.Errorf("Expected ContainerImage %q, got %q", tt.wantImage, image)
+			}
+		})
+	}
+
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\ncontainer_image_sha256 = " + digest + "\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+		t.Error("Expected an error for container_image_sha256 without an image")
+	}
+}
+
+func TestURLExecutable(t *testing.T) {
+	submit := `
+executable = https://example.org/tools/analyze.sh?version=2
+transfer_executable = true
+copy_to_spool = true
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(7)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	ad := result.ProcAds[0]
+
+	if cmd, _ := ad.EvaluateAttrString("Cmd"); cmd != "https://example.org/tools/analyze.sh?version=2" {
+		t.Errorf("Expected Cmd to be the URL, got %q", cmd)
+	}
+	if transfer, ok := ad.EvaluateAttrBool("TransferExecutable"); !ok || !transfer {
+		t.Error("Expected TransferExecutable = true")
+	}
+	if stf, _ := ad.EvaluateAttrString("ShouldTransferFiles"); stf != "YES" {
+		t.Errorf("Expected ShouldTransferFiles YES, got %q", stf)
+	}
+	// There is no local file to spool
+	if spool, _ := ad.EvaluateAttrBool("CopyToSpool"); spool {
+		t.Error("Expected CopyToSpool to be false for a URL executable")
+	}
+	if name, _ := ad.EvaluateAttrString("JobBatchName"); name != "analyze.sh.7" {
+		t.Errorf("Expected JobBatchName analyze.sh.7, got %q", name)
+	}
+
+	for _, extra := range []string{"transfer_executable = false", "should_transfer_files = NO"} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = osdf:///ospool/tools/analyze.sh\n" + extra + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+			t.Errorf("Expected an error for a URL executable with %s", extra)
+		}
+	}
+}
+
+// TestParseSubmitFileErrors verifies every syntax error is reported with its
+// line, along with the submit file made from the rest
+func TestParseSubmitFileErrors(t *testing.T) {
+	submit := `executable = /bin/echo
+arguments hello
+request_memory = 1024
+queue 2 ) extra
+output = out.$(Process)
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	var errs SubmitParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected SubmitParseErrors, got %v", err)
+	}
+	if len(errs) != 2 || errs[0].Line != 2 || errs[1].Line != 4 {
+		t.Fatalf("Expected errors on lines 2 and 4, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "line 2") || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("Expected both lines in the message, got %q", err.Error())
+	}
+
+	// The statements around the errors still make a submit file
+	if sf == nil {
+		t.Fatal("Expected a partial submit file")
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("MakeJobAd failed: %v", err)
+	}
+	if memory, _ := ad.EvaluateAttrInt("RequestMemory"); memory != 1024 {
+		t.Errorf("Expected RequestMemory 1024, got %d", memory)
+	}
+	if args, _ := ad.EvaluateAttrString("Args"); args != "" {
+		t.Errorf("Expected no arguments from the malformed line, got %q", args)
+	}
+}
+
+func TestOutputDestination(t *testing.T) {
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\noutput_destination = osdf:///ospool/results/$(Cluster)\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 12, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("MakeJobAd failed: %v", err)
+	}
+	if destination, _ := ad.EvaluateAttrString("OutputDestination"); destination != "osdf:///ospool/results/12" {
+		t.Errorf("Expected OutputDestination osdf:///ospool/results/12, got %q", destination)
+	}
+
+	for _, extra := range []string{"output_destination = /tmp/results", "output_destination = https://example.org/up\nshould_transfer_files = NO"} {
+		sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\n" + extra + "\nqueue\n"))
+		if err != nil {
+			t.Fatalf("Failed to parse submit file: %v", err)
+		}
+		if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{}); err == nil {
+			t.Errorf("Expected an error for %q", extra)
+		}
+	}
+}
+
+func TestSubmitConditionalOnDAGMan(t *testing.T) {
+	submit := `
+executable = /bin/analyze
+if defined DAGManJobId
+  log = dag_node.log
+  arguments = --dag
+else
+  log = standalone.log
+  arguments = --standalone
+endif
+if defined $ENV(SCRATCH_DIR)
+  initialdir = $ENV(SCRATCH_DIR)
+endif
+queue
+`
+	env := func(name string) (string, bool) { return "", false }
+
+	// Standalone submission
+	sf, err := ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{EnvLookup: env})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+	if args, _ := ad.EvaluateAttrString("Args"); args != "--standalone" {
+		t.Errorf("Expected standalone arguments, got %q", args)
+	}
+	if _, ok := ad.Lookup("Iwd"); ok {
+		t.Error("Did not expect Iwd without SCRATCH_DIR in the environment")
+	}
+
+	// Under DAGMan, which defines DAGManJobId
+	env = func(name string) (string, bool) {
+		if name == "SCRATCH_DIR" {
+			return "/scratch/run1", true
+		}
+		return "", false
+	}
+	sf, err = ParseSubmitFileWithOptions(strings.NewReader(submit), &SubmitFileOptions{
+		EnvLookup: env,
+		Macros:    map[string]string{"DAGManJobId": "1234"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err = sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+	if args, _ := ad.EvaluateAttrString("Args"); args != "--dag" {
+		t.Errorf("Expected DAG arguments, got %q", args)
+	}
+	if log, _ := ad.EvaluateAttrString("UserLog"); !strings.HasSuffix(log, "dag_node.log") {
+		t.Errorf("Expected dag_node.log user log, got %q", log)
+	}
+	if iwd, _ := ad.EvaluateAttrString("Iwd"); iwd != "/scratch/run1" {
+		t.Errorf("Expected Iwd /scratch/run1, got %q", iwd)
+	}
+}
+
+func TestResourceRequestSizeUnits(t *testing.T) {
+	tests := []struct {
+		key, value string
+		attr       string
+		want       int64
+	}{
+		{"request_memory", "4096", "RequestMemory", 4096},
+		{"request_memory", "4GB", "RequestMemory", 4096},
+		{"request_memory", "4096MB", "RequestMemory", 4096},
+		{"request_memory", "1G", "RequestMemory", 1024},
+		{"request_memory", "1.5 gb", "RequestMemory", 1536},
+		{"request_memory", "2T", "RequestMemory", 2 << 20},
+		{"request_memory", "1TB", "RequestMemory", 1 << 20},
+		{"request_memory", "512K", "RequestMemory", 1},
+		{"request_memory", "2048KB", "RequestMemory", 2},
+		{"request_memory", "100M", "RequestMemory", 100},
+		{"request_disk", "1024", "RequestDisk", 1024},
+		{"request_disk", "10KB", "RequestDisk", 10},
+		{"request_disk", "10k", "RequestDisk", 10},
+		{"request_disk", "2MB", "RequestDisk", 2048},
+		{"request_disk", "2M", "RequestDisk", 2048},
+		{"request_disk", "1GB", "RequestDisk", 1 << 20},
+		{"request_disk", "1G", "RequestDisk", 1 << 20},
+		{"request_disk", "1TB", "RequestDisk", 1 << 30},
+		{"request_disk", "1T", "RequestDisk", 1 << 30},
+		{"request_gpu_memory", "8GB", "RequestGpuMemory", 8192},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			submit := "executable = /bin/true\n" + tt.key + " = " + tt.value + "\nqueue\n"
+			sf, err := ParseSubmitFile(strings.NewReader(submit))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+			if err != nil {
+				t.Fatalf("Failed to make job ad: %v", err)
+			}
+			if got, ok := ad.EvaluateAttrInt(tt.attr); !ok || got != tt.want {
+				t.Errorf("Expected %s %d, got %d", tt.attr, tt.want, got)
+			}
+		})
+	}
+
+	// Suffixes are not taken from expressions or other commands
+	sf, err := ParseSubmitFile(strings.NewReader("executable = /bin/true\nrequest_cpus = 4G\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil); err == nil {
+		t.Error("Expected request_cpus = 4G to be rejected")
+	}
+}
+
+func TestResourceRequestExpressions(t *testing.T) {
+	submit := `
+executable = /bin/true
+request_memory = 2 * 1024
+request_disk = MY.RequestCpus * 1024
+request_cpus = 4
+request_gpus =
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+	if err != nil {
+		t.Fatalf("Failed to make job ad: %v", err)
+	}
+
+	// Expressions are kept as expressions, not replaced by the default
+	memExpr, ok := ad.Lookup("RequestMemory")
+	if !ok || !strings.Contains(memExpr.String(), "*") {
+		t.Errorf("Expected RequestMemory to be an expression, got %v", memExpr)
+	}
+	if mem, ok := ad.EvaluateAttrInt("RequestMemory"); !ok || mem != 2048 {
+		t.Errorf("Expected RequestMemory to evaluate to 2048, got %d", mem)
+	}
+	diskExpr, ok := ad.Lookup("RequestDisk")
+	if !ok || !strings.Contains(diskExpr.String(), "RequestCpus") {
+		t.Errorf("Expected RequestDisk to reference RequestCpus, got %v", diskExpr)
+	}
+	if disk, ok := ad.EvaluateAttrInt("RequestDisk"); !ok || disk != 4096 {
+		t.Errorf("Expected RequestDisk to evaluate to 4096, got %d", disk)
+	}
+	if cpus, ok := ad.EvaluateAttrInt("RequestCpus"); !ok || cpus != 4 {
+		t.Errorf("Expected RequestCpus 4, got %d", cpus)
+	}
+	if _, ok := ad.Lookup("RequestGpus"); ok {
+		t.Error("Expected an empty request_gpus to be ignored")
+	}
+
+	sf, err = ParseSubmitFile(strings.NewReader("executable = /bin/true\nrequest_memory = 2 *\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil); err == nil || !strings.Contains(err.Error(), "request_memory") {
+		t.Errorf("Expected an invalid request_memory error, got %v", err)
+	}
+}
+
+func TestProxyDelegation(t *testing.T) {
+	env := func(vars map[string]string) *SubmitFileOptions {
+		return &SubmitFileOptions{EnvLookup: func(name string) (string, bool) {
+			v, ok := vars[name]
+			return v, ok
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		submit       string
+		opts         *SubmitFileOptions
+		wantProxy    string
+		wantLifetime int64
+		wantErr      string
+	}{
+		{
+			name:         "vanilla with proxy",
+			submit:       "universe = vanilla\nx509userproxy = /tmp/x509up_u1000\ndelegate_job_gsi_credentials_lifetime = 3600\n",
+			wantProxy:    "/tmp/x509up_u1000",
+			wantLifetime: 3600,
+		},
+		{
+			name:      "use_x509userproxy from environment",
+			submit:    "use_x509userproxy = true\n",
+			opts:      env(map[string]string{"X509_USER_PROXY": "/home/alice/proxy.pem"}),
+			wantProxy: "/home/alice/proxy.pem",
+		},
+		{
+			name:         "grid universe",
+			submit:       "universe = grid\ngrid_resource = condor ce.example.com ce.example.com:9619\nx509userproxy = proxy.pem\ndelegate_job_gsi_credentials_lifetime = 0\n",
+			wantProxy:    "proxy.pem",
+			wantLifetime: 0,
+		},
+		{
+			name:    "use_x509userproxy without a known proxy",
+			submit:  "use_x509userproxy = true\n",
+			opts:    env(nil),
+			wantErr: "X509_USER_PROXY",
+		},
+		{
+			name:    "negative lifetime",
+			submit:  "x509userproxy = /tmp/proxy\ndelegate_job_gsi_credentials_lifetime = -60\n",
+			wantErr: "delegate_job_gsi_credentials_lifetime",
+		},
+		{
+			name:    "non-numeric lifetime",
+			submit:  "x509userproxy = /tmp/proxy\ndelegate_job_gsi_credentials_lifetime = 1h\n",
+			wantErr: "delegate_job_gsi_credentials_lifetime",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFileWithOptions(strings.NewReader("executable = /bin/true\n"+tt.submit+"queue\n"), tt.opts)
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1, Proc: 0}, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error mentioning %s, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to make job ad: %v", err)
+			}
+
+			if proxy, _ := ad.EvaluateAttrString("X509UserProxy"); proxy != tt.wantProxy {
+				t.Errorf("Expected X509UserProxy %q, got %q", tt.wantProxy, proxy)
+			}
+			lifetime, ok := ad.EvaluateAttrInt("DelegateJobGSICredentialsLifetime")
+			if strings.Contains(tt.submit, "delegate_job_gsi_credentials_lifetime") {
+				if !ok || lifetime != tt.wantLifetime {
+					t.Errorf("Expected DelegateJobGSICredentialsLifetime %d, got %d (set: %v)", tt.wantLifetime, lifetime, ok)
+				}
+			} else if ok {
+				t.Errorf("Did not expect DelegateJobGSICredentialsLifetime, got %d", lifetime)
+			}
+		})
+	}
+}
+
+func TestSubmitDAGAttributes(t *testing.T) {
+	dagNode := `
+executable = /bin/analyze
+dagman_job_id = 1234
+dag_node_name = B
+dag_parent_node_names = A1, A2
+dagman_log = /home/user/workflow.dag.nodes.log
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(dagNode))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	nodeAd, err := sf.MakeJobAd(JobID{Cluster: 1300, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+
+	if id, ok := nodeAd.EvaluateAttrInt("DAGManJobId"); !ok || id != 1234 {
+		t.Errorf("Expected DAGManJobId 1234, got %d (ok=%v)", id, ok)
+	}
+	for attr, want := range map[string]string{
+		"DAGNodeName":        "B",
+		"DAGParentNodeNames": "A1,A2",
+		"DAGManNodesLog":     "/home/user/workflow.dag.nodes.log",
+	} {
+		if got, _ := nodeAd.EvaluateAttrString(attr); got != want {
+			t.Errorf("Expected %s %q, got %q", attr, want, got)
+		}
+	}
+
+	sf, err = ParseSubmitFile(strings.NewReader("executable = /bin/analyze\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	standaloneAd, err := sf.MakeJobAd(JobID{Cluster: 1301, Proc: 0}, map[string]string{})
+	if err != nil {
+		t.Fatalf("Failed to create job ad: %v", err)
+	}
+	if _, ok := standaloneAd.Lookup("DAGManJobId"); ok {
+		t.Error("Did not expect DAGManJobId on a standalone job")
+	}
+
+	// A query for the workflow's jobs selects only the DAG node
+	constraint, err := classad.ParseExpr("DAGManJobId == 1234")
+	if err != nil {
+		t.Fatalf("Failed to parse constraint: %v", err)
+	}
+	for _, tc := range []struct {
+		ad   *classad.ClassAd
+		want bool
+	}{
+		{nodeAd, true},
+		{standaloneAd, false},
+	} {
+		if matched, _ := tc.ad.EvaluateExprWithTarget(constraint, nil).BoolValue(); matched != tc.want {
+			t.Errorf("Constraint matched %v, expected %v", matched, tc.want)
+		}
+	}
+
+	sf, err = ParseSubmitFile(strings.NewReader("executable = /bin/analyze\ndagman_job_id = 12x\nqueue\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	if _, err := sf.MakeJobAd(JobID{Cluster: 1302, Proc: 0}, map[string]string{}); err == nil {
+		t.Error("Expected an error for a non-integer dagman_job_id")
+	}
+}
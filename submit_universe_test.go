@@ -113,6 +113,112 @@ arguments = -np 32 ./my_mpi_app
 	// Note: ClassAd attribute verification requires the ClassAd API
 }
 
+func TestParallelUniverseNodeBlocks(t *testing.T) {
+	// A head node and four workers with less memory, scheduled in groups
+	submit := `
+universe = parallel
+executable = /usr/bin/mpiexec
+output = node_$(Node).out
++WantParallelSchedulingGroups = True
++ParallelShutdownPolicy = "WAIT_FOR_ALL"
+
+machine_count = 1
+request_memory = 8192
+queue
+
+machine_count = 4
+request_memory = 2048
+queue
+`
+	sf, err := ParseSubmitFile(strings.NewReader(submit))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	result, err := sf.Submit(200)
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if len(result.ProcAds) != 2 {
+		t.Fatalf("Expected 2 node blocks, got %d", len(result.ProcAds))
+	}
+
+	for i, want := range []struct{ hosts, memory int64 }{{1, 8192}, {4, 2048}} {
+		ad := result.ProcAds[i]
+		for _, attr := range []string{"MachineCount", "MinHosts", "MaxHosts"} {
+			if got, ok := ad.EvaluateAttrInt(attr); !ok || got != want.hosts {
+				t.Errorf("Proc %d: expected %s %d, got %d", i, attr, want.hosts, got)
+			}
+		}
+		if got, ok := ad.EvaluateAttrInt("RequestMemory"); !ok || got != want.memory {
+			t.Errorf("Proc %d: expected RequestMemory %d, got %d", i, want.memory, got)
+		}
+		for _, attr := range []string{"WantIOProxy", "JobRequiresSandbox", "WantParallelSchedulingGroups"} {
+			if got, ok := ad.EvaluateAttrBool(attr); !ok || !got {
+				t.Errorf("Proc %d: expected %s = true", i, attr)
+			}
+		}
+		if got, _ := ad.EvaluateAttrString("ParallelShutdownPolicy"); got != "WAIT_FOR_ALL" {
+			t.Errorf("Proc %d: expected ParallelShutdownPolicy WAIT_FOR_ALL, got %q", i, got)
+		}
+		// The shadow fills in the node number
+		if got, _ := ad.EvaluateAttrString("Out"); got != "node_#pArAlLeLnOdE#.out" {
+			t.Errorf("Proc %d: expected Out with the node placeholder, got %q", i, got)
+		}
+	}
+}
+
+func TestParallelMachineCountValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		submit  string
+		wantErr bool
+		hosts   int64
+	}{
+		{"missing", "universe = parallel\nexecutable = /bin/true\n", true, 0},
+		{"zero", "universe = parallel\nexecutable = /bin/true\nmachine_count = 0\n", true, 0},
+		{"not a number", "universe = parallel\nexecutable = /bin/true\nmachine_count = many\n", true, 0},
+		{"node_count", "universe = parallel\nexecutable = /bin/true\nnode_count = 3\n", false, 3},
+		// Dedicated scheduling of a vanilla job needs a machine count too
+		{"vanilla", "universe = vanilla\nexecutable = /bin/true\nwant_parallel_scheduling = true\n", true, 0},
+		{"vanilla dedicated", "universe = vanilla\nexecutable = /bin/true\nwant_parallel_scheduling = true\nmachine_count = 2\n", false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, err := ParseSubmitFile(strings.NewReader(tt.submit))
+			if err != nil {
+				t.Fatalf("Failed to parse submit file: %v", err)
+			}
+			ad, err := sf.MakeJobAd(JobID{Cluster: 1}, map[string]string{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MakeJobAd failed: %v", err)
+			}
+			if got, ok := ad.EvaluateAttrInt("MinHosts"); !ok || got != tt.hosts {
+				t.Errorf("Expected MinHosts %d, got %d", tt.hosts, got)
+			}
+		})
+	}
+
+	// Vanilla jobs are not dedicated unless they ask
+	sf, err := ParseSubmitFile(strings.NewReader("universe = vanilla\nexecutable = /bin/true\n"))
+	if err != nil {
+		t.Fatalf("Failed to parse submit file: %v", err)
+	}
+	ad, err := sf.MakeJobAd(JobID{Cluster: 1}, map[string]string{})
+	if err != nil {
+		t.Fatalf("MakeJobAd failed: %v", err)
+	}
+	if _, ok := ad.Lookup("MinHosts"); ok {
+		t.Error("Expected no MinHosts for a vanilla job")
+	}
+}
+
 func TestJavaUniverseParameters(t *testing.T) {
 	submit := `
 universe = java
@@ -295,8 +401,8 @@ queue datafile in (data1.txt, data2.txt, data3.txt)
 	}
 
 	// Should create 3 jobs
-	if sf.queueCount != 3 {
-		t.Errorf("Expected queue count 3, got %d", sf.queueCount)
+	if sf.queueCount() != 3 {
+		t.Errorf("Expected queue count 3, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(2000)
@@ -340,8 +446,8 @@ queue config in (config_a, config_b)
 	}
 
 	// Should create 2 jobs
-	if sf.queueCount != 2 {
-		t.Errorf("Expected queue count 2, got %d", sf.queueCount)
+	if sf.queueCount() != 2 {
+		t.Errorf("Expected queue count 2, got %d", sf.queueCount())
 	}
 
 	result, err := sf.Submit(2001)
@@ -0,0 +1,61 @@
+package htcondor
+
+import (
+	"context"
+
+	"github.com/bbockelm/cedar/client"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// Connection is an established CEDAR connection to a daemon.
+// *client.HTCondorClient satisfies this interface.
+type Connection interface {
+	// GetStream returns the CEDAR stream used to exchange messages with the daemon
+	GetStream() *stream.Stream
+	// Close closes the underlying connection
+	Close() error
+}
+
+// Transport establishes CEDAR connections to a daemon address.
+// The default transport uses the cedar client; tests can supply their own
+// implementation to replay a recorded or scripted protocol exchange.
+type Transport interface {
+	// Connect opens a new connection to the daemon at address.
+	// address can be a hostname:port or a sinful string like "<IP:PORT?addrs=...>"
+	Connect(ctx context.Context, address string) (Connection, error)
+}
+
+// ConnectError reports that a daemon could not be reached, as opposed to a
+// request that reached the daemon and failed. Its message is that of the
+// underlying error.
+type ConnectError struct {
+	Address string
+	Err     error
+}
+
+func (e *ConnectError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying connection error
+func (e *ConnectError) Unwrap() error {
+	return e.Err
+}
+
+// cedarTransport is the default Transport, backed by the cedar client
+type cedarTransport struct{}
+
+// Connect implements Transport using client.ConnectToAddress
+func (cedarTransport) Connect(ctx context.Context, address string) (Connection, error) {
+	htcondorClient, err := client.ConnectToAddress(ctx, address)
+	if err != nil {
+		// Avoid returning a typed nil inside a non-nil interface
+		return nil, &ConnectError{Address: address, Err: err}
+	}
+	return htcondorClient, nil
+}
+
+// DefaultTransport returns the Transport used when none is configured explicitly
+func DefaultTransport() Transport {
+	return cedarTransport{}
+}
@@ -0,0 +1,238 @@
+package htcondor
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/PelicanPlatform/classad/classad"
+	"github.com/bbockelm/cedar/message"
+	"github.com/bbockelm/cedar/security"
+	"github.com/bbockelm/cedar/stream"
+)
+
+// pipeConnection is a Connection backed by one end of a net.Pipe
+type pipeConnection struct {
+	conn   net.Conn
+	stream *stream.Stream
+}
+
+func (c *pipeConnection) GetStream() *stream.Stream { return c.stream }
+func (c *pipeConnection) Close() error              { return c.conn.Close() }
+
+// scriptedTransport is a mock Transport that runs a scripted server
+// on the other end of an in-memory pipe for every connection.
+type scriptedTransport struct {
+	script  func(ctx context.Context, s *stream.Stream) error
+	address string
+	errCh   chan error
+}
+
+func newScriptedTransport(script func(ctx context.Context, s *stream.Stream) error) *scriptedTransport {
+	return &scriptedTransport{script: script, errCh: make(chan error, 1)}
+}
+
+func (t *scriptedTransport) Connect(ctx context.Context, address string) (Connection, error) {
+	t.address = address
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		defer func() { _ = serverConn.Close() }()
+		t.errCh <- t.script(ctx, stream.NewStream(serverConn))
+	}()
+	return &pipeConnection{conn: clientConn, stream: stream.NewStream(clientConn)}, nil
+}
+
+// testSecurityContext returns a context whose security config requires no credentials
+func testSecurityContext(ctx context.Context) context.Context {
+	return WithSecurityConfig(ctx, &security.SecurityConfig{
+		AuthMethods:    []security.AuthMethod{security.AuthNone},
+		Authentication: security.SecurityNever,
+		Encryption:     security.SecurityNever,
+		Integrity:      security.SecurityNever,
+		SessionCache:   security.NewSessionCache(),
+	})
+}
+
+// serverHandshake performs the server side of DC_AUTHENTICATE for the scripted peer
+func serverHandshake(ctx context.Context, s *stream.Stream) error {
+	auth := security.NewAuthenticator(&security.SecurityConfig{
+		AuthMethods:    []security.AuthMethod{security.AuthNone},
+		Authentication: security.SecurityNever,
+		Encryption:     security.SecurityNever,
+		Integrity:      security.SecurityNever,
+	}, s)
+	_, err := auth.ServerHandshake(ctx)
+	return err
+}
+
+// sendMessage sends a single CEDAR message built by fill
+func sendMessage(ctx context.Context, s *stream.Stream, fill func(*message.Message) error) error {
+	msg := message.NewMessageForStream(s)
+	if err := fill(msg); err != nil {
+		return err
+	}
+	return msg.FinishMessage(ctx)
+}
+
+func TestNewScheddWithTransport(t *testing.T) {
+	transport := newScriptedTransport(nil)
+	schedd := NewScheddWithTransport("test_schedd", "schedd.example.com:9618", transport)
+
+	if schedd.Name() != "test_schedd" {
+		t.Errorf("Expected name 'test_schedd', got '%s'", schedd.Name())
+	}
+	if schedd.Address() != "schedd.example.com:9618" {
+		t.Errorf("Expected address 'schedd.example.com:9618', got '%s'", schedd.Address())
+	}
+	if schedd.getTransport() != transport {
+		t.Error("Expected custom transport to be used")
+	}
+
+	if _, ok := NewSchedd("test", "localhost:9618").getTransport().(cedarTransport); !ok {
+		t.Error("Expected NewSchedd to use the default cedar transport")
+	}
+}
+
+//nolint:gocyclo // Scripted server replays the full sandbox protocol
+func TestReceiveJobSandboxScriptedTransport(t *testing.T) {
+	fileContent := []byte("hello from the sandbox\n")
+
+	transport := newScriptedTransport(func(ctx context.Context, s *stream.Stream) error {
+		if err := serverHandshake(ctx, s); err != nil {
+			return fmt.Errorf("handshake: %w", err)
+		}
+
+		// Version string and constraint
+		req := message.NewMessageFromStream(s)
+		if _, err := req.GetString(ctx); err != nil {
+			return fmt.Errorf("version: %w", err)
+		}
+		constraint, err := req.GetString(ctx)
+		if err != nil {
+			return fmt.Errorf("constraint: %w", err)
+		}
+		if constraint != "ClusterId == 42" {
+			return fmt.Errorf("unexpected constraint %q", constraint)
+		}
+
+		// One matching job
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 1) }); err != nil {
+			return err
+		}
+		jobAd := classad.New()
+		_ = jobAd.Set("ClusterId", int64(42))
+		_ = jobAd.Set("ProcId", int64(0))
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, jobAd) }); err != nil {
+			return err
+		}
+
+		// Transfer headers: final_transfer flag and xfer_info
+		xferInfo := classad.New()
+		_ = xferInfo.Set("SandboxSize", int64(len(fileContent)))
+		if err := sendMessage(ctx, s, func(m *message.Message) error {
+			if err := m.PutInt32(ctx, 1); err != nil {
+				return err
+			}
+			return m.PutClassAd(ctx, xferInfo)
+		}); err != nil {
+			return err
+		}
+
+		// Transfer one file
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandXferFile)) }); err != nil {
+			return err
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutString(ctx, "output.txt") }); err != nil {
+			return err
+		}
+
+		// GoAhead exchange
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, 300) }); err != nil {
+			return err
+		}
+		if _, err := message.NewMessageFromStream(s).GetClassAd(ctx); err != nil {
+			return fmt.Errorf("client GoAhead: %w", err)
+		}
+		if _, err := message.NewMessageFromStream(s).GetInt32(ctx); err != nil {
+			return fmt.Errorf("client alive_interval: %w", err)
+		}
+		goAhead := classad.New()
+		_ = goAhead.Set("Result", int64(2))
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutClassAd(ctx, goAhead) }); err != nil {
+			return err
+		}
+
+		// Permissions, size, and data
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt64(ctx, 0644) }); err != nil {
+			return err
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error {
+			if err := m.PutInt64(ctx, int64(len(fileContent))); err != nil {
+				return err
+			}
+			return m.PutInt32(ctx, 256*1024)
+		}); err != nil {
+			return err
+		}
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutBytes(ctx, fileContent) }); err != nil {
+			return err
+		}
+
+		// End of files for this job
+		if err := sendMessage(ctx, s, func(m *message.Message) error { return m.PutInt32(ctx, int32(CommandFinished)) }); err != nil {
+			return err
+		}
+
+		// Final OK from the client
+		reply, err := message.NewMessageFromStream(s).GetInt32(ctx)
+		if err != nil {
+			return fmt.Errorf("final reply: %w", err)
+		}
+		if reply != 0 {
+			return fmt.Errorf("unexpected final reply %d", reply)
+		}
+		return nil
+	})
+
+	schedd := NewScheddWithTransport("test_schedd", "mock-schedd:9618", transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = testSecurityContext(ctx)
+
+	var buf bytes.Buffer
+	if err := <-schedd.ReceiveJobSandbox(ctx, "ClusterId == 42", &buf); err != nil {
+		t.Fatalf("ReceiveJobSandbox failed: %v", err)
+	}
+	if err := <-transport.errCh; err != nil {
+		t.Fatalf("Scripted server failed: %v", err)
+	}
+	if transport.address != "mock-schedd:9618" {
+		t.Errorf("Expected transport to connect to 'mock-schedd:9618', got '%s'", transport.address)
+	}
+
+	tr := tar.NewReader(&buf)
+	header, err := tr.Next()
+	if err != nil {
+		t.Fatalf("Failed to read tar entry: %v", err)
+	}
+	if header.Name != "output.txt" {
+		t.Errorf("Expected tar entry 'output.txt', got '%s'", header.Name)
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		t.Fatalf("Failed to read tar data: %v", err)
+	}
+	if !bytes.Equal(data, fileContent) {
+		t.Errorf("Expected file content %q, got %q", fileContent, data)
+	}
+	if _, err := tr.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected a single tar entry, got err=%v", err)
+	}
+}
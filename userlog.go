@@ -0,0 +1,375 @@
+package htcondor
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultUserLogPollInterval is how often FollowUserLog checks the log for
+// new events
+const DefaultUserLogPollInterval = time.Second
+
+// ErrInvalidUserLog is returned (wrapped) when a user log holds something
+// other than events in the default text format
+var ErrInvalidUserLog = errors.New("invalid user log")
+
+// maxUserLogEventLines bounds the lines of one event, so that a file that is
+// not a user log is not buffered whole
+const maxUserLogEventLines = 1000
+
+// maxUserLogLineBytes bounds the length of one line, for the same reason
+const maxUserLogLineBytes = 64 * 1024
+
+// JobEventType is the event number of a user log event
+type JobEventType int
+
+// Event numbers as written in the user log (ULogEventNumber in HTCondor)
+const (
+	EventSubmit           JobEventType = 0
+	EventExecute          JobEventType = 1
+	EventExecutableError  JobEventType = 2
+	EventCheckpointed     JobEventType = 3
+	EventJobEvicted       JobEventType = 4
+	EventJobTerminated    JobEventType = 5
+	EventImageSize        JobEventType = 6
+	EventShadowException  JobEventType = 7
+	EventGeneric          JobEventType = 8
+	EventJobAborted       JobEventType = 9
+	EventJobSuspended     JobEventType = 10
+	EventJobUnsuspended   JobEventType = 11
+	EventJobHeld          JobEventType = 12
+	EventJobReleased      JobEventType = 13
+	EventJobDisconnected  JobEventType = 22
+	EventJobReconnected   JobEventType = 23
+	EventJobReconnectFail JobEventType = 24
+	EventFileTransfer     JobEventType = 40
+)
+
+// jobEventNames are the names of the event numbers, indexed by number, as in
+// the Python bindings' JobEventType
+var jobEventNames = []string{
+	"SUBMIT", "EXECUTE", "EXECUTABLE_ERROR", "CHECKPOINTED", "JOB_EVICTED",
+	"JOB_TERMINATED", "IMAGE_SIZE", "SHADOW_EXCEPTION", "GENERIC", "JOB_ABORTED",
+	"JOB_SUSPENDED", "JOB_UNSUSPENDED", "JOB_HELD", "JOB_RELEASED", "NODE_EXECUTE",
+	"NODE_TERMINATED", "POST_SCRIPT_TERMINATED", "GLOBUS_SUBMIT", "GLOBUS_SUBMIT_FAILED", "GLOBUS_RESOURCE_UP",
+	"GLOBUS_RESOURCE_DOWN", "REMOTE_ERROR", "JOB_DISCONNECTED", "JOB_RECONNECTED", "JOB_RECONNECT_FAILED",
+	"GRID_RESOURCE_UP", "GRID_RESOURCE_DOWN", "GRID_SUBMIT", "JOB_AD_INFORMATION", "JOB_STATUS_UNKNOWN",
+	"JOB_STATUS_KNOWN", "JOB_STAGE_IN", "JOB_STAGE_OUT", "ATTRIBUTE_UPDATE", "PRESKIP",
+	"CLUSTER_SUBMIT", "CLUSTER_REMOVE", "FACTORY_PAUSED", "FACTORY_RESUMED", "NONE",
+	"FILE_TRANSFER", "RESERVE_SPACE", "RELEASE_SPACE", "FILE_COMPLETE", "FILE_USED",
+	"FILE_REMOVED", "DATAFLOW_JOB_SKIPPED",
+}
+
+// String returns the event's name, e.g. JOB_TERMINATED
+func (t JobEventType) String() string {
+	if t >= 0 && int(t) < len(jobEventNames) {
+		return jobEventNames[t]
+	}
+	return fmt.Sprintf("JobEventType(%d)", int(t))
+}
+
+// Final reports whether the event ends the job: it terminated or was
+// removed (aborted)
+func (t JobEventType) Final() bool {
+	return t == EventJobTerminated || t == EventJobAborted
+}
+
+// JobEvent is one event read from a job's user log
+type JobEvent struct {
+	Type  JobEventType
+	JobID JobID
+	Time  time.Time
+	// Message is the rest of the event's first line, e.g. "Job terminated."
+	Message string
+	// Details are the event's remaining lines, without their indentation
+	Details []string
+
+	// The fields below are parsed from Message and Details for the events
+	// that carry them, and are zero for the others
+
+	// Host is the submit host of a SUBMIT event, or the execute host of an
+	// EXECUTE event, as a sinful string
+	Host string
+	// Reason is why a job was held (JOB_HELD), released (JOB_RELEASED) or
+	// removed (JOB_ABORTED), or the error of a SHADOW_EXCEPTION event
+	Reason string
+	// HoldReasonCode and HoldReasonSubCode are the codes of a JOB_HELD event
+	HoldReasonCode    int
+	HoldReasonSubCode int
+	// Termination is how the job exited, for JOB_TERMINATED events and
+	// JOB_EVICTED events of jobs that exited while being evicted
+	Termination *JobTermination
+	// Usage is the resource usage reported by JOB_TERMINATED, JOB_EVICTED,
+	// SHADOW_EXCEPTION and IMAGE_SIZE events
+	Usage *JobEventUsage
+}
+
+// EventLogReader is another name for UserLogReader, after HTCondor's name
+// for the user log, the job event log
+type EventLogReader = UserLogReader
+
+// UserLogReader reads events from a user log in the default text format
+// (not the XML or JSON formats). At the end of the available data Next
+// returns io.EOF, keeping any partly written event; calling it again once
+// more has been written continues where it left off, so a reader over a
+// log file can be used to tail it.
+type UserLogReader struct {
+	r       *bufio.Reader
+	partial string   // an incomplete last line, completed by a later read
+	lines   []string // lines of the event being read
+}
+
+// NewUserLogReader creates a reader for the user log read from r
+func NewUserLogReader(r io.Reader) *UserLogReader {
+	return &UserLogReader{r: bufio.NewReader(r)}
+}
+
+// NewEventLogReader is NewUserLogReader
+func NewEventLogReader(r io.Reader) *EventLogReader {
+	return NewUserLogReader(r)
+}
+
+// ParseEventLog reads all the events of a user (job event) log. An
+// incomplete last event, not yet ended by its "..." line because the log is
+// still being written, is left out.
+func ParseEventLog(r io.Reader) ([]JobEvent, error) {
+	reader := NewUserLogReader(r)
+	var events []JobEvent
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+		if err != nil {
+			return events, err
+		}
+		events = append(events, *event)
+	}
+}
+
+// Next returns the next complete event, or io.EOF if there is none yet
+func (u *UserLogReader) Next() (*JobEvent, error) {
+	for {
+		chunk, err := u.r.ReadSlice('\n')
+		if len(u.partial)+len(chunk) > maxUserLogLineBytes {
+			u.partial = ""
+			return nil, fmt.Errorf("%w: line longer than %d bytes", ErrInvalidUserLog, maxUserLogLineBytes)
+		}
+		if err != nil {
+			u.partial += string(chunk)
+			if errors.Is(err, bufio.ErrBufferFull) {
+				continue
+			}
+			return nil, err
+		}
+		line := strings.TrimRight(u.partial+string(chunk), "\r\n")
+		u.partial = ""
+
+		// Each event ends with a line of three dots
+		if line != "..." {
+			if len(u.lines) == maxUserLogEventLines {
+				return nil, fmt.Errorf("%w: event longer than %d lines", ErrInvalidUserLog, maxUserLogEventLines)
+			}
+			u.lines = append(u.lines, line)
+			continue
+		}
+		lines := u.lines
+		u.lines = nil
+		return parseJobEvent(lines)
+	}
+}
+
+// parseJobEvent parses the lines of one event, whose first line is a header
+// such as "005 (123.000.000) 2024-03-05 10:11:12 Job terminated."
+func parseJobEvent(lines []string) (*JobEvent, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: empty event", ErrInvalidUserLog)
+	}
+	number, rest, ok := strings.Cut(lines[0], " ")
+	if !ok || len(number) != 3 {
+		return nil, fmt.Errorf("%w: missing event number", ErrInvalidUserLog)
+	}
+	eventType, err := strconv.Atoi(number)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid event number", ErrInvalidUserLog)
+	}
+
+	id, rest, ok := strings.Cut(rest, " ")
+	if !ok || !strings.HasPrefix(id, "(") || !strings.HasSuffix(id, ")") {
+		return nil, fmt.Errorf("%w: missing job ID in %s event", ErrInvalidUserLog, JobEventType(eventType))
+	}
+	idParts := strings.Split(strings.Trim(id, "()"), ".")
+	if len(idParts) != 3 {
+		return nil, fmt.Errorf("%w: invalid job ID in %s event", ErrInvalidUserLog, JobEventType(eventType))
+	}
+	cluster, cerr := strconv.Atoi(idParts[0])
+	proc, perr := strconv.Atoi(idParts[1])
+	if cerr != nil || perr != nil {
+		return nil, fmt.Errorf("%w: invalid job ID in %s event", ErrInvalidUserLog, JobEventType(eventType))
+	}
+
+	// The timestamp is a date and a time ("2024-03-05 10:11:12", or
+	// "03/05 10:11:12" in old logs), or a single ISO 8601 field
+	fields := strings.SplitN(rest, " ", 3)
+	timestamp, message := fields[0], ""
+	if strings.Contains(timestamp, "T") {
+		message = strings.TrimPrefix(rest, timestamp)
+	} else if len(fields) >= 2 {
+		timestamp += " " + fields[1]
+		if len(fields) == 3 {
+			message = fields[2]
+		}
+	}
+	eventTime, err := parseUserLogTime(timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid time in %s event", ErrInvalidUserLog, JobEventType(eventType))
+	}
+
+	event := &JobEvent{
+		Type:    JobEventType(eventType),
+		JobID:   JobID{Cluster: cluster, Proc: proc},
+		Time:    eventTime,
+		Message: strings.TrimSpace(message),
+	}
+	for _, line := range lines[1:] {
+		if line = strings.TrimSpace(line); line != "" {
+			event.Details = append(event.Details, line)
+		}
+	}
+	event.parseFields()
+	return event, nil
+}
+
+// userLogTimeLayouts are the timestamp formats HTCondor writes, with the
+// date and time separated by a space
+var userLogTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"01/02 15:04:05",
+}
+
+// parseUserLogTime parses an event timestamp. Timestamps without a time zone
+// are in local time, and those without a year in the current year.
+func parseUserLogTime(timestamp string) (time.Time, error) {
+	timestamp = strings.Replace(timestamp, "T", " ", 1)
+	var err error
+	for _, layout := range userLogTimeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, timestamp, time.Local); err == nil {
+			if t.Year() == 0 {
+				t = t.AddDate(time.Now().Year(), 0, 0)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// FollowUserLogOptions configures FollowUserLog
+type FollowUserLogOptions struct {
+	// PollInterval is the time between checks for new events (default: DefaultUserLogPollInterval)
+	PollInterval time.Duration
+	// Job limits the events to one job and ends the follow after the job
+	// terminates or is removed (nil for the events of every job, until ctx
+	// is cancelled)
+	Job *JobID
+	// Open opens the log (default: os.Open), e.g. to check who owns it.
+	// An error matching fs.ErrNotExist is waited out like a missing log.
+	Open func(path string) (*os.File, error)
+}
+
+// FollowUserLog tails the user log at path, emitting its events as they are
+// written, from the start of the log. The log not existing yet is not an
+// error: it is created when the first event is written.
+//
+// The events channel is closed when the follow ends; the error channel then
+// receives nil if it ended with the job's final event, ctx.Err() if ctx was
+// cancelled, or the error that ended it. opts may be nil.
+func FollowUserLog(ctx context.Context, path string, opts *FollowUserLogOptions) (<-chan *JobEvent, <-chan error) {
+	interval := DefaultUserLogPollInterval
+	var job *JobID
+	open := os.Open
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+		job = opts.Job
+		if opts.Open != nil {
+			open = opts.Open
+		}
+	}
+
+	events := make(chan *JobEvent)
+	errCh := make(chan error, 1)
+	go func() {
+		err := followUserLog(ctx, path, interval, job, open, events)
+		close(events)
+		errCh <- err
+	}()
+	return events, errCh
+}
+
+// followUserLog sends the events of the log at path to events until the
+// follow ends, returning the error it ended with
+func followUserLog(ctx context.Context, path string, interval time.Duration, job *JobID,
+	open func(string) (*os.File, error), events chan<- *JobEvent) error {
+	wait := func() error {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+
+	var file *os.File
+	for {
+		var err error
+		if file, err = open(path); err == nil {
+			break
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to open user log: %w", err)
+		}
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+	defer func() { _ = file.Close() }()
+
+	reader := NewUserLogReader(file)
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			if err := wait(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read user log: %w", err)
+		}
+		if job != nil && event.JobID != *job {
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if job != nil && event.Type.Final() {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package htcondor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JobTermination is how a job exited, from a JOB_TERMINATED or JOB_EVICTED
+// event
+type JobTermination struct {
+	// Normal is true if the job exited, false if it was killed by a signal
+	Normal bool
+	// ReturnValue is the exit code of a normal termination
+	ReturnValue int
+	// Signal is the signal that killed the job, for an abnormal termination
+	Signal int
+	// CoreFile is the core file the job dumped, if any
+	CoreFile string
+}
+
+// JobEventUsage is the resource usage an event reports. Only the fields of
+// the lines present in the event are set.
+type JobEventUsage struct {
+	// CPU time of the run the event ends, and of all runs of the job
+	RunRemoteUserCPU   time.Duration
+	RunRemoteSysCPU    time.Duration
+	TotalRemoteUserCPU time.Duration
+	TotalRemoteSysCPU  time.Duration
+
+	// Bytes the job transferred in the run the event ends, and in all runs
+	RunBytesSent       int64
+	RunBytesReceived   int64
+	TotalBytesSent     int64
+	TotalBytesReceived int64
+
+	// Resources are the rows of the partitionable resources table, keyed by
+	// resource name without its unit, e.g. "Cpus", "Disk" (KiB) and
+	// "Memory" (MB)
+	Resources map[string]JobResourceUsage
+
+	// ImageSize (KiB), MemoryUsage (MB) and ResidentSetSize (KiB) are
+	// reported by IMAGE_SIZE events
+	ImageSize       int64
+	MemoryUsage     int64
+	ResidentSetSize int64
+}
+
+// JobResourceUsage is one row of an event's partitionable resources table
+type JobResourceUsage struct {
+	Usage     float64
+	Request   float64
+	Allocated float64
+	// Assigned lists the assigned devices, e.g. GPU IDs
+	Assigned string
+}
+
+// parseFields sets the event-specific fields from the message and details.
+// Lines that do not parse are skipped: the text remains in Details.
+func (e *JobEvent) parseFields() {
+	switch e.Type {
+	case EventSubmit, EventExecute:
+		if _, host, ok := strings.Cut(e.Message, "host: "); ok {
+			e.Host = strings.TrimSpace(host)
+		}
+	case EventJobHeld:
+		for _, line := range e.Details {
+			var code, subcode int
+			if n, _ := fmt.Sscanf(line, "Code %d Subcode %d", &code, &subcode); n == 2 {
+				e.HoldReasonCode, e.HoldReasonSubCode = code, subcode
+			} else if e.Reason == "" {
+				e.Reason = line
+			}
+		}
+	case EventJobReleased, EventJobAborted:
+		if len(e.Details) > 0 {
+			e.Reason = e.Details[0]
+		}
+	case EventShadowException:
+		if len(e.Details) > 0 {
+			e.Reason = e.Details[0]
+		}
+		e.parseUsage()
+	case EventJobTerminated, EventJobEvicted:
+		e.parseTermination()
+		e.parseUsage()
+	case EventImageSize:
+		usage := &JobEventUsage{}
+		if _, size, ok := strings.Cut(e.Message, ": "); ok {
+			usage.ImageSize, _ = strconv.ParseInt(strings.TrimSpace(size), 10, 64)
+		}
+		for _, line := range e.Details {
+			value, label, ok := cutUsageLine(line)
+			if !ok {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(label, "MemoryUsage"):
+				usage.MemoryUsage, _ = strconv.ParseInt(value, 10, 64)
+			case strings.HasPrefix(label, "ResidentSetSize"):
+				usage.ResidentSetSize, _ = strconv.ParseInt(value, 10, 64)
+			}
+		}
+		e.Usage = usage
+	}
+}
+
+// parseTermination reads lines such as "(1) Normal termination (return
+// value 0)", "(0) Abnormal termination (signal 9)" and "(1) Corefile in:
+// core.123"
+func (e *JobEvent) parseTermination() {
+	for _, line := range e.Details {
+		var value int
+		switch {
+		case strings.Contains(line, "Normal termination (return value"):
+			if _, rest, ok := strings.Cut(line, "(return value "); ok {
+				if n, _ := fmt.Sscanf(rest, "%d)", &value); n == 1 {
+					e.termination().ReturnValue = value
+					e.Termination.Normal = true
+				}
+			}
+		case strings.Contains(line, "Abnormal termination (signal"):
+			if _, rest, ok := strings.Cut(line, "(signal "); ok {
+				if n, _ := fmt.Sscanf(rest, "%d)", &value); n == 1 {
+					e.termination().Signal = value
+				}
+			}
+		case strings.Contains(line, "Corefile in:"):
+			_, path, _ := strings.Cut(line, "Corefile in:")
+			e.termination().CoreFile = strings.TrimSpace(path)
+		}
+	}
+}
+
+// termination returns the event's Termination, creating it if need be
+func (e *JobEvent) termination() *JobTermination {
+	if e.Termination == nil {
+		e.Termination = &JobTermination{}
+	}
+	return e.Termination
+}
+
+// parseUsage reads the CPU usage, bytes transferred and partitionable
+// resources lines of an event
+func (e *JobEvent) parseUsage() {
+	usage := &JobEventUsage{}
+	found := false
+	var columns []string
+	for _, line := range e.Details {
+		if columns != nil {
+			name, values, ok := strings.Cut(line, ":")
+			name, _, _ = strings.Cut(strings.TrimSpace(name), " (")
+			if ok && name != "" && !strings.Contains(name, " ") {
+				if usage.Resources == nil {
+					usage.Resources = make(map[string]JobResourceUsage)
+				}
+				usage.Resources[name] = parseResourceRow(columns, strings.Fields(values))
+				continue
+			}
+			columns = nil
+		}
+		if header, cols, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(header) == "Partitionable Resources" {
+			columns = strings.Fields(cols)
+			found = true
+			continue
+		}
+
+		if user, sys, label, ok := cutCPUUsageLine(line); ok {
+			switch label {
+			case "Run Remote Usage":
+				usage.RunRemoteUserCPU, usage.RunRemoteSysCPU = user, sys
+			case "Total Remote Usage":
+				usage.TotalRemoteUserCPU, usage.TotalRemoteSysCPU = user, sys
+			}
+			found = true
+			continue
+		}
+		value, label, ok := cutUsageLine(line)
+		if !ok {
+			continue
+		}
+		bytes, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch label {
+		case "Run Bytes Sent By Job":
+			usage.RunBytesSent = bytes
+		case "Run Bytes Received By Job":
+			usage.RunBytesReceived = bytes
+		case "Total Bytes Sent By Job":
+			usage.TotalBytesSent = bytes
+		case "Total Bytes Received By Job":
+			usage.TotalBytesReceived = bytes
+		default:
+			continue
+		}
+		found = true
+	}
+	if found {
+		e.Usage = usage
+	}
+}
+
+// parseResourceRow maps the values of a resources table row to the header
+// columns (Usage, Request, Allocated and possibly Assigned). A resource
+// without a measured usage has that column blank.
+func parseResourceRow(columns, values []string) JobResourceUsage {
+	if len(values) < len(columns) && len(columns) > 0 && columns[0] == "Usage" {
+		columns = columns[1:]
+	}
+	var row JobResourceUsage
+	for i, column := range columns {
+		if i >= len(values) {
+			break
+		}
+		number, _ := strconv.ParseFloat(values[i], 64)
+		switch column {
+		case "Usage":
+			row.Usage = number
+		case "Request":
+			row.Request = number
+		case "Allocated":
+			row.Allocated = number
+		case "Assigned":
+			row.Assigned = strings.Join(values[i:], " ")
+		}
+	}
+	return row
+}
+
+// cutUsageLine splits a line such as "33  -  Run Bytes Received By Job"
+// into its value and label
+func cutUsageLine(line string) (value, label string, ok bool) {
+	value, label, ok = strings.Cut(line, " - ")
+	return strings.TrimSpace(value), strings.TrimSpace(label), ok
+}
+
+// cutCPUUsageLine parses a line such as "Usr 0 00:00:01, Sys 0 00:00:00  -
+// Run Remote Usage"
+func cutCPUUsageLine(line string) (user, sys time.Duration, label string, ok bool) {
+	times, label, ok := cutUsageLine(line)
+	if !ok {
+		return 0, 0, "", false
+	}
+	var ud, uh, um, us, sd, sh, sm, ss int
+	if n, _ := fmt.Sscanf(times, "Usr %d %d:%d:%d, Sys %d %d:%d:%d", &ud, &uh, &um, &us, &sd, &sh, &sm, &ss); n != 8 {
+		return 0, 0, "", false
+	}
+	duration := func(d, h, m, s int) time.Duration {
+		return time.Duration(((d*24+h)*60+m)*60+s) * time.Second
+	}
+	return duration(ud, uh, um, us), duration(sd, sh, sm, ss), label, true
+}
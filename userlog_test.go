@@ -0,0 +1,278 @@
+package htcondor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testUserLogEvents are the events of a short job, as HTCondor logs them
+var testUserLogEvents = []string{
+	"000 (042.000.000) 2024-03-05 10:11:12 Job submitted from host: <127.0.0.1:9618?addrs=127.0.0.1-9618>\n...\n",
+	"001 (042.000.000) 2024-03-05 10:11:15 Job executing on host: <127.0.0.1:9619?addrs=127.0.0.1-9619>\n...\n",
+	"005 (042.000.000) 2024-03-05 10:11:20 Job terminated.\n" +
+		"\t(1) Normal termination (return value 0)\n" +
+		"\t\tUsr 0 00:00:00, Sys 0 00:00:00  -  Run Remote Usage\n" +
+		"...\n",
+}
+
+func TestUserLogReader(t *testing.T) {
+	reader := NewUserLogReader(strings.NewReader(strings.Join(testUserLogEvents, "")))
+
+	var events []*JobEvent
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		events = append(events, event)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	wantTypes := []JobEventType{EventSubmit, EventExecute, EventJobTerminated}
+	for i, event := range events {
+		if event.Type != wantTypes[i] {
+			t.Errorf("Event %d: type %s, expected %s", i, event.Type, wantTypes[i])
+		}
+		if event.JobID != (JobID{Cluster: 42, Proc: 0}) {
+			t.Errorf("Event %d: job %v, expected 42.0", i, event.JobID)
+		}
+	}
+	terminated := events[2]
+	if terminated.Message != "Job terminated." {
+		t.Errorf("Message = %q", terminated.Message)
+	}
+	if len(terminated.Details) != 2 || terminated.Details[0] != "(1) Normal termination (return value 0)" {
+		t.Errorf("Details = %q", terminated.Details)
+	}
+	if want := time.Date(2024, 3, 5, 10, 11, 20, 0, time.Local); !terminated.Time.Equal(want) {
+		t.Errorf("Time = %v, expected %v", terminated.Time, want)
+	}
+	if !terminated.Type.Final() || events[1].Type.Final() {
+		t.Error("Expected only JOB_TERMINATED to be final")
+	}
+}
+
+func TestUserLogReaderLongLine(t *testing.T) {
+	// Lines longer than the read buffer are read whole
+	detail := strings.Repeat("x", 10000)
+	events, err := ParseEventLog(strings.NewReader("000 (042.000.000) 2024-03-05 10:11:12 Job submitted\n\t" + detail + "\n...\n"))
+	if err != nil {
+		t.Fatalf("ParseEventLog failed: %v", err)
+	}
+	if len(events) != 1 || len(events[0].Details) != 1 || events[0].Details[0] != detail {
+		t.Errorf("Expected one event with the long detail line, got %+v", events)
+	}
+
+	// A line without an end is not buffered whole
+	_, err = ParseEventLog(io.MultiReader(strings.NewReader("000 (042.000.000) 2024-03-05 10:11:12 "),
+		io.LimitReader(zeroReader{}, 2*maxUserLogLineBytes)))
+	if !errors.Is(err, ErrInvalidUserLog) {
+		t.Errorf("Expected ErrInvalidUserLog for an overlong line, got %v", err)
+	}
+}
+
+func TestUserLogReaderTimestamps(t *testing.T) {
+	year := time.Now().Year()
+	tests := []struct {
+		header string
+		want   time.Time
+	}{
+		{"012 (001.002.000) 2024-03-05T10:11:12 Job was held.", time.Date(2024, 3, 5, 10, 11, 12, 0, time.Local)},
+		{"012 (001.002.000) 2024-03-05T10:11:12.250Z Job was held.", time.Date(2024, 3, 5, 10, 11, 12, 250000000, time.UTC)},
+		{"012 (001.002.000) 03/05 10:11:12 Job was held.", time.Date(year, 3, 5, 10, 11, 12, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		event, err := NewUserLogReader(strings.NewReader(tt.header + "\n...\n")).Next()
+		if err != nil {
+			t.Errorf("%s: %v", tt.header, err)
+			continue
+		}
+		if !event.Time.Equal(tt.want) || event.Message != "Job was held." || event.JobID != (JobID{Cluster: 1, Proc: 2}) {
+			t.Errorf("%s: got time %v, message %q, job %v", tt.header, event.Time, event.Message, event.JobID)
+		}
+	}
+
+	if _, err := NewUserLogReader(strings.NewReader("root:x:0:0:root:/root:/bin/sh\n...\n")).Next(); !errors.Is(err, ErrInvalidUserLog) {
+		t.Errorf("Expected ErrInvalidUserLog for a file that is not a user log, got %v", err)
+	}
+}
+
+// testEventLog is a log with the event-specific lines HTCondor writes
+const testEventLog = `000 (007.001.000) 2024-03-05 10:11:12 Job submitted from host: <10.0.0.1:9618?addrs=10.0.0.1-9618>
+...
+012 (007.001.000) 2024-03-05 10:11:13 Job was held.
+	Spooling input data files
+	Code 16 Subcode 0
+...
+013 (007.001.000) 2024-03-05 10:11:14 Job was released.
+	Data files spooled
+...
+001 (007.001.000) 2024-03-05 10:11:15 Job executing on host: <10.0.0.2:9618?addrs=10.0.0.2-9618>
+	SlotName: slot1_1@worker
+...
+006 (007.001.000) 2024-03-05 10:11:20 Image size of job updated: 2500
+	3  -  MemoryUsage of job (MB)
+	2484  -  ResidentSetSize of job (KB)
+...
+005 (007.001.000) 2024-03-05 10:12:20 Job terminated.
+	(1) Normal termination (return value 3)
+		Usr 0 00:00:51, Sys 0 00:00:02  -  Run Remote Usage
+		Usr 0 00:00:00, Sys 0 00:00:00  -  Run Local Usage
+		Usr 1 00:00:51, Sys 0 00:00:02  -  Total Remote Usage
+		Usr 0 00:00:00, Sys 0 00:00:00  -  Total Local Usage
+	120  -  Run Bytes Sent By Job
+	33  -  Run Bytes Received By Job
+	240  -  Total Bytes Sent By Job
+	66  -  Total Bytes Received By Job
+	Partitionable Resources :    Usage  Request Allocated
+	   Cpus                 :                 1         1
+	   Disk (KB)            :       40     1024   1234567
+	   Memory (MB)          :        3      128       128
+
+	Job terminated of its own accord at 2024-03-05T10:12:20Z with exit-code 3.
+...
+004 (007.002.000) 2024-03-05 10:13:00 Job was evicted.
+	(0) Job was not checkpointed.
+	(0) Abnormal termination (signal 9)
+	(1) Corefile in: /tmp/core.7.2
+...
+009 (007.003.000) 2024-03-05 10:13:01 Job was aborted.
+	via condor_rm (by user alice)
+...
+000 (007.004.000) 2024-03-05 10:13:02 Job submitted from host: <10.0.0.1:9618>
+	incomplete, still being written
+`
+
+func TestParseEventLog(t *testing.T) {
+	events, err := ParseEventLog(strings.NewReader(testEventLog))
+	if err != nil {
+		t.Fatalf("ParseEventLog failed: %v", err)
+	}
+	want := []JobEventType{EventSubmit, EventJobHeld, EventJobReleased, EventExecute, EventImageSize, EventJobTerminated, EventJobEvicted, EventJobAborted}
+	if len(events) != len(want) {
+		t.Fatalf("Expected %d events, got %d", len(want), len(events))
+	}
+	for i, event := range events {
+		if event.Type != want[i] {
+			t.Errorf("Event %d: type %s, expected %s", i, event.Type, want[i])
+		}
+	}
+
+	if events[0].Host != "<10.0.0.1:9618?addrs=10.0.0.1-9618>" || events[3].Host != "<10.0.0.2:9618?addrs=10.0.0.2-9618>" {
+		t.Errorf("Unexpected hosts %q and %q", events[0].Host, events[3].Host)
+	}
+	if held := events[1]; held.Reason != "Spooling input data files" || held.HoldReasonCode != 16 || held.HoldReasonSubCode != 0 {
+		t.Errorf("Unexpected hold: %q, code %d/%d", held.Reason, held.HoldReasonCode, held.HoldReasonSubCode)
+	}
+	if events[2].Reason != "Data files spooled" || events[7].Reason != "via condor_rm (by user alice)" {
+		t.Errorf("Unexpected reasons %q and %q", events[2].Reason, events[7].Reason)
+	}
+	if usage := events[4].Usage; usage == nil || usage.ImageSize != 2500 || usage.MemoryUsage != 3 || usage.ResidentSetSize != 2484 {
+		t.Errorf("Unexpected image size usage: %+v", usage)
+	}
+
+	terminated := events[5]
+	if term := terminated.Termination; term == nil || !term.Normal || term.ReturnValue != 3 {
+		t.Errorf("Unexpected termination: %+v", term)
+	}
+	usage := terminated.Usage
+	if usage == nil {
+		t.Fatal("Expected usage in the terminated event")
+	}
+	if usage.RunRemoteUserCPU != 51*time.Second || usage.RunRemoteSysCPU != 2*time.Second || usage.TotalRemoteUserCPU != 24*time.Hour+51*time.Second {
+		t.Errorf("Unexpected CPU usage: %+v", usage)
+	}
+	if usage.RunBytesSent != 120 || usage.RunBytesReceived != 33 || usage.TotalBytesSent != 240 || usage.TotalBytesReceived != 66 {
+		t.Errorf("Unexpected bytes: %+v", usage)
+	}
+	wantResources := map[string]JobResourceUsage{
+		"Cpus":   {Request: 1, Allocated: 1},
+		"Disk":   {Usage: 40, Request: 1024, Allocated: 1234567},
+		"Memory": {Usage: 3, Request: 128, Allocated: 128},
+	}
+	if len(usage.Resources) != len(wantResources) {
+		t.Errorf("Expected resources %v, got %v", wantResources, usage.Resources)
+	}
+	for name, want := range wantResources {
+		if got := usage.Resources[name]; got != want {
+			t.Errorf("Resource %s: got %+v, expected %+v", name, got, want)
+		}
+	}
+
+	if term := events[6].Termination; term == nil || term.Normal || term.Signal != 9 || term.CoreFile != "/tmp/core.7.2" {
+		t.Errorf("Unexpected eviction termination: %+v", term)
+	}
+}
+
+func TestFollowUserLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.log")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The log does not exist until the job's first event
+	events, errCh := FollowUserLog(ctx, path, &FollowUserLogOptions{
+		PollInterval: 10 * time.Millisecond,
+		Job:          &JobID{Cluster: 42, Proc: 0},
+	})
+
+	go func() {
+		// Events are written a piece at a time, interleaved with another job's
+		writes := []string{
+			testUserLogEvents[0][:20], testUserLogEvents[0][20:],
+			"001 (043.000.000) 2024-03-05 10:11:14 Job executing on host: <127.0.0.1:9619>\n...\n",
+			testUserLogEvents[1],
+			testUserLogEvents[2],
+			"000 (044.000.000) 2024-03-05 10:12:00 Job submitted from host: <127.0.0.1:9618>\n...\n",
+		}
+		for _, data := range writes {
+			time.Sleep(20 * time.Millisecond)
+			file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+			if err != nil {
+				return
+			}
+			_, _ = file.WriteString(data)
+			_ = file.Close()
+		}
+	}()
+
+	var got []JobEventType
+	for event := range events {
+		got = append(got, event.Type)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("FollowUserLog failed: %v", err)
+	}
+	want := []JobEventType{EventSubmit, EventExecute, EventJobTerminated}
+	if len(got) != len(want) {
+		t.Fatalf("Got events %v, expected %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Event %d: %s, expected %s", i, got[i], want[i])
+		}
+	}
+
+	// Without a job, the follow runs until cancelled
+	ctx, cancel = context.WithCancel(context.Background())
+	events, errCh = FollowUserLog(ctx, path, &FollowUserLogOptions{PollInterval: 10 * time.Millisecond})
+	for i := 0; i < 5; i++ {
+		<-events
+	}
+	cancel()
+	for range events {
+	}
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}